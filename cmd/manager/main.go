@@ -23,16 +23,24 @@ import (
 	"github.com/go-logr/zapr"
 	"go.uber.org/zap"
 
+	"k8c.io/application-catalog-manager/internal/controllers/catalogsource"
+	"k8c.io/application-catalog-manager/internal/controllers/seedsync"
 	"k8c.io/application-catalog-manager/internal/controllers/synchronizer"
 	aclog "k8c.io/application-catalog-manager/internal/pkg/log"
+	"k8c.io/application-catalog-manager/internal/pkg/storagemigration"
+	"k8c.io/application-catalog-manager/internal/pkg/version"
 	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
 	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	ctrlruntimelog "sigs.k8s.io/controller-runtime/pkg/log"
@@ -49,6 +57,7 @@ func init() {
 	utilruntime.Must(kubermaticv1.AddToScheme(scheme))
 	utilruntime.Must(appskubermaticv1.AddToScheme(scheme))
 	utilruntime.Must(catalogv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
 }
 
 type flags struct {
@@ -57,6 +66,9 @@ type flags struct {
 	healthProbeAddress     string
 	metricsAddress         string
 	namespace              string
+	requireSignatures      bool
+	masterMode             bool
+	migrateStorageVersion  bool
 }
 
 func main() {
@@ -69,6 +81,9 @@ func main() {
 	flag.StringVar(&f.healthProbeAddress, "health-probe-address", "127.0.0.1:8085", "The address on which the liveness check on /healthz and readiness check on /readyz will be available")
 	flag.StringVar(&f.metricsAddress, "metrics-address", "127.0.0.1:8080", "The address on which Prometheus metrics will be available under /metrics")
 	flag.StringVar(&f.namespace, "namespace", "kubermatic", "The namespace where the operator is deployed")
+	flag.BoolVar(&f.requireSignatures, "require-source-signatures", false, "Refuse ApplicationCatalogSource content without valid signature, regardless of the verification policy of the source")
+	flag.BoolVar(&f.migrateStorageVersion, "migrate-storage-version", true, "Rewrite all ApplicationCatalogs stored in an older version in the storage version of the CRD")
+	flag.BoolVar(&f.masterMode, "master-mode", false, "Synchronize the ApplicationDefinitions to the KKP Seeds in the namespace. The kubeconfig Secrets of the Seeds must be in the same namespace, the manager refuses to start otherwise")
 
 	flag.Parse()
 
@@ -84,6 +99,14 @@ func main() {
 		Metrics: metricsserver.Options{
 			BindAddress: f.metricsAddress,
 		},
+		// ConfigMaps and Secrets are only read from the manager namespace, so there
+		// is no need to cache them cluster-wide.
+		Cache: cache.Options{
+			ByObject: map[ctrlruntimeclient.Object]cache.ByObject{
+				&corev1.ConfigMap{}: {Namespaces: map[string]cache.Config{f.namespace: {}}},
+				&corev1.Secret{}:    {Namespaces: map[string]cache.Config{f.namespace: {}}},
+			},
+		},
 	}
 
 	mgr, err := manager.New(config.GetConfigOrDie(), options)
@@ -101,14 +124,49 @@ func main() {
 	err = synchronizer.Add(mgr, &synchronizer.ControllerConfig{
 		Log:                    rawLog.Sugar().Named("synchronizer"),
 		ReconciliationInterval: f.reconciliationInterval,
+		Namespace:              f.namespace,
 	})
 	if err != nil {
 		l.Fatalf("Failed to add synchronizer controller: %v", err)
 	}
 
-	l.Infof("Starting manager, with reconciliation interval %s", f.reconciliationInterval)
+	err = catalogsource.Add(mgr, &catalogsource.ControllerConfig{
+		Log:               rawLog.Sugar().Named("catalogsource"),
+		Namespace:         f.namespace,
+		RequireSignatures: f.requireSignatures,
+	})
+	if err != nil {
+		l.Fatalf("Failed to add catalog source controller: %v", err)
+	}
+
+	if f.migrateStorageVersion {
+		if err := mgr.Add(storagemigration.New(mgr.GetClient(), mgr.GetAPIReader(), rawLog.Sugar().Named("storagemigration"))); err != nil {
+			l.Fatalf("Failed to add storage migration: %v", err)
+		}
+	}
+
+	ctx := ctrl.SetupSignalHandler()
+
+	if f.masterMode {
+		// The manager only caches Secrets of its own namespace, so Seeds referencing kubeconfig
+		// Secrets elsewhere could never be synchronized.
+		if err := seedsync.ValidateSeeds(ctx, mgr.GetAPIReader(), f.namespace); err != nil {
+			l.Fatalf("Invalid Seeds: %v", err)
+		}
+
+		err = seedsync.Add(mgr, &seedsync.ControllerConfig{
+			Log:                    rawLog.Sugar().Named("seedsync"),
+			ReconciliationInterval: f.reconciliationInterval,
+			Namespace:              f.namespace,
+		})
+		if err != nil {
+			l.Fatalf("Failed to add seed sync controller: %v", err)
+		}
+	}
+
+	l.Infof("Starting manager %s, with reconciliation interval %s", version.Get(), f.reconciliationInterval)
 
-	if err = mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+	if err = mgr.Start(ctx); err != nil {
 		l.Fatalf("Failed to start manager: %v", err)
 	}
 }
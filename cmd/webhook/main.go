@@ -19,26 +19,39 @@ package main
 import (
 	"flag"
 	"log"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/go-logr/zapr"
 	"go.uber.org/zap"
 
+	"k8c.io/application-catalog-manager/internal/controllers/crdconversion"
 	applicationcatalogmutation "k8c.io/application-catalog-manager/internal/pkg/admission/applicationcatalog/mutation"
 	applicationcatalogvalidation "k8c.io/application-catalog-manager/internal/pkg/admission/applicationcatalog/validation"
+	applicationdefinitionvalidation "k8c.io/application-catalog-manager/internal/pkg/admission/applicationdefinition/validation"
+	"k8c.io/application-catalog-manager/internal/pkg/chartschema"
 	aclog "k8c.io/application-catalog-manager/internal/pkg/log"
+	"k8c.io/application-catalog-manager/internal/pkg/repositorypolicy"
 	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	catalogv1beta1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1beta1"
 	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
 
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	ctrlruntimelog "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
 )
 
 var (
@@ -48,6 +61,8 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(catalogv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(catalogv1beta1.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
 	utilruntime.Must(appskubermaticv1.AddToScheme(scheme))
 }
 
@@ -56,6 +71,14 @@ type options struct {
 	probeAddr   string
 	certDir     string
 	webhookPort int
+
+	namespace            string
+	managerUsernames     []string
+	repositoryPolicyFile string
+
+	conversionServiceName string
+	conversionServicePort int
+	conversionCAFile      string
 }
 
 func main() {
@@ -67,6 +90,19 @@ func main() {
 	flag.StringVar(&opt.probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to")
 	flag.StringVar(&opt.certDir, "cert-dir", "/tmp/k8s-webhook-server/serving-certs", "Directory containing TLS certificates for the webhook server")
 	flag.IntVar(&opt.webhookPort, "webhook-port", 9443, "Port for the webhook server")
+	flag.StringVar(&opt.namespace, "namespace", "kubermatic", "The namespace of the application-catalog-manager, from which ConfigMaps with values schemas and repository credentials are read")
+	flag.StringVar(&opt.repositoryPolicyFile, "repository-policy-file", "", "Path to a YAML file with the repository policy that ApplicationCatalogs must comply with")
+	flag.Func("manager-username", "Comma-separated usernames allowed to update managed ApplicationDefinitions, like the application-catalog-manager (e.g. system:serviceaccount:<namespace>:<name>) and, on Seeds, the user of the Seed kubeconfig of the master. Can be given multiple times", func(value string) error {
+		for _, username := range strings.Split(value, ",") {
+			if username = strings.TrimSpace(username); username != "" {
+				opt.managerUsernames = append(opt.managerUsernames, username)
+			}
+		}
+		return nil
+	})
+	flag.StringVar(&opt.conversionServiceName, "conversion-service-name", "", "Name of the Service of the webhook server in the namespace. If set, the conversion of the ApplicationCatalog CRD is corrected to use the conversion webhook of this server, in case it differs from the one declared in the CRD")
+	flag.IntVar(&opt.conversionServicePort, "conversion-service-port", 443, "Port of the Service of the webhook server")
+	flag.StringVar(&opt.conversionCAFile, "conversion-ca-file", "", "Path to the CA bundle for the conversion webhook configuration (default: ca.crt in the cert dir)")
 	flag.Parse()
 
 	rawLog := aclog.New(logFlags.Debug, logFlags.Format)
@@ -88,6 +124,12 @@ func main() {
 			CertDir: opt.certDir,
 			Port:    opt.webhookPort,
 		}),
+		// Only the ApplicationCatalog CRD is reconciled, so there is no need to cache all CRDs.
+		Cache: cache.Options{
+			ByObject: map[ctrlruntimeclient.Object]cache.ByObject{
+				&apiextensionsv1.CustomResourceDefinition{}: {Field: fields.OneTermEqualSelector("metadata.name", crdconversion.CRDName)},
+			},
+		},
 	})
 	if err != nil {
 		log.Fatalf("Failed to create manager: %v", err)
@@ -99,13 +141,55 @@ func main() {
 	).SetupWebhookWithManager(mgr)
 	l.Info("ApplicationCatalog mutation webhook registered")
 
+	mgr.GetWebhookServer().Register(crdconversion.WebhookPath, conversion.NewWebhookHandler(scheme))
+	l.Info("ApplicationCatalog conversion webhook registered")
+
+	if opt.conversionServiceName != "" {
+		caFile := opt.conversionCAFile
+		if caFile == "" {
+			caFile = filepath.Join(opt.certDir, "ca.crt")
+		}
+
+		err := crdconversion.Add(mgr, &crdconversion.ControllerConfig{
+			Log:                    rawLog.Sugar().Named("crdconversion"),
+			ServiceName:            opt.conversionServiceName,
+			ServiceNamespace:       opt.namespace,
+			ServicePort:            int32(opt.conversionServicePort),
+			CABundleFile:           caFile,
+			ReconciliationInterval: 10 * time.Minute,
+		})
+		if err != nil {
+			log.Fatalf("Failed to add CRD conversion controller: %v", err)
+		}
+	}
+
+	var policy *repositorypolicy.Policy
+	if opt.repositoryPolicyFile != "" {
+		policy, err = repositorypolicy.Load(opt.repositoryPolicyFile)
+		if err != nil {
+			log.Fatalf("Failed to load repository policy: %v", err)
+		}
+		l.Infow("Loaded repository policy", "file", opt.repositoryPolicyFile)
+	}
+
 	applicationcatalogvalidation.NewAdmissionHandler(
 		rawLog.Sugar().Named("applicationcatalog-validation"),
 		scheme,
 		mgr.GetClient(),
+		policy,
+		// ConfigMaps and Secrets are read without cache, so that the webhook does not
+		// watch them in all namespaces.
+		chartschema.NewResolver(mgr.GetAPIReader(), opt.namespace, chartschema.NewFetcher()),
 	).SetupWebhookWithManager(mgr)
 	l.Info("ApplicationCatalog validation webhook registered")
 
+	applicationdefinitionvalidation.NewAdmissionHandler(
+		rawLog.Sugar().Named("applicationdefinition-validation"),
+		scheme,
+		opt.managerUsernames...,
+	).SetupWebhookWithManager(mgr)
+	l.Info("ApplicationDefinition validation webhook registered")
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		log.Fatalf("Failed to add health check: %v", err)
 	}
@@ -19,9 +19,55 @@ set -euo pipefail
 cd $(dirname $0)/..
 source hack/lib.sh
 
-echodate "Generating deepcopy for applicationcatalog:v1alpha1"
+echodate "Generating deepcopy for applicationcatalog"
 go run sigs.k8s.io/controller-tools/cmd/controller-gen \
   object:headerFile="hack/boilerplate/ce/boilerplate.go.txt" \
-  paths="./pkg/..."
+  paths="./pkg/apis/..."
+
+MODULE=k8c.io/application-catalog-manager
+APIS_PKG="$MODULE/pkg/apis"
+CLIENT_PKG="$MODULE/pkg/client"
+BOILERPLATE=hack/boilerplate/ce/boilerplate.go.txt
+INPUTS=(applicationcatalog/v1alpha1 applicationcatalog/v1beta1)
+
+INPUT_DIRS=()
+for input in "${INPUTS[@]}"; do
+  INPUT_DIRS+=("./pkg/apis/$input")
+done
+
+rm -rf pkg/client
+
+echodate "Generating apply configurations for applicationcatalog"
+go run k8s.io/code-generator/cmd/applyconfiguration-gen \
+  --go-header-file "$BOILERPLATE" \
+  --output-dir pkg/client/applyconfiguration \
+  --output-pkg "$CLIENT_PKG/applyconfiguration" \
+  "${INPUT_DIRS[@]}"
+
+echodate "Generating clientset for applicationcatalog"
+go run k8s.io/code-generator/cmd/client-gen \
+  --go-header-file "$BOILERPLATE" \
+  --output-dir pkg/client/clientset \
+  --output-pkg "$CLIENT_PKG/clientset" \
+  --clientset-name versioned \
+  --apply-configuration-package "$CLIENT_PKG/applyconfiguration" \
+  --input-base "$APIS_PKG" \
+  $(printf -- '--input %s ' "${INPUTS[@]}")
+
+echodate "Generating listers for applicationcatalog"
+go run k8s.io/code-generator/cmd/lister-gen \
+  --go-header-file "$BOILERPLATE" \
+  --output-dir pkg/client/listers \
+  --output-pkg "$CLIENT_PKG/listers" \
+  "${INPUT_DIRS[@]}"
+
+echodate "Generating informers for applicationcatalog"
+go run k8s.io/code-generator/cmd/informer-gen \
+  --go-header-file "$BOILERPLATE" \
+  --output-dir pkg/client/informers \
+  --output-pkg "$CLIENT_PKG/informers" \
+  --versioned-clientset-package "$CLIENT_PKG/clientset/versioned" \
+  --listers-package "$CLIENT_PKG/listers" \
+  "${INPUT_DIRS[@]}"
 
 echodate "Code generation complete"
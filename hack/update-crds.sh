@@ -29,4 +29,35 @@ go run sigs.k8s.io/controller-tools/cmd/controller-gen \
   paths="./pkg/..." \
   output:crd:artifacts:config=./deploy/crd
 
+# controller-gen does not generate the conversion of CRDs. It points to the webhook server of
+# the chart installed as "app-manager" in the "kubermatic" namespace, with the CA bundle
+# injected by cert-manager. The webhook server corrects it for other installations.
+tmpdir=$(mktemp -d)
+trap "rm -rf $tmpdir" EXIT
+
+cat > "$tmpdir/annotations.yaml" << EOF
+    cert-manager.io/inject-ca-from: kubermatic/app-manager-application-catalog-webhook-cert
+EOF
+
+cat > "$tmpdir/conversion.yaml" << EOF
+  conversion:
+    strategy: Webhook
+    webhook:
+      clientConfig:
+        service:
+          name: app-manager-application-catalog-webhook
+          namespace: kubermatic
+          path: /convert
+          port: 9443
+      conversionReviewVersions:
+      - v1
+EOF
+
+crd=deploy/crd/applicationcatalog.k8c.io_applicationcatalogs.yaml
+sed \
+  -e "/^  annotations:$/r $tmpdir/annotations.yaml" \
+  -e "/^spec:$/r $tmpdir/conversion.yaml" \
+  "$crd" > "$tmpdir/crd.yaml"
+mv "$tmpdir/crd.yaml" "$crd"
+
 echodate "CRD generation complete"
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalogsource
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"k8c.io/application-catalog-manager/internal/pkg/validation"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+// parseCatalogs parses the YAML documents of the files into ApplicationCatalogs.
+// Empty documents are skipped, documents of other kinds are rejected. The status of the
+// catalogs only records the signer that verified their file, as returned by verifyArtifact.
+func parseCatalogs(files [][]byte, signers []string) ([]*catalogv1alpha1.ApplicationCatalog, error) {
+	var catalogs []*catalogv1alpha1.ApplicationCatalog
+	names := sets.New[string]()
+
+	for i, file := range files {
+		reader := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(file)))
+
+		for doc := 0; ; doc++ {
+			data, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("file %d: failed to read document %d: %w", i, doc, err)
+			}
+
+			// Documents that are empty or contain only comments are skipped.
+			data, err = yaml.YAMLToJSON(data)
+			if err != nil {
+				return nil, fmt.Errorf("file %d: failed to parse document %d: %w", i, doc, err)
+			}
+			if string(data) == "null" {
+				continue
+			}
+
+			catalog := &catalogv1alpha1.ApplicationCatalog{}
+			if err := yaml.UnmarshalStrict(data, catalog); err != nil {
+				return nil, fmt.Errorf("file %d: failed to parse document %d: %w", i, doc, err)
+			}
+
+			if catalog.APIVersion != catalogv1alpha1.SchemeGroupVersion.String() || catalog.Kind != catalogv1alpha1.ApplicationCatalogKindName {
+				return nil, fmt.Errorf("file %d: document %d is a %s %s, only %s %s is supported", i, doc,
+					catalog.APIVersion, catalog.Kind, catalogv1alpha1.SchemeGroupVersion, catalogv1alpha1.ApplicationCatalogKindName)
+			}
+
+			if catalog.Name == "" {
+				return nil, fmt.Errorf("file %d: document %d has no name", i, doc)
+			}
+
+			if names.Has(catalog.Name) {
+				return nil, fmt.Errorf("ApplicationCatalog %q is defined more than once", catalog.Name)
+			}
+			names.Insert(catalog.Name)
+
+			// The status is never taken from the artifact.
+			catalog.Status = catalogv1alpha1.ApplicationCatalogStatus{}
+			if i < len(signers) {
+				catalog.Status.VerifiedSigner = signers[i]
+			}
+
+			catalogs = append(catalogs, catalog)
+		}
+	}
+
+	return catalogs, nil
+}
+
+// validateCatalogs validates the catalogs with the rules of the admission webhook.
+func validateCatalogs(catalogs []*catalogv1alpha1.ApplicationCatalog) error {
+	var errs []error
+	for _, catalog := range catalogs {
+		if allErrs, _ := validation.ValidateApplicationCatalog(catalog); len(allErrs) > 0 {
+			errs = append(errs, fmt.Errorf("ApplicationCatalog %q is invalid: %w", catalog.Name, allErrs.ToAggregate()))
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalogsource
+
+import (
+	"testing"
+)
+
+func TestParseCatalogs(t *testing.T) {
+	tests := []struct {
+		name          string
+		files         []string
+		expectedNames []string
+		expectedErr   bool
+	}{
+		{
+			name: "multiple documents and files",
+			files: []string{
+				"---\napiVersion: applicationcatalog.k8c.io/v1alpha1\nkind: ApplicationCatalog\nmetadata:\n  name: a\n---\n# comment only\n---\napiVersion: applicationcatalog.k8c.io/v1alpha1\nkind: ApplicationCatalog\nmetadata:\n  name: b\n",
+				"apiVersion: applicationcatalog.k8c.io/v1alpha1\nkind: ApplicationCatalog\nmetadata:\n  name: c\n",
+			},
+			expectedNames: []string{"a", "b", "c"},
+		},
+		{
+			name:  "empty file",
+			files: []string{""},
+		},
+		{
+			name:        "other kind",
+			files:       []string{"apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a\n"},
+			expectedErr: true,
+		},
+		{
+			name:        "missing name",
+			files:       []string{"apiVersion: applicationcatalog.k8c.io/v1alpha1\nkind: ApplicationCatalog\nspec: {}\n"},
+			expectedErr: true,
+		},
+		{
+			name:        "unknown field",
+			files:       []string{"apiVersion: applicationcatalog.k8c.io/v1alpha1\nkind: ApplicationCatalog\nmetadata:\n  name: a\nspec:\n  chart: {}\n"},
+			expectedErr: true,
+		},
+		{
+			name: "duplicate name",
+			files: []string{
+				"apiVersion: applicationcatalog.k8c.io/v1alpha1\nkind: ApplicationCatalog\nmetadata:\n  name: a\n",
+				"apiVersion: applicationcatalog.k8c.io/v1alpha1\nkind: ApplicationCatalog\nmetadata:\n  name: a\n",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			files := make([][]byte, 0, len(tc.files))
+			for _, file := range tc.files {
+				files = append(files, []byte(file))
+			}
+
+			catalogs, err := parseCatalogs(files, nil)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(catalogs) != len(tc.expectedNames) {
+				t.Fatalf("expected %d catalogs, got %d", len(tc.expectedNames), len(catalogs))
+			}
+			for i, catalog := range catalogs {
+				if catalog.Name != tc.expectedNames[i] {
+					t.Errorf("expected catalog %d to be %q, got %q", i, tc.expectedNames[i], catalog.Name)
+				}
+			}
+		})
+	}
+}
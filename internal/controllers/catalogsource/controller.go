@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalogsource
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	controllerName = "CatalogSourceController"
+)
+
+// ControllerConfig holds the configuration for the catalog source controller.
+type ControllerConfig struct {
+	Log *zap.SugaredLogger
+
+	// Namespace is the namespace the controller is deployed in. Secrets referenced
+	// in the credentials and verification of the ApplicationCatalogSources are read
+	// from this namespace.
+	Namespace string
+
+	// RequireSignatures refuses content without valid signature for all sources,
+	// regardless of their verification policy. Sources without verification fail.
+	RequireSignatures bool
+}
+
+func (c *ControllerConfig) validate() error {
+	if c.Log == nil {
+		return fmt.Errorf("log cannot be nil")
+	}
+
+	if c.Namespace == "" {
+		return fmt.Errorf("namespace cannot be empty")
+	}
+
+	return nil
+}
+
+// Reconciler reconciles ApplicationCatalogSource objects.
+type Reconciler struct {
+	ctrlruntimeclient.Client
+	cfg    *ControllerConfig
+	logger *zap.SugaredLogger
+}
+
+// Add creates a new catalog source controller and adds it to the Manager.
+// The Manager will set fields on the Reconciler and start it when the Manager is started.
+func Add(mgr manager.Manager, cfg *ControllerConfig) error {
+	if cfg == nil {
+		return fmt.Errorf("failed to instantiate controller: config is nil")
+	}
+
+	if err := cfg.validate(); err != nil {
+		return fmt.Errorf("failed to instantiate controller: %w", err)
+	}
+
+	reconciler := &Reconciler{
+		Client: mgr.GetClient(),
+		cfg:    cfg,
+		logger: cfg.Log,
+	}
+
+	// Only spec changes trigger a fetch, the status is updated on every fetch and the
+	// catalogs are updated by the webhook and the synchronizer. Deleted catalogs are
+	// recreated right away.
+	_, err := builder.ControllerManagedBy(mgr).
+		Named(controllerName).
+		For(&catalogv1alpha1.ApplicationCatalogSource{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&catalogv1alpha1.ApplicationCatalog{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Build(reconciler)
+
+	return err
+}
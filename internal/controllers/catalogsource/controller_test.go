@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalogsource
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestControllerConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         *ControllerConfig
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:        "valid config",
+			cfg:         &ControllerConfig{Log: zap.NewNop().Sugar(), Namespace: "kubermatic"},
+			expectError: false,
+		},
+		{
+			name:        "invalid config with nil logger",
+			cfg:         &ControllerConfig{Namespace: "kubermatic"},
+			expectError: true,
+			errorMsg:    "log cannot be nil",
+		},
+		{
+			name:        "invalid config without namespace",
+			cfg:         &ControllerConfig{Log: zap.NewNop().Sugar()},
+			expectError: true,
+			errorMsg:    "namespace cannot be empty",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.validate()
+
+			if tc.expectError {
+				if err == nil {
+					t.Errorf("expected error but got nil")
+					return
+				}
+				if err.Error() != tc.errorMsg {
+					t.Errorf("expected error message %q, got %q", tc.errorMsg, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("expected no error but got: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package catalogsource implements a controller that periodically fetches ApplicationCatalogs
+// from the HTTP URL, Git repository or OCI artifact of an ApplicationCatalogSource and
+// creates or updates them in the cluster.
+//
+// Key features:
+// - All fetched catalogs are validated before any of them is applied
+// - Labels and owner references for tracking ownership (source-name)
+// - Catalogs not managed by the source are never adopted or overwritten
+// - Catalogs removed from the source are deleted
+// - The revision and digest of the applied artifact are recorded in the status
+package catalogsource
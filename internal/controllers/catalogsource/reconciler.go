@@ -0,0 +1,387 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalogsource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+
+	"k8c.io/application-catalog-manager/internal/pkg/kubernetes"
+	"k8c.io/application-catalog-manager/internal/pkg/signature"
+	"k8c.io/application-catalog-manager/internal/pkg/source"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// defaultInterval is used if the interval of a source is not set.
+	defaultInterval = 10 * time.Minute
+
+	reasonSynced             = "Synced"
+	reasonFetchFailed        = "FetchFailed"
+	reasonVerificationFailed = "VerificationFailed"
+	reasonInvalidArtifact    = "InvalidArtifact"
+	reasonApplyFailed        = "ApplyFailed"
+)
+
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	l := r.logger.With("source", req.Name)
+	l.Info("Reconciling ApplicationCatalogSource")
+
+	src := &catalogv1alpha1.ApplicationCatalogSource{}
+	if err := r.Get(ctx, req.NamespacedName, src); err != nil {
+		// The catalogs of deleted sources are garbage collected through their owner references.
+		return reconcile.Result{}, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	if src.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	err := r.reconcile(ctx, l, src)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	interval := src.Spec.Interval.Duration
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+
+	return reconcile.Result{RequeueAfter: interval}, nil
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, l *zap.SugaredLogger, src *catalogv1alpha1.ApplicationCatalogSource) error {
+	fetcher, err := r.newFetcher(ctx, src)
+	if err != nil {
+		return r.setFailed(ctx, src, reasonFetchFailed, err)
+	}
+
+	artifact, err := fetcher.Fetch(ctx)
+	if err != nil {
+		return r.setFailed(ctx, src, reasonFetchFailed, err)
+	}
+
+	l = l.With("revision", artifact.Revision)
+
+	signers, err := r.verifyArtifact(ctx, src, artifact)
+	if err != nil {
+		return r.setFailed(ctx, src, reasonVerificationFailed, fmt.Errorf("signature verification failed: %w", err))
+	}
+
+	// Nothing is applied unless all catalogs of the artifact are valid. Invalid artifacts
+	// are not retried before the next interval, since fetching them again won't help.
+	catalogs, err := parseCatalogs(artifact.Files, signers)
+	if err == nil {
+		err = validateCatalogs(catalogs)
+	}
+	if err != nil {
+		l.Infow("Artifact is invalid", "error", err)
+		if err := r.updateStatus(ctx, src, func(status *catalogv1alpha1.ApplicationCatalogSourceStatus) {
+			setReadyCondition(src, status, metav1.ConditionFalse, reasonInvalidArtifact, err.Error())
+		}); err != nil {
+			return fmt.Errorf("failed to update status: %w", err)
+		}
+		return nil
+	}
+
+	if err := r.applyCatalogs(ctx, l, src, catalogs); err != nil {
+		return r.setFailed(ctx, src, reasonApplyFailed, err)
+	}
+
+	names := make([]string, 0, len(catalogs))
+	for _, catalog := range catalogs {
+		names = append(names, catalog.Name)
+	}
+	sort.Strings(names)
+
+	if err := r.updateStatus(ctx, src, func(status *catalogv1alpha1.ApplicationCatalogSourceStatus) {
+		status.Revision = artifact.Revision
+		status.Digest = artifact.Digest()
+		status.Catalogs = names
+		status.VerifiedSigners = sets.List(sets.New(signers...).Delete(""))
+		setReadyCondition(src, status, metav1.ConditionTrue, reasonSynced, fmt.Sprintf("Applied revision %s", artifact.Revision))
+	}); err != nil {
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+
+	l.Info("Reconciliation complete")
+	return nil
+}
+
+// applyCatalogs creates or updates the catalogs and deletes the catalogs of the source
+// which are not part of the artifact anymore.
+func (r *Reconciler) applyCatalogs(ctx context.Context, l *zap.SugaredLogger, src *catalogv1alpha1.ApplicationCatalogSource, catalogs []*catalogv1alpha1.ApplicationCatalog) error {
+	var errs []error
+	desired := sets.New[string]()
+
+	for _, catalog := range catalogs {
+		desired.Insert(catalog.Name)
+
+		if err := r.applyCatalog(ctx, l, src, catalog); err != nil {
+			errs = append(errs, fmt.Errorf("ApplicationCatalog %q: %w", catalog.Name, err))
+		}
+	}
+
+	existing := &catalogv1alpha1.ApplicationCatalogList{}
+	if err := r.List(ctx, existing, ctrlruntimeclient.MatchingLabels{catalogv1alpha1.LabelApplicationCatalogSourceName: src.Name}); err != nil {
+		return kerrors.NewAggregate(append(errs, fmt.Errorf("failed to list ApplicationCatalogs: %w", err)))
+	}
+
+	for i := range existing.Items {
+		catalog := &existing.Items[i]
+		if desired.Has(catalog.Name) {
+			continue
+		}
+
+		l.Infow("Deleting ApplicationCatalog removed from the source", "catalog", catalog.Name)
+		if err := r.Delete(ctx, catalog); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			errs = append(errs, fmt.Errorf("failed to delete ApplicationCatalog %q: %w", catalog.Name, err))
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// applyCatalog creates or updates a single catalog and records the signer of its file in
+// the status. Existing catalogs that are not managed by the source are left untouched.
+func (r *Reconciler) applyCatalog(ctx context.Context, l *zap.SugaredLogger, src *catalogv1alpha1.ApplicationCatalogSource, desired *catalogv1alpha1.ApplicationCatalog) error {
+	kubernetes.EnsureLabels(desired, map[string]string{catalogv1alpha1.LabelApplicationCatalogSourceName: src.Name})
+
+	// The status is not written on create, so the signer is set afterwards.
+	signer := desired.Status.VerifiedSigner
+
+	existing := &catalogv1alpha1.ApplicationCatalog{}
+	if err := r.Get(ctx, ctrlruntimeclient.ObjectKey{Name: desired.Name}, existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get ApplicationCatalog: %w", err)
+		}
+
+		if err := controllerutil.SetControllerReference(src, desired, r.Scheme()); err != nil {
+			return fmt.Errorf("failed to set owner reference: %w", err)
+		}
+
+		l.Debugw("Creating ApplicationCatalog", "catalog", desired.Name)
+		if err := r.Create(ctx, desired); err != nil {
+			return err
+		}
+
+		return r.setVerifiedSigner(ctx, desired, signer)
+	}
+
+	if owner := existing.Labels[catalogv1alpha1.LabelApplicationCatalogSourceName]; owner != src.Name {
+		if owner == "" {
+			return fmt.Errorf("already exists and is not managed by a source")
+		}
+		return fmt.Errorf("already managed by ApplicationCatalogSource %q", owner)
+	}
+
+	updated := existing.DeepCopy()
+	kubernetes.EnsureLabels(updated, desired.Labels)
+	kubernetes.EnsureAnnotations(updated, desired.Annotations)
+	updated.Spec = desired.Spec
+
+	if err := controllerutil.SetControllerReference(src, updated, r.Scheme()); err != nil {
+		return fmt.Errorf("failed to set owner reference: %w", err)
+	}
+
+	l.Debugw("Updating ApplicationCatalog", "catalog", desired.Name)
+	if err := r.Patch(ctx, updated, ctrlruntimeclient.MergeFrom(existing)); err != nil {
+		return err
+	}
+
+	return r.setVerifiedSigner(ctx, updated, signer)
+}
+
+// setVerifiedSigner records the signer in the status of the catalog. Unlike annotations, the
+// status can only be written by users allowed to update the status subresource.
+func (r *Reconciler) setVerifiedSigner(ctx context.Context, catalog *catalogv1alpha1.ApplicationCatalog, signer string) error {
+	if catalog.Status.VerifiedSigner == signer {
+		return nil
+	}
+
+	oldCatalog := catalog.DeepCopy()
+	catalog.Status.VerifiedSigner = signer
+	if err := r.Status().Patch(ctx, catalog, ctrlruntimeclient.MergeFrom(oldCatalog)); err != nil {
+		return fmt.Errorf("failed to update verified signer: %w", err)
+	}
+
+	return nil
+}
+
+// newFetcher returns the fetcher of the source with its credentials resolved.
+func (r *Reconciler) newFetcher(ctx context.Context, src *catalogv1alpha1.ApplicationCatalogSource) (source.Fetcher, error) {
+	switch {
+	case src.Spec.HTTP != nil:
+		creds, err := r.getCredentials(ctx, src.Spec.HTTP.Credentials)
+		if err != nil {
+			return nil, err
+		}
+
+		fetcher := &source.HTTPFetcher{
+			URL:                   src.Spec.HTTP.URL,
+			InsecureSkipTLSVerify: src.Spec.HTTP.InsecureSkipTLSVerify,
+			Credentials:           creds,
+		}
+		if r.verifies(src) {
+			fetcher.SignatureURL = src.Spec.HTTP.URL + ".sig"
+		}
+
+		return fetcher, nil
+
+	case src.Spec.Git != nil:
+		creds, err := r.getCredentials(ctx, src.Spec.Git.Credentials)
+		if err != nil {
+			return nil, err
+		}
+
+		fetcher := &source.GitFetcher{
+			URL:                   src.Spec.Git.URL,
+			Path:                  src.Spec.Git.Path,
+			InsecureSkipTLSVerify: src.Spec.Git.InsecureSkipTLSVerify,
+			Credentials:           creds,
+		}
+		if ref := src.Spec.Git.Ref; ref != nil {
+			fetcher.Branch = ref.Branch
+			fetcher.Tag = ref.Tag
+			fetcher.Commit = ref.Commit
+		}
+
+		return fetcher, nil
+
+	case src.Spec.OCI != nil:
+		creds, err := r.getCredentials(ctx, src.Spec.OCI.Credentials)
+		if err != nil {
+			return nil, err
+		}
+
+		return &source.OCIFetcher{
+			URL:                   src.Spec.OCI.URL,
+			Tag:                   src.Spec.OCI.Tag,
+			Digest:                src.Spec.OCI.Digest,
+			PlainHTTP:             src.Spec.OCI.PlainHTTP,
+			InsecureSkipTLSVerify: src.Spec.OCI.InsecureSkipTLSVerify,
+			Credentials:           creds,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("no source configured")
+	}
+}
+
+// verifies returns true if the signatures of the source are verified.
+func (r *Reconciler) verifies(src *catalogv1alpha1.ApplicationCatalogSource) bool {
+	return src.Spec.Verification != nil || r.cfg.RequireSignatures
+}
+
+// verifyArtifact verifies the signatures of all files of the artifact and returns the names
+// of the keys that verified them by file. Unsigned files accepted by the VerifyIfSigned policy
+// have no signer, sources without verification return no signers at all.
+func (r *Reconciler) verifyArtifact(ctx context.Context, src *catalogv1alpha1.ApplicationCatalogSource, artifact *source.Artifact) ([]string, error) {
+	verification := src.Spec.Verification
+	if verification == nil {
+		if r.cfg.RequireSignatures {
+			return nil, fmt.Errorf("signatures are required, but no verification is configured")
+		}
+		return nil, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: r.cfg.Namespace, Name: verification.SecretName}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get public keys secret: %w", err)
+	}
+
+	verifier, err := signature.NewVerifier(secret.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	allowUnsigned := verification.Policy == catalogv1alpha1.SignaturePolicyVerifyIfSigned && !r.cfg.RequireSignatures
+
+	signers := make([]string, len(artifact.Files))
+	for i, file := range artifact.Files {
+		var sig []byte
+		if i < len(artifact.Signatures) {
+			sig = artifact.Signatures[i]
+		}
+
+		signer, err := verifier.Verify(file, sig)
+		if errors.Is(err, signature.ErrUnsigned) && allowUnsigned {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("file %d: %w", i, err)
+		}
+
+		signers[i] = signer
+	}
+
+	return signers, nil
+}
+
+// getCredentials reads the referenced secret keys from the namespace of the controller.
+func (r *Reconciler) getCredentials(ctx context.Context, creds *catalogv1alpha1.RepositoryCredentials) (*source.Credentials, error) {
+	return source.ResolveCredentials(ctx, r.Client, r.cfg.Namespace, creds)
+}
+
+// setFailed records the error in the Ready condition and returns it, so that the
+// source is retried with backoff.
+func (r *Reconciler) setFailed(ctx context.Context, src *catalogv1alpha1.ApplicationCatalogSource, reason string, err error) error {
+	if statusErr := r.updateStatus(ctx, src, func(status *catalogv1alpha1.ApplicationCatalogSourceStatus) {
+		setReadyCondition(src, status, metav1.ConditionFalse, reason, err.Error())
+	}); statusErr != nil {
+		return kerrors.NewAggregate([]error{err, fmt.Errorf("failed to update status: %w", statusErr)})
+	}
+
+	return err
+}
+
+// updateStatus patches the status of the source after a fetch.
+func (r *Reconciler) updateStatus(ctx context.Context, src *catalogv1alpha1.ApplicationCatalogSource, modify func(status *catalogv1alpha1.ApplicationCatalogSourceStatus)) error {
+	oldSrc := src.DeepCopy()
+
+	now := metav1.Now()
+	src.Status.ObservedGeneration = src.Generation
+	src.Status.LastFetchTime = &now
+	modify(&src.Status)
+
+	return r.Status().Patch(ctx, src, ctrlruntimeclient.MergeFrom(oldSrc))
+}
+
+func setReadyCondition(src *catalogv1alpha1.ApplicationCatalogSource, status *catalogv1alpha1.ApplicationCatalogSourceStatus, conditionStatus metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               catalogv1alpha1.SourceConditionReady,
+		Status:             conditionStatus,
+		ObservedGeneration: src.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
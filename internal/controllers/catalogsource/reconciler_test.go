@@ -0,0 +1,438 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package catalogsource
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"k8c.io/application-catalog-manager/internal/pkg/source"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func newTestReconciler(t *testing.T, objects ...ctrlruntimeclient.Object) *Reconciler {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := catalogv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add catalogv1alpha1 to scheme: %v", err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add corev1 to scheme: %v", err)
+	}
+
+	client := ctrlruntimefakeclient.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&catalogv1alpha1.ApplicationCatalogSource{}, &catalogv1alpha1.ApplicationCatalog{}).
+		Build()
+
+	return &Reconciler{
+		Client: client,
+		cfg:    &ControllerConfig{Namespace: "kubermatic"},
+		logger: zap.NewNop().Sugar(),
+	}
+}
+
+func newTestSource(url string) *catalogv1alpha1.ApplicationCatalogSource {
+	return &catalogv1alpha1.ApplicationCatalogSource{
+		ObjectMeta: metav1.ObjectMeta{Name: "platform", Generation: 1},
+		Spec: catalogv1alpha1.ApplicationCatalogSourceSpec{
+			HTTP: &catalogv1alpha1.HTTPCatalogSource{URL: url},
+		},
+	}
+}
+
+func catalogYAML(name, chartName string) string {
+	return fmt.Sprintf(`apiVersion: applicationcatalog.k8c.io/v1alpha1
+kind: ApplicationCatalog
+metadata:
+  name: %s
+spec:
+  helm:
+    charts:
+      - chartName: %s
+        chartVersions:
+          - chartVersion: 1.0.0
+            appVersion: v1.0.0
+`, name, chartName)
+}
+
+func newManagedCatalog(name, sourceName string) *catalogv1alpha1.ApplicationCatalog {
+	catalog := &catalogv1alpha1.ApplicationCatalog{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if sourceName != "" {
+		catalog.Labels = map[string]string{catalogv1alpha1.LabelApplicationCatalogSourceName: sourceName}
+	}
+
+	return catalog
+}
+
+func TestReconcile(t *testing.T) {
+	tests := []struct {
+		name              string
+		content           string
+		existing          []ctrlruntimeclient.Object
+		expectedErr       bool
+		expectedReason    string
+		expectedCatalogs  map[string]string
+		expectedStatusSet []string
+	}{
+		{
+			name:              "creates catalogs",
+			content:           catalogYAML("platform", "nginx") + "---\n" + catalogYAML("security", "cert-manager"),
+			expectedReason:    reasonSynced,
+			expectedCatalogs:  map[string]string{"platform": "nginx", "security": "cert-manager"},
+			expectedStatusSet: []string{"platform", "security"},
+		},
+		{
+			name:    "updates managed catalogs and deletes removed ones",
+			content: catalogYAML("platform", "nginx"),
+			existing: []ctrlruntimeclient.Object{
+				newManagedCatalog("platform", "platform"),
+				newManagedCatalog("removed", "platform"),
+				newManagedCatalog("other", "other"),
+			},
+			expectedReason:    reasonSynced,
+			expectedCatalogs:  map[string]string{"platform": "nginx", "other": ""},
+			expectedStatusSet: []string{"platform"},
+		},
+		{
+			name:    "does not adopt unmanaged catalogs",
+			content: catalogYAML("platform", "nginx") + "---\n" + catalogYAML("security", "cert-manager"),
+			existing: []ctrlruntimeclient.Object{
+				newManagedCatalog("platform", ""),
+			},
+			expectedErr:      true,
+			expectedReason:   reasonApplyFailed,
+			expectedCatalogs: map[string]string{"platform": "", "security": "cert-manager"},
+		},
+		{
+			name:    "invalid artifacts are not applied",
+			content: catalogYAML("platform", "nginx") + "---\n" + strings.Replace(catalogYAML("security", "cert-manager"), "1.0.0\n", "1.0.0\n        defaultValuesBlock: \"a:\\n\\tb: c\"\n", 1),
+			existing: []ctrlruntimeclient.Object{
+				newManagedCatalog("removed", "platform"),
+			},
+			expectedReason:   reasonInvalidArtifact,
+			expectedCatalogs: map[string]string{"removed": ""},
+		},
+		{
+			name:             "fetch errors are returned",
+			expectedErr:      true,
+			expectedReason:   reasonFetchFailed,
+			expectedCatalogs: map[string]string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if tc.content == "" {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				w.Header().Set("ETag", `"rev-1"`)
+				_, _ = w.Write([]byte(tc.content))
+			}))
+			defer server.Close()
+
+			src := newTestSource(server.URL)
+			r := newTestReconciler(t, append(tc.existing, src)...)
+			ctx := context.Background()
+
+			_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: src.Name}})
+			if tc.expectedErr != (err != nil) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			catalogs := &catalogv1alpha1.ApplicationCatalogList{}
+			if err := r.List(ctx, catalogs); err != nil {
+				t.Fatalf("failed to list catalogs: %v", err)
+			}
+
+			result := map[string]string{}
+			for _, catalog := range catalogs.Items {
+				result[catalog.Name] = ""
+				if charts := catalog.GetHelmCharts(); len(charts) > 0 {
+					result[catalog.Name] = charts[0].ChartName
+
+					if catalog.Labels[catalogv1alpha1.LabelApplicationCatalogSourceName] != src.Name {
+						t.Errorf("expected catalog %q to have the source label", catalog.Name)
+					}
+					if ref := metav1.GetControllerOf(&catalog); ref == nil || ref.Name != src.Name {
+						t.Errorf("expected catalog %q to be controlled by the source", catalog.Name)
+					}
+				}
+			}
+
+			if !reflect.DeepEqual(result, tc.expectedCatalogs) {
+				t.Errorf("expected catalogs %v, got %v", tc.expectedCatalogs, result)
+			}
+
+			updated := &catalogv1alpha1.ApplicationCatalogSource{}
+			if err := r.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(src), updated); err != nil {
+				t.Fatalf("failed to get source: %v", err)
+			}
+
+			condition := meta.FindStatusCondition(updated.Status.Conditions, catalogv1alpha1.SourceConditionReady)
+			if condition == nil || condition.Reason != tc.expectedReason {
+				t.Fatalf("expected Ready condition with reason %q, got %+v", tc.expectedReason, condition)
+			}
+
+			if updated.Status.ObservedGeneration != src.Generation || updated.Status.LastFetchTime == nil {
+				t.Errorf("expected observedGeneration and lastFetchTime to be set, got %+v", updated.Status)
+			}
+
+			if tc.expectedReason != reasonSynced {
+				if updated.Status.Revision != "" {
+					t.Errorf("expected no revision to be recorded, got %q", updated.Status.Revision)
+				}
+				return
+			}
+
+			if updated.Status.Revision != `"rev-1"` {
+				t.Errorf("expected revision %q, got %q", `"rev-1"`, updated.Status.Revision)
+			}
+			if !strings.HasPrefix(updated.Status.Digest, "sha256:") {
+				t.Errorf("expected a sha256 digest, got %q", updated.Status.Digest)
+			}
+			if !reflect.DeepEqual(updated.Status.Catalogs, tc.expectedStatusSet) {
+				t.Errorf("expected status catalogs %v, got %v", tc.expectedStatusSet, updated.Status.Catalogs)
+			}
+		})
+	}
+}
+
+func TestGetCredentials(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: "kubermatic"},
+		Data: map[string][]byte{
+			"username": []byte("user"),
+			"password": []byte("pass"),
+		},
+	}
+
+	r := newTestReconciler(t, secret)
+
+	creds, err := r.getCredentials(context.Background(), &catalogv1alpha1.RepositoryCredentials{
+		Username: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "creds"}, Key: "username"},
+		Password: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "creds"}, Key: "password"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if creds.Username != "user" || creds.Password != "pass" {
+		t.Errorf("expected user:pass, got %s:%s", creds.Username, creds.Password)
+	}
+
+	_, err = r.getCredentials(context.Background(), &catalogv1alpha1.RepositoryCredentials{
+		Username: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "creds"}, Key: "missing"},
+	})
+	if err == nil {
+		t.Error("expected an error for a missing key")
+	}
+}
+
+func TestVerifyArtifact(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(public)
+	if err != nil {
+		t.Fatalf("failed to marshal public key: %v", err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "catalog-keys", Namespace: "kubermatic"},
+		Data: map[string][]byte{
+			"release.pub": pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}),
+		},
+	}
+
+	content := []byte(catalogYAML("platform", "nginx"))
+	sign := func(data []byte) []byte {
+		return []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(private, data)))
+	}
+
+	tests := []struct {
+		name              string
+		verification      *catalogv1alpha1.SignatureVerification
+		requireSignatures bool
+		artifact          *source.Artifact
+		expectedSigners   []string
+		expectedErr       bool
+	}{
+		{
+			name:     "no verification",
+			artifact: &source.Artifact{Files: [][]byte{content}},
+		},
+		{
+			name:              "no verification with required signatures",
+			requireSignatures: true,
+			artifact:          &source.Artifact{Files: [][]byte{content}},
+			expectedErr:       true,
+		},
+		{
+			name:            "signed content",
+			verification:    &catalogv1alpha1.SignatureVerification{SecretName: "catalog-keys"},
+			artifact:        &source.Artifact{Files: [][]byte{content}, Signatures: [][]byte{sign(content)}},
+			expectedSigners: []string{"release.pub"},
+		},
+		{
+			name:         "unsigned content",
+			verification: &catalogv1alpha1.SignatureVerification{SecretName: "catalog-keys", Policy: catalogv1alpha1.SignaturePolicyRequireSignature},
+			artifact:     &source.Artifact{Files: [][]byte{content}, Signatures: [][]byte{nil}},
+			expectedErr:  true,
+		},
+		{
+			name:            "unsigned content if signed",
+			verification:    &catalogv1alpha1.SignatureVerification{SecretName: "catalog-keys", Policy: catalogv1alpha1.SignaturePolicyVerifyIfSigned},
+			artifact:        &source.Artifact{Files: [][]byte{content, content}, Signatures: [][]byte{nil, sign(content)}},
+			expectedSigners: []string{"", "release.pub"},
+		},
+		{
+			name:              "unsigned content if signed with required signatures",
+			verification:      &catalogv1alpha1.SignatureVerification{SecretName: "catalog-keys", Policy: catalogv1alpha1.SignaturePolicyVerifyIfSigned},
+			requireSignatures: true,
+			artifact:          &source.Artifact{Files: [][]byte{content}},
+			expectedErr:       true,
+		},
+		{
+			name:         "tampered content",
+			verification: &catalogv1alpha1.SignatureVerification{SecretName: "catalog-keys", Policy: catalogv1alpha1.SignaturePolicyVerifyIfSigned},
+			artifact:     &source.Artifact{Files: [][]byte{append(content, '#')}, Signatures: [][]byte{sign(content)}},
+			expectedErr:  true,
+		},
+		{
+			name:         "missing secret",
+			verification: &catalogv1alpha1.SignatureVerification{SecretName: "missing"},
+			artifact:     &source.Artifact{Files: [][]byte{content}, Signatures: [][]byte{sign(content)}},
+			expectedErr:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newTestReconciler(t, secret)
+			r.cfg.RequireSignatures = tc.requireSignatures
+
+			src := newTestSource("https://catalogs.example.com/catalog.yaml")
+			src.Spec.Verification = tc.verification
+
+			signers, err := r.verifyArtifact(context.Background(), src, tc.artifact)
+			if tc.expectedErr != (err != nil) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			if !reflect.DeepEqual(signers, tc.expectedSigners) {
+				t.Errorf("expected signers %v, got %v", tc.expectedSigners, signers)
+			}
+		})
+	}
+}
+
+func TestApplyCatalogsVerifiedSigner(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing []ctrlruntimeclient.Object
+		files    []string
+		signers  []string
+		expected map[string]string
+	}{
+		{
+			name:     "signers are recorded per file",
+			files:    []string{catalogYAML("unsigned", "nginx"), catalogYAML("signed", "redis")},
+			signers:  []string{"", "release.pub"},
+			expected: map[string]string{"unsigned": "", "signed": "release.pub"},
+		},
+		{
+			name: "signers of files which are not signed anymore are removed",
+			existing: []ctrlruntimeclient.Object{
+				&catalogv1alpha1.ApplicationCatalog{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "platform",
+						Labels: map[string]string{catalogv1alpha1.LabelApplicationCatalogSourceName: "platform"},
+					},
+					Status: catalogv1alpha1.ApplicationCatalogStatus{VerifiedSigner: "release.pub"},
+				},
+			},
+			files:    []string{catalogYAML("platform", "nginx")},
+			expected: map[string]string{"platform": ""},
+		},
+		{
+			name:     "signers of the artifact are not trusted",
+			files:    []string{catalogYAML("platform", "nginx") + "status:\n  verifiedSigner: forged.pub\n"},
+			signers:  []string{""},
+			expected: map[string]string{"platform": ""},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			src := newTestSource("https://catalogs.example.com/catalog.yaml")
+			r := newTestReconciler(t, append(tc.existing, src)...)
+			ctx := context.Background()
+
+			files := make([][]byte, 0, len(tc.files))
+			for _, file := range tc.files {
+				files = append(files, []byte(file))
+			}
+
+			catalogs, err := parseCatalogs(files, tc.signers)
+			if err != nil {
+				t.Fatalf("failed to parse catalogs: %v", err)
+			}
+
+			if err := r.applyCatalogs(ctx, zap.NewNop().Sugar(), src, catalogs); err != nil {
+				t.Fatalf("failed to apply catalogs: %v", err)
+			}
+
+			for name, expected := range tc.expected {
+				catalog := &catalogv1alpha1.ApplicationCatalog{}
+				if err := r.Get(ctx, ctrlruntimeclient.ObjectKey{Name: name}, catalog); err != nil {
+					t.Fatalf("failed to get catalog: %v", err)
+				}
+
+				if catalog.Status.VerifiedSigner != expected {
+					t.Errorf("expected verified signer %q for catalog %q, got %q", expected, name, catalog.Status.VerifiedSigner)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdconversion
+
+import (
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	controllerName = "CRDConversionController"
+
+	// WebhookPath is the HTTP path of the conversion webhook.
+	WebhookPath = "/convert"
+)
+
+// CRDName is the name of the ApplicationCatalog CustomResourceDefinition.
+var CRDName = catalogv1alpha1.ApplicationCatalogResourceName + "." + catalogv1alpha1.GroupName
+
+// ControllerConfig holds the configuration for the CRD conversion controller.
+type ControllerConfig struct {
+	Log *zap.SugaredLogger
+
+	// ServiceName and ServiceNamespace identify the Service of the webhook server.
+	ServiceName      string
+	ServiceNamespace string
+
+	// ServicePort is the port of the Service of the webhook server.
+	ServicePort int32
+
+	// CABundleFile is the path to the PEM-encoded CA bundle the serving certificate of the
+	// webhook server is verified with.
+	CABundleFile string
+
+	// ReconciliationInterval is the duration after which the CRD is checked again, so that
+	// a rotated CA bundle is picked up.
+	ReconciliationInterval time.Duration
+}
+
+func (c *ControllerConfig) validate() error {
+	if c.Log == nil {
+		return fmt.Errorf("log cannot be nil")
+	}
+
+	if c.ServiceName == "" || c.ServiceNamespace == "" {
+		return fmt.Errorf("service name and namespace cannot be empty")
+	}
+
+	if c.ServicePort <= 0 {
+		return fmt.Errorf("service port must be positive")
+	}
+
+	if c.CABundleFile == "" {
+		return fmt.Errorf("CA bundle file cannot be empty")
+	}
+
+	if c.ReconciliationInterval < 0 {
+		return fmt.Errorf("reconciliation interval must be a non-negative duration")
+	}
+
+	return nil
+}
+
+// Reconciler reconciles the conversion of the ApplicationCatalog CustomResourceDefinition.
+type Reconciler struct {
+	ctrlruntimeclient.Client
+	cfg    *ControllerConfig
+	logger *zap.SugaredLogger
+}
+
+// Add creates a new CRD conversion controller and adds it to the Manager.
+// The Manager will set fields on the Reconciler and start it when the Manager is started.
+// The cache of the Manager should be restricted to the CRD named CRDName.
+func Add(mgr manager.Manager, cfg *ControllerConfig) error {
+	if cfg == nil {
+		return fmt.Errorf("failed to instantiate controller: config is nil")
+	}
+
+	if err := cfg.validate(); err != nil {
+		return fmt.Errorf("failed to instantiate controller: %w", err)
+	}
+
+	reconciler := &Reconciler{
+		Client: mgr.GetClient(),
+		cfg:    cfg,
+		logger: cfg.Log,
+	}
+
+	isCatalogCRD := predicate.NewPredicateFuncs(func(obj ctrlruntimeclient.Object) bool {
+		return obj.GetName() == CRDName
+	})
+
+	_, err := builder.ControllerManagedBy(mgr).
+		Named(controllerName).
+		For(&apiextensionsv1.CustomResourceDefinition{}, builder.WithPredicates(isCatalogCRD)).
+		Build(reconciler)
+
+	return err
+}
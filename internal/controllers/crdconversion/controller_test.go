@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdconversion
+
+import (
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestControllerConfigValidate(t *testing.T) {
+	logger := zap.NewNop().Sugar()
+
+	validConfig := func() *ControllerConfig {
+		return &ControllerConfig{
+			Log:                    logger,
+			ServiceName:            "application-catalog-webhook",
+			ServiceNamespace:       "kubermatic",
+			ServicePort:            443,
+			CABundleFile:           "/tmp/k8s-webhook-server/serving-certs/ca.crt",
+			ReconciliationInterval: 10 * time.Minute,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(cfg *ControllerConfig)
+		expectError bool
+		errorMsg    string
+	}{
+		{
+			name:        "valid config",
+			modify:      func(cfg *ControllerConfig) {},
+			expectError: false,
+		},
+		{
+			name:        "invalid config with nil logger",
+			modify:      func(cfg *ControllerConfig) { cfg.Log = nil },
+			expectError: true,
+			errorMsg:    "log cannot be nil",
+		},
+		{
+			name:        "invalid config without service name",
+			modify:      func(cfg *ControllerConfig) { cfg.ServiceName = "" },
+			expectError: true,
+			errorMsg:    "service name and namespace cannot be empty",
+		},
+		{
+			name:        "invalid config without service port",
+			modify:      func(cfg *ControllerConfig) { cfg.ServicePort = 0 },
+			expectError: true,
+			errorMsg:    "service port must be positive",
+		},
+		{
+			name:        "invalid config without CA bundle",
+			modify:      func(cfg *ControllerConfig) { cfg.CABundleFile = "" },
+			expectError: true,
+			errorMsg:    "CA bundle file cannot be empty",
+		},
+		{
+			name:        "invalid config with negative interval",
+			modify:      func(cfg *ControllerConfig) { cfg.ReconciliationInterval = -time.Minute },
+			expectError: true,
+			errorMsg:    "reconciliation interval must be a non-negative duration",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := validConfig()
+			tc.modify(cfg)
+
+			err := cfg.validate()
+
+			if tc.expectError {
+				if err == nil {
+					t.Errorf("expected error but got nil")
+					return
+				}
+				if err.Error() != tc.errorMsg {
+					t.Errorf("expected error message %q, got %q", tc.errorMsg, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("expected no error but got: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package crdconversion implements a controller for the webhook server that configures the
+// ApplicationCatalog CustomResourceDefinition to convert between its versions with the
+// conversion webhook of the server. The shipped CRD already declares the conversion webhook
+// of the default installation, the controller is a fallback for other installations and
+// restores the conversion if the CRD is applied without it.
+//
+// Key features:
+// - The conversion of the CRD points to the Service of the webhook server
+// - The CA bundle is read from a file, so rotated certificates are picked up
+// - The CRD is only patched if its conversion differs
+package crdconversion
@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdconversion
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.uber.org/zap"
+
+	"k8c.io/application-catalog-manager/internal/pkg/kubernetes"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	l := r.logger.With("crd", req.Name)
+	l.Debug("Reconciling CustomResourceDefinition conversion")
+
+	if err := r.reconcile(ctx, l, req); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if r.cfg.ReconciliationInterval > 0 {
+		return reconcile.Result{RequeueAfter: r.cfg.ReconciliationInterval}, nil
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, l *zap.SugaredLogger, req reconcile.Request) error {
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := r.Get(ctx, req.NamespacedName, crd); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get CustomResourceDefinition: %w", err)
+	}
+
+	caBundle, err := os.ReadFile(r.cfg.CABundleFile)
+	if err != nil {
+		return fmt.Errorf("failed to read CA bundle: %w", err)
+	}
+
+	desired := r.conversion(caBundle)
+	if equality.Semantic.DeepEqual(crd.Spec.Conversion, desired) {
+		return nil
+	}
+
+	l.Info("Configuring conversion webhook")
+
+	return kubernetes.PatchObject(ctx, r.Client, crd, func() {
+		crd.Spec.Conversion = desired
+	})
+}
+
+// conversion returns the conversion of the CRD pointing to the webhook server.
+func (r *Reconciler) conversion(caBundle []byte) *apiextensionsv1.CustomResourceConversion {
+	return &apiextensionsv1.CustomResourceConversion{
+		Strategy: apiextensionsv1.WebhookConverter,
+		Webhook: &apiextensionsv1.WebhookConversion{
+			ClientConfig: &apiextensionsv1.WebhookClientConfig{
+				Service: &apiextensionsv1.ServiceReference{
+					Namespace: r.cfg.ServiceNamespace,
+					Name:      r.cfg.ServiceName,
+					Path:      ptr.To(WebhookPath),
+					Port:      ptr.To(r.cfg.ServicePort),
+				},
+				CABundle: caBundle,
+			},
+			ConversionReviewVersions: []string{"v1"},
+		},
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crdconversion
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/zap"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	if err := apiextensionsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add apiextensionsv1 to scheme: %v", err)
+	}
+
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	if err := os.WriteFile(caFile, []byte("first CA"), 0o600); err != nil {
+		t.Fatalf("failed to write CA bundle: %v", err)
+	}
+
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: CRDName},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Conversion: &apiextensionsv1.CustomResourceConversion{Strategy: apiextensionsv1.NoneConverter},
+		},
+	}
+
+	client := ctrlruntimefakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(crd).Build()
+
+	r := &Reconciler{
+		Client: client,
+		cfg: &ControllerConfig{
+			ServiceName:      "application-catalog-webhook",
+			ServiceNamespace: "kubermatic",
+			ServicePort:      443,
+			CABundleFile:     caFile,
+		},
+		logger: zap.NewNop().Sugar(),
+	}
+
+	reconcileCRD := func() *apiextensionsv1.CustomResourceDefinition {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: CRDName}}); err != nil {
+			t.Fatalf("reconcile failed: %v", err)
+		}
+
+		current := &apiextensionsv1.CustomResourceDefinition{}
+		if err := client.Get(ctx, types.NamespacedName{Name: CRDName}, current); err != nil {
+			t.Fatalf("failed to get CustomResourceDefinition: %v", err)
+		}
+		return current
+	}
+
+	current := reconcileCRD()
+
+	conversion := current.Spec.Conversion
+	if conversion.Strategy != apiextensionsv1.WebhookConverter {
+		t.Fatalf("expected webhook conversion, got %q", conversion.Strategy)
+	}
+	service := conversion.Webhook.ClientConfig.Service
+	if service.Name != "application-catalog-webhook" || service.Namespace != "kubermatic" || *service.Path != WebhookPath || *service.Port != 443 {
+		t.Errorf("unexpected service reference %+v", service)
+	}
+	if string(conversion.Webhook.ClientConfig.CABundle) != "first CA" {
+		t.Errorf("expected CA bundle %q, got %q", "first CA", conversion.Webhook.ClientConfig.CABundle)
+	}
+
+	resourceVersion := current.ResourceVersion
+	if current = reconcileCRD(); current.ResourceVersion != resourceVersion {
+		t.Error("expected an up-to-date CustomResourceDefinition not to be patched")
+	}
+
+	// A rotated CA bundle is picked up.
+	if err := os.WriteFile(caFile, []byte("second CA"), 0o600); err != nil {
+		t.Fatalf("failed to write CA bundle: %v", err)
+	}
+
+	if current = reconcileCRD(); string(current.Spec.Conversion.Webhook.ClientConfig.CABundle) != "second CA" {
+		t.Errorf("expected CA bundle %q, got %q", "second CA", current.Spec.Conversion.Webhook.ClientConfig.CABundle)
+	}
+}
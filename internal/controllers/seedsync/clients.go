@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seedsync
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// defaultKubeconfigKey is the key of the kubeconfig in the Secret referenced by a Seed,
+// if the reference does not set a fieldPath.
+const defaultKubeconfigKey = "kubeconfig"
+
+// SeedClientProvider returns clients for the clusters of Seeds.
+type SeedClientProvider interface {
+	SeedClient(ctx context.Context, seed *kubermaticv1.Seed) (ctrlruntimeclient.Client, error)
+}
+
+// NewKubeconfigClientProvider returns a SeedClientProvider which builds the clients from the
+// kubeconfig Secrets referenced by the Seeds. Clients are reused until the Secret changes.
+// The Secrets must be in the given namespace, as the manager only caches Secrets of its own
+// namespace.
+func NewKubeconfigClientProvider(reader ctrlruntimeclient.Reader, scheme *runtime.Scheme, namespace string) SeedClientProvider {
+	return &kubeconfigClientProvider{
+		reader:    reader,
+		scheme:    scheme,
+		namespace: namespace,
+		clients:   map[string]cachedClient{},
+	}
+}
+
+type kubeconfigClientProvider struct {
+	reader    ctrlruntimeclient.Reader
+	scheme    *runtime.Scheme
+	namespace string
+	lock      sync.Mutex
+	clients   map[string]cachedClient
+}
+
+type cachedClient struct {
+	secret ctrlruntimeclient.ObjectKey
+	// resourceVersion is the resourceVersion of the Secret the client was built from.
+	resourceVersion string
+	client          ctrlruntimeclient.Client
+}
+
+func (p *kubeconfigClientProvider) SeedClient(ctx context.Context, seed *kubermaticv1.Seed) (ctrlruntimeclient.Client, error) {
+	ref := seed.Spec.Kubeconfig
+	key, err := kubeconfigSecretKey(seed, p.namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{}
+	if err := p.reader.Get(ctx, key, secret); err != nil {
+		return nil, fmt.Errorf("failed to get kubeconfig Secret %s: %w", key, err)
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if cached, ok := p.clients[seed.Name]; ok && cached.secret == key && cached.resourceVersion == secret.ResourceVersion {
+		return cached.client, nil
+	}
+
+	client, err := newClient(secret, ref.FieldPath, p.scheme)
+	if err != nil {
+		return nil, fmt.Errorf("kubeconfig Secret %s: %w", key, err)
+	}
+
+	p.clients[seed.Name] = cachedClient{
+		secret:          key,
+		resourceVersion: secret.ResourceVersion,
+		client:          client,
+	}
+
+	return client, nil
+}
+
+// kubeconfigSecretKey returns the key of the kubeconfig Secret referenced by the Seed, which
+// must be in the given namespace.
+func kubeconfigSecretKey(seed *kubermaticv1.Seed, namespace string) (ctrlruntimeclient.ObjectKey, error) {
+	ref := seed.Spec.Kubeconfig
+	if ref.Name == "" {
+		return ctrlruntimeclient.ObjectKey{}, fmt.Errorf("seed does not reference a kubeconfig")
+	}
+
+	key := ctrlruntimeclient.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}
+	if key.Namespace == "" {
+		key.Namespace = seed.Namespace
+	}
+
+	if key.Namespace != namespace {
+		return ctrlruntimeclient.ObjectKey{}, fmt.Errorf("kubeconfig Secret %s must be in the namespace %q of the manager", key, namespace)
+	}
+
+	return key, nil
+}
+
+// ValidateSeeds checks that the kubeconfig Secrets of all Seeds in the namespace are in the
+// same namespace. It is meant to be called with an uncached reader before the manager starts.
+func ValidateSeeds(ctx context.Context, reader ctrlruntimeclient.Reader, namespace string) error {
+	seeds := &kubermaticv1.SeedList{}
+	if err := reader.List(ctx, seeds, ctrlruntimeclient.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("failed to list Seeds: %w", err)
+	}
+
+	var errs []error
+	for i := range seeds.Items {
+		seed := &seeds.Items[i]
+		if seed.Spec.Kubeconfig.Name == "" {
+			continue
+		}
+
+		if _, err := kubeconfigSecretKey(seed, namespace); err != nil {
+			errs = append(errs, fmt.Errorf("seed %s: %w", seed.Name, err))
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// newClient returns a client for the cluster of the kubeconfig stored in the Secret under
+// the given key, or under the default key if it is empty.
+func newClient(secret *corev1.Secret, key string, scheme *runtime.Scheme) (ctrlruntimeclient.Client, error) {
+	if key == "" {
+		key = defaultKubeconfigKey
+	}
+
+	kubeconfig, ok := secret.Data[key]
+	if !ok {
+		return nil, fmt.Errorf("key %q not found", key)
+	}
+
+	cfg, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kubeconfig: %w", err)
+	}
+
+	return ctrlruntimeclient.New(cfg, ctrlruntimeclient.Options{Scheme: scheme})
+}
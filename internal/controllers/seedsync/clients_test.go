@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seedsync
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestSeedWithKubeconfig(name, secretNamespace string) *kubermaticv1.Seed {
+	seed := newTestSeed(name)
+	seed.Spec.Kubeconfig = corev1.ObjectReference{Namespace: secretNamespace, Name: name + "-kubeconfig"}
+
+	return seed
+}
+
+func TestValidateSeeds(t *testing.T) {
+	tests := []struct {
+		name     string
+		seeds    []ctrlruntimeclient.Object
+		errorMsg string
+	}{
+		{
+			name: "secrets in the manager namespace",
+			seeds: []ctrlruntimeclient.Object{
+				newTestSeedWithKubeconfig("explicit", "kubermatic"),
+				newTestSeedWithKubeconfig("implicit", ""),
+			},
+		},
+		{
+			name: "secret in another namespace",
+			seeds: []ctrlruntimeclient.Object{
+				newTestSeedWithKubeconfig("europe", "kubermatic"),
+				newTestSeedWithKubeconfig("asia", "seeds"),
+			},
+			errorMsg: `seed asia: kubeconfig Secret seeds/asia-kubeconfig must be in the namespace "kubermatic" of the manager`,
+		},
+		{
+			name: "seeds in other namespaces are ignored",
+			seeds: []ctrlruntimeclient.Object{
+				func() *kubermaticv1.Seed {
+					seed := newTestSeedWithKubeconfig("other", "")
+					seed.Namespace = "other"
+					return seed
+				}(),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := ctrlruntimefakeclient.NewClientBuilder().
+				WithScheme(newTestScheme(t)).
+				WithObjects(tc.seeds...).
+				Build()
+
+			err := ValidateSeeds(context.Background(), client, "kubermatic")
+
+			if tc.errorMsg == "" {
+				if err != nil {
+					t.Errorf("expected no error but got: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error but got nil")
+			}
+			if err.Error() != tc.errorMsg {
+				t.Errorf("expected error message %q, got %q", tc.errorMsg, err.Error())
+			}
+		})
+	}
+}
+
+func TestSeedClientRejectsSecretInOtherNamespace(t *testing.T) {
+	scheme := newTestScheme(t)
+	provider := NewKubeconfigClientProvider(ctrlruntimefakeclient.NewClientBuilder().WithScheme(scheme).Build(), scheme, "kubermatic")
+
+	_, err := provider.SeedClient(context.Background(), newTestSeedWithKubeconfig("asia", "seeds"))
+	if err == nil || !strings.Contains(err.Error(), `must be in the namespace "kubermatic" of the manager`) {
+		t.Errorf("expected namespace error, got: %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seedsync
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	controllerName = "SeedSyncController"
+)
+
+// ControllerConfig holds the configuration for the seed sync controller.
+type ControllerConfig struct {
+	Log *zap.SugaredLogger
+
+	// ReconciliationInterval is the duration after which the controller will requeue
+	// the ApplicationCatalog to synchronize it to the Seeds again. When set to 0, the
+	// catalog is only synchronized on changes.
+	ReconciliationInterval time.Duration
+
+	// Namespace is the namespace the controller is deployed in. The Seeds and their
+	// kubeconfig Secrets are read from this namespace, Seeds referencing Secrets in other
+	// namespaces cannot be synchronized.
+	Namespace string
+
+	// SeedClients returns the clients for the Seeds. If nil, the clients are built from
+	// the kubeconfig Secrets referenced by the Seeds.
+	SeedClients SeedClientProvider
+}
+
+func (c *ControllerConfig) validate() error {
+	if c.Log == nil {
+		return fmt.Errorf("log cannot be nil")
+	}
+
+	if c.Namespace == "" {
+		return fmt.Errorf("namespace cannot be empty")
+	}
+
+	return nil
+}
+
+// Reconciler synchronizes the ApplicationDefinitions of ApplicationCatalogs to the Seeds.
+type Reconciler struct {
+	ctrlruntimeclient.Client
+	cfg         *ControllerConfig
+	logger      *zap.SugaredLogger
+	seedClients SeedClientProvider
+}
+
+// Add creates a new seed sync controller and adds it to the Manager.
+// The Manager will set fields on the Reconciler and start it when the Manager is started.
+func Add(mgr manager.Manager, cfg *ControllerConfig) error {
+	if cfg == nil {
+		return fmt.Errorf("failed to instantiate controller: config is nil")
+	}
+
+	if err := cfg.validate(); err != nil {
+		return fmt.Errorf("failed to instantiate controller: %w", err)
+	}
+
+	seedClients := cfg.SeedClients
+	if seedClients == nil {
+		seedClients = NewKubeconfigClientProvider(mgr.GetClient(), mgr.GetScheme(), cfg.Namespace)
+	}
+
+	reconciler := &Reconciler{
+		Client:      mgr.GetClient(),
+		cfg:         cfg,
+		logger:      cfg.Log,
+		seedClients: seedClients,
+	}
+
+	inNamespace := predicate.NewPredicateFuncs(func(obj ctrlruntimeclient.Object) bool {
+		return obj.GetNamespace() == cfg.Namespace
+	})
+
+	// Watch ApplicationCatalog as the primary resource. Changes of the ApplicationDefinitions
+	// re-sync their previous and current catalog, and changes of the Seeds re-sync all catalogs.
+	_, err := builder.ControllerManagedBy(mgr).
+		Named(controllerName).
+		For(&catalogv1alpha1.ApplicationCatalog{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(
+			&appskubermaticv1.ApplicationDefinition{},
+			handler.Funcs{
+				CreateFunc: func(_ context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+					enqueueOwners(q, e.Object)
+				},
+				UpdateFunc: func(_ context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+					enqueueOwners(q, e.ObjectOld, e.ObjectNew)
+				},
+				DeleteFunc: func(_ context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+					enqueueOwners(q, e.Object)
+				},
+			},
+		).
+		Watches(
+			&kubermaticv1.Seed{},
+			handler.EnqueueRequestsFromMapFunc(reconciler.enqueueAllCatalogs),
+			builder.WithPredicates(inNamespace),
+		).
+		Build(reconciler)
+
+	return err
+}
+
+// enqueueOwners enqueues the ApplicationCatalogs owning the given ApplicationDefinitions.
+func enqueueOwners(q workqueue.TypedRateLimitingInterface[reconcile.Request], objs ...ctrlruntimeclient.Object) {
+	for _, obj := range objs {
+		if owner := obj.GetLabels()[catalogv1alpha1.LabelApplicationCatalogName]; owner != "" {
+			q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Name: owner}})
+		}
+	}
+}
+
+// enqueueAllCatalogs enqueues all ApplicationCatalogs.
+func (r *Reconciler) enqueueAllCatalogs(ctx context.Context, _ ctrlruntimeclient.Object) []reconcile.Request {
+	catalogs := &catalogv1alpha1.ApplicationCatalogList{}
+	if err := r.List(ctx, catalogs); err != nil {
+		r.logger.Errorw("Failed to list ApplicationCatalogs", "error", err)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(catalogs.Items))
+	for i := range catalogs.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: catalogs.Items[i].Name},
+		})
+	}
+
+	return requests
+}
@@ -0,0 +1,27 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package seedsync implements a controller for KKP master clusters that synchronizes the
+// ApplicationDefinitions generated from the ApplicationCatalogs to all Seeds.
+//
+// Key features:
+// - Seeds are discovered from the Seed objects in the namespace of the manager
+// - Clients are built from the kubeconfig Secrets referenced by the Seeds
+// - Kubeconfig Secrets must be in the namespace of the manager, the only one it caches
+// - Spec, labels and annotations of the catalog are mirrored to the Seeds
+// - ApplicationDefinitions unmanaged or handed over on the master are mirrored as well
+// - The state of the synchronization to every Seed is recorded in the catalog status
+package seedsync
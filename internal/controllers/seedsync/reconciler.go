@@ -0,0 +1,300 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seedsync
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"k8c.io/application-catalog-manager/internal/pkg/kubernetes"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	l := r.logger.With("catalog", req.Name)
+	l.Info("Synchronizing ApplicationCatalog to Seeds")
+
+	if err := r.reconcile(ctx, l, req); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if r.cfg.ReconciliationInterval > 0 {
+		return reconcile.Result{RequeueAfter: r.cfg.ReconciliationInterval}, nil
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, l *zap.SugaredLogger, req reconcile.Request) error {
+	// A deleted catalog is still synchronized, so that the seeds unmanage its
+	// ApplicationDefinitions like the master does.
+	catalog := &catalogv1alpha1.ApplicationCatalog{}
+	if err := r.Get(ctx, req.NamespacedName, catalog); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get ApplicationCatalog: %w", err)
+		}
+		catalog = nil
+	}
+
+	seeds := &kubermaticv1.SeedList{}
+	if err := r.List(ctx, seeds, ctrlruntimeclient.InNamespace(r.cfg.Namespace)); err != nil {
+		return fmt.Errorf("failed to list Seeds: %w", err)
+	}
+
+	appDefs := &appskubermaticv1.ApplicationDefinitionList{}
+	if err := r.List(ctx, appDefs, ctrlruntimeclient.MatchingLabels{catalogv1alpha1.LabelApplicationCatalogName: req.Name}); err != nil {
+		return fmt.Errorf("failed to list ApplicationDefinitions: %w", err)
+	}
+
+	var errs []error
+	statuses := make([]catalogv1alpha1.SeedSyncStatus, 0, len(seeds.Items))
+
+	for i := range seeds.Items {
+		seed := &seeds.Items[i]
+		status := catalogv1alpha1.SeedSyncStatus{Name: seed.Name, Synced: true}
+
+		if err := r.syncSeed(ctx, l.With("seed", seed.Name), req.Name, seed, appDefs.Items); err != nil {
+			errs = append(errs, fmt.Errorf("seed %q: %w", seed.Name, err))
+			status.Synced = false
+			status.Message = err.Error()
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	if catalog != nil {
+		if err := r.updateStatus(ctx, catalog, statuses); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update status: %w", err))
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// updateStatus records the per-Seed synchronization state in the catalog status. The
+// transition time only changes with the state, so a successful re-sync does not patch the
+// catalog.
+func (r *Reconciler) updateStatus(ctx context.Context, catalog *catalogv1alpha1.ApplicationCatalog, statuses []catalogv1alpha1.SeedSyncStatus) error {
+	previous := make(map[string]catalogv1alpha1.SeedSyncStatus, len(catalog.Status.Seeds))
+	for _, status := range catalog.Status.Seeds {
+		previous[status.Name] = status
+	}
+
+	now := metav1.Now()
+	for i := range statuses {
+		old, ok := previous[statuses[i].Name]
+		if ok && old.Synced == statuses[i].Synced && old.Message == statuses[i].Message {
+			statuses[i].LastTransitionTime = old.LastTransitionTime
+		} else {
+			statuses[i].LastTransitionTime = now
+		}
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+
+	if len(statuses) == 0 {
+		statuses = nil
+	}
+
+	if equality.Semantic.DeepEqual(catalog.Status.Seeds, statuses) {
+		return nil
+	}
+
+	oldCatalog := catalog.DeepCopy()
+	catalog.Status.Seeds = statuses
+
+	return r.Status().Patch(ctx, catalog, ctrlruntimeclient.MergeFrom(oldCatalog))
+}
+
+// syncSeed mirrors the ApplicationDefinitions of the catalog to the Seed. ApplicationDefinitions
+// on the Seed which are not part of the catalog on the master anymore are mirrored as well, so
+// that they are unmanaged or handed over like on the master. ApplicationDefinitions are never
+// deleted from the Seed.
+func (r *Reconciler) syncSeed(
+	ctx context.Context,
+	l *zap.SugaredLogger,
+	catalogName string,
+	seed *kubermaticv1.Seed,
+	appDefs []appskubermaticv1.ApplicationDefinition,
+) error {
+	seedClient, err := r.seedClients.SeedClient(ctx, seed)
+	if err != nil {
+		return fmt.Errorf("failed to get client: %w", err)
+	}
+
+	var errs []error
+	synced := make(map[string]bool, len(appDefs))
+
+	for i := range appDefs {
+		synced[appDefs[i].Name] = true
+
+		if err := syncApplicationDefinition(ctx, l, seedClient, &appDefs[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	seedAppDefs := &appskubermaticv1.ApplicationDefinitionList{}
+	if err := seedClient.List(ctx, seedAppDefs, ctrlruntimeclient.MatchingLabels{catalogv1alpha1.LabelApplicationCatalogName: catalogName}); err != nil {
+		return fmt.Errorf("failed to list ApplicationDefinitions: %w", err)
+	}
+
+	for i := range seedAppDefs.Items {
+		seedAppDef := &seedAppDefs.Items[i]
+		if synced[seedAppDef.Name] {
+			continue
+		}
+
+		appDef := &appskubermaticv1.ApplicationDefinition{}
+		if err := r.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(seedAppDef), appDef); err != nil {
+			if !apierrors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("failed to get ApplicationDefinition %q: %w", seedAppDef.Name, err))
+				continue
+			}
+
+			// The ApplicationDefinition was deleted on the master. Like orphans on the master,
+			// it is kept on the Seed but not managed anymore.
+			l.Debugw("Unmanaging ApplicationDefinition deleted on the master", "name", seedAppDef.Name)
+			if err := unmanage(ctx, seedClient, seedAppDef); err != nil {
+				errs = append(errs, fmt.Errorf("failed to unmanage ApplicationDefinition %q: %w", seedAppDef.Name, err))
+			}
+			continue
+		}
+
+		if err := syncApplicationDefinition(ctx, l, seedClient, appDef); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// syncApplicationDefinition creates or updates the ApplicationDefinition on the Seed to match
+// the one on the master. Labels and annotations which are not managed by the catalog, like the
+// ones of other controllers on the Seed, are kept. Like on the master, the fields owned by an
+// admin of the Seed are preserved, see kubernetes.PreserveClusterFields.
+func syncApplicationDefinition(
+	ctx context.Context,
+	l *zap.SugaredLogger,
+	seedClient ctrlruntimeclient.Client,
+	appDef *appskubermaticv1.ApplicationDefinition,
+) error {
+	existing := &appskubermaticv1.ApplicationDefinition{}
+	if err := seedClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(appDef), existing); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get ApplicationDefinition %q: %w", appDef.Name, err)
+		}
+
+		desired := &appskubermaticv1.ApplicationDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: appDef.Name},
+			Spec:       *appDef.Spec.DeepCopy(),
+		}
+		desired.Labels = mirrorCatalogKeys(nil, appDef.Labels)
+		desired.Annotations = mirrorCatalogKeys(nil, appDef.Annotations)
+
+		l.Debugw("Creating ApplicationDefinition", "name", appDef.Name)
+		if err := seedClient.Create(ctx, desired); err != nil {
+			return fmt.Errorf("failed to create ApplicationDefinition %q: %w", appDef.Name, err)
+		}
+		return nil
+	}
+
+	// Like on the master, an admin can take over an ApplicationDefinition on a Seed.
+	if _, ok := existing.Annotations[catalogv1alpha1.AnnotationBreakGlass]; ok {
+		l.Debugw("Skipping ApplicationDefinition with break-glass annotation", "name", appDef.Name)
+		return nil
+	}
+
+	desired := existing.DeepCopy()
+	desired.Spec = *appDef.Spec.DeepCopy()
+	kubernetes.PreserveClusterFields(existing, desired)
+	desired.Labels = mirrorCatalogKeys(desired.Labels, appDef.Labels)
+	desired.Annotations = mirrorCatalogKeys(desired.Annotations, appDef.Annotations)
+
+	if equality.Semantic.DeepEqual(existing.Spec, desired.Spec) &&
+		equality.Semantic.DeepEqual(existing.Labels, desired.Labels) &&
+		equality.Semantic.DeepEqual(existing.Annotations, desired.Annotations) {
+		return nil
+	}
+
+	l.Debugw("Updating ApplicationDefinition", "name", appDef.Name)
+	if err := kubernetes.PatchObject(ctx, seedClient, existing, func() {
+		existing.Spec = desired.Spec
+		existing.Labels = desired.Labels
+		existing.Annotations = desired.Annotations
+	}); err != nil {
+		return fmt.Errorf("failed to update ApplicationDefinition %q: %w", appDef.Name, err)
+	}
+
+	return nil
+}
+
+// unmanage removes the labels and annotations managed by the catalog from the
+// ApplicationDefinition on the Seed.
+func unmanage(ctx context.Context, seedClient ctrlruntimeclient.Client, appDef *appskubermaticv1.ApplicationDefinition) error {
+	return kubernetes.PatchObject(ctx, seedClient, appDef, func() {
+		appDef.Labels = mirrorCatalogKeys(appDef.Labels, nil)
+		appDef.Annotations = mirrorCatalogKeys(appDef.Annotations, nil)
+	})
+}
+
+// isCatalogKey returns true if the label or annotation is managed by the catalog. The
+// break-glass annotation is set by admins on each cluster and therefore never mirrored.
+func isCatalogKey(key string) bool {
+	if key == catalogv1alpha1.AnnotationBreakGlass {
+		return false
+	}
+
+	return strings.HasPrefix(key, "applicationcatalog.k8c.io/") ||
+		strings.HasPrefix(key, catalogv1alpha1.AnnotationPrefixVersionDefaultValues)
+}
+
+// mirrorCatalogKeys returns the entries of existing which are not managed by the catalog,
+// together with the entries of source which are.
+func mirrorCatalogKeys(existing, source map[string]string) map[string]string {
+	result := make(map[string]string, len(existing)+len(source))
+	for key, value := range existing {
+		if !isCatalogKey(key) {
+			result[key] = value
+		}
+	}
+	for key, value := range source {
+		if isCatalogKey(key) {
+			result[key] = value
+		}
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+
+	return result
+}
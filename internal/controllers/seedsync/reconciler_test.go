@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package seedsync
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// staticClientProvider returns fixed clients for the Seeds, and an error for unknown Seeds.
+type staticClientProvider map[string]ctrlruntimeclient.Client
+
+func (p staticClientProvider) SeedClient(_ context.Context, seed *kubermaticv1.Seed) (ctrlruntimeclient.Client, error) {
+	if client, ok := p[seed.Name]; ok {
+		return client, nil
+	}
+
+	return nil, fmt.Errorf("seed is unreachable")
+}
+
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := catalogv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add catalogv1alpha1 to scheme: %v", err)
+	}
+	if err := appskubermaticv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add appskubermaticv1 to scheme: %v", err)
+	}
+	if err := kubermaticv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add kubermaticv1 to scheme: %v", err)
+	}
+
+	return scheme
+}
+
+func newTestSeed(name string) *kubermaticv1.Seed {
+	return &kubermaticv1.Seed{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "kubermatic"}}
+}
+
+func newTestApplicationDefinition(name, catalog, description string) *appskubermaticv1.ApplicationDefinition {
+	appDef := &appskubermaticv1.ApplicationDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{catalogv1alpha1.AnnotationPrefixVersionDefaultValues + "v1.0.0": "replicas: 1"},
+		},
+		Spec: appskubermaticv1.ApplicationDefinitionSpec{Description: description},
+	}
+
+	if catalog != "" {
+		appDef.Labels = map[string]string{
+			catalogv1alpha1.LabelManagedByApplicationCatalog: "true",
+			catalogv1alpha1.LabelApplicationCatalogName:      catalog,
+		}
+	}
+
+	return appDef
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+	scheme := newTestScheme(t)
+
+	catalog := &catalogv1alpha1.ApplicationCatalog{ObjectMeta: metav1.ObjectMeta{Name: "platform"}}
+
+	master := ctrlruntimefakeclient.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			catalog,
+			newTestSeed("europe"),
+			newTestSeed("asia"),
+			newTestApplicationDefinition("nginx", "platform", "nginx"),
+			newTestApplicationDefinition("redis", "", "redis"),
+			newTestApplicationDefinition("other", "team", "other"),
+		).
+		WithStatusSubresource(catalog).
+		Build()
+
+	// The Seed already has an ApplicationDefinition with labels of another controller, and
+	// ApplicationDefinitions which are not managed by the catalog on the master anymore.
+	outdated := newTestApplicationDefinition("nginx", "platform", "outdated")
+	outdated.Labels["example.com/team"] = "platform"
+	seed := ctrlruntimefakeclient.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			outdated,
+			newTestApplicationDefinition("redis", "platform", "redis"),
+			newTestApplicationDefinition("deleted", "platform", "deleted"),
+		).
+		Build()
+
+	r := &Reconciler{
+		Client:      master,
+		cfg:         &ControllerConfig{Namespace: "kubermatic"},
+		logger:      zap.NewNop().Sugar(),
+		seedClients: staticClientProvider{"europe": seed},
+	}
+
+	_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "platform"}})
+	if err == nil || !strings.Contains(err.Error(), `seed "asia"`) {
+		t.Fatalf("expected the unreachable Seed to fail the reconciliation, got %v", err)
+	}
+
+	getSeedAppDef := func(name string) *appskubermaticv1.ApplicationDefinition {
+		t.Helper()
+
+		appDef := &appskubermaticv1.ApplicationDefinition{}
+		if err := seed.Get(ctx, types.NamespacedName{Name: name}, appDef); err != nil {
+			t.Fatalf("failed to get ApplicationDefinition %q from Seed: %v", name, err)
+		}
+		return appDef
+	}
+
+	nginx := getSeedAppDef("nginx")
+	if nginx.Spec.Description != "nginx" {
+		t.Errorf("expected the spec to be synced, got description %q", nginx.Spec.Description)
+	}
+	if nginx.Labels["example.com/team"] != "platform" {
+		t.Errorf("expected labels of other controllers to be kept, got %v", nginx.Labels)
+	}
+
+	// Unmanaged on the master, so unmanaged on the Seed.
+	redis := getSeedAppDef("redis")
+	if _, ok := redis.Labels[catalogv1alpha1.LabelApplicationCatalogName]; ok {
+		t.Errorf("expected ApplicationDefinition to be unmanaged, got labels %v", redis.Labels)
+	}
+
+	// Deleted on the master, but kept unmanaged on the Seed.
+	deleted := getSeedAppDef("deleted")
+	if _, ok := deleted.Labels[catalogv1alpha1.LabelApplicationCatalogName]; ok {
+		t.Errorf("expected ApplicationDefinition to be unmanaged, got labels %v", deleted.Labels)
+	}
+	if _, ok := deleted.Annotations[catalogv1alpha1.AnnotationPrefixVersionDefaultValues+"v1.0.0"]; ok {
+		t.Errorf("expected catalog annotations to be removed, got %v", deleted.Annotations)
+	}
+
+	// ApplicationDefinitions of other catalogs are synced by their catalogs.
+	if err := seed.Get(ctx, types.NamespacedName{Name: "other"}, &appskubermaticv1.ApplicationDefinition{}); err == nil {
+		t.Error("expected ApplicationDefinition of another catalog not to be synced")
+	}
+
+	if err := master.Get(ctx, types.NamespacedName{Name: "platform"}, catalog); err != nil {
+		t.Fatalf("failed to get catalog: %v", err)
+	}
+
+	seeds := catalog.Status.Seeds
+	if len(seeds) != 2 || seeds[0].Name != "asia" || seeds[1].Name != "europe" {
+		t.Fatalf("expected status of Seeds asia and europe, got %v", seeds)
+	}
+	if seeds[0].Synced || !strings.Contains(seeds[0].Message, "seed is unreachable") {
+		t.Errorf("expected Seed asia to fail, got %v", seeds[0])
+	}
+	if !seeds[1].Synced || seeds[1].Message != "" {
+		t.Errorf("expected Seed europe to be synced, got %v", seeds[1])
+	}
+
+	// A re-sync without changes does not touch the catalog.
+	resourceVersion := catalog.ResourceVersion
+	_, _ = r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "platform"}})
+
+	if err := master.Get(ctx, types.NamespacedName{Name: "platform"}, catalog); err != nil {
+		t.Fatalf("failed to get catalog: %v", err)
+	}
+	if catalog.ResourceVersion != resourceVersion {
+		t.Error("expected an unchanged status not to be patched")
+	}
+}
+
+func TestSyncApplicationDefinitionBreakGlass(t *testing.T) {
+	ctx := context.Background()
+
+	existing := newTestApplicationDefinition("nginx", "platform", "patched by admin")
+	existing.Annotations[catalogv1alpha1.AnnotationBreakGlass] = "true"
+
+	seed := ctrlruntimefakeclient.NewClientBuilder().
+		WithScheme(newTestScheme(t)).
+		WithObjects(existing).
+		Build()
+
+	if err := syncApplicationDefinition(ctx, zap.NewNop().Sugar(), seed, newTestApplicationDefinition("nginx", "platform", "nginx")); err != nil {
+		t.Fatalf("failed to sync ApplicationDefinition: %v", err)
+	}
+
+	appDef := &appskubermaticv1.ApplicationDefinition{}
+	if err := seed.Get(ctx, types.NamespacedName{Name: "nginx"}, appDef); err != nil {
+		t.Fatalf("failed to get ApplicationDefinition: %v", err)
+	}
+	if appDef.Spec.Description != "patched by admin" {
+		t.Errorf("expected ApplicationDefinition with break-glass annotation to be skipped, got description %q", appDef.Spec.Description)
+	}
+}
+
+func TestSyncApplicationDefinitionPreservesClusterFields(t *testing.T) {
+	ctx := context.Background()
+
+	existing := newTestApplicationDefinition("nginx", "platform", "outdated")
+	existing.Spec.Enforced = true
+	existing.Spec.Default = true
+	existing.Spec.DefaultVersion = "1.0.0"
+	existing.Spec.Selector.Datacenters = []string{"europe-west"}
+
+	seed := ctrlruntimefakeclient.NewClientBuilder().
+		WithScheme(newTestScheme(t)).
+		WithObjects(existing).
+		Build()
+
+	if err := syncApplicationDefinition(ctx, zap.NewNop().Sugar(), seed, newTestApplicationDefinition("nginx", "platform", "nginx")); err != nil {
+		t.Fatalf("failed to sync ApplicationDefinition: %v", err)
+	}
+
+	appDef := &appskubermaticv1.ApplicationDefinition{}
+	if err := seed.Get(ctx, types.NamespacedName{Name: "nginx"}, appDef); err != nil {
+		t.Fatalf("failed to get ApplicationDefinition: %v", err)
+	}
+	if appDef.Spec.Description != "nginx" {
+		t.Errorf("expected the spec to be synced, got description %q", appDef.Spec.Description)
+	}
+	if !appDef.Spec.Enforced || !appDef.Spec.Default || appDef.Spec.DefaultVersion != "1.0.0" || !reflect.DeepEqual(appDef.Spec.Selector.Datacenters, []string{"europe-west"}) {
+		t.Errorf("expected the fields owned by the admin of the Seed to be preserved, got %+v", appDef.Spec)
+	}
+}
@@ -17,16 +17,27 @@ limitations under the License.
 package synchronizer
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"go.uber.org/zap"
 
+	"k8c.io/application-catalog-manager/internal/pkg/imports"
 	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 const (
@@ -41,6 +52,10 @@ type ControllerConfig struct {
 	// the ApplicationCatalog for reconciliation. When set to 0, the default reconciliation
 	// interval is used.
 	ReconciliationInterval time.Duration
+
+	// Namespace is the namespace the controller is deployed in. ConfigMaps and Secrets
+	// referenced by the ApplicationCatalogs are read from this namespace.
+	Namespace string
 }
 
 func (c *ControllerConfig) validate() error {
@@ -52,14 +67,19 @@ func (c *ControllerConfig) validate() error {
 		return fmt.Errorf("reconciliation interval must be a non-negative duration")
 	}
 
+	if c.Namespace == "" {
+		return fmt.Errorf("namespace cannot be empty")
+	}
+
 	return nil
 }
 
 // Reconciler reconciles ApplicationCatalog objects.
 type Reconciler struct {
 	ctrlruntimeclient.Client
-	cfg    *ControllerConfig
-	logger *zap.SugaredLogger
+	cfg     *ControllerConfig
+	logger  *zap.SugaredLogger
+	imports *imports.Resolver
 }
 
 // Add creates a new Synchronizer controller and adds it to the Manager.
@@ -74,16 +94,192 @@ func Add(mgr manager.Manager, cfg *ControllerConfig) error {
 	}
 
 	reconciler := &Reconciler{
-		Client: mgr.GetClient(),
-		cfg:    cfg,
-		logger: cfg.Log,
+		Client:  mgr.GetClient(),
+		cfg:     cfg,
+		logger:  cfg.Log,
+		imports: imports.NewResolver(mgr.GetClient()),
 	}
 
-	// Watch ApplicationCatalog as the primary resource.
+	inNamespace := predicate.NewPredicateFuncs(func(obj ctrlruntimeclient.Object) bool {
+		return obj.GetNamespace() == cfg.Namespace
+	})
+
+	// Watch ApplicationCatalog as the primary resource, and the ConfigMaps and Secrets
+	// referenced in defaultValuesFrom, logoFrom and varsFrom to re-sync the ApplicationDefinitions on changes.
+	// Changes of a catalog also re-sync all catalogs importing it, the deletion of a catalog the
+	// catalogs it imported, and ApplicationDefinitions handed over to another catalog re-sync the
+	// previous and the new owner and the displaced catalogs.
 	_, err := builder.ControllerManagedBy(mgr).
 		Named(controllerName).
 		For(&catalogv1alpha1.ApplicationCatalog{}).
+		Watches(
+			&catalogv1alpha1.ApplicationCatalog{},
+			handler.EnqueueRequestsFromMapFunc(reconciler.enqueueImportingCatalogs),
+		).
+		Watches(
+			&catalogv1alpha1.ApplicationCatalog{},
+			handler.EnqueueRequestsFromMapFunc(reconciler.enqueueImportedCatalogs),
+			builder.WithPredicates(predicate.Funcs{
+				CreateFunc:  func(event.CreateEvent) bool { return false },
+				UpdateFunc:  func(event.UpdateEvent) bool { return false },
+				GenericFunc: func(event.GenericEvent) bool { return false },
+			}),
+		).
+		Watches(
+			&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(reconciler.enqueueReferencingCatalogs(catalogv1alpha1.ValuesReferenceKindConfigMap)),
+			builder.WithPredicates(inNamespace),
+		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(reconciler.enqueueReferencingCatalogs(catalogv1alpha1.ValuesReferenceKindSecret)),
+			builder.WithPredicates(inNamespace),
+		).
+		Watches(
+			&appskubermaticv1.ApplicationDefinition{},
+			handler.Funcs{UpdateFunc: reconciler.enqueueOwnerChange},
+		).
 		Build(reconciler)
 
 	return err
 }
+
+// enqueueOwnerChange enqueues the previous and the new ApplicationCatalog owning an
+// ApplicationDefinition whose owner changed, and the catalogs whose chart for it is displaced,
+// so that they can take it over once it is unmanaged.
+func (r *Reconciler) enqueueOwnerChange(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	oldOwner := e.ObjectOld.GetLabels()[catalogv1alpha1.LabelApplicationCatalogName]
+	newOwner := e.ObjectNew.GetLabels()[catalogv1alpha1.LabelApplicationCatalogName]
+	if oldOwner == newOwner {
+		return
+	}
+
+	for _, owner := range []string{oldOwner, newOwner} {
+		if owner != "" {
+			q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Name: owner}})
+		}
+	}
+
+	catalogs := &catalogv1alpha1.ApplicationCatalogList{}
+	if err := r.List(ctx, catalogs); err != nil {
+		r.logger.Errorw("Failed to list ApplicationCatalogs", "error", err)
+		return
+	}
+
+	for i := range catalogs.Items {
+		for _, chart := range catalogs.Items[i].Status.DisplacedCharts {
+			if chart.ApplicationDefinition == e.ObjectNew.GetName() {
+				q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Name: catalogs.Items[i].Name}})
+				break
+			}
+		}
+	}
+}
+
+// enqueueReferencingCatalogs returns a map function that enqueues all ApplicationCatalogs
+// referencing the given object of the given kind.
+func (r *Reconciler) enqueueReferencingCatalogs(kind catalogv1alpha1.ValuesReferenceKind) handler.MapFunc {
+	return func(ctx context.Context, obj ctrlruntimeclient.Object) []reconcile.Request {
+		catalogs := &catalogv1alpha1.ApplicationCatalogList{}
+		if err := r.List(ctx, catalogs); err != nil {
+			r.logger.Errorw("Failed to list ApplicationCatalogs", "error", err)
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for i := range catalogs.Items {
+			if referencesObject(&catalogs.Items[i], kind, obj.GetName()) {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Name: catalogs.Items[i].Name},
+				})
+			}
+		}
+
+		return requests
+	}
+}
+
+// enqueueImportingCatalogs enqueues all ApplicationCatalogs importing the given catalog,
+// directly or transitively.
+func (r *Reconciler) enqueueImportingCatalogs(ctx context.Context, obj ctrlruntimeclient.Object) []reconcile.Request {
+	catalogs := &catalogv1alpha1.ApplicationCatalogList{}
+	if err := r.List(ctx, catalogs); err != nil {
+		r.logger.Errorw("Failed to list ApplicationCatalogs", "error", err)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range catalogs.Items {
+		catalog := &catalogs.Items[i]
+		if catalog.Name == obj.GetName() || len(catalog.Spec.Imports) == 0 {
+			continue
+		}
+
+		// Catalogs whose imports cannot be resolved are enqueued as well, since
+		// the change might have been the deletion of one of their imports.
+		imported, err := r.imports.ImportedCatalogs(ctx, catalog)
+		if err != nil || imported.Has(obj.GetName()) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: catalog.Name},
+			})
+		}
+	}
+
+	return requests
+}
+
+// enqueueImportedCatalogs enqueues the catalogs imported by a deleted catalog, directly or
+// transitively, so that they take over the ApplicationDefinitions of the imported charts
+// the deleted catalog released. Imports that cannot be resolved are enqueued directly.
+func (r *Reconciler) enqueueImportedCatalogs(ctx context.Context, obj ctrlruntimeclient.Object) []reconcile.Request {
+	catalog, ok := obj.(*catalogv1alpha1.ApplicationCatalog)
+	if !ok || len(catalog.Spec.Imports) == 0 {
+		return nil
+	}
+
+	imported, err := r.imports.ImportedCatalogs(ctx, catalog)
+	if err != nil {
+		imported = sets.New[string]()
+		for _, imp := range catalog.Spec.Imports {
+			imported.Insert(imp.Name)
+		}
+	}
+
+	var requests []reconcile.Request
+	for _, name := range sets.List(imported) {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: name},
+		})
+	}
+
+	return requests
+}
+
+// referencesObject returns true if the catalog references the object of the given kind and
+// name, either in the defaultValuesFrom of a chart or, for ConfigMaps, in the logoFrom of a
+// chart or in varsFrom.
+func referencesObject(catalog *catalogv1alpha1.ApplicationCatalog, kind catalogv1alpha1.ValuesReferenceKind, name string) bool {
+	if kind == catalogv1alpha1.ValuesReferenceKindConfigMap {
+		for _, ref := range catalog.Spec.VarsFrom {
+			if ref.Name == name {
+				return true
+			}
+		}
+	}
+
+	for _, chart := range catalog.GetHelmCharts() {
+		for _, ref := range chart.DefaultValuesFrom {
+			if ref.Kind == kind && ref.Name == name {
+				return true
+			}
+		}
+
+		if kind == catalogv1alpha1.ValuesReferenceKindConfigMap && chart.Metadata != nil &&
+			chart.Metadata.LogoFrom != nil && chart.Metadata.LogoFrom.ConfigMapKeyRef != nil &&
+			chart.Metadata.LogoFrom.ConfigMapKeyRef.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -17,10 +17,18 @@ limitations under the License.
 package synchronizer
 
 import (
+	"context"
+	"reflect"
 	"testing"
 	"time"
 
 	"go.uber.org/zap"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 func TestControllerConfigValidate(t *testing.T) {
@@ -37,6 +45,7 @@ func TestControllerConfigValidate(t *testing.T) {
 			cfg: &ControllerConfig{
 				Log:                    logger,
 				ReconciliationInterval: 10 * time.Minute,
+				Namespace:              "kubermatic",
 			},
 			expectError: false,
 		},
@@ -45,6 +54,7 @@ func TestControllerConfigValidate(t *testing.T) {
 			cfg: &ControllerConfig{
 				Log:                    logger,
 				ReconciliationInterval: 0,
+				Namespace:              "kubermatic",
 			},
 			expectError: false,
 		},
@@ -53,6 +63,7 @@ func TestControllerConfigValidate(t *testing.T) {
 			cfg: &ControllerConfig{
 				Log:                    logger,
 				ReconciliationInterval: -1 * time.Minute,
+				Namespace:              "kubermatic",
 			},
 			expectError: true,
 			errorMsg:    "reconciliation interval must be a non-negative duration",
@@ -66,6 +77,15 @@ func TestControllerConfigValidate(t *testing.T) {
 			expectError: true,
 			errorMsg:    "log cannot be nil",
 		},
+		{
+			name: "invalid config without namespace",
+			cfg: &ControllerConfig{
+				Log:                    logger,
+				ReconciliationInterval: 10 * time.Minute,
+			},
+			expectError: true,
+			errorMsg:    "namespace cannot be empty",
+		},
 	}
 
 	for _, tc := range tests {
@@ -88,3 +108,79 @@ func TestControllerConfigValidate(t *testing.T) {
 		})
 	}
 }
+
+func newImportTestCatalog(name string, imports ...string) *catalogv1alpha1.ApplicationCatalog {
+	catalog := &catalogv1alpha1.ApplicationCatalog{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	for _, imp := range imports {
+		catalog.Spec.Imports = append(catalog.Spec.Imports, catalogv1alpha1.CatalogImport{Name: imp})
+	}
+
+	return catalog
+}
+
+func TestEnqueueImportingCatalogs(t *testing.T) {
+	r := newTestReconciler(t,
+		newImportTestCatalog("prod", "platform"),
+		newImportTestCatalog("staging", "base"),
+		newImportTestCatalog("platform", "base"),
+		newImportTestCatalog("base"),
+		newImportTestCatalog("broken", "missing"),
+	)
+
+	requests := r.enqueueImportingCatalogs(context.Background(), newImportTestCatalog("base"))
+
+	var names []string
+	for _, req := range requests {
+		names = append(names, req.Name)
+	}
+
+	expected := []string{"broken", "platform", "prod", "staging"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected %v to be enqueued, got %v", expected, names)
+	}
+
+	if requests[0] != (reconcile.Request{NamespacedName: types.NamespacedName{Name: "broken"}}) {
+		t.Errorf("expected cluster-scoped request, got %v", requests[0])
+	}
+}
+
+func TestEnqueueImportedCatalogs(t *testing.T) {
+	r := newTestReconciler(t,
+		newImportTestCatalog("platform", "base"),
+		newImportTestCatalog("base"),
+	)
+
+	testCases := []struct {
+		name     string
+		catalog  *catalogv1alpha1.ApplicationCatalog
+		expected []string
+	}{
+		{
+			name:     "transitive imports",
+			catalog:  newImportTestCatalog("prod", "platform"),
+			expected: []string{"base", "platform"},
+		},
+		{
+			name:     "unresolvable imports",
+			catalog:  newImportTestCatalog("broken", "missing", "base"),
+			expected: []string{"base", "missing"},
+		},
+		{
+			name:    "no imports",
+			catalog: newImportTestCatalog("base"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var names []string
+			for _, req := range r.enqueueImportedCatalogs(context.Background(), tc.catalog) {
+				names = append(names, req.Name)
+			}
+
+			if !reflect.DeepEqual(names, tc.expected) {
+				t.Errorf("expected %v to be enqueued, got %v", tc.expected, names)
+			}
+		})
+	}
+}
@@ -17,22 +17,56 @@ limitations under the License.
 package synchronizer
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"k8c.io/application-catalog-manager/internal/pkg/validation"
+	"k8c.io/application-catalog-manager/internal/pkg/values"
 	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
 	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 )
 
 // convertChartToApplicationDefinition converts a ChartConfig from an ApplicationCatalog
 // into an ApplicationDefinition. This creates a new ApplicationDefinition with all
 // fields populated from the catalog.
+// The variables referenced in the default values are rendered with the given vars, the
+// names of undefined variables are returned. Afterwards the image registries of the chart
+// are rewritten according to the imageRegistryRewrite of the catalog.
 // The caller is responsible for preserving user customizations (like defaultValuesBlock)
 // when updating existing resources.
 func convertChartToApplicationDefinition(
 	catalog *catalogv1alpha1.ApplicationCatalog,
 	chart *catalogv1alpha1.ChartConfig,
-) *appskubermaticv1.ApplicationDefinition {
-	appName := chart.GetAppName()
+	vars map[string]string,
+) (*appskubermaticv1.ApplicationDefinition, []string, error) {
+	appName := catalog.ResolveAppName(chart)
+
+	chart, unresolved, err := renderDefaultValues(chart, vars)
+	if err != nil {
+		return nil, nil, &renderError{err: err}
+	}
+
+	if catalog.Spec.Helm != nil && len(catalog.Spec.Helm.ImageRegistryRewrite) > 0 {
+		if chart, err = rewriteImageRegistries(chart, catalog.Spec.Helm.ImageRegistryRewrite); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	annotations, err := convertVersionDefaultValues(chart)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The webhook cannot resolve variables and values from ConfigMaps, so the size is checked
+	// again before the ApplicationDefinition is rejected for its annotations.
+	if size := annotationsSize(annotations); size > validation.MaxVersionDefaultValuesSize {
+		return nil, nil, &renderError{err: fmt.Errorf("per-version default values take %d bytes, but may not exceed %d bytes combined, as they are stored in annotations of the ApplicationDefinition", size, validation.MaxVersionDefaultValuesSize)}
+	}
 
 	appDef := &appskubermaticv1.ApplicationDefinition{
 		ObjectMeta: metav1.ObjectMeta{
@@ -49,10 +83,26 @@ func convertChartToApplicationDefinition(
 		},
 	}
 
+	if sourceAppName := chart.GetAppName(); sourceAppName != appName {
+		annotations[catalogv1alpha1.AnnotationSourceAppName] = sourceAppName
+	}
+
+	if chart.DefaultValuesBlock != "" {
+		annotations[catalogv1alpha1.AnnotationDefaultValuesHash] = hashDefaultValues(chart.DefaultValuesBlock)
+	}
+
+	if len(annotations) > 0 {
+		appDef.Annotations = annotations
+	}
+
 	if chart.DefaultDeployOptions != nil {
 		appDef.Spec.DefaultDeployOptions = convertDeployOptions(chart.DefaultDeployOptions)
 	}
 
+	if chart.DefaultNamespace != nil {
+		appDef.Spec.DefaultNamespace = convertNamespace(chart.DefaultNamespace)
+	}
+
 	if chart.Metadata != nil {
 		appDef.Spec.DisplayName = chart.Metadata.DisplayName
 		appDef.Spec.Description = chart.Metadata.Description
@@ -62,7 +112,99 @@ func convertChartToApplicationDefinition(
 		appDef.Spec.LogoFormat = chart.Metadata.LogoFormat
 	}
 
-	return appDef
+	return appDef, unresolved, nil
+}
+
+// renderError is returned by convertChartToApplicationDefinition if the default values of
+// the chart could not be rendered.
+type renderError struct {
+	err error
+}
+
+func (e *renderError) Error() string {
+	return fmt.Sprintf("failed to render default values: %v", e.err)
+}
+
+func (e *renderError) Unwrap() error {
+	return e.err
+}
+
+// renderDefaultValues returns a copy of the chart whose chart-level and per-version default
+// values have their variables rendered, and the sorted names of the undefined variables.
+func renderDefaultValues(chart *catalogv1alpha1.ChartConfig, vars map[string]string) (*catalogv1alpha1.ChartConfig, []string, error) {
+	unresolved := sets.New[string]()
+	render := func(block *string, path string) error {
+		rendered, missing, err := values.Render(*block, vars)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		*block = rendered
+		unresolved.Insert(missing...)
+		return nil
+	}
+
+	rendered := chart.DeepCopy()
+	if err := render(&rendered.DefaultValuesBlock, "defaultValuesBlock"); err != nil {
+		return nil, nil, err
+	}
+	for i := range rendered.ChartVersions {
+		version := &rendered.ChartVersions[i]
+		if err := render(&version.DefaultValuesBlock, fmt.Sprintf("version %q defaultValuesBlock", version.AppVersion)); err != nil {
+			return nil, nil, err
+		}
+		if err := render(&version.DefaultValuesPatch, fmt.Sprintf("version %q defaultValuesPatch", version.AppVersion)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if unresolved.Len() == 0 {
+		return rendered, nil, nil
+	}
+
+	return rendered, sets.List(unresolved), nil
+}
+
+// convertVersionDefaultValues resolves the default values of all versions that override the
+// chart-level default values. KKP has no per-version default values, so they are returned as
+// annotations for the ApplicationDefinition.
+func convertVersionDefaultValues(chart *catalogv1alpha1.ChartConfig) (map[string]string, error) {
+	annotations := map[string]string{}
+
+	for i := range chart.ChartVersions {
+		version := &chart.ChartVersions[i]
+
+		switch {
+		case version.DefaultValuesBlock != "":
+			annotations[version.DefaultValuesAnnotation()] = version.DefaultValuesBlock
+
+		case version.DefaultValuesPatch != "":
+			merged, err := values.Merge(chart.DefaultValuesBlock, version.DefaultValuesPatch)
+			if err != nil {
+				return nil, fmt.Errorf("failed to merge default values of version %q: %w", version.AppVersion, err)
+			}
+			annotations[version.DefaultValuesAnnotation()] = merged
+		}
+	}
+
+	return annotations, nil
+}
+
+// annotationsSize returns the size the annotations count against the total annotation size limit.
+func annotationsSize(annotations map[string]string) int {
+	size := 0
+	for key, value := range annotations {
+		size += len(key) + len(value)
+	}
+
+	return size
+}
+
+// hashDefaultValues returns the hash of a values block that is recorded on the generated
+// ApplicationDefinition to detect changes made in the cluster.
+func hashDefaultValues(block string) string {
+	sum := sha256.Sum256([]byte(block))
+	return hex.EncodeToString(sum[:])
 }
 
 // convertVersions converts ChartVersions from a ChartConfig into ApplicationVersions.
@@ -92,9 +234,12 @@ func convertVersions(
 			},
 		}
 
-		creds := catalog.ResolveChartCredentials(chart, chartVersion)
-		if creds != nil {
-			version.Template.Source.Helm.Credentials = convertCredentials(creds)
+		if settings := catalog.ResolveChartRepositorySettings(chart, chartVersion); settings != nil {
+			convertTransportSettings(settings, version.Template.Source.Helm)
+
+			if settings.Credentials != nil {
+				version.Template.Source.Helm.Credentials = convertCredentials(settings.Credentials)
+			}
 		}
 
 		versions = append(versions, version)
@@ -103,6 +248,34 @@ func convertVersions(
 	return versions
 }
 
+// convertNamespace converts an AppNamespaceSpec from ApplicationCatalog format
+// to AppNamespaceSpec format used by ApplicationDefinition.
+func convertNamespace(ns *catalogv1alpha1.AppNamespaceSpec) *appskubermaticv1.AppNamespaceSpec {
+	if ns == nil {
+		return nil
+	}
+
+	return &appskubermaticv1.AppNamespaceSpec{
+		Name: ns.Name,
+		// Create defaults to true in both APIs.
+		Create:      ns.Create == nil || *ns.Create,
+		Labels:      ns.Labels,
+		Annotations: ns.Annotations,
+	}
+}
+
+// convertTransportSettings sets the TLS and transport options of the repository on the
+// HelmSource. Unset options are left nil, so KKP falls back to its defaults.
+func convertTransportSettings(settings *catalogv1alpha1.RepositorySettings, source *appskubermaticv1.HelmSource) {
+	if settings.InsecureSkipTLSVerify {
+		source.Insecure = ptr.To(true)
+	}
+
+	if settings.PlainHTTP {
+		source.PlainHTTP = ptr.To(true)
+	}
+}
+
 // convertCredentials converts RepositoryCredentials from ApplicationCatalog format
 // to HelmCredentials format used by ApplicationDefinition.
 func convertCredentials(creds *catalogv1alpha1.RepositoryCredentials) *appskubermaticv1.HelmCredentials {
@@ -128,7 +301,13 @@ func convertDeployOptions(opts *catalogv1alpha1.DeployOptions) *appskubermaticv1
 
 	if opts.Helm != nil {
 		result.Helm = &appskubermaticv1.HelmDeployOptions{
-			Wait: opts.Helm.Wait,
+			Wait:      opts.Helm.Wait,
+			Atomic:    opts.Helm.Atomic,
+			EnableDNS: opts.Helm.EnableDNS,
+		}
+
+		if opts.Helm.Timeout != nil {
+			result.Helm.Timeout = *opts.Helm.Timeout
 		}
 	}
 
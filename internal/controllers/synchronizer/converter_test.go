@@ -0,0 +1,298 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestConvertDeployOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     *catalogv1alpha1.DeployOptions
+		expected *appskubermaticv1.DeployOptions
+	}{
+		{
+			name:     "nil options",
+			opts:     nil,
+			expected: nil,
+		},
+		{
+			name:     "no helm options",
+			opts:     &catalogv1alpha1.DeployOptions{},
+			expected: &appskubermaticv1.DeployOptions{},
+		},
+		{
+			name: "wait only",
+			opts: &catalogv1alpha1.DeployOptions{
+				Helm: &catalogv1alpha1.HelmDeployOptions{Wait: true},
+			},
+			expected: &appskubermaticv1.DeployOptions{
+				Helm: &appskubermaticv1.HelmDeployOptions{Wait: true},
+			},
+		},
+		{
+			name: "all options",
+			opts: &catalogv1alpha1.DeployOptions{
+				Helm: &catalogv1alpha1.HelmDeployOptions{
+					Wait:      true,
+					Timeout:   &metav1.Duration{Duration: 20 * time.Minute},
+					Atomic:    true,
+					EnableDNS: true,
+				},
+			},
+			expected: &appskubermaticv1.DeployOptions{
+				Helm: &appskubermaticv1.HelmDeployOptions{
+					Wait:      true,
+					Timeout:   metav1.Duration{Duration: 20 * time.Minute},
+					Atomic:    true,
+					EnableDNS: true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := convertDeployOptions(tc.opts)
+			if !equality.Semantic.DeepEqual(result, tc.expected) {
+				t.Errorf("expected %+v, got %+v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestConvertVersionsTransportSettings(t *testing.T) {
+	catalog := &catalogv1alpha1.ApplicationCatalog{
+		Spec: catalogv1alpha1.ApplicationCatalogSpec{
+			Helm: &catalogv1alpha1.HelmSpec{
+				RepositorySettings: &catalogv1alpha1.RepositorySettings{
+					BaseURL:               "https://charts.example.com",
+					InsecureSkipTLSVerify: true,
+				},
+			},
+		},
+	}
+
+	chart := &catalogv1alpha1.ChartConfig{
+		ChartName: "nginx",
+		ChartVersions: []catalogv1alpha1.ChartVersion{
+			{ChartVersion: "1.0.0", AppVersion: "v1.0.0"},
+			{
+				ChartVersion: "2.0.0",
+				AppVersion:   "v2.0.0",
+				RepositorySettings: &catalogv1alpha1.RepositorySettings{
+					BaseURL:   "oci://legacy.example.com/charts",
+					PlainHTTP: true,
+				},
+			},
+		},
+	}
+
+	expected := []*appskubermaticv1.HelmSource{
+		{
+			URL:          "https://charts.example.com",
+			Insecure:     ptr.To(true),
+			ChartName:    "nginx",
+			ChartVersion: "1.0.0",
+		},
+		{
+			URL:          "oci://legacy.example.com/charts",
+			PlainHTTP:    ptr.To(true),
+			ChartName:    "nginx",
+			ChartVersion: "2.0.0",
+		},
+	}
+
+	versions := convertVersions(catalog, chart)
+	if len(versions) != len(expected) {
+		t.Fatalf("expected %d versions, got %d", len(expected), len(versions))
+	}
+
+	for i, version := range versions {
+		if !equality.Semantic.DeepEqual(version.Template.Source.Helm, expected[i]) {
+			t.Errorf("version %d: expected %+v, got %+v", i, expected[i], version.Template.Source.Helm)
+		}
+	}
+}
+
+func TestConvertNamespace(t *testing.T) {
+	tests := []struct {
+		name     string
+		ns       *catalogv1alpha1.AppNamespaceSpec
+		expected *appskubermaticv1.AppNamespaceSpec
+	}{
+		{
+			name:     "nil namespace",
+			ns:       nil,
+			expected: nil,
+		},
+		{
+			name:     "create defaults to true",
+			ns:       &catalogv1alpha1.AppNamespaceSpec{Name: "cert-manager"},
+			expected: &appskubermaticv1.AppNamespaceSpec{Name: "cert-manager", Create: true},
+		},
+		{
+			name: "all fields",
+			ns: &catalogv1alpha1.AppNamespaceSpec{
+				Name:        "kube-system",
+				Create:      ptr.To(false),
+				Labels:      map[string]string{"a": "b"},
+				Annotations: map[string]string{"c": "d"},
+			},
+			expected: &appskubermaticv1.AppNamespaceSpec{
+				Name:        "kube-system",
+				Create:      false,
+				Labels:      map[string]string{"a": "b"},
+				Annotations: map[string]string{"c": "d"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result := convertNamespace(tc.ns)
+			if !equality.Semantic.DeepEqual(result, tc.expected) {
+				t.Errorf("expected %+v, got %+v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestConvertChartToApplicationDefinitionVersionDefaultValues(t *testing.T) {
+	catalog := &catalogv1alpha1.ApplicationCatalog{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-catalog"},
+	}
+
+	chart := &catalogv1alpha1.ChartConfig{
+		ChartName: "argo-cd",
+		DefaultValuesBlock: `# server settings
+server:
+  replicas: 1
+  extraArgs: []
+`,
+		ChartVersions: []catalogv1alpha1.ChartVersion{
+			{ChartVersion: "5.5.12", AppVersion: "v2.4.14", DefaultValuesBlock: "server:\n  replicaCount: 1\n"},
+			{ChartVersion: "6.0.0", AppVersion: "v2.10.0", DefaultValuesPatch: "server:\n  # more replicas\n  replicas: 2\n  extraArgs: null\n"},
+			{ChartVersion: "7.0.0", AppVersion: "v2.12.0"},
+		},
+	}
+
+	appDef, _, err := convertChartToApplicationDefinition(catalog, chart, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if appDef.Spec.DefaultValuesBlock != chart.DefaultValuesBlock {
+		t.Errorf("expected chart-level default values to be used for the ApplicationDefinition, got %q", appDef.Spec.DefaultValuesBlock)
+	}
+
+	expected := map[string]string{
+		"default-values.applicationcatalog.k8c.io/v2.4.14": "server:\n  replicaCount: 1\n",
+		"default-values.applicationcatalog.k8c.io/v2.10.0": "# server settings\nserver:\n  # more replicas\n  replicas: 2\n",
+		catalogv1alpha1.AnnotationDefaultValuesHash:        hashDefaultValues(chart.DefaultValuesBlock),
+	}
+	if !equality.Semantic.DeepEqual(appDef.Annotations, expected) {
+		t.Errorf("expected annotations %v, got %v", expected, appDef.Annotations)
+	}
+}
+
+func TestConvertChartToApplicationDefinitionInvalidPatch(t *testing.T) {
+	chart := &catalogv1alpha1.ChartConfig{
+		ChartName:          "argo-cd",
+		DefaultValuesBlock: "server:\n\treplicas: 1\n",
+		ChartVersions: []catalogv1alpha1.ChartVersion{
+			{ChartVersion: "6.0.0", AppVersion: "v2.10.0", DefaultValuesPatch: "server:\n  replicas: 2\n"},
+		},
+	}
+
+	if _, _, err := convertChartToApplicationDefinition(&catalogv1alpha1.ApplicationCatalog{}, chart, nil); err == nil {
+		t.Error("expected an error for an invalid chart-level values block")
+	}
+}
+
+func TestConvertChartToApplicationDefinitionVersionDefaultValuesSize(t *testing.T) {
+	chart := &catalogv1alpha1.ChartConfig{
+		ChartName: "argo-cd",
+		ChartVersions: []catalogv1alpha1.ChartVersion{
+			{ChartVersion: "5.0.0", AppVersion: "v5.0.0", DefaultValuesBlock: "config: ${catalog.vars.config}\n"},
+			{ChartVersion: "6.0.0", AppVersion: "v6.0.0", DefaultValuesBlock: "config: ${catalog.vars.config}\n"},
+		},
+	}
+
+	// The values only exceed the limit once the variables are rendered.
+	vars := map[string]string{"config": strings.Repeat("x", 120*1024)}
+
+	_, _, err := convertChartToApplicationDefinition(&catalogv1alpha1.ApplicationCatalog{}, chart, vars)
+
+	var renderErr *renderError
+	if !errors.As(err, &renderErr) {
+		t.Fatalf("expected a render error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "stored in annotations of the ApplicationDefinition") {
+		t.Errorf("expected the error to explain the size limit, got %q", err.Error())
+	}
+}
+
+func TestConvertChartToApplicationDefinitionVars(t *testing.T) {
+	chart := &catalogv1alpha1.ChartConfig{
+		ChartName:          "minio",
+		DefaultValuesBlock: "# storage of this installation\npersistence:\n  storageClass: ${catalog.vars.storageClass}\n",
+		ChartVersions: []catalogv1alpha1.ChartVersion{
+			{ChartVersion: "1.0.0", AppVersion: "v1.0.0", DefaultValuesPatch: "image:\n  registry: ${catalog.vars.registry}\n"},
+			{ChartVersion: "2.0.0", AppVersion: "v2.0.0", DefaultValuesBlock: "image:\n  registry: ${catalog.vars.mirror}\n"},
+		},
+	}
+
+	vars := map[string]string{"storageClass": "fast", "registry": "registry.example.com"}
+
+	appDef, unresolved, err := convertChartToApplicationDefinition(&catalogv1alpha1.ApplicationCatalog{}, chart, vars)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if expected := "# storage of this installation\npersistence:\n  storageClass: fast\n"; appDef.Spec.DefaultValuesBlock != expected {
+		t.Errorf("expected default values %q, got %q", expected, appDef.Spec.DefaultValuesBlock)
+	}
+
+	expected := map[string]string{
+		"default-values.applicationcatalog.k8c.io/v1.0.0": "# storage of this installation\npersistence:\n  storageClass: fast\nimage:\n  registry: registry.example.com\n",
+		"default-values.applicationcatalog.k8c.io/v2.0.0": "image:\n  registry: ${catalog.vars.mirror}\n",
+		catalogv1alpha1.AnnotationDefaultValuesHash:       hashDefaultValues(appDef.Spec.DefaultValuesBlock),
+	}
+	if !equality.Semantic.DeepEqual(appDef.Annotations, expected) {
+		t.Errorf("expected annotations %v, got %v", expected, appDef.Annotations)
+	}
+
+	if !equality.Semantic.DeepEqual(unresolved, []string{"mirror"}) {
+		t.Errorf("expected unresolved variables [mirror], got %v", unresolved)
+	}
+
+	if chart.DefaultValuesBlock != "# storage of this installation\npersistence:\n  storageClass: ${catalog.vars.storageClass}\n" {
+		t.Error("expected the chart not to be modified")
+	}
+}
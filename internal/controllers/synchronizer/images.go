@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"fmt"
+	"strings"
+
+	"k8c.io/application-catalog-manager/internal/pkg/values"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	"sigs.k8s.io/yaml"
+)
+
+// rewriteImageRegistries returns a copy of the chart whose chart-level and per-version default
+// values point the images listed in imageRegistryKeys to the registries of the rewrite map.
+// The rewritten keys take precedence over the values configured in the catalog. The chart is
+// returned unchanged if none of its image registries are rewritten.
+func rewriteImageRegistries(chart *catalogv1alpha1.ChartConfig, rewrite map[string]string) (*catalogv1alpha1.ChartConfig, error) {
+	patch, err := imageRegistryPatch(chart.ImageRegistryKeys, rewrite)
+	if err != nil {
+		return nil, err
+	}
+
+	if patch == "" {
+		return chart, nil
+	}
+
+	rewritten := chart.DeepCopy()
+
+	rewritten.DefaultValuesBlock, err = values.Merge(chart.DefaultValuesBlock, patch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rewrite image registries: %w", err)
+	}
+
+	for i := range rewritten.ChartVersions {
+		version := &rewritten.ChartVersions[i]
+
+		switch {
+		case version.DefaultValuesBlock != "":
+			version.DefaultValuesBlock, err = values.Merge(version.DefaultValuesBlock, patch)
+		case version.DefaultValuesPatch != "":
+			version.DefaultValuesPatch, err = values.Merge(version.DefaultValuesPatch, patch)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to rewrite image registries of version %q: %w", version.AppVersion, err)
+		}
+	}
+
+	return rewritten, nil
+}
+
+// imageRegistryPatch returns a values block setting every key whose registry is rewritten to
+// its new value, or an empty string if none of the registries are rewritten.
+func imageRegistryPatch(keys []catalogv1alpha1.ImageRegistryKey, rewrite map[string]string) (string, error) {
+	patch := map[string]any{}
+
+	for i := range keys {
+		key := &keys[i]
+
+		registry, ok := rewrite[key.Registry]
+		if !ok {
+			continue
+		}
+
+		if err := setPath(patch, strings.Split(key.Path, "."), key.Value(registry)); err != nil {
+			return "", fmt.Errorf("invalid image registry key %q: %w", key.Path, err)
+		}
+	}
+
+	if len(patch) == 0 {
+		return "", nil
+	}
+
+	out, err := yaml.Marshal(patch)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode image registry values: %w", err)
+	}
+
+	return string(out), nil
+}
+
+// setPath sets the value at the given path, creating intermediate maps as needed.
+func setPath(m map[string]any, path []string, value string) error {
+	for i, segment := range path {
+		if segment == "" {
+			return fmt.Errorf("empty path segment")
+		}
+
+		if i == len(path)-1 {
+			if _, exists := m[segment]; exists {
+				return fmt.Errorf("conflicts with another key")
+			}
+			m[segment] = value
+			return nil
+		}
+
+		next, exists := m[segment]
+		if !exists {
+			next = map[string]any{}
+			m[segment] = next
+		}
+
+		child, ok := next.(map[string]any)
+		if !ok {
+			return fmt.Errorf("conflicts with another key")
+		}
+		m = child
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"testing"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+func TestImageRegistryPatch(t *testing.T) {
+	rewrite := map[string]string{
+		"registry.k8s.io": "mirror.example.com/k8s",
+		"quay.io":         "mirror.example.com/quay/",
+	}
+
+	tests := []struct {
+		name          string
+		keys          []catalogv1alpha1.ImageRegistryKey
+		expectedPatch string
+		expectError   bool
+	}{
+		{
+			name: "no keys",
+		},
+		{
+			name: "registries without rewrite are skipped",
+			keys: []catalogv1alpha1.ImageRegistryKey{
+				{Path: "image.registry", Registry: "docker.io"},
+			},
+		},
+		{
+			name: "registry and repository keys",
+			keys: []catalogv1alpha1.ImageRegistryKey{
+				{Path: "controller.image.registry", Registry: "registry.k8s.io"},
+				{Path: "controller.admissionWebhooks.patch.image.registry", Registry: "registry.k8s.io"},
+				{Path: "webhook.image.repository", Registry: "quay.io", Repository: "jetstack/cert-manager-webhook"},
+				{Path: "image.registry", Registry: "docker.io"},
+			},
+			expectedPatch: `controller:
+  admissionWebhooks:
+    patch:
+      image:
+        registry: mirror.example.com/k8s
+  image:
+    registry: mirror.example.com/k8s
+webhook:
+  image:
+    repository: mirror.example.com/quay/jetstack/cert-manager-webhook
+`,
+		},
+		{
+			name: "conflicting keys",
+			keys: []catalogv1alpha1.ImageRegistryKey{
+				{Path: "image", Registry: "quay.io", Repository: "jetstack/cert-manager-controller"},
+				{Path: "image.registry", Registry: "registry.k8s.io"},
+			},
+			expectError: true,
+		},
+		{
+			name: "empty path segment",
+			keys: []catalogv1alpha1.ImageRegistryKey{
+				{Path: "controller..registry", Registry: "registry.k8s.io"},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			patch, err := imageRegistryPatch(tc.keys, rewrite)
+			if tc.expectError {
+				if err == nil {
+					t.Error("expected error but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if patch != tc.expectedPatch {
+				t.Errorf("expected patch %q, got %q", tc.expectedPatch, patch)
+			}
+		})
+	}
+}
+
+func TestConvertChartToApplicationDefinitionImageRegistryRewrite(t *testing.T) {
+	chart := &catalogv1alpha1.ChartConfig{
+		ChartName:          "ingress-nginx",
+		DefaultValuesBlock: "controller:\n  # pulled from the mirror\n  image:\n    registry: registry.k8s.io\n    tag: v1.12.1\n",
+		ImageRegistryKeys: []catalogv1alpha1.ImageRegistryKey{
+			{Path: "controller.image.registry", Registry: "registry.k8s.io"},
+		},
+		ChartVersions: []catalogv1alpha1.ChartVersion{
+			{ChartVersion: "4.12.2", AppVersion: "1.12.1"},
+			{ChartVersion: "4.12.0", AppVersion: "1.12.0", DefaultValuesPatch: "controller:\n  replicaCount: 2\n"},
+			{ChartVersion: "4.7.1", AppVersion: "1.8.1", DefaultValuesBlock: "controller:\n  image:\n    registry: registry.k8s.io\n"},
+		},
+	}
+
+	catalog := &catalogv1alpha1.ApplicationCatalog{
+		Spec: catalogv1alpha1.ApplicationCatalogSpec{
+			Helm: &catalogv1alpha1.HelmSpec{
+				ImageRegistryRewrite: map[string]string{"registry.k8s.io": "mirror.example.com/k8s"},
+			},
+		},
+	}
+
+	appDef, _, err := convertChartToApplicationDefinition(catalog, chart, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if expected := "controller:\n  # pulled from the mirror\n  image:\n    registry: mirror.example.com/k8s\n    tag: v1.12.1\n"; appDef.Spec.DefaultValuesBlock != expected {
+		t.Errorf("expected default values %q, got %q", expected, appDef.Spec.DefaultValuesBlock)
+	}
+
+	expected := map[string]string{
+		"default-values.applicationcatalog.k8c.io/1.12.0": "controller:\n  # pulled from the mirror\n  image:\n    registry: mirror.example.com/k8s\n    tag: v1.12.1\n  replicaCount: 2\n",
+		"default-values.applicationcatalog.k8c.io/1.8.1":  "controller:\n  image:\n    registry: mirror.example.com/k8s\n",
+		catalogv1alpha1.AnnotationDefaultValuesHash:       hashDefaultValues(appDef.Spec.DefaultValuesBlock),
+	}
+	if !equality.Semantic.DeepEqual(appDef.Annotations, expected) {
+		t.Errorf("expected annotations %v, got %v", expected, appDef.Annotations)
+	}
+
+	if chart.ChartVersions[2].DefaultValuesBlock != "controller:\n  image:\n    registry: registry.k8s.io\n" {
+		t.Error("expected the chart not to be modified")
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"k8c.io/application-catalog-manager/internal/pkg/logos"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// resolveLogo returns a copy of the chart with the logo referenced in logoFrom inlined into
+// metadata.logo. If no logoFormat is set, it is detected from the image.
+// Charts that need neither are returned unchanged.
+func (r *Reconciler) resolveLogo(ctx context.Context, chart *catalogv1alpha1.ChartConfig) (*catalogv1alpha1.ChartConfig, error) {
+	metadata := chart.Metadata
+	if metadata == nil || (metadata.LogoFrom == nil && (metadata.Logo == "" || metadata.LogoFormat != "")) {
+		return chart, nil
+	}
+
+	resolved := chart.DeepCopy()
+
+	// Inline logo without logoFormat. Invalid logos are rejected by the webhook,
+	// so decoding errors are ignored here.
+	if metadata.LogoFrom == nil {
+		if data, err := base64.StdEncoding.DecodeString(metadata.Logo); err == nil {
+			resolved.Metadata.LogoFormat = logos.DetectFormat(data)
+		}
+		return resolved, nil
+	}
+
+	data, err := r.getReferencedLogo(ctx, metadata.LogoFrom)
+	if err != nil {
+		return nil, fmt.Errorf("logoFrom: %w", err)
+	}
+
+	resolved.Metadata.LogoFrom = nil
+
+	// Missing optional logo.
+	if data == nil {
+		return resolved, nil
+	}
+
+	if len(data) > logos.MaxSize {
+		return nil, fmt.Errorf("logoFrom: logo is %d bytes, which exceeds the maximum size of %d bytes", len(data), logos.MaxSize)
+	}
+
+	format := logos.DetectFormat(data)
+	if format == "" {
+		return nil, fmt.Errorf("logoFrom: unsupported logo format, only PNG and SVG images are supported")
+	}
+
+	resolved.Metadata.Logo = base64.StdEncoding.EncodeToString(data)
+	if resolved.Metadata.LogoFormat == "" {
+		resolved.Metadata.LogoFormat = format
+	}
+
+	return resolved, nil
+}
+
+// getReferencedLogo returns the image data of the referenced logo.
+// Missing optional ConfigMap keys resolve to nil.
+func (r *Reconciler) getReferencedLogo(ctx context.Context, source *catalogv1alpha1.LogoSource) ([]byte, error) {
+	if source.Bundled != "" {
+		data, _, ok := logos.Get(source.Bundled)
+		if !ok {
+			return nil, fmt.Errorf("bundled logo %q does not exist", source.Bundled)
+		}
+		return data, nil
+	}
+
+	ref := source.ConfigMapKeyRef
+	if ref == nil {
+		return nil, fmt.Errorf("either configMapKeyRef or bundled must be set")
+	}
+
+	optional := ptr.Deref(ref.Optional, false)
+
+	cm := &corev1.ConfigMap{}
+	if err := r.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: r.cfg.Namespace, Name: ref.Name}, cm); err != nil {
+		return nil, handleMissingObject("ConfigMap", ref.Name, optional, err)
+	}
+
+	if data, ok := cm.BinaryData[ref.Key]; ok {
+		return data, nil
+	}
+
+	if data, ok := cm.Data[ref.Key]; ok {
+		return []byte(data), nil
+	}
+
+	if optional {
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf("key %q not found in ConfigMap %s/%s", ref.Key, r.cfg.Namespace, ref.Name)
+}
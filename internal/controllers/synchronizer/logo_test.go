@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"k8c.io/application-catalog-manager/internal/pkg/logos"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestResolveLogo(t *testing.T) {
+	const svg = `<svg xmlns="http://www.w3.org/2000/svg"></svg>`
+
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00")
+	bundled, _, _ := logos.Get("cert-manager")
+
+	r := newTestReconciler(t, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "logos", Namespace: "kubermatic"},
+		Data:       map[string]string{"app.svg": svg},
+		BinaryData: map[string][]byte{
+			"app.png":   png,
+			"large.svg": append([]byte("<svg>"), make([]byte, logos.MaxSize)...),
+			"app.gif":   []byte("GIF89a"),
+		},
+	})
+
+	configMapRef := func(key string, optional bool) *catalogv1alpha1.LogoSource {
+		return &catalogv1alpha1.LogoSource{
+			ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "logos"},
+				Key:                  key,
+				Optional:             ptr.To(optional),
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		metadata       *catalogv1alpha1.ChartMetadata
+		expectedLogo   []byte
+		expectedFormat string
+		expectedErr    string
+	}{
+		{
+			name:           "bundled logo",
+			metadata:       &catalogv1alpha1.ChartMetadata{LogoFrom: &catalogv1alpha1.LogoSource{Bundled: "cert-manager"}},
+			expectedLogo:   bundled,
+			expectedFormat: logos.FormatPNG,
+		},
+		{
+			name:           "logo from ConfigMap binaryData",
+			metadata:       &catalogv1alpha1.ChartMetadata{LogoFrom: configMapRef("app.png", false)},
+			expectedLogo:   png,
+			expectedFormat: logos.FormatPNG,
+		},
+		{
+			name:           "logo from ConfigMap data",
+			metadata:       &catalogv1alpha1.ChartMetadata{LogoFrom: configMapRef("app.svg", false)},
+			expectedLogo:   []byte(svg),
+			expectedFormat: logos.FormatSVG,
+		},
+		{
+			name:           "explicit logo format is kept",
+			metadata:       &catalogv1alpha1.ChartMetadata{LogoFrom: configMapRef("app.svg", false), LogoFormat: logos.FormatSVG},
+			expectedLogo:   []byte(svg),
+			expectedFormat: logos.FormatSVG,
+		},
+		{
+			name:     "missing optional key",
+			metadata: &catalogv1alpha1.ChartMetadata{LogoFrom: configMapRef("missing.png", true)},
+		},
+		{
+			name:           "format of inline logo is detected",
+			metadata:       &catalogv1alpha1.ChartMetadata{Logo: base64.StdEncoding.EncodeToString([]byte(svg))},
+			expectedLogo:   []byte(svg),
+			expectedFormat: logos.FormatSVG,
+		},
+		{
+			name:        "missing key",
+			metadata:    &catalogv1alpha1.ChartMetadata{LogoFrom: configMapRef("missing.png", false)},
+			expectedErr: `key "missing.png" not found in ConfigMap kubermatic/logos`,
+		},
+		{
+			name:        "unknown bundled logo",
+			metadata:    &catalogv1alpha1.ChartMetadata{LogoFrom: &catalogv1alpha1.LogoSource{Bundled: "does-not-exist"}},
+			expectedErr: `bundled logo "does-not-exist" does not exist`,
+		},
+		{
+			name:        "logo exceeds the maximum size",
+			metadata:    &catalogv1alpha1.ChartMetadata{LogoFrom: configMapRef("large.svg", false)},
+			expectedErr: "exceeds the maximum size",
+		},
+		{
+			name:        "unsupported format",
+			metadata:    &catalogv1alpha1.ChartMetadata{LogoFrom: configMapRef("app.gif", false)},
+			expectedErr: "unsupported logo format",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			chart := &catalogv1alpha1.ChartConfig{ChartName: "app", Metadata: tc.metadata}
+
+			resolved, err := r.resolveLogo(context.Background(), chart)
+			if tc.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if resolved.Metadata.LogoFrom != nil {
+				t.Errorf("expected logoFrom to be cleared, got %v", resolved.Metadata.LogoFrom)
+			}
+
+			logo, err := base64.StdEncoding.DecodeString(resolved.Metadata.Logo)
+			if err != nil {
+				t.Fatalf("expected base64-encoded logo: %v", err)
+			}
+			if string(logo) != string(tc.expectedLogo) {
+				t.Errorf("expected logo %q, got %q", tc.expectedLogo, logo)
+			}
+
+			if resolved.Metadata.LogoFormat != tc.expectedFormat {
+				t.Errorf("expected logo format %q, got %q", tc.expectedFormat, resolved.Metadata.LogoFormat)
+			}
+		})
+	}
+}
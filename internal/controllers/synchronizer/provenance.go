@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"k8c.io/application-catalog-manager/internal/pkg/defaulting"
+	"k8c.io/application-catalog-manager/internal/pkg/version"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+)
+
+// resolveChartSource returns where the chart comes from and, for imported charts, the name of
+// the imported catalog. The origins map the names of the imported charts to their catalogs.
+func resolveChartSource(
+	catalog *catalogv1alpha1.ApplicationCatalog,
+	chart *catalogv1alpha1.ChartConfig,
+	origins map[string]string,
+) (catalogv1alpha1.ChartSource, string) {
+	if origin, ok := origins[chart.ChartName]; ok {
+		return catalogv1alpha1.ChartSourceImported, origin
+	}
+
+	if catalog.Spec.Helm != nil && catalog.Spec.Helm.IncludeDefaults && defaulting.IsDefaultChart(chart) {
+		return catalogv1alpha1.ChartSourceDefault, ""
+	}
+
+	return catalogv1alpha1.ChartSourceUser, ""
+}
+
+// setProvenance stamps the provenance annotations on the desired ApplicationDefinition and
+// the hash of its content. The catalog generation and the manager version are not part of
+// the hash, so they record the generation and version that last changed the content.
+func setProvenance(
+	appDef *appskubermaticv1.ApplicationDefinition,
+	generation int64,
+	source catalogv1alpha1.ChartSource,
+	sourceCatalog string,
+) error {
+	if appDef.Annotations == nil {
+		appDef.Annotations = map[string]string{}
+	}
+
+	appDef.Annotations[catalogv1alpha1.AnnotationChartSource] = string(source)
+	if sourceCatalog != "" {
+		appDef.Annotations[catalogv1alpha1.AnnotationSourceCatalog] = sourceCatalog
+	}
+
+	hash, err := hashContent(appDef)
+	if err != nil {
+		return err
+	}
+
+	appDef.Annotations[catalogv1alpha1.AnnotationContentHash] = hash
+	appDef.Annotations[catalogv1alpha1.AnnotationCatalogGeneration] = strconv.FormatInt(generation, 10)
+	appDef.Annotations[catalogv1alpha1.AnnotationManagerVersion] = version.Get()
+
+	return nil
+}
+
+// hashContent returns the SHA-256 hash of the labels, annotations and spec of the
+// ApplicationDefinition, ignoring the generation, version and hash annotations. Versions are
+// sorted first, since updated ApplicationDefinitions store them sorted by version.
+func hashContent(appDef *appskubermaticv1.ApplicationDefinition) (string, error) {
+	annotations := make(map[string]string, len(appDef.Annotations))
+	for key, value := range appDef.Annotations {
+		switch key {
+		case catalogv1alpha1.AnnotationCatalogGeneration, catalogv1alpha1.AnnotationManagerVersion, catalogv1alpha1.AnnotationContentHash:
+			continue
+		}
+		annotations[key] = value
+	}
+
+	spec := appDef.Spec.DeepCopy()
+	sort.Slice(spec.Versions, func(i, j int) bool {
+		return spec.Versions[i].Version < spec.Versions[j].Version
+	})
+
+	// Maps are marshalled with sorted keys, so the hash is stable.
+	content, err := json.Marshal(struct {
+		Labels      map[string]string                          `json:"labels"`
+		Annotations map[string]string                          `json:"annotations"`
+		Spec        appskubermaticv1.ApplicationDefinitionSpec `json:"spec"`
+	}{
+		Labels:      appDef.Labels,
+		Annotations: annotations,
+		Spec:        *spec,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal ApplicationDefinition: %w", err)
+	}
+
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// hasDrifted returns true if updating the existing ApplicationDefinition with the desired one
+// would change its content. The desired one is merged first, so that the fields preserved from
+// the cluster, e.g. Enforced or retained versions, don't count as drift.
+func hasDrifted(existing, desired *appskubermaticv1.ApplicationDefinition) (bool, error) {
+	merged := existing.DeepCopy()
+	mergeApplicationDefinition(merged, desired.DeepCopy())
+
+	current, err := hashContent(existing)
+	if err != nil {
+		return false, err
+	}
+
+	expected, err := hashContent(merged)
+	if err != nil {
+		return false, err
+	}
+
+	return current != expected, nil
+}
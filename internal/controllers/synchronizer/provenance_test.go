@@ -0,0 +1,244 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"testing"
+
+	"k8c.io/application-catalog-manager/internal/pkg/defaulting"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestResolveChartSource(t *testing.T) {
+	catalog := &catalogv1alpha1.ApplicationCatalog{
+		Spec: catalogv1alpha1.ApplicationCatalogSpec{
+			Helm: &catalogv1alpha1.HelmSpec{IncludeDefaults: true},
+		},
+	}
+	defaulting.DefaultApplicationCatalog(catalog)
+
+	var defaultChart catalogv1alpha1.ChartConfig
+	for _, chart := range catalog.Spec.Helm.Charts {
+		if chart.ChartName == "cert-manager" {
+			defaultChart = chart
+		}
+	}
+
+	customized := defaultChart.DeepCopy()
+	customized.DefaultValuesBlock = "replicas: 3"
+
+	testCases := []struct {
+		name                  string
+		includeDefaults       bool
+		chart                 *catalogv1alpha1.ChartConfig
+		expectedSource        catalogv1alpha1.ChartSource
+		expectedSourceCatalog string
+	}{
+		{
+			name:            "default chart",
+			includeDefaults: true,
+			chart:           &defaultChart,
+			expectedSource:  catalogv1alpha1.ChartSourceDefault,
+		},
+		{
+			name:            "default chart without includeDefaults",
+			includeDefaults: false,
+			chart:           &defaultChart,
+			expectedSource:  catalogv1alpha1.ChartSourceUser,
+		},
+		{
+			name:            "customized default chart",
+			includeDefaults: true,
+			chart:           customized,
+			expectedSource:  catalogv1alpha1.ChartSourceUser,
+		},
+		{
+			name:                  "imported chart",
+			includeDefaults:       true,
+			chart:                 &catalogv1alpha1.ChartConfig{ChartName: "redis"},
+			expectedSource:        catalogv1alpha1.ChartSourceImported,
+			expectedSourceCatalog: "shared",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			catalog.Spec.Helm.IncludeDefaults = tc.includeDefaults
+
+			source, sourceCatalog := resolveChartSource(catalog, tc.chart, map[string]string{"redis": "shared"})
+			if source != tc.expectedSource || sourceCatalog != tc.expectedSourceCatalog {
+				t.Errorf("expected source %q from %q, got %q from %q", tc.expectedSource, tc.expectedSourceCatalog, source, sourceCatalog)
+			}
+		})
+	}
+}
+
+func TestSetProvenance(t *testing.T) {
+	newAppDef := func() *appskubermaticv1.ApplicationDefinition {
+		return &appskubermaticv1.ApplicationDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "nginx"},
+			Spec:       appskubermaticv1.ApplicationDefinitionSpec{Description: "nginx"},
+		}
+	}
+
+	first := newAppDef()
+	if err := setProvenance(first, 1, catalogv1alpha1.ChartSourceUser, ""); err != nil {
+		t.Fatalf("failed to set provenance: %v", err)
+	}
+
+	if first.Annotations[catalogv1alpha1.AnnotationCatalogGeneration] != "1" {
+		t.Errorf("expected generation annotation 1, got %q", first.Annotations[catalogv1alpha1.AnnotationCatalogGeneration])
+	}
+	if first.Annotations[catalogv1alpha1.AnnotationChartSource] != string(catalogv1alpha1.ChartSourceUser) {
+		t.Errorf("expected source annotation %q, got %q", catalogv1alpha1.ChartSourceUser, first.Annotations[catalogv1alpha1.AnnotationChartSource])
+	}
+	if first.Annotations[catalogv1alpha1.AnnotationManagerVersion] == "" {
+		t.Error("expected manager version annotation to be set")
+	}
+
+	// The generation does not change the content.
+	second := newAppDef()
+	if err := setProvenance(second, 2, catalogv1alpha1.ChartSourceUser, ""); err != nil {
+		t.Fatalf("failed to set provenance: %v", err)
+	}
+	if first.Annotations[catalogv1alpha1.AnnotationContentHash] != second.Annotations[catalogv1alpha1.AnnotationContentHash] {
+		t.Error("expected the hash not to depend on the catalog generation")
+	}
+
+	changed := newAppDef()
+	changed.Spec.Description = "web server"
+	if err := setProvenance(changed, 1, catalogv1alpha1.ChartSourceUser, ""); err != nil {
+		t.Fatalf("failed to set provenance: %v", err)
+	}
+	if first.Annotations[catalogv1alpha1.AnnotationContentHash] == changed.Annotations[catalogv1alpha1.AnnotationContentHash] {
+		t.Error("expected the hash to change with the spec")
+	}
+
+	imported := newAppDef()
+	if err := setProvenance(imported, 1, catalogv1alpha1.ChartSourceImported, "shared"); err != nil {
+		t.Fatalf("failed to set provenance: %v", err)
+	}
+	if imported.Annotations[catalogv1alpha1.AnnotationSourceCatalog] != "shared" {
+		t.Errorf("expected source catalog annotation %q, got %q", "shared", imported.Annotations[catalogv1alpha1.AnnotationSourceCatalog])
+	}
+	if first.Annotations[catalogv1alpha1.AnnotationContentHash] == imported.Annotations[catalogv1alpha1.AnnotationContentHash] {
+		t.Error("expected the hash to change with the source")
+	}
+}
+
+func TestReconcileSkipsUpToDateApplicationDefinition(t *testing.T) {
+	ctx := context.Background()
+
+	catalog := newRevisionTestCatalog("nginx")
+
+	r := newTestReconciler(t, catalog)
+
+	reconcileCatalog := func() *appskubermaticv1.ApplicationDefinition {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: "platform"}}); err != nil {
+			t.Fatalf("reconcile failed: %v", err)
+		}
+
+		appDef := &appskubermaticv1.ApplicationDefinition{}
+		if err := r.Get(ctx, types.NamespacedName{Name: "nginx"}, appDef); err != nil {
+			t.Fatalf("failed to get ApplicationDefinition: %v", err)
+		}
+		return appDef
+	}
+
+	appDef := reconcileCatalog()
+	if appDef.Annotations[catalogv1alpha1.AnnotationChartSource] != string(catalogv1alpha1.ChartSourceUser) {
+		t.Errorf("expected source annotation %q, got %v", catalogv1alpha1.ChartSourceUser, appDef.Annotations)
+	}
+
+	resourceVersion := appDef.ResourceVersion
+	if appDef = reconcileCatalog(); appDef.ResourceVersion != resourceVersion {
+		t.Error("expected an up-to-date ApplicationDefinition not to be patched")
+	}
+
+	// Changes made in the cluster keep the stored hash, but are reverted nonetheless.
+	appDef.Spec.Description = "changed in the cluster"
+	appDef.Labels["team"] = "platform"
+	if err := r.Update(ctx, appDef); err != nil {
+		t.Fatalf("failed to update ApplicationDefinition: %v", err)
+	}
+
+	if appDef = reconcileCatalog(); appDef.Spec.Description != "" {
+		t.Errorf("expected the drifted description to be reverted, got %q", appDef.Spec.Description)
+	}
+
+	// Labels added in the cluster are kept by updates, so they don't count as drift.
+	resourceVersion = appDef.ResourceVersion
+	if appDef = reconcileCatalog(); appDef.ResourceVersion != resourceVersion {
+		t.Error("expected an ApplicationDefinition with additional labels not to be changed")
+	}
+
+	// Fields preserved from the cluster don't count as drift either.
+	appDef.Spec.Enforced = true
+	if err := r.Update(ctx, appDef); err != nil {
+		t.Fatalf("failed to update ApplicationDefinition: %v", err)
+	}
+
+	resourceVersion = appDef.ResourceVersion
+	if appDef = reconcileCatalog(); appDef.ResourceVersion != resourceVersion {
+		t.Error("expected an enforced ApplicationDefinition not to be patched")
+	}
+	if !appDef.Spec.Enforced {
+		t.Error("expected enforced to be preserved")
+	}
+
+	// A change of the catalog changes the content hash, so the ApplicationDefinition is updated.
+	if err := r.Get(ctx, types.NamespacedName{Name: "platform"}, catalog); err != nil {
+		t.Fatalf("failed to get ApplicationCatalog: %v", err)
+	}
+	catalog.Spec.Helm.Charts[0].ChartVersions = append(catalog.Spec.Helm.Charts[0].ChartVersions, catalogv1alpha1.ChartVersion{ChartVersion: "1.1.0", AppVersion: "v1.1.0"})
+	catalog.Generation = 2
+	if err := r.Update(ctx, catalog); err != nil {
+		t.Fatalf("failed to update ApplicationCatalog: %v", err)
+	}
+
+	if appDef = reconcileCatalog(); len(appDef.Spec.Versions) != 2 {
+		t.Errorf("expected ApplicationDefinition to be updated with 2 versions, got %v", appDef.Spec.Versions)
+	}
+	if appDef.Annotations[catalogv1alpha1.AnnotationCatalogGeneration] != "2" {
+		t.Errorf("expected generation annotation 2, got %q", appDef.Annotations[catalogv1alpha1.AnnotationCatalogGeneration])
+	}
+	// Versions removed from the catalog are retained, which doesn't count as drift.
+	if err := r.Get(ctx, types.NamespacedName{Name: "platform"}, catalog); err != nil {
+		t.Fatalf("failed to get ApplicationCatalog: %v", err)
+	}
+	catalog.Spec.Helm.Charts[0].ChartVersions = catalog.Spec.Helm.Charts[0].ChartVersions[1:]
+	catalog.Generation = 3
+	if err := r.Update(ctx, catalog); err != nil {
+		t.Fatalf("failed to update ApplicationCatalog: %v", err)
+	}
+
+	if appDef = reconcileCatalog(); len(appDef.Spec.Versions) != 2 {
+		t.Errorf("expected the removed version to be retained, got %v", appDef.Spec.Versions)
+	}
+
+	resourceVersion = appDef.ResourceVersion
+	if appDef = reconcileCatalog(); appDef.ResourceVersion != resourceVersion {
+		t.Error("expected an ApplicationDefinition with retained versions not to be patched")
+	}
+}
@@ -20,7 +20,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
@@ -30,14 +32,34 @@ import (
 	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 var errRequeueAfter10Secs = fmt.Errorf("requeue after 10 seconds")
 
+// displacedError is returned if the ApplicationDefinition of a chart is managed by a catalog
+// with a higher or equal priority.
+type displacedError struct {
+	catalog string
+}
+
+func (e *displacedError) Error() string {
+	return fmt.Sprintf("ApplicationDefinition is managed by catalog %q with a higher or equal priority", e.catalog)
+}
+
+const (
+	reasonPaused       = "Paused"
+	reasonReconciling  = "Reconciling"
+	reasonRendered     = "Rendered"
+	reasonRenderFailed = "RenderFailed"
+)
+
 func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
 	l := r.logger.With("catalog", req.Name)
 	l.Info("Reconciling ApplicationCatalog")
@@ -70,6 +92,32 @@ func (r *Reconciler) reconcile(ctx context.Context, l *zap.SugaredLogger, req re
 		return fmt.Errorf("failed to get ApplicationCatalog: %w", err)
 	}
 
+	// The rollback changes the spec, which triggers another reconciliation with the
+	// restored spec. Rollbacks are possible while the catalog is paused, since they do not
+	// touch the ApplicationDefinitions.
+	if _, ok := catalog.Annotations[catalogv1alpha1.AnnotationRollbackTo]; ok {
+		return r.rollback(ctx, l, catalog)
+	}
+
+	var errs []error
+
+	// A failed snapshot neither blocks nor retries the reconciliation of the ApplicationDefinitions,
+	// the next generation of the catalog is recorded again.
+	if err := r.recordRevision(ctx, l, catalog); err != nil {
+		l.Errorw("Failed to record revision", "error", err)
+	}
+
+	// A paused catalog leaves its ApplicationDefinitions untouched, including the ones that
+	// would be unmanaged because they are not part of the catalog anymore.
+	if catalog.IsPaused() {
+		l.Info("ApplicationCatalog is paused, skipping reconciliation")
+
+		if err := r.updateStatus(ctx, catalog, catalog.Status.UnresolvedVariables, catalog.Status.DisplacedCharts); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update status: %w", err))
+		}
+		return kerrors.NewAggregate(errs)
+	}
+
 	charts := catalog.GetHelmCharts()
 
 	// If charts is nil but includeDefaults is true, the webhook should have
@@ -79,30 +127,101 @@ func (r *Reconciler) reconcile(ctx context.Context, l *zap.SugaredLogger, req re
 		return errRequeueAfter10Secs
 	}
 
+	// Imported charts are materialized into this catalog. If an import cannot be resolved,
+	// nothing is changed, otherwise the ApplicationDefinitions of the import would be unmanaged.
+	charts, origins, err := r.imports.ResolveChartsWithOrigins(ctx, catalog)
+	if err != nil {
+		return fmt.Errorf("failed to resolve imports: %w", err)
+	}
+
 	// If charts is nil and includeDefaults is false (or spec.helm is nil),
 	// this is a valid empty catalog. Convert nil to empty slice.
 	if charts == nil {
 		charts = []catalogv1alpha1.ChartConfig{}
 	}
 
-	var errs []error
+	// Like imports, variables must be resolvable, otherwise all ApplicationDefinitions
+	// would be updated with unresolved variables.
+	vars, err := r.resolveVars(ctx, catalog)
+	if err != nil {
+		return fmt.Errorf("failed to resolve vars: %w", err)
+	}
+
 	generatedApps := make(map[string]bool)
+	unresolved := sets.New[string]()
+	var (
+		displaced      []catalogv1alpha1.DisplacedChart
+		renderFailures []string
+	)
 
 	for i := range charts {
 		chart := &charts[i]
-		desired := convertChartToApplicationDefinition(catalog, chart)
-		generatedApps[desired.Name] = true
+		generatedApps[catalog.ResolveAppName(chart)] = true
+
+		resolved, err := r.resolveDefaultValues(ctx, chart)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("chart %q: %w", chart.ChartName, err))
+			continue
+		}
+
+		resolved, err = r.resolveLogo(ctx, resolved)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("chart %q: %w", chart.ChartName, err))
+			continue
+		}
+
+		desired, missing, err := convertChartToApplicationDefinition(catalog, resolved, vars)
+		if err != nil {
+			// Retrying does not help until the catalog or its variables change, the
+			// ApplicationDefinition keeps its previous values until then.
+			var renderErr *renderError
+			if errors.As(err, &renderErr) {
+				l.Infow("Failed to render default values", "chart", chart.ChartName, "error", renderErr.err)
+				renderFailures = append(renderFailures, fmt.Sprintf("chart %q: %v", chart.ChartName, renderErr.err))
+				continue
+			}
+
+			errs = append(errs, fmt.Errorf("chart %q: %w", chart.ChartName, err))
+			continue
+		}
+
+		if len(missing) > 0 {
+			l.Infow("Default values reference undefined variables", "chart", chart.ChartName, "variables", missing)
+			unresolved.Insert(missing...)
+		}
+
+		setSharedOwnership(desired, resolved)
+
+		source, sourceCatalog := resolveChartSource(catalog, chart, origins)
+		if err := setProvenance(desired, catalog.Generation, source, sourceCatalog); err != nil {
+			errs = append(errs, fmt.Errorf("chart %q: %w", chart.ChartName, err))
+			continue
+		}
+
+		if err := r.reconcileApplicationDefinition(ctx, l, catalog, desired); err != nil {
+			var displacedErr *displacedError
+			if errors.As(err, &displacedErr) {
+				displaced = append(displaced, catalogv1alpha1.DisplacedChart{
+					ChartName:             chart.ChartName,
+					ApplicationDefinition: desired.Name,
+					Catalog:               displacedErr.catalog,
+				})
+				continue
+			}
 
-		if err := r.reconcileApplicationDefinition(ctx, l, desired); err != nil {
 			errs = append(errs, fmt.Errorf("chart %q: %w", chart.ChartName, err))
 		}
 	}
 
-	err := r.unmanageOrphans(ctx, catalog.Name, generatedApps)
+	err = r.unmanageOrphans(ctx, catalog.Name, generatedApps)
 	if err != nil {
 		errs = append(errs, err)
 	}
 
+	if err := r.updateStatus(ctx, catalog, sets.List(unresolved), displaced, valuesRenderedCondition(catalog, renderFailures)); err != nil {
+		errs = append(errs, fmt.Errorf("failed to update status: %w", err))
+	}
+
 	if len(errs) > 0 {
 		return kerrors.NewAggregate(errs)
 	}
@@ -111,10 +230,88 @@ func (r *Reconciler) reconcile(ctx context.Context, l *zap.SugaredLogger, req re
 	return nil
 }
 
-// reconcileApplicationDefinition creates or updates an ApplicationDefinition.
+// updateStatus records the observed generation, the unresolved variables, the displaced
+// charts, whether the catalog is paused and the given conditions.
+func (r *Reconciler) updateStatus(
+	ctx context.Context,
+	catalog *catalogv1alpha1.ApplicationCatalog,
+	unresolved []string,
+	displaced []catalogv1alpha1.DisplacedChart,
+	conditions ...metav1.Condition,
+) error {
+	if len(unresolved) == 0 {
+		unresolved = nil
+	}
+	if len(displaced) == 0 {
+		displaced = nil
+	}
+
+	oldCatalog := catalog.DeepCopy()
+	conditionsChanged := setPausedCondition(catalog)
+	for _, condition := range conditions {
+		if meta.SetStatusCondition(&catalog.Status.Conditions, condition) {
+			conditionsChanged = true
+		}
+	}
+
+	if !conditionsChanged && catalog.Status.ObservedGeneration == catalog.Generation &&
+		slices.Equal(catalog.Status.UnresolvedVariables, unresolved) && slices.Equal(catalog.Status.DisplacedCharts, displaced) {
+		return nil
+	}
+
+	catalog.Status.ObservedGeneration = catalog.Generation
+	catalog.Status.UnresolvedVariables = unresolved
+	catalog.Status.DisplacedCharts = displaced
+
+	return r.Status().Patch(ctx, catalog, ctrlruntimeclient.MergeFrom(oldCatalog))
+}
+
+// setPausedCondition sets the Paused condition of the catalog and returns true if it changed.
+func setPausedCondition(catalog *catalogv1alpha1.ApplicationCatalog) bool {
+	condition := metav1.Condition{
+		Type:               catalogv1alpha1.CatalogConditionPaused,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: catalog.Generation,
+		Reason:             reasonReconciling,
+		Message:            "ApplicationDefinitions are reconciled",
+	}
+
+	if catalog.IsPaused() {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = reasonPaused
+		condition.Message = "ApplicationDefinitions are not created, updated or unmanaged while the catalog is paused"
+	}
+
+	return meta.SetStatusCondition(&catalog.Status.Conditions, condition)
+}
+
+// valuesRenderedCondition returns the ValuesRendered condition for the given render failures.
+func valuesRenderedCondition(catalog *catalogv1alpha1.ApplicationCatalog, failures []string) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               catalogv1alpha1.CatalogConditionValuesRendered,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: catalog.Generation,
+		Reason:             reasonRendered,
+		Message:            "Default values of all charts are rendered",
+	}
+
+	if len(failures) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reasonRenderFailed
+		condition.Message = strings.Join(failures, "; ")
+	}
+
+	return condition
+}
+
+// reconcileApplicationDefinition creates or updates an ApplicationDefinition. ApplicationDefinitions
+// managed by catalogs with a lower priority are taken over in the same update, so that they are
+// never unmanaged in between. A displacedError is returned for ApplicationDefinitions managed by
+// catalogs with a higher or equal priority.
 func (r *Reconciler) reconcileApplicationDefinition(
 	ctx context.Context,
 	l *zap.SugaredLogger,
+	catalog *catalogv1alpha1.ApplicationCatalog,
 	desired *appskubermaticv1.ApplicationDefinition,
 ) error {
 	l.Debugw("Reconciling ApplicationDefinition", "applicationDefinition", ctrlruntimeclient.ObjectKeyFromObject(desired))
@@ -135,19 +332,53 @@ func (r *Reconciler) reconcileApplicationDefinition(
 		return nil
 	}
 
+	if isBreakGlass(existing) {
+		l.Infow("Skipping ApplicationDefinition with break-glass annotation", "name", existing.Name)
+		return r.removeContentHash(ctx, existing)
+	}
+
+	if r.isManagedByImportingCatalog(ctx, existing, desired) {
+		l.Debugw("Skipping ApplicationDefinition managed by an importing catalog", "name", existing.Name, "owner", existing.Labels[catalogv1alpha1.LabelApplicationCatalogName])
+		return nil
+	}
+
+	if owner := existing.Labels[catalogv1alpha1.LabelApplicationCatalogName]; owner != "" && owner != desired.Labels[catalogv1alpha1.LabelApplicationCatalogName] && isShared(existing) && isShared(desired) {
+		return r.contributeVersions(ctx, l, existing, desired)
+	}
+
+	if owner := existing.Labels[catalogv1alpha1.LabelApplicationCatalogName]; owner != "" && owner != catalog.Name {
+		displaced, err := r.isDisplaced(ctx, catalog, owner)
+		if err != nil {
+			return err
+		}
+
+		if displaced {
+			l.Infow("Skipping ApplicationDefinition managed by a catalog with a higher or equal priority", "name", existing.Name, "owner", owner)
+			return &displacedError{catalog: owner}
+		}
+
+		l.Infow("Taking over ApplicationDefinition", "name", existing.Name, "owner", owner)
+	}
+
+	// The stored hash is only trusted if an update would not change the ApplicationDefinition,
+	// otherwise changes made in the cluster would never be reconciled.
+	if hash := existing.Annotations[catalogv1alpha1.AnnotationContentHash]; hash != "" && hash == desired.Annotations[catalogv1alpha1.AnnotationContentHash] {
+		drifted, err := hasDrifted(existing, desired)
+		if err != nil {
+			return err
+		}
+
+		if !drifted {
+			l.Debugw("ApplicationDefinition is up to date", "name", existing.Name)
+			return nil
+		}
+	}
+
 	return r.updateApplicationDefinition(ctx, l, existing, desired)
 }
 
-// updateApplicationDefinition updates an existing ApplicationDefinition.
-// It preserves user customizations using KKP's pattern:
-// promote preserved fields to desired, then do full spec replacement.
-//
-// Fields preserved from existing (cluster state has higher precedence):
-//   - Enforced: if true in cluster, preserve it
-//   - Default: if true in cluster, preserve it
-//   - Selector.Datacenters: if set in cluster, preserve it
-//   - DefaultValuesBlock: if non-empty in cluster, preserve it
-//   - Versions: merged (existing versions preserved, new ones added/updated)
+// updateApplicationDefinition updates an existing ApplicationDefinition, see
+// mergeApplicationDefinition.
 func (r *Reconciler) updateApplicationDefinition(
 	ctx context.Context,
 	l *zap.SugaredLogger,
@@ -156,38 +387,89 @@ func (r *Reconciler) updateApplicationDefinition(
 	l.Debugw("Updating ApplicationDefinition", "name", existing.Name)
 
 	return kubernetes.PatchObject(ctx, r.Client, existing, func() {
-		kubernetes.EnsureLabels(existing, desired.Labels)
-		kubernetes.EnsureAnnotations(existing, desired.Annotations)
+		mergeApplicationDefinition(existing, desired)
+	})
+}
 
-		// Preserve fields where cluster state has higher precedence than catalog.
-		// This follows KKP's pattern from pkg/ee/default-application-catalog/application_catalog.go
-		if existing.Spec.Enforced {
-			desired.Spec.Enforced = true
-		}
-		if existing.Spec.Default {
-			desired.Spec.Default = true
-		}
-		if existing.Spec.Selector.Datacenters != nil {
-			desired.Spec.Selector.Datacenters = existing.Spec.Selector.Datacenters
-		}
-		if existing.Spec.DefaultVersion != "" {
-			desired.Spec.DefaultVersion = existing.Spec.DefaultVersion
+// mergeApplicationDefinition applies the desired ApplicationDefinition to the existing one.
+// It preserves user customizations using KKP's pattern:
+// promote preserved fields to desired, then do full spec replacement.
+//
+// Fields preserved from existing (cluster state has higher precedence):
+//   - Enforced: if true in cluster, preserve it
+//   - Default: if true in cluster, preserve it
+//   - Selector.Datacenters: if set in cluster, preserve it
+//   - DefaultVersion: if set in cluster, preserve it
+//   - DefaultValuesBlock: if non-empty in cluster and customized, preserve it
+//   - Versions: merged (existing versions preserved, new ones added/updated)
+//
+// Per-version default values annotations that are no longer part of the catalog are removed.
+// For ApplicationDefinitions with shared ownership, the versions provided by other catalogs
+// are kept with their owners.
+func mergeApplicationDefinition(existing, desired *appskubermaticv1.ApplicationDefinition) {
+	// Preserve the user customization unless the defaultValuesBlock is empty or "{}", or it is still
+	// the one last written by the controller. In case of empty or "{}", application-catalog enforces
+	// the desired state to keep the KKP's existing pattern in order to prevent breaking changes.
+	if isCustomizedDefaultValues(existing) {
+		desired.Spec.DefaultValuesBlock = existing.Spec.DefaultValuesBlock
+		if hash, ok := existing.Annotations[catalogv1alpha1.AnnotationDefaultValuesHash]; ok {
+			if desired.Annotations == nil {
+				desired.Annotations = map[string]string{}
+			}
+			desired.Annotations[catalogv1alpha1.AnnotationDefaultValuesHash] = hash
+		} else {
+			delete(desired.Annotations, catalogv1alpha1.AnnotationDefaultValuesHash)
 		}
+	}
 
-		// Preserve the user customization unless the defaultValuesBlock is empty or "{}"
-		// In case of empty or "{}", application-catalog enforces the desired state to keep the KKP's existing pattern
-		// in order to prevent breaking changes.
-		if existing.Spec.DefaultValuesBlock != "" && existing.Spec.DefaultValuesBlock != "{}" {
-			desired.Spec.DefaultValuesBlock = existing.Spec.DefaultValuesBlock
+	mergeVersionOwners(existing, desired)
+	pruneVersionDefaultValues(existing, desired)
+	for _, key := range []string{catalogv1alpha1.AnnotationSourceCatalog, catalogv1alpha1.AnnotationVersionOwners} {
+		if _, ok := desired.Annotations[key]; !ok {
+			delete(existing.Annotations, key)
 		}
+	}
+	kubernetes.EnsureLabels(existing, desired.Labels)
+	kubernetes.EnsureAnnotations(existing, desired.Annotations)
 
-		desired.Spec.Versions = mergeVersions(existing.Spec.Versions, desired.Spec.Versions)
-		// Sort versions to have a deterministic order
-		sort.Slice(desired.Spec.Versions, func(i, j int) bool {
-			return desired.Spec.Versions[i].Version < desired.Spec.Versions[j].Version
-		})
-		existing.Spec = desired.Spec
+	// Preserve fields where cluster state has higher precedence than catalog.
+	kubernetes.PreserveClusterFields(existing, desired)
+
+	desired.Spec.Versions = mergeVersions(existing.Spec.Versions, desired.Spec.Versions)
+	// Sort versions to have a deterministic order
+	sort.Slice(desired.Spec.Versions, func(i, j int) bool {
+		return desired.Spec.Versions[i].Version < desired.Spec.Versions[j].Version
 	})
+	existing.Spec = desired.Spec
+}
+
+// isManagedByImportingCatalog returns true if the existing ApplicationDefinition is managed by
+// another catalog which imports the catalog of the desired one. Importing catalogs take
+// precedence, so the ApplicationDefinition is left to them.
+func (r *Reconciler) isManagedByImportingCatalog(ctx context.Context, existing, desired *appskubermaticv1.ApplicationDefinition) bool {
+	owner := existing.Labels[catalogv1alpha1.LabelApplicationCatalogName]
+	catalogName := desired.Labels[catalogv1alpha1.LabelApplicationCatalogName]
+
+	if owner == "" || owner == catalogName {
+		return false
+	}
+
+	return r.imports.Imports(ctx, owner, catalogName)
+}
+
+// isDisplaced returns true if the catalog owning an ApplicationDefinition has a higher or equal
+// priority than the given catalog, so on a tie the current owner keeps it. ApplicationDefinitions
+// of deleted catalogs can be taken over.
+func (r *Reconciler) isDisplaced(ctx context.Context, catalog *catalogv1alpha1.ApplicationCatalog, owner string) (bool, error) {
+	ownerCatalog := &catalogv1alpha1.ApplicationCatalog{}
+	if err := r.Get(ctx, ctrlruntimeclient.ObjectKey{Name: owner}, ownerCatalog); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get ApplicationCatalog %q: %w", owner, err)
+	}
+
+	return !catalog.TakesPrecedenceOver(ownerCatalog), nil
 }
 
 func (r *Reconciler) handleDeletion(ctx context.Context, catalogName string) error {
@@ -195,12 +477,13 @@ func (r *Reconciler) handleDeletion(ctx context.Context, catalogName string) err
 }
 
 // unmanageOrphans removes managed labels from ApplicationDefinitions that are no longer in the catalog.
-// If `generatedApps` is nil, it unmanages all ApplicationDefinitions for the catalog.
+// If `generatedApps` is nil, it unmanages all ApplicationDefinitions for the catalog. Shared
+// ApplicationDefinitions are released instead, see releaseApplicationDefinition.
 func (r *Reconciler) unmanageOrphans(ctx context.Context, catalogName string, generatedApps map[string]bool) error {
 	appDefList := &appskubermaticv1.ApplicationDefinitionList{}
 	listOpts := &ctrlruntimeclient.ListOptions{
 		LabelSelector: labels.SelectorFromSet(labels.Set{
-			catalogv1alpha1.LabelApplicationCatalogName: catalogName,
+			catalogv1alpha1.LabelManagedByApplicationCatalog: "true",
 		}),
 	}
 
@@ -211,11 +494,25 @@ func (r *Reconciler) unmanageOrphans(ctx context.Context, catalogName string, ge
 	var errs []error
 	for i := range appDefList.Items {
 		appDef := &appDefList.Items[i]
-		if generatedApps == nil || !generatedApps[appDef.Name] {
-			r.logger.Debug("Removing managed labels from ApplicationDefinition %s", appDef.Name)
-			if err := r.removeManagedLabels(ctx, appDef); err != nil {
-				errs = append(errs, fmt.Errorf("failed to unmanage %s: %w", appDef.Name, err))
+		if generatedApps != nil && generatedApps[appDef.Name] {
+			continue
+		}
+
+		if appDef.Labels[catalogv1alpha1.LabelApplicationCatalogName] != catalogName && !isContributor(appDef, catalogName) {
+			continue
+		}
+
+		if isShared(appDef) {
+			r.logger.Debugw("Releasing shared ApplicationDefinition", "name", appDef.Name)
+			if err := r.releaseApplicationDefinition(ctx, catalogName, appDef); err != nil {
+				errs = append(errs, fmt.Errorf("failed to release %s: %w", appDef.Name, err))
 			}
+			continue
+		}
+
+		r.logger.Debug("Removing managed labels from ApplicationDefinition %s", appDef.Name)
+		if err := r.removeManagedLabels(ctx, appDef); err != nil {
+			errs = append(errs, fmt.Errorf("failed to unmanage %s: %w", appDef.Name, err))
 		}
 	}
 
@@ -223,15 +520,68 @@ func (r *Reconciler) unmanageOrphans(ctx context.Context, catalogName string, ge
 }
 
 // removeManagedLabels removes the catalog management labels from an ApplicationDefinition.
+// The content hash is removed as well, so that the ApplicationDefinition is fully updated
+// if a catalog manages it again.
 func (r *Reconciler) removeManagedLabels(ctx context.Context, appDef *appskubermaticv1.ApplicationDefinition) error {
 	return kubernetes.PatchObject(ctx, r.Client, appDef, func() {
 		if appDef.Labels != nil {
 			delete(appDef.Labels, catalogv1alpha1.LabelManagedByApplicationCatalog)
 			delete(appDef.Labels, catalogv1alpha1.LabelApplicationCatalogName)
 		}
+		delete(appDef.Annotations, catalogv1alpha1.AnnotationContentHash)
+		delete(appDef.Annotations, catalogv1alpha1.AnnotationVersionOwners)
+	})
+}
+
+// removeContentHash removes the content hash from an ApplicationDefinition taken over by an
+// admin, so that it is fully updated once the break-glass annotation is removed.
+func (r *Reconciler) removeContentHash(ctx context.Context, appDef *appskubermaticv1.ApplicationDefinition) error {
+	if _, ok := appDef.Annotations[catalogv1alpha1.AnnotationContentHash]; !ok {
+		return nil
+	}
+
+	return kubernetes.PatchObject(ctx, r.Client, appDef, func() {
+		delete(appDef.Annotations, catalogv1alpha1.AnnotationContentHash)
 	})
 }
 
+// isCustomizedDefaultValues returns true if the defaultValuesBlock of the ApplicationDefinition
+// was changed in the cluster. Non-empty values without a hash annotation were written by an
+// older controller version and are treated as customized.
+func isCustomizedDefaultValues(appDef *appskubermaticv1.ApplicationDefinition) bool {
+	block := appDef.Spec.DefaultValuesBlock
+	if block == "" || block == "{}" {
+		return false
+	}
+
+	hash, ok := appDef.Annotations[catalogv1alpha1.AnnotationDefaultValuesHash]
+
+	return !ok || hash != hashDefaultValues(block)
+}
+
+// pruneVersionDefaultValues removes the per-version default values annotations from the
+// existing ApplicationDefinition which are not set on the desired one anymore.
+func pruneVersionDefaultValues(existing, desired *appskubermaticv1.ApplicationDefinition) {
+	catalogName := desired.Labels[catalogv1alpha1.LabelApplicationCatalogName]
+	owners := versionOwners(existing)
+
+	for key := range existing.Annotations {
+		version, ok := strings.CutPrefix(key, catalogv1alpha1.AnnotationPrefixVersionDefaultValues)
+		if !ok {
+			continue
+		}
+
+		// The default values of versions provided by other catalogs are kept.
+		if owner, ok := owners[version]; ok && owner != catalogName {
+			continue
+		}
+
+		if _, ok := desired.Annotations[key]; !ok {
+			delete(existing.Annotations, key)
+		}
+	}
+}
+
 // mergeVersions merges existing versions with desired ones without removing
 // existing versions (to prevent breaking changes in KKP deployments).
 func mergeVersions(existing, desired []appskubermaticv1.ApplicationVersion) []appskubermaticv1.ApplicationVersion {
@@ -274,3 +624,13 @@ func isSystemApplication(appDef *appskubermaticv1.ApplicationDefinition) bool {
 	}
 	return false
 }
+
+// isBreakGlass returns true if an admin has taken over the ApplicationDefinition
+// by setting the break-glass annotation.
+func isBreakGlass(appDef *appskubermaticv1.ApplicationDefinition) bool {
+	if appDef == nil {
+		return false
+	}
+
+	return appDef.GetAnnotations()[catalogv1alpha1.AnnotationBreakGlass] == "true"
+}
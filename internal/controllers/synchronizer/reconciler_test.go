@@ -17,12 +17,53 @@ limitations under the License.
 package synchronizer
 
 import (
+	"context"
+	"reflect"
 	"testing"
 
+	"go.uber.org/zap"
+
+	"k8c.io/application-catalog-manager/internal/pkg/imports"
 	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
 	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+// newTestReconciler returns a Reconciler using a fake client with the given objects. The status
+// subresource of ApplicationCatalogs is enabled, like in the cluster.
+func newTestReconciler(t *testing.T, objects ...ctrlruntimeclient.Object) *Reconciler {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{corev1.AddToScheme, catalogv1alpha1.AddToScheme, appskubermaticv1.AddToScheme} {
+		if err := add(scheme); err != nil {
+			t.Fatalf("failed to add to scheme: %v", err)
+		}
+	}
+
+	client := ctrlruntimefakeclient.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&catalogv1alpha1.ApplicationCatalog{}).
+		Build()
+
+	return &Reconciler{
+		Client:  client,
+		cfg:     &ControllerConfig{Namespace: "kubermatic"},
+		logger:  zap.NewNop().Sugar(),
+		imports: imports.NewResolver(client),
+	}
+}
+
 func TestMergeVersions(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -561,3 +602,255 @@ func TestGetHelmChartsReturnsCorrectly(t *testing.T) {
 		})
 	}
 }
+
+func TestPruneVersionDefaultValues(t *testing.T) {
+	existing := &appskubermaticv1.ApplicationDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				"default-values.applicationcatalog.k8c.io/v1.0.0": "a: 1",
+				"default-values.applicationcatalog.k8c.io/v2.0.0": "a: 2",
+				"example.com/unrelated":                           "keep",
+			},
+		},
+	}
+
+	desired := &appskubermaticv1.ApplicationDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				"default-values.applicationcatalog.k8c.io/v2.0.0": "a: 3",
+			},
+		},
+	}
+
+	pruneVersionDefaultValues(existing, desired)
+
+	expected := map[string]string{
+		"default-values.applicationcatalog.k8c.io/v2.0.0": "a: 2",
+		"example.com/unrelated":                           "keep",
+	}
+	if !reflect.DeepEqual(existing.Annotations, expected) {
+		t.Errorf("expected annotations %v, got %v", expected, existing.Annotations)
+	}
+}
+
+func TestIsManagedByImportingCatalog(t *testing.T) {
+	r := newTestReconciler(t,
+		newImportTestCatalog("prod", "platform"),
+		newImportTestCatalog("platform"),
+		newImportTestCatalog("other"),
+	)
+
+	appDef := func(owner string) *appskubermaticv1.ApplicationDefinition {
+		return &appskubermaticv1.ApplicationDefinition{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{catalogv1alpha1.LabelApplicationCatalogName: owner},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		owner    string
+		catalog  string
+		expected bool
+	}{
+		{name: "managed by the same catalog", owner: "platform", catalog: "platform", expected: false},
+		{name: "managed by a catalog importing it", owner: "prod", catalog: "platform", expected: true},
+		{name: "managed by an imported catalog", owner: "platform", catalog: "prod", expected: false},
+		{name: "managed by an unrelated catalog", owner: "other", catalog: "platform", expected: false},
+		{name: "managed by a deleted catalog", owner: "deleted", catalog: "platform", expected: false},
+		{name: "unmanaged", owner: "", catalog: "platform", expected: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := r.isManagedByImportingCatalog(context.Background(), appDef(tc.owner), appDef(tc.catalog)); result != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestReconcilePausedCatalog(t *testing.T) {
+	catalog := &catalogv1alpha1.ApplicationCatalog{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "platform",
+			Annotations: map[string]string{catalogv1alpha1.AnnotationPaused: "true"},
+		},
+		Spec: catalogv1alpha1.ApplicationCatalogSpec{
+			Helm: &catalogv1alpha1.HelmSpec{Charts: []catalogv1alpha1.ChartConfig{}},
+		},
+	}
+
+	// The ApplicationDefinition is not part of the catalog anymore and would be unmanaged.
+	orphan := &appskubermaticv1.ApplicationDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nginx",
+			Labels: map[string]string{
+				catalogv1alpha1.LabelManagedByApplicationCatalog: "true",
+				catalogv1alpha1.LabelApplicationCatalogName:      "platform",
+			},
+		},
+	}
+
+	r := newTestReconciler(t, catalog, orphan)
+
+	reconcileCatalog := func() {
+		t.Helper()
+		if _, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "platform"}}); err != nil {
+			t.Fatalf("reconcile failed: %v", err)
+		}
+	}
+
+	reconcileCatalog()
+
+	appDef := &appskubermaticv1.ApplicationDefinition{}
+	if err := r.Get(context.Background(), types.NamespacedName{Name: "nginx"}, appDef); err != nil {
+		t.Fatalf("failed to get ApplicationDefinition: %v", err)
+	}
+	if appDef.Labels[catalogv1alpha1.LabelApplicationCatalogName] != "platform" {
+		t.Errorf("expected ApplicationDefinition to stay managed while the catalog is paused, got labels %v", appDef.Labels)
+	}
+
+	if err := r.Get(context.Background(), types.NamespacedName{Name: "platform"}, catalog); err != nil {
+		t.Fatalf("failed to get ApplicationCatalog: %v", err)
+	}
+	if !meta.IsStatusConditionTrue(catalog.Status.Conditions, catalogv1alpha1.CatalogConditionPaused) {
+		t.Errorf("expected Paused condition to be true, got %v", catalog.Status.Conditions)
+	}
+
+	delete(catalog.Annotations, catalogv1alpha1.AnnotationPaused)
+	if err := r.Update(context.Background(), catalog); err != nil {
+		t.Fatalf("failed to resume ApplicationCatalog: %v", err)
+	}
+
+	reconcileCatalog()
+
+	if err := r.Get(context.Background(), types.NamespacedName{Name: "nginx"}, appDef); err != nil {
+		t.Fatalf("failed to get ApplicationDefinition: %v", err)
+	}
+	if _, ok := appDef.Labels[catalogv1alpha1.LabelApplicationCatalogName]; ok {
+		t.Errorf("expected ApplicationDefinition to be unmanaged after resuming the catalog, got labels %v", appDef.Labels)
+	}
+
+	if err := r.Get(context.Background(), types.NamespacedName{Name: "platform"}, catalog); err != nil {
+		t.Fatalf("failed to get ApplicationCatalog: %v", err)
+	}
+	if !meta.IsStatusConditionFalse(catalog.Status.Conditions, catalogv1alpha1.CatalogConditionPaused) {
+		t.Errorf("expected Paused condition to be false, got %v", catalog.Status.Conditions)
+	}
+}
+
+func TestReconcileCatalogPriority(t *testing.T) {
+	ctx := context.Background()
+
+	defaults := newRevisionTestCatalog("nginx")
+	defaults.Name = "defaults"
+
+	team := newRevisionTestCatalog("nginx")
+	team.Name = "team"
+	team.Spec.Priority = 10
+
+	r := newTestReconciler(t, defaults, team)
+
+	reconcileCatalog := func(name string) (*appskubermaticv1.ApplicationDefinition, *catalogv1alpha1.ApplicationCatalog) {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: name}}); err != nil {
+			t.Fatalf("reconcile of %q failed: %v", name, err)
+		}
+
+		appDef := &appskubermaticv1.ApplicationDefinition{}
+		if err := r.Get(ctx, types.NamespacedName{Name: "nginx"}, appDef); err != nil {
+			t.Fatalf("failed to get ApplicationDefinition: %v", err)
+		}
+
+		catalog := &catalogv1alpha1.ApplicationCatalog{}
+		if err := r.Get(ctx, types.NamespacedName{Name: name}, catalog); err != nil && !apierrors.IsNotFound(err) {
+			t.Fatalf("failed to get ApplicationCatalog: %v", err)
+		}
+
+		return appDef, catalog
+	}
+
+	if appDef, _ := reconcileCatalog("defaults"); appDef.Labels[catalogv1alpha1.LabelApplicationCatalogName] != "defaults" {
+		t.Fatalf("expected ApplicationDefinition to be managed by %q, got labels %v", "defaults", appDef.Labels)
+	}
+
+	// The catalog with the higher priority takes over the ApplicationDefinition.
+	if appDef, _ := reconcileCatalog("team"); appDef.Labels[catalogv1alpha1.LabelApplicationCatalogName] != "team" {
+		t.Errorf("expected ApplicationDefinition to be taken over by %q, got labels %v", "team", appDef.Labels)
+	}
+
+	appDef, catalog := reconcileCatalog("defaults")
+	if appDef.Labels[catalogv1alpha1.LabelApplicationCatalogName] != "team" {
+		t.Errorf("expected ApplicationDefinition to stay managed by %q, got labels %v", "team", appDef.Labels)
+	}
+
+	expected := []catalogv1alpha1.DisplacedChart{{ChartName: "nginx", ApplicationDefinition: "nginx", Catalog: "team"}}
+	if !reflect.DeepEqual(catalog.Status.DisplacedCharts, expected) {
+		t.Errorf("expected displaced charts %v, got %v", expected, catalog.Status.DisplacedCharts)
+	}
+
+	// Once the catalog with the higher priority is gone, the displaced catalog takes over again.
+	if err := r.Delete(ctx, team); err != nil {
+		t.Fatalf("failed to delete catalog: %v", err)
+	}
+	reconcileCatalog("team")
+
+	appDef, catalog = reconcileCatalog("defaults")
+	if appDef.Labels[catalogv1alpha1.LabelApplicationCatalogName] != "defaults" {
+		t.Errorf("expected ApplicationDefinition to be managed by %q again, got labels %v", "defaults", appDef.Labels)
+	}
+	if len(catalog.Status.DisplacedCharts) != 0 {
+		t.Errorf("expected no displaced charts, got %v", catalog.Status.DisplacedCharts)
+	}
+}
+
+func TestReconcileCatalogEqualPriority(t *testing.T) {
+	ctx := context.Background()
+
+	defaults := newRevisionTestCatalog("nginx")
+	defaults.Name = "defaults"
+
+	team := newRevisionTestCatalog("nginx")
+	team.Name = "team"
+
+	r := newTestReconciler(t, defaults, team)
+
+	owner := func(name string) string {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: name}}); err != nil {
+			t.Fatalf("reconcile of %q failed: %v", name, err)
+		}
+
+		appDef := &appskubermaticv1.ApplicationDefinition{}
+		if err := r.Get(ctx, types.NamespacedName{Name: "nginx"}, appDef); err != nil {
+			t.Fatalf("failed to get ApplicationDefinition: %v", err)
+		}
+		return appDef.Labels[catalogv1alpha1.LabelApplicationCatalogName]
+	}
+
+	if got := owner("defaults"); got != "defaults" {
+		t.Fatalf("expected ApplicationDefinition to be managed by %q, got %q", "defaults", got)
+	}
+
+	// On a tie the current owner keeps the ApplicationDefinition, so the catalogs don't take it
+	// over from each other in turn.
+	for i := 0; i < 2; i++ {
+		for _, name := range []string{"team", "defaults"} {
+			if got := owner(name); got != "defaults" {
+				t.Fatalf("expected ApplicationDefinition to stay managed by %q after reconciling %q, got %q", "defaults", name, got)
+			}
+		}
+	}
+
+	catalog := &catalogv1alpha1.ApplicationCatalog{}
+	if err := r.Get(ctx, types.NamespacedName{Name: "team"}, catalog); err != nil {
+		t.Fatalf("failed to get ApplicationCatalog: %v", err)
+	}
+
+	expected := []catalogv1alpha1.DisplacedChart{{ChartName: "nginx", ApplicationDefinition: "nginx", Catalog: "defaults"}}
+	if !reflect.DeepEqual(catalog.Status.DisplacedCharts, expected) {
+		t.Errorf("expected displaced charts %v, got %v", expected, catalog.Status.DisplacedCharts)
+	}
+}
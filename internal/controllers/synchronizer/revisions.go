@@ -0,0 +1,281 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// revisionKey is the key of the revision ConfigMaps holding the gzip-compressed spec of
+	// the catalog.
+	revisionKey = "spec.yaml.gz"
+
+	// maxRevisionSize is the maximum size of the compressed spec of a revision. It leaves room
+	// for the metadata of the ConfigMap below the 1MiB limit of objects in etcd.
+	maxRevisionSize = 1000 * 1024
+
+	reasonRolledBack     = "RolledBack"
+	reasonRollbackFailed = "RollbackFailed"
+)
+
+// revisionName returns the name of the ConfigMap holding the given revision of the catalog.
+// The catalog name is shortened if the name would exceed the maximum length. A hash of the
+// full name is appended then, so that catalogs sharing a long prefix get distinct names.
+func revisionName(catalogName string, revision int64) string {
+	prefix := "applicationcatalog-"
+	suffix := fmt.Sprintf("-r%d", revision)
+
+	if maxLen := 253 - len(prefix) - len(suffix); len(catalogName) > maxLen {
+		sum := sha256.Sum256([]byte(catalogName))
+		hash := hex.EncodeToString(sum[:])[:8]
+		catalogName = strings.TrimRight(catalogName[:maxLen-len(hash)-1], ".-") + "-" + hash
+	}
+
+	return prefix + catalogName + suffix
+}
+
+// recordRevision snapshots the spec of the current generation of the catalog into an
+// immutable ConfigMap and deletes the oldest revisions exceeding the revision history limit.
+// Generations whose compressed spec exceeds maxRevisionSize are not recorded.
+func (r *Reconciler) recordRevision(ctx context.Context, l *zap.SugaredLogger, catalog *catalogv1alpha1.ApplicationCatalog) error {
+	revisions, err := r.listRevisions(ctx, catalog.Name)
+	if err != nil {
+		return err
+	}
+
+	limit := catalog.GetRevisionHistoryLimit()
+
+	// Old revisions are deleted even if the current generation cannot be recorded.
+	var errs []error
+	if limit > 0 && (len(revisions) == 0 || revisionOf(&revisions[len(revisions)-1]) < catalog.Generation) {
+		revision, err := r.createRevision(ctx, l, catalog)
+		if err != nil {
+			errs = append(errs, err)
+		} else {
+			revisions = append(revisions, *revision)
+		}
+	}
+
+	for i := 0; i < len(revisions)-limit; i++ {
+		l.Debugw("Deleting revision exceeding the revision history limit", "revision", revisionOf(&revisions[i]))
+		if err := r.Delete(ctx, &revisions[i]); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			errs = append(errs, fmt.Errorf("failed to delete revision %d: %w", revisionOf(&revisions[i]), err))
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// createRevision creates the ConfigMap holding the current generation of the catalog.
+func (r *Reconciler) createRevision(ctx context.Context, l *zap.SugaredLogger, catalog *catalogv1alpha1.ApplicationCatalog) (*corev1.ConfigMap, error) {
+	revision, err := r.newRevision(catalog)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create revision %d: %w", catalog.Generation, err)
+	}
+
+	l.Debugw("Recording revision", "revision", catalog.Generation)
+	if err := r.Create(ctx, revision); err != nil && !apierrors.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("failed to create revision %d: %w", catalog.Generation, err)
+	}
+
+	return revision, nil
+}
+
+// newRevision returns the ConfigMap holding the current generation of the catalog. It is owned
+// by the catalog, so that the revisions are garbage collected together with the catalog.
+func (r *Reconciler) newRevision(catalog *catalogv1alpha1.ApplicationCatalog) (*corev1.ConfigMap, error) {
+	spec, err := yaml.Marshal(catalog.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal spec: %w", err)
+	}
+
+	compressed, err := compress(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compress spec: %w", err)
+	}
+
+	if len(compressed) > maxRevisionSize {
+		return nil, fmt.Errorf("compressed spec of %d bytes exceeds the maximum size of %d bytes", len(compressed), maxRevisionSize)
+	}
+
+	revision := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      revisionName(catalog.Name, catalog.Generation),
+			Namespace: r.cfg.Namespace,
+			Labels: map[string]string{
+				catalogv1alpha1.LabelApplicationCatalogName:     catalog.Name,
+				catalogv1alpha1.LabelApplicationCatalogRevision: strconv.FormatInt(catalog.Generation, 10),
+			},
+		},
+		Immutable:  ptr.To(true),
+		BinaryData: map[string][]byte{revisionKey: compressed},
+	}
+
+	if err := controllerutil.SetOwnerReference(catalog, revision, r.Scheme()); err != nil {
+		return nil, fmt.Errorf("failed to set owner reference: %w", err)
+	}
+
+	return revision, nil
+}
+
+// listRevisions returns the revision ConfigMaps of the catalog, ordered from oldest to newest.
+func (r *Reconciler) listRevisions(ctx context.Context, catalogName string) ([]corev1.ConfigMap, error) {
+	list := &corev1.ConfigMapList{}
+	if err := r.List(ctx, list,
+		ctrlruntimeclient.InNamespace(r.cfg.Namespace),
+		ctrlruntimeclient.MatchingLabels{catalogv1alpha1.LabelApplicationCatalogName: catalogName},
+		ctrlruntimeclient.HasLabels{catalogv1alpha1.LabelApplicationCatalogRevision},
+	); err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+
+	revisions := list.Items
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisionOf(&revisions[i]) < revisionOf(&revisions[j])
+	})
+
+	return revisions, nil
+}
+
+// revisionOf returns the revision number of the ConfigMap, or 0 if the label is invalid.
+func revisionOf(revision *corev1.ConfigMap) int64 {
+	n, _ := strconv.ParseInt(revision.Labels[catalogv1alpha1.LabelApplicationCatalogRevision], 10, 64)
+	return n
+}
+
+// rollback restores the spec of the catalog from the revision named in the rollback annotation
+// and removes the annotation. spec.paused is kept, so that a paused catalog stays paused. The
+// outcome is recorded in the RolledBack condition, a failed rollback is not retried.
+func (r *Reconciler) rollback(ctx context.Context, l *zap.SugaredLogger, catalog *catalogv1alpha1.ApplicationCatalog) error {
+	value := catalog.Annotations[catalogv1alpha1.AnnotationRollbackTo]
+
+	spec, rollbackErr := r.getRevision(ctx, catalog.Name, value)
+
+	oldCatalog := catalog.DeepCopy()
+	delete(catalog.Annotations, catalogv1alpha1.AnnotationRollbackTo)
+	if rollbackErr == nil {
+		l.Infow("Rolling back ApplicationCatalog", "revision", value)
+
+		spec.Paused = catalog.Spec.Paused
+		catalog.Spec = *spec
+	}
+
+	if err := r.Patch(ctx, catalog, ctrlruntimeclient.MergeFrom(oldCatalog)); err != nil {
+		return fmt.Errorf("failed to roll back to revision %s: %w", value, err)
+	}
+
+	condition := metav1.Condition{
+		Type:               catalogv1alpha1.CatalogConditionRolledBack,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: catalog.Generation,
+		Reason:             reasonRolledBack,
+		Message:            fmt.Sprintf("Rolled back to revision %s", value),
+	}
+
+	if rollbackErr != nil {
+		l.Infow("Failed to roll back ApplicationCatalog", "revision", value, "error", rollbackErr)
+
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reasonRollbackFailed
+		condition.Message = rollbackErr.Error()
+	}
+
+	oldCatalog = catalog.DeepCopy()
+	meta.SetStatusCondition(&catalog.Status.Conditions, condition)
+
+	return r.Status().Patch(ctx, catalog, ctrlruntimeclient.MergeFrom(oldCatalog))
+}
+
+// getRevision returns the spec of the catalog stored in the given revision.
+func (r *Reconciler) getRevision(ctx context.Context, catalogName string, value string) (*catalogv1alpha1.ApplicationCatalogSpec, error) {
+	revision, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || revision < 1 {
+		return nil, fmt.Errorf("invalid revision %q, must be a positive integer", value)
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: r.cfg.Namespace, Name: revisionName(catalogName, revision)}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("revision %d does not exist", revision)
+		}
+		return nil, fmt.Errorf("failed to get revision %d: %w", revision, err)
+	}
+
+	if configMap.Labels[catalogv1alpha1.LabelApplicationCatalogName] != catalogName || revisionOf(configMap) != revision {
+		return nil, fmt.Errorf("revision %d does not exist", revision)
+	}
+
+	data, err := decompress(configMap.BinaryData[revisionKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed to read revision %d: %w", revision, err)
+	}
+
+	spec := &catalogv1alpha1.ApplicationCatalogSpec{}
+	if err := yaml.UnmarshalStrict(data, spec); err != nil {
+		return nil, fmt.Errorf("failed to parse revision %d: %w", revision, err)
+	}
+
+	return spec, nil
+}
+
+func compress(data []byte) ([]byte, error) {
+	buf := &bytes.Buffer{}
+
+	gz := gzip.NewWriter(buf)
+	if _, err := gz.Write(data); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decompress(data []byte) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	return io.ReadAll(gz)
+}
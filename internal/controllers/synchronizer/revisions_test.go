@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newRevisionTestCatalog(chartName string) *catalogv1alpha1.ApplicationCatalog {
+	return &catalogv1alpha1.ApplicationCatalog{
+		ObjectMeta: metav1.ObjectMeta{Name: "platform", Generation: 1},
+		Spec: catalogv1alpha1.ApplicationCatalogSpec{
+			Helm: &catalogv1alpha1.HelmSpec{
+				Charts: []catalogv1alpha1.ChartConfig{{
+					ChartName:     chartName,
+					ChartVersions: []catalogv1alpha1.ChartVersion{{ChartVersion: "1.0.0", AppVersion: "v1.0.0"}},
+				}},
+			},
+		},
+	}
+}
+
+func listRevisionNumbers(t *testing.T, r *Reconciler) []int64 {
+	t.Helper()
+
+	revisions, err := r.listRevisions(context.Background(), "platform")
+	if err != nil {
+		t.Fatalf("failed to list revisions: %v", err)
+	}
+
+	var numbers []int64
+	for i := range revisions {
+		numbers = append(numbers, revisionOf(&revisions[i]))
+	}
+
+	return numbers
+}
+
+func TestRecordRevision(t *testing.T) {
+	ctx := context.Background()
+	log := zap.NewNop().Sugar()
+
+	catalog := newRevisionTestCatalog("nginx")
+	catalog.Spec.RevisionHistoryLimit = ptr.To[int32](2)
+	r := newTestReconciler(t, catalog)
+
+	for generation := int64(1); generation <= 3; generation++ {
+		catalog.Generation = generation
+		if err := r.recordRevision(ctx, log, catalog); err != nil {
+			t.Fatalf("failed to record revision %d: %v", generation, err)
+		}
+	}
+
+	// Recording the same generation again is a no-op.
+	if err := r.recordRevision(ctx, log, catalog); err != nil {
+		t.Fatalf("failed to record revision: %v", err)
+	}
+
+	if numbers := listRevisionNumbers(t, r); len(numbers) != 2 || numbers[0] != 2 || numbers[1] != 3 {
+		t.Fatalf("expected revisions [2 3], got %v", numbers)
+	}
+
+	revision := &corev1.ConfigMap{}
+	if err := r.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: "kubermatic", Name: revisionName("platform", 3)}, revision); err != nil {
+		t.Fatalf("failed to get revision: %v", err)
+	}
+	if revision.Immutable == nil || !*revision.Immutable {
+		t.Error("expected revision to be immutable")
+	}
+	if len(revision.OwnerReferences) != 1 || revision.OwnerReferences[0].Name != "platform" {
+		t.Errorf("expected revision to be owned by the catalog, got %v", revision.OwnerReferences)
+	}
+
+	catalog.Spec.RevisionHistoryLimit = ptr.To[int32](0)
+	catalog.Generation = 4
+	if err := r.recordRevision(ctx, log, catalog); err != nil {
+		t.Fatalf("failed to record revision: %v", err)
+	}
+
+	if numbers := listRevisionNumbers(t, r); len(numbers) != 0 {
+		t.Errorf("expected no revisions with a limit of 0, got %v", numbers)
+	}
+}
+
+func TestRecordRevisionExceedingSizeLimit(t *testing.T) {
+	ctx := context.Background()
+	log := zap.NewNop().Sugar()
+
+	catalog := newRevisionTestCatalog("nginx")
+	catalog.Spec.RevisionHistoryLimit = ptr.To[int32](2)
+	r := newTestReconciler(t, catalog)
+
+	for generation := int64(1); generation <= 2; generation++ {
+		catalog.Generation = generation
+		if err := r.recordRevision(ctx, log, catalog); err != nil {
+			t.Fatalf("failed to record revision %d: %v", generation, err)
+		}
+	}
+
+	// Random data does not compress, so the spec exceeds the size limit.
+	logo := make([]byte, maxRevisionSize)
+	if _, err := rand.Read(logo); err != nil {
+		t.Fatalf("failed to generate logo: %v", err)
+	}
+
+	catalog.Generation = 3
+	catalog.Spec.RevisionHistoryLimit = ptr.To[int32](1)
+	catalog.Spec.Helm.Charts[0].Metadata = &catalogv1alpha1.ChartMetadata{Logo: base64.StdEncoding.EncodeToString(logo)}
+
+	if err := r.recordRevision(ctx, log, catalog); err == nil || !strings.Contains(err.Error(), "exceeds the maximum size") {
+		t.Fatalf("expected the revision to exceed the maximum size, got %v", err)
+	}
+
+	if numbers := listRevisionNumbers(t, r); len(numbers) != 1 || numbers[0] != 2 {
+		t.Errorf("expected the revisions exceeding the limit to be deleted anyway, got %v", numbers)
+	}
+}
+
+func TestRollback(t *testing.T) {
+	ctx := context.Background()
+	log := zap.NewNop().Sugar()
+
+	catalog := newRevisionTestCatalog("nginx")
+	r := newTestReconciler(t, catalog)
+
+	if err := r.recordRevision(ctx, log, catalog); err != nil {
+		t.Fatalf("failed to record revision: %v", err)
+	}
+
+	current := &catalogv1alpha1.ApplicationCatalog{}
+	if err := r.Get(ctx, ctrlruntimeclient.ObjectKey{Name: "platform"}, current); err != nil {
+		t.Fatalf("failed to get catalog: %v", err)
+	}
+	current.Spec = newRevisionTestCatalog("redis").Spec
+	current.Spec.Paused = true
+	current.Annotations = map[string]string{catalogv1alpha1.AnnotationRollbackTo: "1"}
+	if err := r.Update(ctx, current); err != nil {
+		t.Fatalf("failed to update catalog: %v", err)
+	}
+
+	if err := r.rollback(ctx, log, current); err != nil {
+		t.Fatalf("rollback failed: %v", err)
+	}
+
+	restored := &catalogv1alpha1.ApplicationCatalog{}
+	if err := r.Get(ctx, ctrlruntimeclient.ObjectKey{Name: "platform"}, restored); err != nil {
+		t.Fatalf("failed to get catalog: %v", err)
+	}
+
+	if charts := restored.GetHelmCharts(); len(charts) != 1 || charts[0].ChartName != "nginx" {
+		t.Errorf("expected the charts of revision 1 to be restored, got %v", charts)
+	}
+	if !restored.Spec.Paused {
+		t.Error("expected the catalog to stay paused")
+	}
+	if _, ok := restored.Annotations[catalogv1alpha1.AnnotationRollbackTo]; ok {
+		t.Error("expected the rollback annotation to be removed")
+	}
+	if !meta.IsStatusConditionTrue(restored.Status.Conditions, catalogv1alpha1.CatalogConditionRolledBack) {
+		t.Errorf("expected RolledBack condition to be true, got %v", restored.Status.Conditions)
+	}
+
+	restored.Annotations = map[string]string{catalogv1alpha1.AnnotationRollbackTo: "7"}
+	if err := r.Update(ctx, restored); err != nil {
+		t.Fatalf("failed to update catalog: %v", err)
+	}
+
+	if err := r.rollback(ctx, log, restored); err != nil {
+		t.Fatalf("rollback failed: %v", err)
+	}
+
+	if err := r.Get(ctx, ctrlruntimeclient.ObjectKey{Name: "platform"}, restored); err != nil {
+		t.Fatalf("failed to get catalog: %v", err)
+	}
+
+	if _, ok := restored.Annotations[catalogv1alpha1.AnnotationRollbackTo]; ok {
+		t.Error("expected the rollback annotation to be removed after a failed rollback")
+	}
+
+	condition := meta.FindStatusCondition(restored.Status.Conditions, catalogv1alpha1.CatalogConditionRolledBack)
+	if condition == nil || condition.Status != metav1.ConditionFalse || !strings.Contains(condition.Message, "revision 7 does not exist") {
+		t.Errorf("expected RolledBack condition to report the missing revision, got %v", condition)
+	}
+}
+
+func TestRevisionName(t *testing.T) {
+	if name := revisionName("platform", 12); name != "applicationcatalog-platform-r12" {
+		t.Errorf("unexpected revision name %q", name)
+	}
+
+	long := strings.Repeat("a", 229) + "." + strings.Repeat("b", 23)
+	if name := revisionName(long, 12); len(name) > 253 || strings.Contains(name, ".-") {
+		t.Errorf("expected a valid name of at most 253 characters, got %q (%d)", name, len(name))
+	}
+
+	// Catalogs whose names only differ after the shortened prefix get distinct names.
+	prefix := strings.Repeat("a", 240)
+	if revisionName(prefix+"-x", 12) == revisionName(prefix+"-y", 12) {
+		t.Error("expected distinct revision names for catalogs with a long common prefix")
+	}
+}
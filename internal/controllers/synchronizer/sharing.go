@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"k8c.io/application-catalog-manager/internal/pkg/kubernetes"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// isShared returns true if the ApplicationDefinition has shared ownership.
+func isShared(appDef *appskubermaticv1.ApplicationDefinition) bool {
+	_, ok := appDef.GetAnnotations()[catalogv1alpha1.AnnotationVersionOwners]
+	return ok
+}
+
+// versionOwners returns the catalogs providing the versions of a shared ApplicationDefinition.
+// An invalid annotation is treated as if no version had an owner.
+func versionOwners(appDef *appskubermaticv1.ApplicationDefinition) map[string]string {
+	owners := map[string]string{}
+	if value, ok := appDef.GetAnnotations()[catalogv1alpha1.AnnotationVersionOwners]; ok {
+		_ = json.Unmarshal([]byte(value), &owners)
+	}
+
+	return owners
+}
+
+// isContributor returns true if the catalog provides versions of the shared ApplicationDefinition.
+func isContributor(appDef *appskubermaticv1.ApplicationDefinition, catalogName string) bool {
+	for _, owner := range versionOwners(appDef) {
+		if owner == catalogName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setVersionOwners stores the owners of the versions in the ApplicationDefinition.
+func setVersionOwners(appDef *appskubermaticv1.ApplicationDefinition, owners map[string]string) {
+	if appDef.Annotations == nil {
+		appDef.Annotations = map[string]string{}
+	}
+
+	// Maps are marshalled with sorted keys, so the annotation is stable.
+	value, _ := json.Marshal(owners)
+	appDef.Annotations[catalogv1alpha1.AnnotationVersionOwners] = string(value)
+}
+
+// setSharedOwnership marks the desired ApplicationDefinition of a chart with shared ownership
+// as shared and records the catalog as owner of its versions.
+func setSharedOwnership(appDef *appskubermaticv1.ApplicationDefinition, chart *catalogv1alpha1.ChartConfig) {
+	if !chart.SharedOwnership {
+		return
+	}
+
+	catalogName := appDef.Labels[catalogv1alpha1.LabelApplicationCatalogName]
+
+	owners := make(map[string]string, len(appDef.Spec.Versions))
+	for _, version := range appDef.Spec.Versions {
+		owners[version.Version] = catalogName
+	}
+
+	setVersionOwners(appDef, owners)
+}
+
+// mergeVersionOwners adds the versions provided by other catalogs to the owners of the
+// desired ApplicationDefinition, so that they are kept when the owning catalog updates it.
+func mergeVersionOwners(existing, desired *appskubermaticv1.ApplicationDefinition) {
+	if !isShared(desired) {
+		return
+	}
+
+	catalogName := desired.Labels[catalogv1alpha1.LabelApplicationCatalogName]
+
+	owners := versionOwners(desired)
+	for version, owner := range versionOwners(existing) {
+		if _, ok := owners[version]; !ok && owner != catalogName {
+			owners[version] = owner
+		}
+	}
+
+	setVersionOwners(desired, owners)
+}
+
+// contributeVersions adds the versions of the desired ApplicationDefinition to the shared one
+// owned by another catalog, together with their default values. Versions provided by other
+// catalogs are not overwritten, and versions the catalog does not provide anymore are
+// released but kept, like versions removed from a catalog are kept.
+func (r *Reconciler) contributeVersions(
+	ctx context.Context,
+	l *zap.SugaredLogger,
+	existing, desired *appskubermaticv1.ApplicationDefinition,
+) error {
+	catalogName := desired.Labels[catalogv1alpha1.LabelApplicationCatalogName]
+
+	l.Debugw("Contributing versions to shared ApplicationDefinition", "name", existing.Name, "owner", existing.Labels[catalogv1alpha1.LabelApplicationCatalogName])
+
+	return kubernetes.PatchObject(ctx, r.Client, existing, func() {
+		owners := versionOwners(existing)
+		for version, owner := range owners {
+			if owner == catalogName {
+				delete(owners, version)
+			}
+		}
+
+		var contributed []appskubermaticv1.ApplicationVersion
+		for _, version := range desired.Spec.Versions {
+			if owner, ok := owners[version.Version]; ok {
+				l.Infow("Skipping version provided by another catalog", "name", existing.Name, "version", version.Version, "owner", owner)
+				continue
+			}
+
+			owners[version.Version] = catalogName
+			contributed = append(contributed, version)
+
+			key := catalogv1alpha1.AnnotationPrefixVersionDefaultValues + version.Version
+			if values, ok := desired.Annotations[key]; ok {
+				existing.Annotations[key] = values
+			} else {
+				delete(existing.Annotations, key)
+			}
+		}
+
+		setVersionOwners(existing, owners)
+
+		existing.Spec.Versions = mergeVersions(existing.Spec.Versions, contributed)
+		sort.Slice(existing.Spec.Versions, func(i, j int) bool {
+			return existing.Spec.Versions[i].Version < existing.Spec.Versions[j].Version
+		})
+	})
+}
+
+// releaseApplicationDefinition releases an ApplicationDefinition the catalog does not provide
+// anymore. ApplicationDefinitions owned by the catalog are handed over to the next catalog
+// contributing versions, or unmanaged if there is none. The catalog is removed from the
+// owners of the versions it contributed to ApplicationDefinitions of other catalogs.
+func (r *Reconciler) releaseApplicationDefinition(ctx context.Context, catalogName string, appDef *appskubermaticv1.ApplicationDefinition) error {
+	owner := appDef.Labels[catalogv1alpha1.LabelApplicationCatalogName]
+
+	owners := versionOwners(appDef)
+	contributors := sets.New[string]()
+	for version, o := range owners {
+		if o == catalogName {
+			delete(owners, version)
+		} else {
+			contributors.Insert(o)
+		}
+	}
+	contributors.Delete(owner)
+
+	if owner != catalogName {
+		return kubernetes.PatchObject(ctx, r.Client, appDef, func() {
+			setVersionOwners(appDef, owners)
+		})
+	}
+
+	if contributors.Len() == 0 {
+		return r.removeManagedLabels(ctx, appDef)
+	}
+
+	// The content hash is removed, so that the next owner writes its metadata even if
+	// it would render the same ApplicationDefinition.
+	next := sets.List(contributors)[0]
+	r.logger.Debugw("Handing over shared ApplicationDefinition", "name", appDef.Name, "owner", next)
+
+	return kubernetes.PatchObject(ctx, r.Client, appDef, func() {
+		appDef.Labels[catalogv1alpha1.LabelApplicationCatalogName] = next
+		setVersionOwners(appDef, owners)
+		delete(appDef.Annotations, catalogv1alpha1.AnnotationContentHash)
+	})
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func newSharedTestCatalog(name, description string, versions ...string) *catalogv1alpha1.ApplicationCatalog {
+	chart := catalogv1alpha1.ChartConfig{
+		ChartName:       "cert-manager",
+		Metadata:        &catalogv1alpha1.ChartMetadata{Description: description},
+		SharedOwnership: true,
+	}
+
+	for _, version := range versions {
+		chart.ChartVersions = append(chart.ChartVersions, catalogv1alpha1.ChartVersion{
+			ChartVersion:       version,
+			AppVersion:         "v" + version,
+			DefaultValuesBlock: "version: " + version,
+		})
+	}
+
+	return &catalogv1alpha1.ApplicationCatalog{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 1},
+		Spec: catalogv1alpha1.ApplicationCatalogSpec{
+			Helm: &catalogv1alpha1.HelmSpec{Charts: []catalogv1alpha1.ChartConfig{chart}},
+		},
+	}
+}
+
+func TestReconcileSharedOwnership(t *testing.T) {
+	ctx := context.Background()
+
+	platform := newSharedTestCatalog("platform", "Default cert-manager", "1.16.0")
+	team := newSharedTestCatalog("team", "Patched cert-manager", "1.16.1-patched")
+
+	r := newTestReconciler(t, platform, team)
+
+	reconcileCatalog := func(name string) *appskubermaticv1.ApplicationDefinition {
+		t.Helper()
+		if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: name}}); err != nil {
+			t.Fatalf("reconcile of %q failed: %v", name, err)
+		}
+
+		appDef := &appskubermaticv1.ApplicationDefinition{}
+		if err := r.Get(ctx, types.NamespacedName{Name: "cert-manager"}, appDef); err != nil {
+			t.Fatalf("failed to get ApplicationDefinition: %v", err)
+		}
+		return appDef
+	}
+
+	assertAppDef := func(appDef *appskubermaticv1.ApplicationDefinition, owner, description string, expectedOwners map[string]string) {
+		t.Helper()
+
+		if appDef.Labels[catalogv1alpha1.LabelApplicationCatalogName] != owner {
+			t.Errorf("expected owner %q, got %q", owner, appDef.Labels[catalogv1alpha1.LabelApplicationCatalogName])
+		}
+		if appDef.Spec.Description != description {
+			t.Errorf("expected description %q, got %q", description, appDef.Spec.Description)
+		}
+		if owners := versionOwners(appDef); !reflect.DeepEqual(owners, expectedOwners) {
+			t.Errorf("expected version owners %v, got %v", expectedOwners, owners)
+		}
+
+		// Versions are never removed, even if their catalog is gone.
+		var versions []string
+		for _, version := range appDef.Spec.Versions {
+			versions = append(versions, version.Version)
+		}
+		if expected := []string{"v1.16.0", "v1.16.1-patched"}; !reflect.DeepEqual(versions, expected) {
+			t.Errorf("expected versions %v, got %v", expected, versions)
+		}
+		// Like for versions removed from a catalog, only the provided versions keep their
+		// default values.
+		for _, version := range versions {
+			_, owned := expectedOwners[version]
+			if _, ok := appDef.Annotations[catalogv1alpha1.AnnotationPrefixVersionDefaultValues+version]; ok != owned {
+				t.Errorf("expected default values of version %q to be present: %v", version, owned)
+			}
+		}
+	}
+
+	reconcileCatalog("platform")
+	reconcileCatalog("team")
+
+	// The owning catalog keeps the contributed versions.
+	appDef := reconcileCatalog("platform")
+	assertAppDef(appDef, "platform", "Default cert-manager", map[string]string{"v1.16.0": "platform", "v1.16.1-patched": "team"})
+
+	// Deleting the owning catalog hands the ApplicationDefinition over to the contributing one.
+	if err := r.Delete(ctx, platform); err != nil {
+		t.Fatalf("failed to delete catalog: %v", err)
+	}
+	reconcileCatalog("platform")
+
+	appDef = reconcileCatalog("team")
+	assertAppDef(appDef, "team", "Patched cert-manager", map[string]string{"v1.16.1-patched": "team"})
+
+	// Deleting the last catalog unmanages the ApplicationDefinition.
+	if err := r.Delete(ctx, team); err != nil {
+		t.Fatalf("failed to delete catalog: %v", err)
+	}
+
+	appDef = reconcileCatalog("team")
+	if _, ok := appDef.Labels[catalogv1alpha1.LabelApplicationCatalogName]; ok {
+		t.Errorf("expected ApplicationDefinition to be unmanaged, got labels %v", appDef.Labels)
+	}
+	if isShared(appDef) {
+		t.Errorf("expected version owners to be removed, got annotations %v", appDef.Annotations)
+	}
+}
+
+func TestReleaseContributedVersions(t *testing.T) {
+	ctx := context.Background()
+
+	appDef := &appskubermaticv1.ApplicationDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cert-manager",
+			Labels: map[string]string{
+				catalogv1alpha1.LabelManagedByApplicationCatalog: "true",
+				catalogv1alpha1.LabelApplicationCatalogName:      "platform",
+			},
+			Annotations: map[string]string{
+				catalogv1alpha1.AnnotationVersionOwners: `{"v1.16.0":"platform","v1.16.1":"team","v1.17.0":"other"}`,
+			},
+		},
+	}
+
+	r := newTestReconciler(t, appDef)
+
+	if err := r.unmanageOrphans(ctx, "team", map[string]bool{}); err != nil {
+		t.Fatalf("failed to unmanage orphans: %v", err)
+	}
+
+	if err := r.Get(ctx, types.NamespacedName{Name: "cert-manager"}, appDef); err != nil {
+		t.Fatalf("failed to get ApplicationDefinition: %v", err)
+	}
+
+	if owners := versionOwners(appDef); !reflect.DeepEqual(owners, map[string]string{"v1.16.0": "platform", "v1.17.0": "other"}) {
+		t.Errorf("expected the versions of the contributing catalog to be released, got %v", owners)
+	}
+
+	// The owning catalog hands over to the remaining contributor.
+	if err := r.unmanageOrphans(ctx, "platform", nil); err != nil {
+		t.Fatalf("failed to unmanage orphans: %v", err)
+	}
+
+	if err := r.Get(ctx, types.NamespacedName{Name: "cert-manager"}, appDef); err != nil {
+		t.Fatalf("failed to get ApplicationDefinition: %v", err)
+	}
+
+	if owner := appDef.Labels[catalogv1alpha1.LabelApplicationCatalogName]; owner != "other" {
+		t.Errorf("expected ApplicationDefinition to be handed over to %q, got %q", "other", owner)
+	}
+	if owners := versionOwners(appDef); !reflect.DeepEqual(owners, map[string]string{"v1.17.0": "other"}) {
+		t.Errorf("expected only the versions of %q to remain, got %v", "other", owners)
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"fmt"
+
+	"k8c.io/application-catalog-manager/internal/pkg/values"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// resolveDefaultValues returns a copy of the chart whose defaultValuesBlock contains the
+// values referenced in defaultValuesFrom, merged in order with the defaultValuesBlock on top.
+// Charts without references are returned unchanged.
+func (r *Reconciler) resolveDefaultValues(ctx context.Context, chart *catalogv1alpha1.ChartConfig) (*catalogv1alpha1.ChartConfig, error) {
+	if len(chart.DefaultValuesFrom) == 0 {
+		return chart, nil
+	}
+
+	blocks := make([]string, 0, len(chart.DefaultValuesFrom)+1)
+	for i, ref := range chart.DefaultValuesFrom {
+		block, err := r.getReferencedValues(ctx, ref)
+		if err != nil {
+			return nil, fmt.Errorf("defaultValuesFrom[%d]: %w", i, err)
+		}
+		blocks = append(blocks, block)
+	}
+	blocks = append(blocks, chart.DefaultValuesBlock)
+
+	merged, err := values.Merge(blocks...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge default values: %w", err)
+	}
+
+	resolved := chart.DeepCopy()
+	resolved.DefaultValuesBlock = merged
+	resolved.DefaultValuesFrom = nil
+
+	return resolved, nil
+}
+
+// getReferencedValues returns the values stored in the referenced ConfigMap or Secret key.
+// Missing optional references resolve to an empty values block. Secrets are refused unless
+// they opted in with the LabelValuesSource label, since their values are published in the
+// ApplicationDefinition.
+func (r *Reconciler) getReferencedValues(ctx context.Context, ref catalogv1alpha1.ValuesReference) (string, error) {
+	key := ctrlruntimeclient.ObjectKey{Namespace: r.cfg.Namespace, Name: ref.Name}
+
+	var (
+		data  []byte
+		found bool
+	)
+
+	switch ref.Kind {
+	case catalogv1alpha1.ValuesReferenceKindConfigMap:
+		cm := &corev1.ConfigMap{}
+		if err := r.Get(ctx, key, cm); err != nil {
+			return "", handleMissingObject(string(ref.Kind), ref.Name, ref.Optional, err)
+		}
+
+		var s string
+		if s, found = cm.Data[ref.Key]; found {
+			data = []byte(s)
+		} else {
+			data, found = cm.BinaryData[ref.Key]
+		}
+
+	case catalogv1alpha1.ValuesReferenceKindSecret:
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, key, secret); err != nil {
+			return "", handleMissingObject(string(ref.Kind), ref.Name, ref.Optional, err)
+		}
+
+		if secret.Labels[catalogv1alpha1.LabelValuesSource] != "true" {
+			return "", fmt.Errorf("Secret %q is not labeled with %s=true, which is required to publish its values", ref.Name, catalogv1alpha1.LabelValuesSource)
+		}
+
+		data, found = secret.Data[ref.Key]
+
+	default:
+		return "", fmt.Errorf("unsupported kind %q", ref.Kind)
+	}
+
+	if !found {
+		if ref.Optional {
+			return "", nil
+		}
+		return "", fmt.Errorf("key %q not found in %s %s/%s", ref.Key, ref.Kind, key.Namespace, key.Name)
+	}
+
+	return string(data), nil
+}
+
+// handleMissingObject turns the error of getting a referenced object into the error returned
+// to the user. Optional objects that do not exist are not an error.
+func handleMissingObject(kind, name string, optional bool, err error) error {
+	if apierrors.IsNotFound(err) {
+		if optional {
+			return nil
+		}
+		return fmt.Errorf("%s %q not found", kind, name)
+	}
+
+	return fmt.Errorf("failed to get %s %q: %w", kind, name, err)
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestResolveDefaultValues(t *testing.T) {
+	objects := []ctrlruntimeclient.Object{
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "base-values", Namespace: "kubermatic"},
+			Data: map[string]string{
+				"values.yaml": "# shared defaults\nreplicas: 1\nresources:\n  limits:\n    cpu: 100m\n",
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "secret-values",
+				Namespace: "kubermatic",
+				Labels:    map[string]string{catalogv1alpha1.LabelValuesSource: "true"},
+			},
+			Data: map[string][]byte{
+				"values.yaml": []byte("auth:\n  password: secret\n"),
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "unpublished-values", Namespace: "kubermatic"},
+			Data: map[string][]byte{
+				"values.yaml": []byte("auth:\n  password: secret\n"),
+			},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-namespace", Namespace: "default"},
+			Data:       map[string]string{"values.yaml": "replicas: 5\n"},
+		},
+	}
+
+	r := newTestReconciler(t, objects...)
+
+	tests := []struct {
+		name           string
+		chart          *catalogv1alpha1.ChartConfig
+		expectedValues string
+		expectedErr    string
+	}{
+		{
+			name: "chart without references is unchanged",
+			chart: &catalogv1alpha1.ChartConfig{
+				ChartName:          "nginx",
+				DefaultValuesBlock: "replicas: 2\n",
+			},
+			expectedValues: "replicas: 2\n",
+		},
+		{
+			name: "references are merged in order with defaultValuesBlock on top",
+			chart: &catalogv1alpha1.ChartConfig{
+				ChartName: "nginx",
+				DefaultValuesFrom: []catalogv1alpha1.ValuesReference{
+					{Kind: catalogv1alpha1.ValuesReferenceKindConfigMap, Name: "base-values", Key: "values.yaml"},
+					{Kind: catalogv1alpha1.ValuesReferenceKindSecret, Name: "secret-values", Key: "values.yaml"},
+				},
+				DefaultValuesBlock: "resources:\n  limits:\n    cpu: 200m\n",
+			},
+			expectedValues: "# shared defaults\nreplicas: 1\nresources:\n  limits:\n    cpu: 200m\nauth:\n  password: secret\n",
+		},
+		{
+			name: "missing optional references are ignored",
+			chart: &catalogv1alpha1.ChartConfig{
+				ChartName: "nginx",
+				DefaultValuesFrom: []catalogv1alpha1.ValuesReference{
+					{Kind: catalogv1alpha1.ValuesReferenceKindConfigMap, Name: "does-not-exist", Key: "values.yaml", Optional: true},
+					{Kind: catalogv1alpha1.ValuesReferenceKindConfigMap, Name: "base-values", Key: "missing.yaml", Optional: true},
+				},
+				DefaultValuesBlock: "replicas: 2\n",
+			},
+			expectedValues: "replicas: 2\n",
+		},
+		{
+			name: "missing object is an error",
+			chart: &catalogv1alpha1.ChartConfig{
+				ChartName: "nginx",
+				DefaultValuesFrom: []catalogv1alpha1.ValuesReference{
+					{Kind: catalogv1alpha1.ValuesReferenceKindConfigMap, Name: "other-namespace", Key: "values.yaml"},
+				},
+			},
+			expectedErr: `defaultValuesFrom[0]: ConfigMap "other-namespace" not found`,
+		},
+		{
+			name: "missing key is an error",
+			chart: &catalogv1alpha1.ChartConfig{
+				ChartName: "nginx",
+				DefaultValuesFrom: []catalogv1alpha1.ValuesReference{
+					{Kind: catalogv1alpha1.ValuesReferenceKindSecret, Name: "secret-values", Key: "missing.yaml"},
+				},
+			},
+			expectedErr: `key "missing.yaml" not found in Secret kubermatic/secret-values`,
+		},
+		{
+			name: "Secret without opt-in label is refused",
+			chart: &catalogv1alpha1.ChartConfig{
+				ChartName: "nginx",
+				DefaultValuesFrom: []catalogv1alpha1.ValuesReference{
+					{Kind: catalogv1alpha1.ValuesReferenceKindSecret, Name: "unpublished-values", Key: "values.yaml"},
+				},
+			},
+			expectedErr: `Secret "unpublished-values" is not labeled with applicationcatalog.k8c.io/values-source=true`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resolved, err := r.resolveDefaultValues(context.Background(), tc.chart)
+			if tc.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if resolved.DefaultValuesBlock != tc.expectedValues {
+				t.Errorf("expected values %q, got %q", tc.expectedValues, resolved.DefaultValuesBlock)
+			}
+			if len(resolved.DefaultValuesFrom) != 0 {
+				t.Errorf("expected defaultValuesFrom to be cleared, got %v", resolved.DefaultValuesFrom)
+			}
+		})
+	}
+}
+
+func TestReferencesObject(t *testing.T) {
+	catalog := &catalogv1alpha1.ApplicationCatalog{
+		Spec: catalogv1alpha1.ApplicationCatalogSpec{
+			Helm: &catalogv1alpha1.HelmSpec{
+				Charts: []catalogv1alpha1.ChartConfig{
+					{ChartName: "nginx"},
+					{
+						ChartName: "redis",
+						DefaultValuesFrom: []catalogv1alpha1.ValuesReference{
+							{Kind: catalogv1alpha1.ValuesReferenceKindConfigMap, Name: "redis-values", Key: "values.yaml"},
+						},
+					},
+					{
+						ChartName: "falco",
+						Metadata: &catalogv1alpha1.ChartMetadata{
+							LogoFrom: &catalogv1alpha1.LogoSource{
+								ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "logos"},
+									Key:                  "falco.png",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if !referencesObject(catalog, catalogv1alpha1.ValuesReferenceKindConfigMap, "redis-values") {
+		t.Error("expected catalog to reference ConfigMap redis-values")
+	}
+	if referencesObject(catalog, catalogv1alpha1.ValuesReferenceKindSecret, "redis-values") {
+		t.Error("expected catalog not to reference Secret redis-values")
+	}
+	if !referencesObject(catalog, catalogv1alpha1.ValuesReferenceKindConfigMap, "logos") {
+		t.Error("expected catalog to reference ConfigMap logos")
+	}
+	if referencesObject(catalog, catalogv1alpha1.ValuesReferenceKindSecret, "logos") {
+		t.Error("expected catalog not to reference Secret logos")
+	}
+	if referencesObject(catalog, catalogv1alpha1.ValuesReferenceKindConfigMap, "nginx-values") {
+		t.Error("expected catalog not to reference ConfigMap nginx-values")
+	}
+
+	catalog.Spec.VarsFrom = []catalogv1alpha1.VarsReference{{Name: "installation-vars"}}
+	if !referencesObject(catalog, catalogv1alpha1.ValuesReferenceKindConfigMap, "installation-vars") {
+		t.Error("expected catalog to reference ConfigMap installation-vars")
+	}
+	if referencesObject(catalog, catalogv1alpha1.ValuesReferenceKindSecret, "installation-vars") {
+		t.Error("expected catalog not to reference Secret installation-vars")
+	}
+}
+
+func TestIsCustomizedDefaultValues(t *testing.T) {
+	tests := []struct {
+		name        string
+		block       string
+		annotations map[string]string
+		expected    bool
+	}{
+		{
+			name:     "empty values are not customized",
+			block:    "",
+			expected: false,
+		},
+		{
+			name:     "empty map is not customized",
+			block:    "{}",
+			expected: false,
+		},
+		{
+			name:  "values last written by the controller are not customized",
+			block: "replicas: 1\n",
+			annotations: map[string]string{
+				catalogv1alpha1.AnnotationDefaultValuesHash: hashDefaultValues("replicas: 1\n"),
+			},
+			expected: false,
+		},
+		{
+			name:  "changed values are customized",
+			block: "replicas: 3\n",
+			annotations: map[string]string{
+				catalogv1alpha1.AnnotationDefaultValuesHash: hashDefaultValues("replicas: 1\n"),
+			},
+			expected: true,
+		},
+		{
+			name:     "values without hash are customized",
+			block:    "replicas: 1\n",
+			expected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			appDef := &appskubermaticv1.ApplicationDefinition{
+				ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations},
+				Spec:       appskubermaticv1.ApplicationDefinitionSpec{DefaultValuesBlock: tc.block},
+			}
+
+			if result := isCustomizedDefaultValues(appDef); result != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
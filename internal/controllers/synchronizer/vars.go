@@ -0,0 +1,49 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"maps"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// resolveVars returns the variables of the catalog. The data of the ConfigMaps referenced in
+// varsFrom is merged in order, spec.vars takes precedence over all of them.
+func (r *Reconciler) resolveVars(ctx context.Context, catalog *catalogv1alpha1.ApplicationCatalog) (map[string]string, error) {
+	vars := map[string]string{}
+
+	for _, ref := range catalog.Spec.VarsFrom {
+		cm := &corev1.ConfigMap{}
+		if err := r.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: r.cfg.Namespace, Name: ref.Name}, cm); err != nil {
+			if err := handleMissingObject(string(catalogv1alpha1.ValuesReferenceKindConfigMap), ref.Name, ref.Optional, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		maps.Copy(vars, cm.Data)
+	}
+
+	maps.Copy(vars, catalog.Spec.Vars)
+
+	return vars, nil
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package synchronizer
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestResolveVars(t *testing.T) {
+	r := newTestReconciler(t,
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "installation", Namespace: "kubermatic"},
+			Data:       map[string]string{"registry": "registry.example.com", "domain": "example.com"},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "overrides", Namespace: "kubermatic"},
+			Data:       map[string]string{"domain": "prod.example.com", "storageClass": "standard"},
+		},
+	)
+
+	tests := []struct {
+		name         string
+		spec         catalogv1alpha1.ApplicationCatalogSpec
+		expectedVars map[string]string
+		expectedErr  bool
+	}{
+		{
+			name:         "no vars",
+			expectedVars: map[string]string{},
+		},
+		{
+			name: "later ConfigMaps and spec.vars take precedence",
+			spec: catalogv1alpha1.ApplicationCatalogSpec{
+				Vars:     map[string]string{"storageClass": "fast"},
+				VarsFrom: []catalogv1alpha1.VarsReference{{Name: "installation"}, {Name: "overrides"}},
+			},
+			expectedVars: map[string]string{
+				"registry":     "registry.example.com",
+				"domain":       "prod.example.com",
+				"storageClass": "fast",
+			},
+		},
+		{
+			name: "missing optional ConfigMap",
+			spec: catalogv1alpha1.ApplicationCatalogSpec{
+				VarsFrom: []catalogv1alpha1.VarsReference{{Name: "missing", Optional: true}, {Name: "installation"}},
+			},
+			expectedVars: map[string]string{"registry": "registry.example.com", "domain": "example.com"},
+		},
+		{
+			name: "missing ConfigMap",
+			spec: catalogv1alpha1.ApplicationCatalogSpec{
+				VarsFrom: []catalogv1alpha1.VarsReference{{Name: "missing"}},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			vars, err := r.resolveVars(context.Background(), &catalogv1alpha1.ApplicationCatalog{Spec: tc.spec})
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(vars, tc.expectedVars) {
+				t.Errorf("expected vars %v, got %v", tc.expectedVars, vars)
+			}
+		})
+	}
+}
+
+func TestReconcileRendersVars(t *testing.T) {
+	catalog := &catalogv1alpha1.ApplicationCatalog{
+		ObjectMeta: metav1.ObjectMeta{Name: "edge", Generation: 2},
+		Spec: catalogv1alpha1.ApplicationCatalogSpec{
+			Vars: map[string]string{"registry": "registry.edge.example.com"},
+			Helm: &catalogv1alpha1.HelmSpec{
+				Charts: []catalogv1alpha1.ChartConfig{
+					{
+						ChartName:          "nginx",
+						DefaultValuesBlock: "# mirrored image\nimage:\n  registry: ${catalog.vars.registry}\ningress:\n  host: nginx.${catalog.vars.domain}\n",
+						ChartVersions: []catalogv1alpha1.ChartVersion{
+							{ChartVersion: "1.0.0", AppVersion: "v1.0.0"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	r := newTestReconciler(t, catalog)
+	ctx := context.Background()
+
+	if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: catalog.Name}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	appDef := &appskubermaticv1.ApplicationDefinition{}
+	if err := r.Get(ctx, types.NamespacedName{Name: "nginx"}, appDef); err != nil {
+		t.Fatalf("failed to get ApplicationDefinition: %v", err)
+	}
+
+	expected := "# mirrored image\nimage:\n  registry: registry.edge.example.com\ningress:\n  host: nginx.${catalog.vars.domain}\n"
+	if appDef.Spec.DefaultValuesBlock != expected {
+		t.Errorf("expected default values:\n%s\ngot:\n%s", expected, appDef.Spec.DefaultValuesBlock)
+	}
+
+	updated := &catalogv1alpha1.ApplicationCatalog{}
+	if err := r.Get(ctx, types.NamespacedName{Name: catalog.Name}, updated); err != nil {
+		t.Fatalf("failed to get ApplicationCatalog: %v", err)
+	}
+
+	if !reflect.DeepEqual(updated.Status.UnresolvedVariables, []string{"domain"}) {
+		t.Errorf("expected unresolved variables [domain], got %v", updated.Status.UnresolvedVariables)
+	}
+	if updated.Status.ObservedGeneration != catalog.Generation {
+		t.Errorf("expected observedGeneration %d, got %d", catalog.Generation, updated.Status.ObservedGeneration)
+	}
+}
+
+func TestReconcileKeepsValuesThatFailToRender(t *testing.T) {
+	catalog := &catalogv1alpha1.ApplicationCatalog{
+		ObjectMeta: metav1.ObjectMeta{Name: "edge", Generation: 2},
+		Spec: catalogv1alpha1.ApplicationCatalogSpec{
+			Vars: map[string]string{"registry": "registry.edge.example.com"},
+			Helm: &catalogv1alpha1.HelmSpec{
+				Charts: []catalogv1alpha1.ChartConfig{
+					{
+						ChartName:          "nginx",
+						DefaultValuesBlock: "- ${catalog.vars.registry}\n",
+						ChartVersions: []catalogv1alpha1.ChartVersion{
+							{ChartVersion: "1.0.0", AppVersion: "v1.0.0"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	previous := "image:\n  registry: registry.example.com\n"
+	existing := &appskubermaticv1.ApplicationDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nginx",
+			Labels: map[string]string{
+				catalogv1alpha1.LabelManagedByApplicationCatalog: "true",
+				catalogv1alpha1.LabelApplicationCatalogName:      catalog.Name,
+			},
+		},
+		Spec: appskubermaticv1.ApplicationDefinitionSpec{DefaultValuesBlock: previous},
+	}
+
+	r := newTestReconciler(t, catalog, existing)
+	ctx := context.Background()
+
+	if _, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: catalog.Name}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	appDef := &appskubermaticv1.ApplicationDefinition{}
+	if err := r.Get(ctx, types.NamespacedName{Name: "nginx"}, appDef); err != nil {
+		t.Fatalf("failed to get ApplicationDefinition: %v", err)
+	}
+
+	if appDef.Spec.DefaultValuesBlock != previous {
+		t.Errorf("expected the previous default values to be kept, got:\n%s", appDef.Spec.DefaultValuesBlock)
+	}
+
+	updated := &catalogv1alpha1.ApplicationCatalog{}
+	if err := r.Get(ctx, types.NamespacedName{Name: catalog.Name}, updated); err != nil {
+		t.Fatalf("failed to get ApplicationCatalog: %v", err)
+	}
+
+	condition := meta.FindStatusCondition(updated.Status.Conditions, catalogv1alpha1.CatalogConditionValuesRendered)
+	if condition == nil || condition.Status != metav1.ConditionFalse || condition.Reason != reasonRenderFailed {
+		t.Fatalf("expected ValuesRendered condition with reason %q, got %+v", reasonRenderFailed, condition)
+	}
+	if !strings.Contains(condition.Message, `chart "nginx"`) {
+		t.Errorf("expected the condition message to name the chart, got %q", condition.Message)
+	}
+}
@@ -15,7 +15,7 @@ limitations under the License.
 */
 
 // Package mutation provides a mutating admission webhook for ApplicationCatalog.
-// It injects default charts when spec.helm.charts is nil.
+// It injects default charts when spec.helm.charts is nil. Paused catalogs are not mutated.
 package mutation
 
 import (
@@ -81,6 +81,11 @@ func (h *AdmissionHandler) handleMutation(log *zap.SugaredLogger, req admission.
 		return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to decode request: %w", err))
 	}
 
+	if catalog.IsPaused() {
+		log.Debug("Catalog is paused, no defaults merged")
+		return admission.Allowed("catalog is paused")
+	}
+
 	defaulting.DefaultApplicationCatalog(catalog)
 
 	chartsWereNil := catalog.Spec.Helm == nil || catalog.Spec.Helm.Charts == nil
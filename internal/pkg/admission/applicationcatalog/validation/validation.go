@@ -15,24 +15,36 @@ limitations under the License.
 */
 
 // Package validation provides a validating admission webhook for ApplicationCatalog.
-// It prevents conflicts when multiple catalogs attempt to manage the same ApplicationDefinition.
+// It validates the catalog spec and the default values against the values schemas of
+// the charts, enforces the repository policy and prevents conflicts when multiple
+// catalogs attempt to manage the same ApplicationDefinition.
 package validation
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
+	"k8c.io/application-catalog-manager/internal/pkg/chartschema"
 	"k8c.io/application-catalog-manager/internal/pkg/defaulting"
+	"k8c.io/application-catalog-manager/internal/pkg/imports"
+	"k8c.io/application-catalog-manager/internal/pkg/repositorypolicy"
+	catalogvalidation "k8c.io/application-catalog-manager/internal/pkg/validation"
 	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
 	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -42,6 +54,10 @@ import (
 const (
 	// WebhookPath is the HTTP path for this webhook.
 	WebhookPath = "/validate-applicationcatalog-k8c-io-v1alpha1-applicationcatalog"
+
+	// valuesSchemaTimeout limits the time spent resolving values schemas, which may require
+	// reading ConfigMaps and Secrets, so that the webhook answers before the API server times out.
+	valuesSchemaTimeout = 5 * time.Second
 )
 
 // AdmissionHandler handles validating admission requests for ApplicationCatalog.
@@ -49,14 +65,26 @@ type AdmissionHandler struct {
 	log     *zap.SugaredLogger
 	decoder admission.Decoder
 	client  ctrlruntimeclient.Client
+	policy  *repositorypolicy.Policy
+	schemas *chartschema.Resolver
 }
 
-// NewAdmissionHandler creates a new AdmissionHandler.
-func NewAdmissionHandler(log *zap.SugaredLogger, scheme *runtime.Scheme, client ctrlruntimeclient.Client) *AdmissionHandler {
+// NewAdmissionHandler creates a new AdmissionHandler. The repository policy is optional,
+// if nil, all repository URLs are allowed. The schema resolver is optional, if nil, the
+// default values are only validated against inline values schemas.
+func NewAdmissionHandler(
+	log *zap.SugaredLogger,
+	scheme *runtime.Scheme,
+	client ctrlruntimeclient.Client,
+	policy *repositorypolicy.Policy,
+	schemas *chartschema.Resolver,
+) *AdmissionHandler {
 	return &AdmissionHandler{
 		log:     log,
 		decoder: admission.NewDecoder(scheme),
 		client:  client,
+		policy:  policy,
+		schemas: schemas,
 	}
 }
 
@@ -83,67 +111,206 @@ func (h *AdmissionHandler) Handle(ctx context.Context, req admission.Request) ad
 	}
 }
 
-// handleValidation validates the ApplicationCatalog for conflicts.
+// handleValidation validates the ApplicationCatalog spec and checks it for conflicts.
 func (h *AdmissionHandler) handleValidation(ctx context.Context, log *zap.SugaredLogger, req admission.Request) admission.Response {
 	catalog := &catalogv1alpha1.ApplicationCatalog{}
 	if err := h.decoder.Decode(req, catalog); err != nil {
 		return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to decode request: %w", err))
 	}
 
+	errs, warnings := catalogvalidation.ValidateApplicationCatalog(catalog)
+	errs = append(errs, h.policy.ValidateApplicationCatalog(catalog)...)
+	if len(errs) > 0 {
+		log.Debugw("Catalog spec is invalid", "errors", errs)
+		return admission.Denied(errs.ToAggregate().Error()).WithWarnings(warnings...)
+	}
+
 	// Validate include annotation before checking conflicts
 	if catalog.Spec.Helm != nil && catalog.Spec.Helm.IncludeDefaults {
-		annotation := catalog.Annotations["defaultcatalog.k8c.io/include"]
+		annotation := catalog.Annotations[catalogv1alpha1.AnnotationIncludeDefaults]
 		if invalidNames := defaulting.ValidateIncludeAnnotation(annotation); len(invalidNames) > 0 {
 			validNames := defaulting.GetDefaultChartNames()
-			return admission.Denied(fmt.Sprintf("invalid chart names in annotation defaultcatalog.k8c.io/include: %v. Valid names are: %v", invalidNames, validNames))
+			return admission.Denied(fmt.Sprintf("invalid chart names in annotation %s: %v. Valid names are: %v", catalogv1alpha1.AnnotationIncludeDefaults, invalidNames, validNames)).WithWarnings(warnings...)
 		}
 	}
 
+	errs, schemaWarnings := h.validateValuesSchemas(ctx, catalog)
+	warnings = append(warnings, schemaWarnings...)
+	if len(errs) > 0 {
+		log.Debugw("Default values do not match the values schema", "errors", errs)
+		return admission.Denied(errs.ToAggregate().Error()).WithWarnings(warnings...)
+	}
+
+	errs, importWarnings, err := h.validateImports(ctx, catalog)
+	warnings = append(warnings, importWarnings...)
+	if err != nil {
+		log.Errorw("Failed to resolve imports", "error", err)
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to validate catalog: %w", err)).WithWarnings(warnings...)
+	}
+	if len(errs) > 0 {
+		log.Debugw("Catalog imports are invalid", "errors", errs)
+		return admission.Denied(errs.ToAggregate().Error()).WithWarnings(warnings...)
+	}
+
 	conflicts, err := h.detectConflicts(ctx, catalog)
 	if err != nil {
 		log.Errorw("Failed to detect conflicts", "error", err)
-		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to validate catalog: %w", err))
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to validate catalog: %w", err)).WithWarnings(warnings...)
+	}
+
+	conflicts, priorityWarnings, err := h.resolveConflictsByPriority(ctx, catalog, conflicts)
+	warnings = append(warnings, priorityWarnings...)
+	if err != nil {
+		log.Errorw("Failed to resolve conflicts", "error", err)
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to validate catalog: %w", err)).WithWarnings(warnings...)
 	}
 
 	if len(conflicts) > 0 {
 		log.Warnw("Catalog conflicts detected", "conflicts", conflicts)
-		return admission.Denied(formatConflictMessage(conflicts))
+		return admission.Denied(formatConflictMessage(conflicts)).WithWarnings(warnings...)
 	}
 
 	log.Debug("Validation passed, no conflicts detected")
-	return admission.Allowed("no conflicts detected")
+	return admission.Allowed("no conflicts detected").WithWarnings(warnings...)
+}
+
+// validateValuesSchemas validates the default values of the charts against the values
+// schemas stored in ConfigMaps or chart archives. Inline schemas are validated together
+// with the catalog spec. Schemas that cannot be resolved only produce a warning, as the
+// ConfigMap may be created after the catalog and chart archives are only fetched in the
+// background, so the schema of a chart may not be available yet.
+func (h *AdmissionHandler) validateValuesSchemas(ctx context.Context, catalog *catalogv1alpha1.ApplicationCatalog) (field.ErrorList, []string) {
+	if h.schemas == nil {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, valuesSchemaTimeout)
+	defer cancel()
+
+	type resolved struct {
+		schema      *chartschema.Schema
+		chartValues []byte
+	}
+
+	var (
+		allErrs  field.ErrorList
+		warnings []string
+	)
+
+	charts := catalog.GetHelmCharts()
+	for i := range charts {
+		chart := &charts[i]
+		fldPath := field.NewPath("spec", "helm", "charts").Index(i)
+
+		// ConfigMap schemas are resolved once per chart, so that versions sharing the
+		// chart-level schema are recognized as such.
+		configMapSchemas := map[*catalogv1alpha1.ValuesSchema]resolved{}
+
+		errs, warns := catalogvalidation.ValidateDefaultValuesSchema(chart, catalog.Spec.Vars, fldPath, func(version *catalogv1alpha1.ChartVersion) (*chartschema.Schema, []byte) {
+			source := chart.ResolveValuesSchema(version)
+			if source == nil || source.Inline != "" {
+				return nil, nil
+			}
+
+			if r, ok := configMapSchemas[source]; ok {
+				return r.schema, r.chartValues
+			}
+
+			schema, chartValues, err := h.schemas.Resolve(ctx, catalog, chart, version)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("%s: default values of chart version %s are not validated against the values schema: %v", fldPath, version.ChartVersion, err))
+			}
+
+			if source.ConfigMapKeyRef != nil {
+				configMapSchemas[source] = resolved{schema: schema, chartValues: chartValues}
+			}
+
+			return schema, chartValues
+		})
+		allErrs = append(allErrs, errs...)
+		warnings = append(warnings, warns...)
+	}
+
+	return allErrs, warnings
+}
+
+// validateImports resolves the imports of the catalog to detect import cycles. Imported catalogs
+// that do not exist yet only produce a warning, since they may be created after the catalog.
+func (h *AdmissionHandler) validateImports(ctx context.Context, catalog *catalogv1alpha1.ApplicationCatalog) (field.ErrorList, []string, error) {
+	if len(catalog.Spec.Imports) == 0 {
+		return nil, nil, nil
+	}
+
+	_, err := imports.NewResolver(h.client).ImportedCatalogs(ctx, catalog)
+
+	var cycleErr *imports.CycleError
+	switch {
+	case err == nil:
+		return nil, nil, nil
+
+	case errors.As(err, &cycleErr):
+		return field.ErrorList{field.Invalid(field.NewPath("spec", "imports"), field.OmitValueType{}, cycleErr.Error())}, nil, nil
+
+	case apierrors.IsNotFound(err):
+		return nil, []string{fmt.Sprintf("spec.imports: %v, its charts are not included until it is created", err)}, nil
+
+	default:
+		return nil, nil, err
+	}
 }
 
-// ConflictInfo contains information about a detected conflict.
+// ConflictInfo contains information about a detected conflict. Version is set if the
+// conflict is a version of a shared ApplicationDefinition provided by another catalog,
+// Duplicate if two charts of the catalog resolve to the same ApplicationDefinition.
 type ConflictInfo struct {
 	AppDefName   string
 	OwnerCatalog string
+	Version      string
+	Duplicate    bool
 }
 
 // detectConflicts checks for intra-catalog duplicates and external conflicts
-// with ApplicationDefinitions managed by other catalogs.
+// with ApplicationDefinitions managed by other catalogs. Imported charts are part of
+// the catalog, and ApplicationDefinitions managed by imported or importing catalogs
+// are not conflicts, as they are handed over between the catalogs. Charts with shared
+// ownership only conflict with the versions other catalogs provide for the same
+// ApplicationDefinition.
 func (h *AdmissionHandler) detectConflicts(ctx context.Context, catalog *catalogv1alpha1.ApplicationCatalog) ([]ConflictInfo, error) {
-	charts := catalog.GetHelmCharts()
+	resolver := imports.NewResolver(h.client)
+
+	// Invalid imports are reported by validateImports, only the charts of the catalog
+	// itself are checked in this case.
+	charts, err := resolver.ResolveCharts(ctx, catalog)
+	if err != nil {
+		charts = catalog.GetHelmCharts()
+	}
+
 	if len(charts) == 0 {
 		return nil, nil
 	}
 
+	imported, err := resolver.ImportedCatalogs(ctx, catalog)
+	if err != nil {
+		imported = sets.New[string]()
+	}
+
 	var conflicts []ConflictInfo
 
 	// Check for intra-catalog duplicates
-	appNames := make(map[string]string, len(charts))
+	appNames := make(map[string]*catalogv1alpha1.ChartConfig, len(charts))
 	for i := range charts {
 		chart := &charts[i]
-		appName := chart.GetAppName()
+		appName := catalog.ResolveAppName(chart)
 
 		if existingChart, exists := appNames[appName]; exists {
 			conflicts = append(conflicts, ConflictInfo{
 				AppDefName:   appName,
-				OwnerCatalog: fmt.Sprintf("this catalog (duplicate: charts %q and %q resolve to same appName)", existingChart, chart.ChartName),
+				OwnerCatalog: fmt.Sprintf("this catalog (duplicate: charts %q and %q resolve to same appName)", existingChart.ChartName, chart.ChartName),
+				Duplicate:    true,
 			})
 			continue
 		}
-		appNames[appName] = chart.ChartName
+		appNames[appName] = chart
 	}
 
 	// Check for external conflicts
@@ -166,14 +333,27 @@ func (h *AdmissionHandler) detectConflicts(ctx context.Context, catalog *catalog
 		}
 	}
 
-	for appName := range appNames {
+	for appName, chart := range appNames {
 		appDef, found := existing[appName]
 		if !found {
 			continue
 		}
 
 		owner := appDef.Labels[catalogv1alpha1.LabelApplicationCatalogName]
-		if owner == "" || owner == catalog.Name {
+		if owner == "" {
+			continue
+		}
+
+		if _, shared := appDef.Annotations[catalogv1alpha1.AnnotationVersionOwners]; shared && chart.SharedOwnership {
+			conflicts = append(conflicts, detectVersionConflicts(catalog.Name, appDef, chart)...)
+			continue
+		}
+
+		if owner == catalog.Name || imported.Has(owner) {
+			continue
+		}
+
+		if resolver.Imports(ctx, owner, catalog.Name) {
 			continue
 		}
 
@@ -186,6 +366,70 @@ func (h *AdmissionHandler) detectConflicts(ctx context.Context, catalog *catalog
 	return conflicts, nil
 }
 
+// resolveConflictsByPriority resolves the conflicts with catalogs of a different priority. The
+// catalog with the higher priority wins the ApplicationDefinition, the conflicts are reported
+// as warnings. Conflicts with catalogs of the same priority, conflicts of versions of shared
+// ApplicationDefinitions and conflicts within the catalog are returned.
+func (h *AdmissionHandler) resolveConflictsByPriority(
+	ctx context.Context,
+	catalog *catalogv1alpha1.ApplicationCatalog,
+	conflicts []ConflictInfo,
+) ([]ConflictInfo, []string, error) {
+	var (
+		remaining []ConflictInfo
+		warnings  []string
+	)
+
+	for _, c := range conflicts {
+		if c.Version != "" || c.Duplicate {
+			remaining = append(remaining, c)
+			continue
+		}
+
+		owner := &catalogv1alpha1.ApplicationCatalog{}
+		if err := h.client.Get(ctx, ctrlruntimeclient.ObjectKey{Name: c.OwnerCatalog}, owner); err != nil {
+			if apierrors.IsNotFound(err) {
+				remaining = append(remaining, c)
+				continue
+			}
+			return nil, nil, fmt.Errorf("failed to get ApplicationCatalog %q: %w", c.OwnerCatalog, err)
+		}
+
+		switch {
+		case catalog.TakesPrecedenceOver(owner):
+			warnings = append(warnings, fmt.Sprintf("ApplicationDefinition %q is taken over from catalog %q with a lower priority", c.AppDefName, c.OwnerCatalog))
+		case owner.TakesPrecedenceOver(catalog):
+			warnings = append(warnings, fmt.Sprintf("ApplicationDefinition %q is managed by catalog %q with a higher priority, the chart is displaced", c.AppDefName, c.OwnerCatalog))
+		default:
+			remaining = append(remaining, c)
+		}
+	}
+
+	return remaining, warnings, nil
+}
+
+// detectVersionConflicts returns the versions of the chart which other catalogs provide for
+// the shared ApplicationDefinition.
+func detectVersionConflicts(catalogName string, appDef *appskubermaticv1.ApplicationDefinition, chart *catalogv1alpha1.ChartConfig) []ConflictInfo {
+	owners := map[string]string{}
+	if err := json.Unmarshal([]byte(appDef.Annotations[catalogv1alpha1.AnnotationVersionOwners]), &owners); err != nil {
+		return nil
+	}
+
+	var conflicts []ConflictInfo
+	for _, version := range chart.ChartVersions {
+		if owner, ok := owners[version.AppVersion]; ok && owner != catalogName {
+			conflicts = append(conflicts, ConflictInfo{
+				AppDefName:   appDef.Name,
+				OwnerCatalog: owner,
+				Version:      version.AppVersion,
+			})
+		}
+	}
+
+	return conflicts
+}
+
 // formatConflictMessage formats the conflict information into a user-friendly message.
 func formatConflictMessage(conflicts []ConflictInfo) string {
 	if len(conflicts) == 0 {
@@ -196,13 +440,19 @@ func formatConflictMessage(conflicts []ConflictInfo) string {
 	sb.WriteString("ApplicationCatalog conflicts detected:\n")
 
 	for _, c := range conflicts {
+		if c.Version != "" {
+			sb.WriteString(fmt.Sprintf("  - Version %q of ApplicationDefinition %q is already provided by catalog %q\n", c.Version, c.AppDefName, c.OwnerCatalog))
+			continue
+		}
 		sb.WriteString(fmt.Sprintf("  - ApplicationDefinition %q is already managed by catalog %q\n", c.AppDefName, c.OwnerCatalog))
 	}
 
 	sb.WriteString("\nTo resolve this conflict, either:\n")
 	sb.WriteString("  1. Remove the conflicting chart from this catalog\n")
 	sb.WriteString("  2. Use a different appName in metadata.appName for the chart\n")
-	sb.WriteString("  3. Delete the other catalog or remove the chart from it first")
+	sb.WriteString("  3. Delete the other catalog or remove the chart from it first\n")
+	sb.WriteString("  4. Set sharedOwnership on the chart in both catalogs and provide different versions\n")
+	sb.WriteString("  5. Give one of the catalogs a higher priority")
 
 	return sb.String()
 }
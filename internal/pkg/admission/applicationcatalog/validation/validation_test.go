@@ -18,6 +18,7 @@ package validation
 
 import (
 	"context"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -489,3 +490,265 @@ func TestFormatConflictMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestDetectConflicts_SanitizedAppNames(t *testing.T) {
+	handler := setupTestHandler(t)
+
+	catalog := &catalogv1alpha1.ApplicationCatalog{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-catalog",
+		},
+		Spec: catalogv1alpha1.ApplicationCatalogSpec{
+			Helm: &catalogv1alpha1.HelmSpec{
+				SanitizeAppNames: true,
+				Charts: []catalogv1alpha1.ChartConfig{
+					{ChartName: "nvidia/gpu-operator"},
+					{ChartName: "nvidia_gpu-operator"},
+				},
+			},
+		},
+	}
+
+	conflicts, err := handler.detectConflicts(context.Background(), catalog)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(conflicts) != 1 {
+		t.Fatalf("expected 1 conflict for charts sanitizing to the same name, got %d: %+v", len(conflicts), conflicts)
+	}
+
+	if conflicts[0].AppDefName != "nvidia-gpu-operator" {
+		t.Errorf("expected conflict for %q, got %q", "nvidia-gpu-operator", conflicts[0].AppDefName)
+	}
+}
+
+func newManagedAppDef(name, owner string) *appskubermaticv1.ApplicationDefinition {
+	return &appskubermaticv1.ApplicationDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				catalogv1alpha1.LabelManagedByApplicationCatalog: "true",
+				catalogv1alpha1.LabelApplicationCatalogName:      owner,
+			},
+		},
+	}
+}
+
+func newImportingCatalog(name string, imports []string, chartNames ...string) *catalogv1alpha1.ApplicationCatalog {
+	catalog := &catalogv1alpha1.ApplicationCatalog{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: catalogv1alpha1.ApplicationCatalogSpec{
+			Helm: &catalogv1alpha1.HelmSpec{},
+		},
+	}
+
+	for _, imp := range imports {
+		catalog.Spec.Imports = append(catalog.Spec.Imports, catalogv1alpha1.CatalogImport{Name: imp})
+	}
+
+	for _, chartName := range chartNames {
+		catalog.Spec.Helm.Charts = append(catalog.Spec.Helm.Charts, catalogv1alpha1.ChartConfig{
+			ChartName: chartName,
+			ChartVersions: []catalogv1alpha1.ChartVersion{
+				{ChartVersion: "1.0.0", AppVersion: "v1.0.0"},
+			},
+		})
+	}
+
+	return catalog
+}
+
+func TestDetectConflicts_Imports(t *testing.T) {
+	// prod takes over the ApplicationDefinitions of the imported platform catalog, while
+	// the ApplicationDefinitions of prod are managed by the data catalog importing it.
+	catalog := newImportingCatalog("prod", []string{"platform"}, "redis", "nginx")
+
+	handler := setupTestHandler(t,
+		catalog.DeepCopy(),
+		newImportingCatalog("platform", nil, "cert-manager"),
+		newImportingCatalog("data", []string{"prod"}, "postgres"),
+		newManagedAppDef("cert-manager", "platform"),
+		newManagedAppDef("redis", "data"),
+		newManagedAppDef("nginx", "other-catalog"),
+	)
+
+	conflicts, err := handler.detectConflicts(context.Background(), catalog)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(conflicts) != 1 {
+		t.Fatalf("expected 1 conflict, got %d: %v", len(conflicts), conflicts)
+	}
+
+	if conflicts[0].AppDefName != "nginx" || conflicts[0].OwnerCatalog != "other-catalog" {
+		t.Errorf("expected conflict for 'nginx' with 'other-catalog', got %v", conflicts[0])
+	}
+}
+
+func TestDetectConflicts_ImportedIntraCatalogDuplicates(t *testing.T) {
+	platform := newImportingCatalog("platform", nil, "nginx")
+	platform.Spec.Helm.Charts[0].Metadata = &catalogv1alpha1.ChartMetadata{AppName: "web"}
+
+	handler := setupTestHandler(t, platform)
+
+	conflicts, err := handler.detectConflicts(context.Background(), newImportingCatalog("prod", []string{"platform"}, "web"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(conflicts) != 1 || conflicts[0].AppDefName != "web" {
+		t.Errorf("expected the imported chart to conflict with the local chart, got %v", conflicts)
+	}
+}
+
+func TestDetectConflicts_SharedOwnership(t *testing.T) {
+	newSharedAppDef := func(name, owner, versionOwners string) *appskubermaticv1.ApplicationDefinition {
+		appDef := newManagedAppDef(name, owner)
+		appDef.Annotations = map[string]string{catalogv1alpha1.AnnotationVersionOwners: versionOwners}
+		return appDef
+	}
+
+	catalog := newImportingCatalog("team", nil, "cert-manager", "nginx", "redis", "postgres")
+	for i := range catalog.Spec.Helm.Charts {
+		catalog.Spec.Helm.Charts[i].SharedOwnership = catalog.Spec.Helm.Charts[i].ChartName != "postgres"
+	}
+
+	handler := setupTestHandler(t,
+		// Disjoint versions are contributed to the shared ApplicationDefinition.
+		newSharedAppDef("cert-manager", "platform", `{"v1.16.0":"platform"}`),
+		// The version is already provided by the owning catalog.
+		newSharedAppDef("nginx", "platform", `{"v1.0.0":"platform"}`),
+		// The owning catalog did not opt in to shared ownership.
+		newManagedAppDef("redis", "platform"),
+		// The chart of the catalog did not opt in to shared ownership.
+		newSharedAppDef("postgres", "platform", `{"v2.0.0":"platform"}`),
+	)
+
+	conflicts, err := handler.detectConflicts(context.Background(), catalog)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"nginx":    "v1.0.0",
+		"redis":    "",
+		"postgres": "",
+	}
+
+	if len(conflicts) != len(expected) {
+		t.Fatalf("expected %d conflicts, got %d: %v", len(expected), len(conflicts), conflicts)
+	}
+
+	for _, c := range conflicts {
+		version, ok := expected[c.AppDefName]
+		if !ok || c.Version != version || c.OwnerCatalog != "platform" {
+			t.Errorf("unexpected conflict %v", c)
+		}
+	}
+
+	message := formatConflictMessage([]ConflictInfo{{AppDefName: "nginx", OwnerCatalog: "platform", Version: "v1.0.0"}})
+	if !strings.Contains(message, `Version "v1.0.0" of ApplicationDefinition "nginx" is already provided by catalog "platform"`) {
+		t.Errorf("expected message to name the conflicting version, got %q", message)
+	}
+}
+
+func TestResolveConflictsByPriority(t *testing.T) {
+	newPriorityCatalog := func(name string, priority int32) *catalogv1alpha1.ApplicationCatalog {
+		catalog := newImportingCatalog(name, nil)
+		catalog.Spec.Priority = priority
+		return catalog
+	}
+
+	catalog := newPriorityCatalog("team", 10)
+
+	handler := setupTestHandler(t,
+		newPriorityCatalog("defaults", 0),
+		newPriorityCatalog("security", 100),
+		newPriorityCatalog("other-team", 10),
+	)
+
+	conflicts := []ConflictInfo{
+		{AppDefName: "cert-manager", OwnerCatalog: "defaults"},
+		{AppDefName: "falco", OwnerCatalog: "security"},
+		{AppDefName: "nginx", OwnerCatalog: "other-team"},
+		{AppDefName: "redis", OwnerCatalog: "deleted"},
+		{AppDefName: "postgres", OwnerCatalog: "defaults", Version: "v1.0.0"},
+		{AppDefName: "web", OwnerCatalog: "this catalog", Duplicate: true},
+	}
+
+	remaining, warnings, err := handler.resolveConflictsByPriority(context.Background(), catalog, conflicts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var names []string
+	for _, c := range remaining {
+		names = append(names, c.AppDefName)
+	}
+	if expected := []string{"nginx", "redis", "postgres", "web"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("expected conflicts %v, got %v", expected, names)
+	}
+
+	if len(warnings) != 2 ||
+		!strings.Contains(warnings[0], `"cert-manager" is taken over from catalog "defaults"`) ||
+		!strings.Contains(warnings[1], `"falco" is managed by catalog "security" with a higher priority`) {
+		t.Errorf("expected warnings about the taken over and displaced ApplicationDefinitions, got %v", warnings)
+	}
+}
+
+func TestValidateImports(t *testing.T) {
+	tests := []struct {
+		name             string
+		objects          []ctrlruntimeclient.Object
+		catalog          *catalogv1alpha1.ApplicationCatalog
+		expectedErr      string
+		expectedWarnings int
+	}{
+		{
+			name:    "no imports",
+			catalog: newImportingCatalog("prod", nil),
+		},
+		{
+			name:    "existing imports",
+			objects: []ctrlruntimeclient.Object{newImportingCatalog("platform", nil)},
+			catalog: newImportingCatalog("prod", []string{"platform"}),
+		},
+		{
+			name:             "missing import produces a warning",
+			catalog:          newImportingCatalog("prod", []string{"platform"}),
+			expectedWarnings: 1,
+		},
+		{
+			name: "import cycle is rejected",
+			objects: []ctrlruntimeclient.Object{
+				newImportingCatalog("platform", []string{"prod"}),
+			},
+			catalog:     newImportingCatalog("prod", []string{"platform"}),
+			expectedErr: "import cycle detected: prod -> platform -> prod",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := setupTestHandler(t, tc.objects...)
+
+			errs, warnings, err := handler.validateImports(context.Background(), tc.catalog)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tc.expectedErr == "" && len(errs) > 0 {
+				t.Errorf("expected no errors, got %v", errs)
+			}
+			if tc.expectedErr != "" && !strings.Contains(errs.ToAggregate().Error(), tc.expectedErr) {
+				t.Errorf("expected error containing %q, got %v", tc.expectedErr, errs)
+			}
+
+			if len(warnings) != tc.expectedWarnings {
+				t.Errorf("expected %d warnings, got %v", tc.expectedWarnings, warnings)
+			}
+		})
+	}
+}
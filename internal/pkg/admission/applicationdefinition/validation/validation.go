@@ -0,0 +1,156 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validation provides a validating admission webhook for ApplicationDefinitions
+// managed by an ApplicationCatalog. It rejects manual edits of fields that are owned by
+// the catalog, since the controller would revert them on the next reconciliation.
+package validation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	// WebhookPath is the HTTP path for this webhook.
+	WebhookPath = "/validate-apps-kubermatic-k8c-io-v1-applicationdefinition"
+)
+
+// catalogOwnedFields lists the spec fields that are generated from the ApplicationCatalog.
+// All other spec fields (enforced, default, selector, defaultVersion and defaultValuesBlock)
+// are preserved by the controller and can therefore be changed by admins.
+var catalogOwnedFields = []struct {
+	path string
+	get  func(spec *appskubermaticv1.ApplicationDefinitionSpec) any
+}{
+	{"spec.displayName", func(s *appskubermaticv1.ApplicationDefinitionSpec) any { return s.DisplayName }},
+	{"spec.description", func(s *appskubermaticv1.ApplicationDefinitionSpec) any { return s.Description }},
+	{"spec.method", func(s *appskubermaticv1.ApplicationDefinitionSpec) any { return s.Method }},
+	{"spec.defaultValues", func(s *appskubermaticv1.ApplicationDefinitionSpec) any { return s.DefaultValues }},
+	{"spec.defaultNamespace", func(s *appskubermaticv1.ApplicationDefinitionSpec) any { return s.DefaultNamespace }},
+	{"spec.defaultDeployOptions", func(s *appskubermaticv1.ApplicationDefinitionSpec) any { return s.DefaultDeployOptions }},
+	{"spec.documentationURL", func(s *appskubermaticv1.ApplicationDefinitionSpec) any { return s.DocumentationURL }},
+	{"spec.sourceURL", func(s *appskubermaticv1.ApplicationDefinitionSpec) any { return s.SourceURL }},
+	{"spec.logo", func(s *appskubermaticv1.ApplicationDefinitionSpec) any { return s.Logo }},
+	{"spec.logoFormat", func(s *appskubermaticv1.ApplicationDefinitionSpec) any { return s.LogoFormat }},
+	{"spec.versions", func(s *appskubermaticv1.ApplicationDefinitionSpec) any { return s.Versions }},
+}
+
+// AdmissionHandler handles validating admission requests for ApplicationDefinitions.
+type AdmissionHandler struct {
+	log     *zap.SugaredLogger
+	decoder admission.Decoder
+
+	// trustedUsers are allowed to change catalog-owned fields, this is usually
+	// the service account of the application-catalog-manager.
+	trustedUsers sets.Set[string]
+}
+
+// NewAdmissionHandler creates a new AdmissionHandler. Requests from any of the
+// given trustedUsers are always allowed.
+func NewAdmissionHandler(log *zap.SugaredLogger, scheme *runtime.Scheme, trustedUsers ...string) *AdmissionHandler {
+	return &AdmissionHandler{
+		log:          log,
+		decoder:      admission.NewDecoder(scheme),
+		trustedUsers: sets.New(trustedUsers...),
+	}
+}
+
+// SetupWebhookWithManager registers the webhook with the manager.
+func (h *AdmissionHandler) SetupWebhookWithManager(mgr ctrl.Manager) {
+	mgr.GetWebhookServer().Register(WebhookPath, &webhook.Admission{Handler: h})
+}
+
+// Handle handles admission requests for ApplicationDefinitions.
+func (h *AdmissionHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	log := h.log.With("uid", req.UID, "name", req.Name, "operation", req.Operation, "user", req.UserInfo.Username)
+
+	if req.Operation != admissionv1.Update {
+		log.Debugw("Allowing operation without validation", "operation", req.Operation)
+		return admission.Allowed(fmt.Sprintf("%q operations do not require validation", req.Operation))
+	}
+
+	if h.trustedUsers.Has(req.UserInfo.Username) {
+		return admission.Allowed("request from trusted user")
+	}
+
+	oldAppDef := &appskubermaticv1.ApplicationDefinition{}
+	if err := h.decoder.DecodeRaw(req.OldObject, oldAppDef); err != nil {
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to decode old object: %w", err))
+	}
+
+	newAppDef := &appskubermaticv1.ApplicationDefinition{}
+	if err := h.decoder.Decode(req, newAppDef); err != nil {
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to decode request: %w", err))
+	}
+
+	if oldAppDef.Labels[catalogv1alpha1.LabelManagedByApplicationCatalog] != "true" {
+		return admission.Allowed("ApplicationDefinition is not managed by an ApplicationCatalog")
+	}
+
+	changed := changedCatalogOwnedFields(&oldAppDef.Spec, &newAppDef.Spec)
+	if len(changed) == 0 {
+		return admission.Allowed("no catalog-owned fields changed")
+	}
+
+	if newAppDef.Annotations[catalogv1alpha1.AnnotationBreakGlass] == "true" {
+		log.Infow("Allowing changes to catalog-owned fields because of break-glass annotation", "fields", changed)
+		return admission.Allowed("break-glass annotation is set").WithWarnings(
+			fmt.Sprintf("ApplicationDefinition %q is no longer reconciled by catalog %q until the %s annotation is removed",
+				newAppDef.Name, oldAppDef.Labels[catalogv1alpha1.LabelApplicationCatalogName], catalogv1alpha1.AnnotationBreakGlass),
+		)
+	}
+
+	log.Debugw("Denying changes to catalog-owned fields", "fields", changed)
+
+	return admission.Denied(fmt.Sprintf(
+		"ApplicationDefinition %q is managed by ApplicationCatalog %q and the following fields can only be changed there: %s. "+
+			"Set the annotation %s=true to take over the ApplicationDefinition manually",
+		newAppDef.Name,
+		oldAppDef.Labels[catalogv1alpha1.LabelApplicationCatalogName],
+		strings.Join(changed, ", "),
+		catalogv1alpha1.AnnotationBreakGlass,
+	))
+}
+
+// changedCatalogOwnedFields returns the paths of all catalog-owned fields that differ
+// between the two specs.
+func changedCatalogOwnedFields(oldSpec, newSpec *appskubermaticv1.ApplicationDefinitionSpec) []string {
+	var changed []string
+
+	for _, field := range catalogOwnedFields {
+		if !equality.Semantic.DeepEqual(field.get(oldSpec), field.get(newSpec)) {
+			changed = append(changed, field.path)
+		}
+	}
+
+	return changed
+}
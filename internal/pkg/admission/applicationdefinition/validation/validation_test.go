@@ -0,0 +1,217 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const testManagerUser = "system:serviceaccount:kubermatic:application-catalog-manager"
+
+func newManagedAppDef() *appskubermaticv1.ApplicationDefinition {
+	return &appskubermaticv1.ApplicationDefinition{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: appskubermaticv1.SchemeGroupVersion.String(),
+			Kind:       "ApplicationDefinition",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nginx",
+			Labels: map[string]string{
+				catalogv1alpha1.LabelManagedByApplicationCatalog: "true",
+				catalogv1alpha1.LabelApplicationCatalogName:      "my-catalog",
+			},
+		},
+		Spec: appskubermaticv1.ApplicationDefinitionSpec{
+			Description: "NGINX ingress controller",
+			Method:      appskubermaticv1.HelmTemplateMethod,
+			Versions: []appskubermaticv1.ApplicationVersion{
+				{
+					Version: "v1.0.0",
+					Template: appskubermaticv1.ApplicationTemplate{
+						Source: appskubermaticv1.ApplicationSource{
+							Helm: &appskubermaticv1.HelmSource{
+								URL:          catalogv1alpha1.DefaultHelmRepository,
+								ChartName:    "nginx",
+								ChartVersion: "1.0.0",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newUpdateRequest(t *testing.T, username string, oldObj, newObj *appskubermaticv1.ApplicationDefinition) admission.Request {
+	t.Helper()
+
+	oldRaw, err := json.Marshal(oldObj)
+	if err != nil {
+		t.Fatalf("failed to marshal old object: %v", err)
+	}
+
+	newRaw, err := json.Marshal(newObj)
+	if err != nil {
+		t.Fatalf("failed to marshal new object: %v", err)
+	}
+
+	return admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Name:      newObj.Name,
+			Operation: admissionv1.Update,
+			UserInfo:  authenticationv1.UserInfo{Username: username},
+			Object:    runtime.RawExtension{Raw: newRaw},
+			OldObject: runtime.RawExtension{Raw: oldRaw},
+		},
+	}
+}
+
+func TestHandle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := appskubermaticv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add appskubermaticv1 to scheme: %v", err)
+	}
+
+	handler := NewAdmissionHandler(zap.NewNop().Sugar(), scheme, testManagerUser)
+
+	tests := []struct {
+		name            string
+		username        string
+		modify          func(appDef *appskubermaticv1.ApplicationDefinition)
+		unmanaged       bool
+		expectedAllowed bool
+		expectedMessage string
+		expectWarning   bool
+	}{
+		{
+			name:     "admin-owned fields can be changed",
+			username: "admin",
+			modify: func(appDef *appskubermaticv1.ApplicationDefinition) {
+				appDef.Spec.Enforced = true
+				appDef.Spec.Default = true
+				appDef.Spec.Selector.Datacenters = []string{"dc-1"}
+				appDef.Spec.DefaultVersion = "v1.0.0"
+				appDef.Spec.DefaultValuesBlock = "replicaCount: 2"
+			},
+			expectedAllowed: true,
+		},
+		{
+			name:     "metadata can be changed",
+			username: "admin",
+			modify: func(appDef *appskubermaticv1.ApplicationDefinition) {
+				appDef.Annotations = map[string]string{"example.com/owner": "team-a"}
+			},
+			expectedAllowed: true,
+		},
+		{
+			name:     "catalog-owned fields cannot be changed",
+			username: "admin",
+			modify: func(appDef *appskubermaticv1.ApplicationDefinition) {
+				appDef.Spec.Description = "changed"
+				appDef.Spec.Versions[0].Template.Source.Helm.ChartVersion = "1.0.1"
+			},
+			expectedAllowed: false,
+			expectedMessage: "spec.description, spec.versions",
+		},
+		{
+			name:     "adding a version is denied",
+			username: "admin",
+			modify: func(appDef *appskubermaticv1.ApplicationDefinition) {
+				appDef.Spec.Versions = append(appDef.Spec.Versions, appskubermaticv1.ApplicationVersion{Version: "v2.0.0"})
+			},
+			expectedAllowed: false,
+			expectedMessage: "spec.versions",
+		},
+		{
+			name:     "trusted user can change catalog-owned fields",
+			username: testManagerUser,
+			modify: func(appDef *appskubermaticv1.ApplicationDefinition) {
+				appDef.Spec.Description = "changed"
+			},
+			expectedAllowed: true,
+		},
+		{
+			name:     "break-glass annotation allows changes",
+			username: "admin",
+			modify: func(appDef *appskubermaticv1.ApplicationDefinition) {
+				appDef.Annotations = map[string]string{catalogv1alpha1.AnnotationBreakGlass: "true"}
+				appDef.Spec.Description = "changed"
+			},
+			expectedAllowed: true,
+			expectWarning:   true,
+		},
+		{
+			name:     "break-glass annotation must be true",
+			username: "admin",
+			modify: func(appDef *appskubermaticv1.ApplicationDefinition) {
+				appDef.Annotations = map[string]string{catalogv1alpha1.AnnotationBreakGlass: "yes"}
+				appDef.Spec.Description = "changed"
+			},
+			expectedAllowed: false,
+			expectedMessage: "spec.description",
+		},
+		{
+			name:      "unmanaged ApplicationDefinitions are not validated",
+			username:  "admin",
+			unmanaged: true,
+			modify: func(appDef *appskubermaticv1.ApplicationDefinition) {
+				appDef.Spec.Description = "changed"
+			},
+			expectedAllowed: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			oldAppDef := newManagedAppDef()
+			if tc.unmanaged {
+				oldAppDef.Labels = nil
+			}
+
+			newAppDef := oldAppDef.DeepCopy()
+			tc.modify(newAppDef)
+
+			resp := handler.Handle(context.Background(), newUpdateRequest(t, tc.username, oldAppDef, newAppDef))
+
+			if resp.Allowed != tc.expectedAllowed {
+				t.Fatalf("expected allowed=%v, got %v: %s", tc.expectedAllowed, resp.Allowed, resp.Result.Message)
+			}
+
+			if tc.expectedMessage != "" && !strings.Contains(resp.Result.Message, tc.expectedMessage) {
+				t.Errorf("expected message to contain %q, got %q", tc.expectedMessage, resp.Result.Message)
+			}
+
+			if tc.expectWarning != (len(resp.Warnings) > 0) {
+				t.Errorf("expected warnings=%v, got %v", tc.expectWarning, resp.Warnings)
+			}
+		})
+	}
+}
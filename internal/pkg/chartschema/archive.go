@@ -0,0 +1,415 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chartschema
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+
+	"k8c.io/application-catalog-manager/internal/pkg/source"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ChartLayerMediaType is the media type of the layer holding the archive of a Helm chart
+	// stored in an OCI registry.
+	ChartLayerMediaType = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+
+	// maxIndexSize is the maximum size of the index.yaml of a Helm repository.
+	maxIndexSize = 64 * 1024 * 1024
+
+	// maxArchiveSize is the maximum size of a chart archive.
+	maxArchiveSize = 20 * 1024 * 1024
+
+	// maxFileSize is the maximum size of the values.yaml and values.schema.json of a chart.
+	maxFileSize = 5 * 1024 * 1024
+
+	// maxCachedArchives is the number of archives kept in the cache.
+	maxCachedArchives = 256
+
+	// backgroundFetchTimeout limits the time of the fetches started by FetchCached.
+	backgroundFetchTimeout = 2 * time.Minute
+)
+
+// ErrNotCached is returned by FetchCached if the archive of the chart is not cached yet.
+var ErrNotCached = errors.New("chart archive is not cached yet, it is fetched in the background")
+
+// Chart identifies a chart archive in a Helm repository.
+type Chart struct {
+	// RepositoryURL is the URL of the repository with the http, https or oci scheme.
+	RepositoryURL string
+	Name          string
+	Version       string
+
+	PlainHTTP             bool
+	InsecureSkipTLSVerify bool
+	Credentials           *source.Credentials
+}
+
+func (c *Chart) String() string {
+	return fmt.Sprintf("%s/%s:%s", strings.TrimSuffix(c.RepositoryURL, "/"), c.Name, c.Version)
+}
+
+// Archive holds the files of a chart archive needed to validate values.
+type Archive struct {
+	// Values is the content of the values.yaml of the chart.
+	Values []byte
+
+	// Schema is the content of the values.schema.json of the chart, or nil if the chart
+	// has no schema.
+	Schema []byte
+}
+
+// Fetcher downloads chart archives from HTTP and OCI repositories. Chart versions are
+// immutable, so fetched archives are cached.
+type Fetcher struct {
+	// Client is used for HTTP repositories. If nil, a client honoring the
+	// InsecureSkipTLSVerify setting of the chart is created.
+	Client *http.Client
+
+	lock  sync.Mutex
+	cache map[string]*Archive
+
+	// pending holds the charts fetched in the background, failed the errors of failed
+	// background fetches which were not returned yet.
+	pending map[string]bool
+	failed  map[string]error
+
+	// newTarget replaces the remote OCI repositories, it is used in tests.
+	newTarget func(chart *Chart) (oras.ReadOnlyTarget, error)
+}
+
+// NewFetcher returns a new Fetcher.
+func NewFetcher() *Fetcher {
+	return &Fetcher{
+		cache:   map[string]*Archive{},
+		pending: map[string]bool{},
+		failed:  map[string]error{},
+	}
+}
+
+// FetchCached returns the cached archive of the chart without downloading it. If the archive
+// is not cached, it is fetched in the background and ErrNotCached is returned, so that callers
+// with a deadline, like admission webhooks, never wait for a repository. The error of a failed
+// background fetch is returned once, the next call starts a new fetch.
+func (f *Fetcher) FetchCached(chart *Chart) (*Archive, error) {
+	key := chart.String()
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if archive, ok := f.cache[key]; ok {
+		return archive, nil
+	}
+
+	if f.pending[key] {
+		return nil, ErrNotCached
+	}
+
+	if f.pending == nil {
+		f.pending = map[string]bool{}
+	}
+	f.pending[key] = true
+
+	target := *chart
+	go f.fetchInBackground(key, &target)
+
+	if err, ok := f.failed[key]; ok {
+		delete(f.failed, key)
+		return nil, err
+	}
+
+	return nil, ErrNotCached
+}
+
+// fetchInBackground fetches the chart into the cache and records the error if it fails.
+func (f *Fetcher) fetchInBackground(key string, chart *Chart) {
+	ctx, cancel := context.WithTimeout(context.Background(), backgroundFetchTimeout)
+	defer cancel()
+
+	_, err := f.Fetch(ctx, chart)
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	delete(f.pending, key)
+
+	if err != nil {
+		if f.failed == nil || len(f.failed) >= maxCachedArchives {
+			f.failed = map[string]error{}
+		}
+		f.failed[key] = err
+	}
+}
+
+// Fetch returns the values and the schema of the chart.
+func (f *Fetcher) Fetch(ctx context.Context, chart *Chart) (*Archive, error) {
+	key := chart.String()
+
+	f.lock.Lock()
+	archive, ok := f.cache[key]
+	f.lock.Unlock()
+
+	if ok {
+		return archive, nil
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+
+	if strings.HasPrefix(chart.RepositoryURL, "oci://") {
+		data, err = f.fetchOCI(ctx, chart)
+	} else {
+		data, err = f.fetchHTTP(ctx, chart)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch chart %s: %w", key, err)
+	}
+
+	archive, err = readArchive(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chart %s: %w", key, err)
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.cache == nil || len(f.cache) >= maxCachedArchives {
+		f.cache = map[string]*Archive{}
+	}
+	f.cache[key] = archive
+
+	return archive, nil
+}
+
+// repositoryIndex is the subset of the index.yaml of a Helm repository needed to find
+// the archive of a chart version.
+type repositoryIndex struct {
+	Entries map[string][]struct {
+		Version string   `json:"version"`
+		URLs    []string `json:"urls"`
+	} `json:"entries"`
+}
+
+// fetchHTTP looks up the chart version in the index of the repository and downloads its
+// archive. Like Helm, credentials are only sent to the host of the repository.
+func (f *Fetcher) fetchHTTP(ctx context.Context, chart *Chart) ([]byte, error) {
+	base, err := url.Parse(strings.TrimSuffix(chart.RepositoryURL, "/") + "/")
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository URL: %w", err)
+	}
+
+	data, err := f.get(ctx, chart, base.JoinPath("index.yaml"), maxIndexSize)
+	if err != nil {
+		return nil, err
+	}
+
+	index := repositoryIndex{}
+	if err := yaml.Unmarshal(data, &index); err != nil {
+		return nil, fmt.Errorf("failed to parse repository index: %w", err)
+	}
+
+	var archiveURL string
+	for _, entry := range index.Entries[chart.Name] {
+		if sameVersion(entry.Version, chart.Version) && len(entry.URLs) > 0 {
+			archiveURL = entry.URLs[0]
+			break
+		}
+	}
+
+	if archiveURL == "" {
+		return nil, errors.New("version not found in repository index")
+	}
+
+	ref, err := url.Parse(archiveURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid chart URL %q: %w", archiveURL, err)
+	}
+
+	return f.get(ctx, chart, base.ResolveReference(ref), maxArchiveSize)
+}
+
+// get downloads the given URL.
+func (f *Fetcher) get(ctx context.Context, chart *Chart, u *url.URL, limit int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	if creds := chart.Credentials; creds != nil && (creds.Username != "" || creds.Password != "") {
+		if repo, err := url.Parse(chart.RepositoryURL); err == nil && repo.Host == u.Host {
+			req.SetBasicAuth(creds.Username, creds.Password)
+		}
+	}
+
+	resp, err := f.client(chart).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", u, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %s: unexpected status %s", u, resp.Status)
+	}
+
+	return source.ReadLimited(resp.Body, limit)
+}
+
+func (f *Fetcher) client(chart *Chart) *http.Client {
+	if f.Client != nil {
+		return f.Client
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if chart.InsecureSkipTLSVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	return &http.Client{Transport: transport}
+}
+
+// fetchOCI downloads the chart layer of the chart version from the OCI repository. Like
+// Helm, the chart is stored in the repository named after the chart, with "+" in the
+// version replaced by "_" in the tag.
+func (f *Fetcher) fetchOCI(ctx context.Context, chart *Chart) ([]byte, error) {
+	newTarget := f.newTarget
+	if newTarget == nil {
+		newTarget = remoteTarget
+	}
+
+	target, err := newTarget(chart)
+	if err != nil {
+		return nil, err
+	}
+
+	tag := strings.ReplaceAll(chart.Version, "+", "_")
+
+	desc, err := target.Resolve(ctx, tag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s: %w", tag, err)
+	}
+
+	if desc.MediaType != ocispec.MediaTypeImageManifest {
+		return nil, fmt.Errorf("unsupported manifest media type %q", desc.MediaType)
+	}
+
+	if desc.Size > source.MaxManifestSize {
+		return nil, fmt.Errorf("manifest exceeds the maximum size of %d bytes", source.MaxManifestSize)
+	}
+
+	data, err := content.FetchAll(ctx, target, desc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch manifest: %w", err)
+	}
+
+	manifest := ocispec.Manifest{}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != ChartLayerMediaType {
+			continue
+		}
+
+		if layer.Size > maxArchiveSize {
+			return nil, fmt.Errorf("chart exceeds the maximum size of %d bytes", maxArchiveSize)
+		}
+
+		return content.FetchAll(ctx, target, layer)
+	}
+
+	return nil, fmt.Errorf("manifest contains no layer of media type %q", ChartLayerMediaType)
+}
+
+func remoteTarget(chart *Chart) (oras.ReadOnlyTarget, error) {
+	return source.NewRepository(
+		strings.TrimSuffix(chart.RepositoryURL, "/")+"/"+chart.Name,
+		chart.PlainHTTP,
+		chart.InsecureSkipTLSVerify,
+		chart.Credentials,
+	)
+}
+
+// readArchive reads the values.yaml and values.schema.json of the chart from the archive.
+// Files of subcharts are ignored.
+func readArchive(data []byte) (*Archive, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	archive := &Archive{}
+	tr := tar.NewReader(gz)
+
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return archive, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		_, name, ok := strings.Cut(path.Clean(header.Name), "/")
+		if !ok {
+			continue
+		}
+
+		switch name {
+		case "values.yaml":
+			archive.Values, err = source.ReadLimited(tr, maxFileSize)
+		case "values.schema.json":
+			archive.Schema, err = source.ReadLimited(tr, maxFileSize)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", header.Name, err)
+		}
+	}
+}
+
+// sameVersion compares chart versions, ignoring a "v" prefix like Helm does when
+// looking up versions.
+func sameVersion(a, b string) bool {
+	return strings.TrimPrefix(a, "v") == strings.TrimPrefix(b, "v")
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chartschema
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/memory"
+
+	"k8c.io/application-catalog-manager/internal/pkg/source"
+)
+
+const (
+	testChartValues = "replicaCount: 1\n"
+	testChartSchema = `{"type": "object"}`
+)
+
+// newChartArchive returns a chart archive with the given files, which are placed in the
+// directory of the chart.
+func newChartArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+
+	for name, data := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: "nginx/" + name, Mode: 0o644, Size: int64(len(data)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatalf("failed to write header: %v", err)
+		}
+		if _, err := tw.Write([]byte(data)); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		t.Fatalf("failed to close tar writer: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("failed to close gzip writer: %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+// newRepositoryServer serves a Helm repository with version 1.0.0 of the nginx chart.
+// Requests to the repository require basic auth if a username is given.
+func newRepositoryServer(t *testing.T, username string, requests *int) *httptest.Server {
+	t.Helper()
+
+	archive := newChartArchive(t, map[string]string{
+		"Chart.yaml":                           "name: nginx\nversion: 1.0.0\n",
+		"values.yaml":                          testChartValues,
+		"values.schema.json":                   testChartSchema,
+		"charts/common/values.schema.json":     `{"type": "string"}`,
+		"charts/common/templates/_helpers.tpl": "",
+	})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+
+		if user, _, _ := r.BasicAuth(); user != username {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/charts/index.yaml":
+			_, _ = w.Write([]byte("apiVersion: v1\nentries:\n  nginx:\n    - version: 1.0.0\n      urls:\n        - nginx-1.0.0.tgz\n"))
+		case "/charts/nginx-1.0.0.tgz":
+			_, _ = w.Write(archive)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestFetcherHTTP(t *testing.T) {
+	tests := []struct {
+		name        string
+		chart       func(url string) *Chart
+		username    string
+		expectError bool
+	}{
+		{
+			name: "chart version",
+			chart: func(url string) *Chart {
+				return &Chart{RepositoryURL: url + "/charts", Name: "nginx", Version: "1.0.0"}
+			},
+		},
+		{
+			name: "version with v prefix",
+			chart: func(url string) *Chart {
+				return &Chart{RepositoryURL: url + "/charts/", Name: "nginx", Version: "v1.0.0"}
+			},
+		},
+		{
+			name: "credentials",
+			chart: func(url string) *Chart {
+				return &Chart{RepositoryURL: url + "/charts", Name: "nginx", Version: "1.0.0", Credentials: &source.Credentials{Username: "user", Password: "pass"}}
+			},
+			username: "user",
+		},
+		{
+			name: "missing version",
+			chart: func(url string) *Chart {
+				return &Chart{RepositoryURL: url + "/charts", Name: "nginx", Version: "2.0.0"}
+			},
+			expectError: true,
+		},
+		{
+			name: "missing repository",
+			chart: func(url string) *Chart {
+				return &Chart{RepositoryURL: url + "/missing", Name: "nginx", Version: "1.0.0"}
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := 0
+			server := newRepositoryServer(t, tc.username, &requests)
+			fetcher := NewFetcher()
+
+			archive, err := fetcher.Fetch(context.Background(), tc.chart(server.URL))
+			if tc.expectError {
+				if err == nil {
+					t.Fatal("expected error but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if string(archive.Values) != testChartValues || string(archive.Schema) != testChartSchema {
+				t.Errorf("unexpected archive values %q and schema %q", archive.Values, archive.Schema)
+			}
+
+			// Chart versions are immutable, so the archive is only fetched once.
+			if _, err := fetcher.Fetch(context.Background(), tc.chart(server.URL)); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if requests != 2 {
+				t.Errorf("expected the archive to be cached, got %d requests", requests)
+			}
+		})
+	}
+}
+
+func TestFetcherFetchCached(t *testing.T) {
+	requests := 0
+	server := newRepositoryServer(t, "", &requests)
+	fetcher := NewFetcher()
+
+	// waitForFetch calls FetchCached until the background fetch of the chart finished.
+	waitForFetch := func(chart *Chart) (*Archive, error) {
+		deadline := time.Now().Add(10 * time.Second)
+		for {
+			archive, err := fetcher.FetchCached(chart)
+			if !errors.Is(err, ErrNotCached) || time.Now().After(deadline) {
+				return archive, err
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	chart := &Chart{RepositoryURL: server.URL + "/charts", Name: "nginx", Version: "1.0.0"}
+	if _, err := fetcher.FetchCached(chart); !errors.Is(err, ErrNotCached) {
+		t.Fatalf("expected the archive not to be cached, got %v", err)
+	}
+
+	archive, err := waitForFetch(chart)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(archive.Values) != testChartValues || string(archive.Schema) != testChartSchema {
+		t.Errorf("unexpected archive values %q and schema %q", archive.Values, archive.Schema)
+	}
+
+	missing := &Chart{RepositoryURL: server.URL + "/charts", Name: "nginx", Version: "2.0.0"}
+	if _, err := waitForFetch(missing); err == nil || errors.Is(err, ErrNotCached) {
+		t.Fatalf("expected the error of the background fetch, got %v", err)
+	}
+}
+
+func TestFetcherOCI(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+
+	push := func(mediaType string, data []byte) ocispec.Descriptor {
+		desc := content.NewDescriptorFromBytes(mediaType, data)
+		if err := store.Push(ctx, desc, bytes.NewReader(data)); err != nil {
+			t.Fatalf("failed to push blob: %v", err)
+		}
+		return desc
+	}
+
+	archive := newChartArchive(t, map[string]string{"values.yaml": testChartValues})
+	layers := []ocispec.Descriptor{push(ChartLayerMediaType, archive)}
+
+	manifest, err := oras.PackManifest(ctx, store, oras.PackManifestVersion1_1, "application/vnd.cncf.helm.config.v1+json", oras.PackManifestOptions{Layers: layers})
+	if err != nil {
+		t.Fatalf("failed to pack manifest: %v", err)
+	}
+	if err := store.Tag(ctx, manifest, "1.0.0_build.1"); err != nil {
+		t.Fatalf("failed to tag manifest: %v", err)
+	}
+
+	var repository string
+	fetcher := &Fetcher{newTarget: func(chart *Chart) (oras.ReadOnlyTarget, error) {
+		repository = chart.RepositoryURL + "/" + chart.Name
+		return store, nil
+	}}
+
+	result, err := fetcher.Fetch(ctx, &Chart{RepositoryURL: "oci://registry.example.com/charts", Name: "nginx", Version: "1.0.0+build.1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if repository != "oci://registry.example.com/charts/nginx" {
+		t.Errorf("expected chart to be fetched from the repository named after the chart, got %q", repository)
+	}
+
+	if string(result.Values) != testChartValues || result.Schema != nil {
+		t.Errorf("unexpected archive values %q and schema %q", result.Values, result.Schema)
+	}
+
+	if _, err := fetcher.Fetch(ctx, &Chart{RepositoryURL: "oci://registry.example.com/charts", Name: "nginx", Version: "2.0.0"}); err == nil {
+		t.Error("expected error for missing version")
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chartschema
+
+import (
+	"context"
+	"fmt"
+
+	"k8c.io/application-catalog-manager/internal/pkg/source"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Resolver resolves the values schemas of the charts of a catalog. ConfigMaps and
+// credential Secrets are read from the namespace of the application catalog manager.
+// Chart archives are never downloaded while resolving, they are fetched in the background
+// and only their cached schemas are used.
+type Resolver struct {
+	client    ctrlruntimeclient.Reader
+	namespace string
+	fetcher   *Fetcher
+}
+
+// NewResolver returns a new Resolver.
+func NewResolver(client ctrlruntimeclient.Reader, namespace string, fetcher *Fetcher) *Resolver {
+	return &Resolver{
+		client:    client,
+		namespace: namespace,
+		fetcher:   fetcher,
+	}
+}
+
+// Resolve returns the values schema of the given version of the chart and, if the schema
+// is read from the chart archive, the default values of the chart. Returns a nil schema if
+// none is configured, the referenced optional ConfigMap key does not exist or the chart
+// has no schema.
+func (r *Resolver) Resolve(
+	ctx context.Context,
+	catalog *catalogv1alpha1.ApplicationCatalog,
+	chart *catalogv1alpha1.ChartConfig,
+	version *catalogv1alpha1.ChartVersion,
+) (*Schema, []byte, error) {
+	valuesSchema := chart.ResolveValuesSchema(version)
+
+	switch {
+	case valuesSchema == nil:
+		return nil, nil, nil
+
+	case valuesSchema.Inline != "":
+		schema, err := Compile([]byte(valuesSchema.Inline))
+		return schema, nil, err
+
+	case valuesSchema.ConfigMapKeyRef != nil:
+		data, err := r.getConfigMapKey(ctx, valuesSchema.ConfigMapKeyRef)
+		if err != nil || data == nil {
+			return nil, nil, err
+		}
+
+		schema, err := Compile(data)
+		return schema, nil, err
+
+	case valuesSchema.FromChart:
+		archive, err := r.fetchChart(ctx, catalog, chart, version)
+		if err != nil || archive.Schema == nil {
+			return nil, nil, err
+		}
+
+		schema, err := Compile(archive.Schema)
+		return schema, archive.Values, err
+	}
+
+	return nil, nil, nil
+}
+
+// getConfigMapKey returns the content of the ConfigMap key, or nil if it is optional and
+// does not exist.
+func (r *Resolver) getConfigMapKey(ctx context.Context, selector *corev1.ConfigMapKeySelector) ([]byte, error) {
+	optional := selector.Optional != nil && *selector.Optional
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: r.namespace, Name: selector.Name}, configMap); err != nil {
+		if apierrors.IsNotFound(err) && optional {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get ConfigMap %s/%s: %w", r.namespace, selector.Name, err)
+	}
+
+	if data, ok := configMap.Data[selector.Key]; ok {
+		return []byte(data), nil
+	}
+
+	if data, ok := configMap.BinaryData[selector.Key]; ok {
+		return data, nil
+	}
+
+	if optional {
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf("key %q does not exist in ConfigMap %s/%s", selector.Key, r.namespace, selector.Name)
+}
+
+// fetchChart returns the cached chart archive of the repository the version is installed
+// from. ErrNotCached is returned if the archive is still being fetched.
+func (r *Resolver) fetchChart(
+	ctx context.Context,
+	catalog *catalogv1alpha1.ApplicationCatalog,
+	chart *catalogv1alpha1.ChartConfig,
+	version *catalogv1alpha1.ChartVersion,
+) (*Archive, error) {
+	target := &Chart{
+		RepositoryURL: catalog.ResolveChartURL(chart, version),
+		Name:          chart.ChartName,
+		Version:       version.ChartVersion,
+	}
+
+	if settings := catalog.ResolveChartRepositorySettings(chart, version); settings != nil {
+		target.PlainHTTP = settings.PlainHTTP
+		target.InsecureSkipTLSVerify = settings.InsecureSkipTLSVerify
+
+		creds, err := source.ResolveCredentials(ctx, r.client, r.namespace, settings.Credentials)
+		if err != nil {
+			return nil, err
+		}
+		target.Credentials = creds
+	}
+
+	return r.fetcher.FetchCached(target)
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package chartschema validates Helm values against the JSON schema of a chart, like Helm
+// does with the values.schema.json of a chart during installation, and fetches the schema
+// and the default values from chart archives.
+package chartschema
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/santhosh-tekuri/jsonschema/v6/kind"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+
+	"k8c.io/application-catalog-manager/internal/pkg/values"
+
+	"sigs.k8s.io/yaml"
+)
+
+// schemaURL is the location the schema is registered at. Schemas are compiled in memory,
+// references to other files or URLs are not resolved.
+const schemaURL = "values.schema.json"
+
+var printer = message.NewPrinter(language.English)
+
+// Schema is a compiled values schema.
+type Schema struct {
+	schema *jsonschema.Schema
+}
+
+// Violation is a value that does not match the schema.
+type Violation struct {
+	// Path is the JSON path of the value, e.g. "$.controller.replicaCount".
+	Path string
+
+	// Message describes the violation.
+	Message string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s", v.Path, v.Message)
+}
+
+// Compile compiles the given JSON schema, which may be written in JSON or YAML.
+func Compile(data []byte) (*Schema, error) {
+	doc, err := unmarshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse schema: %w", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(schemaURL, doc); err != nil {
+		return nil, fmt.Errorf("failed to load schema: %w", err)
+	}
+
+	schema, err := compiler.Compile(schemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile schema: %w", err)
+	}
+
+	return &Schema{schema: schema}, nil
+}
+
+// Validate validates the values block against the schema and returns the violations
+// sorted by path. The chartValues are the default values of the chart, which Helm merges
+// with the values of an installation before validating them. If they are not known,
+// missing required properties are not reported, since the chart may provide them.
+func (s *Schema) Validate(chartValues []byte, block string) ([]Violation, error) {
+	merged := block
+	if len(chartValues) > 0 {
+		var err error
+		if merged, err = values.Merge(string(chartValues), block); err != nil {
+			return nil, err
+		}
+	}
+
+	doc, err := unmarshal([]byte(merged))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse values: %w", err)
+	}
+
+	if doc == nil {
+		doc = map[string]any{}
+	}
+
+	var validationErr *jsonschema.ValidationError
+	if err := s.schema.Validate(doc); !errors.As(err, &validationErr) {
+		return nil, err
+	}
+
+	var violations []Violation
+	collectViolations(validationErr, doc, len(chartValues) > 0, &violations)
+
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Path < violations[j].Path
+	})
+
+	return violations, nil
+}
+
+// collectViolations adds the leaf errors of the validation error tree to the violations.
+func collectViolations(err *jsonschema.ValidationError, doc any, reportRequired bool, violations *[]Violation) {
+	if len(err.Causes) > 0 {
+		for _, cause := range err.Causes {
+			collectViolations(cause, doc, reportRequired, violations)
+		}
+		return
+	}
+
+	if _, ok := err.ErrorKind.(*kind.Required); ok && !reportRequired {
+		return
+	}
+
+	*violations = append(*violations, Violation{
+		Path:    formatPath(doc, err.InstanceLocation),
+		Message: err.ErrorKind.LocalizedString(printer),
+	})
+}
+
+// formatPath formats the JSON pointer tokens of a value as JSON path. The document is
+// needed to distinguish array indices from numeric keys.
+func formatPath(doc any, tokens []string) string {
+	var sb strings.Builder
+	sb.WriteString("$")
+
+	current := doc
+	for _, token := range tokens {
+		switch v := current.(type) {
+		case []any:
+			sb.WriteString("[" + token + "]")
+			if i, err := strconv.Atoi(token); err == nil && i < len(v) {
+				current = v[i]
+			} else {
+				current = nil
+			}
+			continue
+
+		case map[string]any:
+			current = v[token]
+
+		default:
+			current = nil
+		}
+
+		if isIdentifier(token) {
+			sb.WriteString("." + token)
+		} else {
+			sb.WriteString("['" + strings.ReplaceAll(token, "'", "\\'") + "']")
+		}
+	}
+
+	return sb.String()
+}
+
+// isIdentifier returns true if the key can be written in dot notation.
+func isIdentifier(key string) bool {
+	if key == "" {
+		return false
+	}
+
+	for i, r := range key {
+		switch {
+		case r == '_' || r == '-' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z'):
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// unmarshal parses a JSON or YAML document. Numbers are kept as json.Number, as expected
+// by the schema validator. Returns nil for empty documents.
+func unmarshal(data []byte) (any, error) {
+	data, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonschema.UnmarshalJSON(bytes.NewReader(data))
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chartschema
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testSchema = `{
+  "$schema": "https://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "properties": {
+    "replicaCount": {"type": "integer", "minimum": 1},
+    "image": {
+      "type": "object",
+      "required": ["repository"],
+      "properties": {
+        "repository": {"type": "string"},
+        "tag": {"type": "string"}
+      }
+    },
+    "tolerations": {
+      "type": "array",
+      "items": {"type": "object", "properties": {"key": {"type": "string"}}}
+    },
+    "podLabels": {
+      "type": "object",
+      "additionalProperties": {"type": "string"}
+    }
+  },
+  "additionalProperties": false
+}`
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		name        string
+		schema      string
+		expectError bool
+	}{
+		{
+			name:   "JSON schema",
+			schema: testSchema,
+		},
+		{
+			name:   "YAML schema",
+			schema: "type: object\nproperties:\n  replicaCount:\n    type: integer\n",
+		},
+		{
+			name:        "invalid document",
+			schema:      "{",
+			expectError: true,
+		},
+		{
+			name:        "invalid schema",
+			schema:      `{"type": "number", "minimum": "one"}`,
+			expectError: true,
+		},
+		{
+			name:        "unresolvable reference",
+			schema:      `{"$ref": "https://example.com/schema.json"}`,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Compile([]byte(tc.schema))
+			if tc.expectError != (err != nil) {
+				t.Errorf("expected error: %v, got: %v", tc.expectError, err)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	schema, err := Compile([]byte(testSchema))
+	if err != nil {
+		t.Fatalf("failed to compile schema: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		chartValues string
+		block       string
+		expected    []string
+	}{
+		{
+			name: "empty values",
+		},
+		{
+			name:  "valid values",
+			block: "# comment\nreplicaCount: 2\nimage:\n  repository: nginx\n  tag: \"1.27\"\n",
+		},
+		{
+			name:  "type errors are reported with their path",
+			block: "replicaCount: two\nimage:\n  tag: 1.27\ntolerations:\n  - key: 1\npodLabels:\n  team.example.com/owner: 1\n",
+			expected: []string{
+				"$.image.tag: got number, want string",
+				"$.podLabels['team.example.com/owner']: got number, want string",
+				"$.replicaCount: got string, want integer",
+				"$.tolerations[0].key: got number, want string",
+			},
+		},
+		{
+			name:     "constraint and additional properties errors",
+			block:    "replicaCount: 0\nreplicas: 2\n",
+			expected: []string{"$: additional properties 'replicas' not allowed", "$.replicaCount: minimum: got 0, want 1"},
+		},
+		{
+			name:  "missing required properties are ignored without chart values",
+			block: "image:\n  tag: \"1.27\"\n",
+		},
+		{
+			name:        "missing required properties are reported with chart values",
+			chartValues: "replicaCount: 1\nimage: {}\n",
+			block:       "image:\n  tag: \"1.27\"\n",
+			expected:    []string{"$.image: missing property 'repository'"},
+		},
+		{
+			name:        "values are merged with chart values",
+			chartValues: "replicaCount: 1\nimage:\n  repository: nginx\n",
+			block:       "image:\n  tag: \"1.27\"\n",
+		},
+		{
+			name:        "chart values can be removed",
+			chartValues: "replicaCount: 1\nimage:\n  repository: nginx\n",
+			block:       "image:\n  repository: null\n",
+			expected:    []string{"$.image: missing property 'repository'"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			violations, err := schema.Validate([]byte(tc.chartValues), tc.block)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var messages []string
+			for _, violation := range violations {
+				messages = append(messages, violation.String())
+			}
+
+			if !reflect.DeepEqual(messages, tc.expected) {
+				t.Errorf("expected violations %q, got %q", tc.expected, messages)
+			}
+		})
+	}
+}
+
+func TestValidateInvalidValues(t *testing.T) {
+	schema, err := Compile([]byte(testSchema))
+	if err != nil {
+		t.Fatalf("failed to compile schema: %v", err)
+	}
+
+	if _, err := schema.Validate(nil, "replicaCount: [1"); err == nil {
+		t.Error("expected error for invalid values")
+	}
+}
@@ -18,8 +18,13 @@ package defaulting
 import (
 	"sort"
 	"strings"
+	"time"
 
 	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 // DefaultApplicationCatalog applies default values to an ApplicationCatalog.
@@ -36,14 +41,14 @@ func DefaultApplicationCatalog(catalog *catalogv1alpha1.ApplicationCatalog) {
 
 	charts := GetDefaultCharts()
 
-	includeAnnotation := catalog.Annotations["defaultcatalog.k8c.io/include"]
+	includeAnnotation := catalog.Annotations[catalogv1alpha1.AnnotationIncludeDefaults]
 	if includeAnnotation != "" {
 		charts = filterDefaultsByName(charts, parseIncludeList(includeAnnotation))
 	}
 
 	sortCharts(charts)
 
-	catalog.Spec.Helm.Charts = mergeCharts(catalog.Spec.Helm.Charts, charts)
+	catalog.Spec.Helm.Charts = MergeCharts(catalog.Spec.Helm.Charts, charts)
 }
 
 // GetDefaultCharts returns the default set of Helm charts that are
@@ -58,8 +63,17 @@ func GetDefaultCharts() []catalogv1alpha1.ChartConfig {
 				Description:      "cert-manager is a Kubernetes addon to automate the management and issuance of TLS certificates from various issuing sources.",
 				DocumentationURL: "https://cert-manager.io/",
 				SourceURL:        "https://github.com/cert-manager/cert-manager",
-				Logo:             "iVBORw0KGgoAAAANSUhEUgAAADIAAAAyCAYAAAAeP4ixAAAAAXNSR0IArs4c6QAAAARnQU1BAACxjwv8YQUAAAAJcEhZcwAADsMAAA7DAcdvqGQAABAdSURBVGhDrVkHeFRVFj7vvenJTCaFEAJIbyYhSLUFlCIgFoqggiAQRZZPEdu3a91dFde260JcUVBwLaCorJ8NAQVREaQFCAQUEKkJpJAyydQ3s+e/815mJoVNwv7fN8l99725c8+95/znP/cp9H9CpxH/sHUbtzzJ2eNGs2K0BWqKdga1WzFoN+Qhc6fhLzk75PzFbrAmqlXHNwW0WxcFSfvfKvSZuslsimt3t6QY7pQUcx9Jkq0UCoZCFPKGVH8ZUahENlir8Gww4Hbw/VRJNjr50kySbAiFVG8oGDhIqn9l5cnNecfWzvHi2dag1YZkTN+SaYxv956smLO1rotCSPUd9LtLZ+x/e9BOratFaJUhfXP3jVPMjvd5dRO0rhgoMpHVLJEzTqY4i0wS/0qNJ0QVLlX8D4a0B+uBd8it+lx37Ft26Rqtq9losSFZuXvmGC3Ji9g1LFqXQJc0A12dYaEhvUzUPd1ISXaZDYodHgZUuoL062k/bT3opW2/eOjw6QYhEgx4yhfsezMrT7tuFpptSK/JX5gtid0WKibHg3wpvmc1SXTDECtNuiqOerQ3okvA5QnS2fMqlVYGqbI2HPMJNpmSHDJ1SDaI3dJxoiRAH26uoc+21VKtN7JVqrd6admh1fed+uEpn9Z1QfxPQ5zdb5A6Dn16sGJ2vsrxMBB9cJ2JV8bRXWPjKcWhkMpzLTzho2/z3bT9Vx/9fjZAXn/j/mM0EHVNM1J2VxNd199K/bqZxCTKq4P09oZqWrmphvRvBlVvoc915q7Cd6/eqnU1iQsaknnnz23ZgOcUY9wMdnSeQtiFnrjdSf14Ij6e7Lrdblr1XQ39csovvtNSdG1noFuHxtHNl9vYSIkOHPfRwg8qo8YLqcx4qzzlRx89tHrMKa2zAZo0pO9dBbcoJnseB3Sa1iVW8PHbEkQA7zzspZc+rqTfigJ1K6gDoZHAge4LhERw60jmuCnnGGF+boCObRSxQAN7mMnjC7ExFfTVDrd2l80JquWq5/y9+5Znr9K6YtBoQsyavfePRmvSa5KkOLQuumN4HP1pSgIpikT//LSKXvyoksrYHRrD4nnJ1J9dpvh8OFaA9ikKLbs/hYZnW0SgR8cDUFUboi9+drOLqZSTaaERl3FKYot3HwmHCHKUbLRNSsmcoZzLf32T6IwCe3sssmbnjzXaUv7GXxWuBNwxPJ4emJAgVve+18qEHyMuAAsHfAeeZDTO82Tapxg4fiLD9+5g5MlIYjdt5kh/57YGMrFL6fj4x1q6e1EZVTNJzB3noLvH2LU7YRhtbZ7Myt07VbusQ4whnKlNBkvSIm7WjTzyMgvNv8nORgTpgaXltIODWQeMeH5WIk1jQxPjI0Md42A3M4n17WzSekjcX/JllQhqMJWOXmzgu4+kUMYlEdbbd8xH9yzWjbHT9YOs2p0wDOaEFzoMezbyBUaMIYoxPoeVQw/tUgT2U1OdFODVf3hZOe39LZYJDbwRSXaFFrOr3XtjnRfSextraPpLpfQK9+vASsPnn2Xfj0bfLkb6crubrJbYcEWuWfBGuYizJzl2+nSMzJvjtkNyzwnjtEuBGENYxI3VmoJin2Qj4Aavf1ktaLU+XO4Qrd1ZS2/MT6YdHPw6/PzjuuvVR3F5OGYAZH6rSaZ3N7poZyPj7+GF+/snlWQySvTUNCfvcsRYSTaN0ZoCMYbw7aFagyZyksvuYmIDvPT+JpfW2xCg3hkvl9LXOyMM01xU1ATp6ZUVjbKYDuzkjwc81JMT7tRr47RehqyM0FoCdYZ0GbvUKSsmIQCReXNHx4uVfeU/VU2ubnOAncXnYvAi0zzmMmNEPHtIeFd4rp263/hOqrhg1P2EPf3yQdgvtG8aYqM2CQq7jZu1UOsSHXDbsDha/1warV+YRjNHxWu9LcfpUpU+2VJLDpY5k3P0XZGM1jbZdR5UZ4ikmEZpTbolxyaSHNymtQDd3neTg/72YQX99f0KYchlnFtai5XfudgzQjSFVYAeKbLReo3WDBvSdsC9Cuuo8WjDF6GF8o94BXO0FmC802UB+ibfQ9/v99ApXlXkjNYCuwLqT0tUKLNzmMFk2TSx56RPhQoXhqT1n3cz74ig3cEsw4GNez3if2uBjJyWaKAFExw0/2YHdUo10J6jDZmpJVi3K0woOZnhvCIpxnaWxO4T0RaGcJl6L/4DqCmAXYcv7kfPVqg0N6+URvazCne4//UykSgvBrvYSwB9sQF2L5QVJLPC7SMbLDm4QILryi5R7Q7S8XOt+1Fw/sOTEmjOWDsNYAFYyRQLZHQy0azr4oVeg8ptDYrKVDrPohOFm1FTRbJi6Z8x/cdBssHsnMnXwnnjOPklxitC6KGeQJXX2E9CqWZ2MnI8GURQ92F5kcHXeN7EPzC8n4WGZVnoGv6kJSlCDY/qb6FRLARH8L1L+Pu9OVOjBK4P6LYsjoEe6Tw2P4OMjvHbcmygwjzJ8gYFHRSFBskU1266dNkfftvDriXyB/x4zZOptKXQQ/OXlAsthQEBDBLkxcWuIXCxMvXRsY1BaCqco4D2vLyps5itBvbgIurxs+IZrKSfkzvGgc6S2ZogD47x0VfEmb+0quHYEJaQK8/e6aSxA2007YUSOqTVLFwbH+UYkbqJK4ZN0zuQHgDqgoLf/eJz4LifDp4Mt2EEVhmrB5bDTgBYLQi+/fzMfn4+OgfF89jduIiCKobUCLAxGLPgdx8dOBEZG0Zgp9J5JzE28hkAIwC9vomL0mbsVl3Zd+QmOXFwLzN15x+PRnqyQjdynT6Cg9hulcntC4qdQIU3eoBVTFiHHLZPAC4GhYBJXtHbTDdfYRXxGI02CbJQulDcyVxCu71BauuUacxAKw3uecEcFDKEgv4jbEsmrjxasaPLgO2/eIVrDeVCB5PCLhRz/EDFRssWuMekiTYh8zNYusOHMWF3VPF05EyEPI4Vh9u3cJa+hBcBz+KDnV7PpTN2S8dJdmPsLu4DDl48oJa9RUdQ9R3n3tAG7VqUofBVHCjoOMwTQEL7bp9H5JZCdgPdCBhnYTdBRQdWemZ6Iv18yCue3cTPbuN2NODnBm2XJlxpo0cmOagz78ombex8zjO6EVg0jK1DF5YpmquVR8dRMLBR9lWfeY+b4jEX0y6o95JURQg9O2sbuzUyGAD/BvPMZipF9QY5U1QeEMc+eB4TaAxj2WVmjIynXP7OxKts7D5WDm4pZtEQ7GA7MTbT9zQur1FYQcAiZjAnMCbKZP2YiaceDHirVoif7Tf3yFbZYL0c7WX3c73d3UxTnjsnaHgKCz+F91VMkD8BDrothd46xtAxiSf32G1O2sCusXyDi7qwHEGpi52KZi1MZuq18azD7GIXoABQl2B8vxoS7gyyiDhOGFjAZB7vsz+3FdJpKrMWEAy484t35Q0S0Rb01yzWDfn5F58wBJ+Pfqih5euarkWi8Z+faqk/J8AxHPAggt85oR454xe5IJ79+gGWKljVvl1NYhfAcDjAQOnbHCCvQXQiVn46GHHZkOpbUrxjkSo8VvXXfhRSveLM6PuCsMaC+7QEiK0n/n2eVvBuIHbASDg+QjBjNXGAMZwNTOZEtpulRu4rpc02QgcSLLCFCy2Aiaq4tqTgfbSFIQfeuSLAnvYR2uB+rFb/7ibhj/UB14CrNAYE5KufVXHFWCIqxmjWCrDbwIBHV5yne/LKmjxKQm5qrBCD6gWplFSqIlcBPOfPDn96ay3adV9hX/sW//HTa7iIwQH01GtiiyEE/nMzE2neDZGDhsaARPc4787IR4sFg21jVxj12Fmas7iMNuS7hUJoDCPZC56ZkSjqmPqkMZlJBRrt0621dazJnrQ+3IoypPrUlq1shiD4j3+soQqm4vFMkVgJHRO4jocLIZO/uSC5LqM3BQ/79UPLyulB/lQxy1yoNgfgemAuiELkGB1tnYo4Q8DhOOI2DHasmqId2kXEkGPr5pVzYtmPNugNBw7gfRyR6njnGxf96/MqIROQFFsSqBcCghhxhV3czDEK11n9faQ6RU2DMhen9mVa/gip/sOHPhh9QlwwYpaUfW6L1qR3vnXRr0yxV15qoXGDIwdk8PvnV1cI9wMGcCzNvd7e9CHy/8BDEx0sV2w8jlns2Jtfu+itddXa3bC7CRbkWmbF+giD8ly3aU2BGENUb+XnWlNk2GdXVYjVfJzzA2Q6gCCFuIMP43jmHjZiUE+ehLjL4rBeAm0M7ZiGddF3okQV58QolqCGAT0GIDJxnoUzYMzFHS1LAu4vtKZAjCF+V9FG9rwz2qVQpeB60Ocrc5LEwDrA51DJXs6LhSfDLIJjpNWPpdJVl5rFew8A30W21s2DKLx/vCN8IM6/jnj89XRA3P9N02BAJy4pFs1NFgeEYELIFx28G2W1ZQfXaZcCMfxaVrgqmJqde4qT42StS2RwvJy5il0MsgLlZkllOHDxDgPG4mDgHJe2gzghXpuN129m+naPm/uCgjL/zKuK1xD4HqpG1BzDssJjIc7AZNs5Eetshp15dV6yIJoPOC6Wrq2OyfSqr3rBwZXXxrz8aZAozu5eciC13xyzrJhF+Qvg9AJsgngZw0UNuByrCKCUhRFAFitfBCt2II9XEQx3F2sruGFCnCKkzSkuV+9giXK0yE9rd3piVDQwliX7C7MTycmVKox4+ZPKaCN4M9yv7l3aa6F2XQd9xxsga9bu2QZbSp4kKTatSyjWR291CpfYzPnhBXY7HDLUB6TIGe2Mdwrz/+YCL13RxyxyQFNAKQv9BTWABcDBOAxBO4wQ3vj+yVt18rVDH4yK+KCGJg0BLp26qavJ3uFl2WgdH44KNrCLiZ663SlemYGm12ypET8Id2kNUjlHwNjJQ+O4KJMFOz3DgR11dMS74FmreiseLlgx4KDW1wAXNERH5szto4221EWSbOyFa2TYmSzJwVrgd5wA/rDfSxs5LnCCjt1oKvkhX6B8Hci0PayvhXIyLOLkBblpJeeuf3Ou0tmJBeExvKom2fhlwVt9L7hSzTIE6HxdniWh86i3FJO97m0R3hPifHc85wGsrI5SjiHQ6pmyAGdjTCok3lS15zI5PdlAqWwIDALKqlThch9yAtSTHcCxsIbTQS7vQuwLlSbQbEOANtm5UvrgBx9SzAkv6q4GYFJ4O3V1hpku720RNI1VbgxY7VMsSvEeER8wV/2AZ1ZauHdp7ye0y2ahRYbo6Dt7zzSDNWUF2xLz+ksHpA2KIBRWMAg/At2FFYeGi05ssQh5VW/1/KNfzV7mOr21qYcaRasMAbJm5ecYrElvS7Khq9Z1UQgF1SKOh+kFy/sJFd5SNMgjzcW5PW+ccHQc+rZicqiSJKVLsuysczfmGf7jCqqeYg7YE3xZxIqhVpIVhZeO06ukRUgoGFIDJ0IB95Lq0z9NP/Th6APh/paj1TtSH71vXZfKvm1nZguZ7e2rSg68W8klaExh7+x+g5I+5OGEgLfKyQZKpvj02rO78s6VFq5qHXfXgei/YxXO6z81n6wAAAAASUVORK5CYII=",
-				LogoFormat:       "png",
+				LogoFrom:         &catalogv1alpha1.LogoSource{Bundled: "cert-manager"},
+			},
+			DefaultNamespace: &catalogv1alpha1.AppNamespaceSpec{
+				Name: "cert-manager",
+			},
+			ImageRegistryKeys: []catalogv1alpha1.ImageRegistryKey{
+				{Path: "image.repository", Registry: "quay.io", Repository: "jetstack/cert-manager-controller"},
+				{Path: "webhook.image.repository", Registry: "quay.io", Repository: "jetstack/cert-manager-webhook"},
+				{Path: "cainjector.image.repository", Registry: "quay.io", Repository: "jetstack/cert-manager-cainjector"},
+				{Path: "acmesolver.image.repository", Registry: "quay.io", Repository: "jetstack/cert-manager-acmesolver"},
+				{Path: "startupapicheck.image.repository", Registry: "quay.io", Repository: "jetstack/cert-manager-startupapicheck"},
 			},
 			ChartVersions: []catalogv1alpha1.ChartVersion{
 				{ChartVersion: "v1.17.2", AppVersion: "v1.17.2"},
@@ -77,8 +91,15 @@ func GetDefaultCharts() []catalogv1alpha1.ChartConfig {
 				Description:      "Ingress controller for Kubernetes using NGINX as a reverse proxy and load balancer.",
 				DocumentationURL: "https://kubernetes.github.io/ingress-nginx/",
 				SourceURL:        "https://github.com/kubernetes/ingress-nginx",
-				Logo:             "iVBORw0KGgoAAAANSUhEUgAAAIwAAAAdCAYAAAB4+oTYAAAABHNCSVQICAgIfAhkiAAAFS96VFh0UmF3IHByb2ZpbGUgdHlwZSBleGlmAAB42q2aabIeKXaG/7MKL4HxAMuBA0R4B16+nzclVUvd5YgO21elq2/IZDjDO5AV7n/95wv/wU8dY4Ta+rBpFvmps868eDHijx+93vzNP1/rK4u/flKc3+/vJwz/6+M/vkjn57/5z8//+n7900D15+xp//nFr8/z+KeB8o9/iibgdao/B5q/Jip8/Dcz2xw91n+8Xz9vfD8HbFzRR46h5HxL5a9plvLj7+Jv5XfR6LxO3yf243ONriGYtWvpP1f03ZoLEZrp9y++hZefEf/t829YfUGQ/ojRP/b+5xd/JaH8+fmvJPz1Y9/oKXyzpt8+/JuM/DsJCX+XkW9bvwW4/nqV//z85PTrTramDLwz3rvfFXVVozDtZ2X9yl/6eR3lWcvP7/74E/71o//dn39zoL/W8n8d6P9hRe+pDgjq+LKmf1hXVloU6kRZfVcFrz9q6wvnr6X/3c+kODrjNCq0MDz3lZ5+JKP+qCP1QfuqaP92o5qycEnTACklrWGkkmqmllLLORcWY3RFKaO3sFuu9FWvqfGr2yo773J+zvmVZvya5X9aafxWmsM354jzx425pn/c+KMA86+e+22t5dvOj7USKZpvBOEJCyV8JZNkRct/DZN+hJKQCGbs9pp7faWlXs0GL83vuAmcWBZ22mu9WbmYXVV3Ppxrt+tMeofdMVbKxdedefq8de27Zhwn3ZZbvu0w2bolrFwmV5sRtp2mj1Xm3nF6Lp3p7lnm+8Tjra+Z3zjXmp89xr5x7uhzXIauFprXxp+bV17r9rvNVubqycKfttTL9N49N2t1t8cII7vPeM0ro1zq3W710HorL4/8dl71vJJapRha5cU5IOPYrZ15Z3rdEzh7C+FddtaOdnY1xiul2Qn2erz7vvQOg8X2WFYa/lhauultglzyGuklqs8TGyovtdfnMbCcaQjWYOBw9xuv1L7yngqORetmbsVW9dVOWZRee7lusCbtXO4tvG/NM1mfOW5+z/LAbPZ/2OQ9z623dV+bix2dvW/ep96dH8F8aZREWvxG81lPZ63LY+7MsSibENnCM4q+M7nWs1nAIXkvOzDPZldfexPVTNbt5Og2L5sbDF/Y3tkMG0sglaVASFSYqiXZcaL9KIVLcGycllrbvShZa1hmuDzI3TyFLDRC6Wq9E6Kp0+z01Ecr2SlLqOuwilkJnvOKlgRHaNWbSXmtDF5mYoM+Ka5dNlVxQqIoNNTe0zoFSWxnqtbJsrOqVfeyGvclUTROYo+DjbPTtcCEXunzOlPyMLsPp+YGb5umNVZ84k2JIQj5LRcqoPzjGd7XXX2XZeuSkQbH15Rt92Q1+Jwkd1vdVG7tl+Wyp3Febqm+vbZTnQ94KJZPb33edsntPokqJBl7xP2StbBOHeSiWCNEBLK8/lVKnZQH23nEQZ0MQXlz9kq8DUiLL+15WFnqt86Ww6WtaedLYON6cZ9zVupGmbBJqrrcXe+k+ajOuDW2dV/0OJn5Sl1sClIEG9XjmefQzuN5OvmeDPccandPopHasoaKWP6cyWJNtgFclBsoNlm7cLimYKaQ7VyfC4VSrbTb1C4p7fNmtxtPGVTa7p1Z15kz5gk8IhNjbvxHoSW2VlSza+5FJ4DbhPyuPM+7Rj/PkVSTzEbOWiesee3cqWWCPUhLpVRuPjRtcyRWZZxTX1q0bqUL2+b+VMaKvD8zrnzv3r2N0ystmNSOd3EjmuHayxR+WMn73K42bkYzEdpN0899D7UPutK5m3YXGNBG6fazBNpzCPO8jjoysDjDA+KboBHE2QBqOpQVIG57pd3HGneWPl+FcTZpS4xZDyAa607LmZfURHggHL73XGO/q4mPCJlVqnI1qmf0/FgbSDNILLmw9coAjPqeb53TO4P2sudYYc7Jqq+BPEBNafHQECdRa+PNRy3nlVjIoyHyjuuAopd79thQDzgN8V6uJtjkt88ETjbCciYYBMU964NuATDgipbWu4OsdCYl9plWSkDCyWAOhEV/+goLRigUEfFj0UbyCvBVmJHqWW2/9ahi7+QmzQhDbFZfv7qiXVf0SzuDDsGhqJVteQUhzgTmCfArNKTwqpwNkFASb7cE9TBEntdAh8gMcGybrZVr2YPyxb568mcsmtVllkIbVxrkAXYwOGXsbJxuXQKz0gkzGcuwX7ltDxodyoY7M5VG0rh5Emrmp/HBbihjnHEzmCqdcixFNWyfq+fli1Cc7dAB+D9ToDy5utBr4xEacCv1zrhE2ZqBIoTrXQC0rbTm2CbwelYmkmJtZMWzSnJWcIoLuCPG2a0KKlp8dyFV2MdObBLopDGzd2BGMbiZIoQZUqe+HP7torOQnZzfTm6QLI3aVntXZlsneaZuWOYF3XdHqmSJA/qRpqeyMv0KzQPvg4FSEmPkuxMTEj27j+JnfjpoX7I+7LXiSCgyx54hgQYPb6hQpMZekafQRAA0I6D/JAlKMWdj/SFJKjB6kYG5owNyawNQrcnL11wZqeFxTMEvWwcwV2jVhp8SYRn6C0v2wMALdw6ExQE6KNtPI0EVp1PrYk+CEjd4PWjfw/J4Gc4+g7De/mAINCgKEM8Mc9xjLaFPx5QkZQciWCTFWZMLqZXGTrIujHDwDITn8fF5SYExJAME9MhsokAWdX0W4MLi/dU2aB8Ip97ibyM70CvgtWCyBeoVoABXm0Q2IAuY8s96rNtvofZIz3M4p8KuGRVFWvuBGdALvNwDekYQB3CGjiCOAqxMrbQ8G/oTvVgzTE+LASIMniZJjZ0NUnAQLsqLJiDFjd8FPLpU/87nC/WlE3PbbUjRoeMvtaUi2BDXqAR6k08/TUcPjbIAsnCRcNNJgW4ovffZ2wWaCvLygIf0G6puZsJD2y5ROtukGC+CHF/ueRDKHSu9T7/DMQGqpFegaALWIN1DtvpudcWTksCxQ5zAxgU8jUnZ+3SKcsUO6MDGjjsBpeD+lyUFQSUABuYkqRiKjGSWFiTpkDfD9w1yIFcbv62Li1ZRRpNvAQYFyZaQ85DmmOOBVOhmCJOu4Tqfh33A4I4CmGqgd5F4Nr2MCJx3OAseYP4VJMqn0Kg70IW8BJrTNkoMOaSijYPOachCiiuxKuQAameUCSRsGaI79i45YGMQjrf7lMab/cE8SJJnTcsj5KAE8hGgRrQTQCgDaWgHXdkWNFLRsNffCINlYSmMom1ocUKNmSsoXWT4k9KHW4FA1gPosBEk3+o0izntTKhHbSj7SbBpGMZM+BbY+K4DXpy4kGx0rzTR3WQuNosKAhW38DJUHkGDHBsKJ90CggXYzmj0jgJ4FKfUMJACn0veSeHnfqQssHgXAUvjRIDUTzLVC8wDDSF+X0AcgtmgHfhOxZI0OAipca9WsGlUsl9pQQCaDqYe0VtcDztv1Ab5N/oPMUqK2DgOCiOQKBDgYaC4ZBAyOJuwKSbi3ZOYs26agMbH+87PhB3xAyzzQtv2CR4kwRrION0NHjRVEplkPDwkhUa+IyVbGJtVs6uksqiQIylFAAUAn8DDXlWSsCfqKZPdnmV576THF4gN7g2toACPbBwgbRNh1fFzlBFAYeEAybChyTRjZxH1D5WSoRyUNUVKUk6kfPHfjw7MOMao8G4NvvwatQfuenhwArhIwaONR7xiiwZbEFvjyndwGLAEEHmYA1geaEIVLSyz4NEoBYy6APyd7kuWiXTEDKK4cUqdesIG9gHT49ERux1aou/qRBbMiX7DtdeKrrloMRR7yBgF3AYytB720R9VBSUyI62jZGPqC4pZ0gutAFOdUZl8S+hv9O0uBCbjaZFK9CY2a5ZDu8ufslZXT0qn48KGDihnFsxbghFR+si4FcFTRpY4pqoBf/DptjIQ68j9IaBC5lLOCKHBMDYo6M+6YoOYqkC4gCn27hX0DFhRI4UaxH8wZ2QPKFA00aJzrtKB0SdxiKaG+r1ySuSQmTC4wCWFGjsrzRvx1ssJuCraptxRqCO+pIvqxFpBfRfrmTCwKAfUCx10JWwdNBiV7DqKD3WEayARPdAbqAJKFVhnojpKRagOeBR0qfLGSSenmE2RWBuQGNhEWmVeABWUygG9LXSSW1g8wG6HvA3ygPWhrFrv9AK4Q0nQB3QAwcdP0wjoGuqDSAz/9AnCLaDBE3WuZofn5fEjHlrt4KAbHGkzqfLapgrwrVlyQgIEZDz0Gy2tqEHZYwlt5xKeXSV7O5IFLwEASnye1fF/UScxj6whV7o4F21yJvoV92UJlRIyNIlgU32gL8XiyENv2Beo1c7rMtYALgFGSjz0F5KeOmziLNLW0CPe7gigW3ou10TbgFDcnOni2XQYArYXiOygeVDevZOCOgi2AH5J+w4IphUwAfB/uHY5a0gVIR0bthV15uAQaRVPk+6VG5i3W5ZR3UsMy8RLh2GgK2BmO+DTnXJ/DcahAY5smSLeaSk8KomD2DGSyA5k6FxQI4ITYGxMiU0ZXh+6q4WRdDaCoIJZm4E0ABHRojwZSZY/DxInegdjICG5e6+9Y2cgLfoBgcWLEjL3INcQmewlXq/zNflX1OhAKtEgkQxj3TY9jcelD0s6mFn0pY7GCdEj6XQ/agO+AXen5KY91l7S22PKwjYAFP5AFRFHQ8FpwyRwonLAcXCtsFnAeAQNXTL3D1KH+QBI0soJN8ZltdP2GC6W4tNNJTVBgQUU63iD2JXPfo96gvoh47SpWCBLzgnjh1usX5GQcdrMQM2HZUS14yF0orl0ZoPbGNhOpB96NhRSudpAWza0JWInVV47opmc3YZw9lKS4apdzhiKo5VJ6XAEStdRAXPc8oDaSUcfuK3LPwFYuGeyNLHsn7ZE2Oq0R7SMlODDim4hYBQqu/wmIB8lgNFUyIexTwzwkA9dR3W2daRW4D4DtzcODzRA2GXwlCYVMi0B0YZrKf6A+SLmECxu/fnWaY6hAqnlY0b/9lrhAnmCpXO2ZRmnDtMZnb5B3LV0sEewB7k/IHMjxsDhVFowTDVNNGTHMmJztw5FcEKYnyJVeEWytHMk7n5gE9x1oMBA6kJPy/vHDuaOi5VD7aAdSRrvY8MEJXOXAMisV0tG5sERdd/n6OAXfnD6PTCC1CRCsQDLuJ6OKAFLUT1Xi9ehK7SBgAXuURIg7ujUDWpCuYJpDetNepALl0rIDr81kksz0y86SaIFUFsJnYyQxv4MWoKuoQmAXQIL935W9OpQBNlRdG6BCYcrDl0LaK8uL4TXgkGPSA+5UyoNQD1Jk0xeV0doo21W0PlndZoelM3LriCdxG/6G1YrcjpIdERHBNDVTTLB5IVuRT3oQHrGknIPTpFSpShP9USfCRptQC8O1iSqCZ5BDRCe09nQTad+3majsgTVKs6cEFSUPwCFicFOoHkZvlEEGKd79aDy0oDdQWu9x3ojxOCOSaBrQtF5LEcccRII6XBUFbBSj+KDKpQBNBFQDgwgWhGPqFymWjjD0XRUiu+gomkVWO74QclAkGQHVQ7fstZFK6uG0Akm/YHQh+fIjihMyKNz5ngJP8LNqtStjnXQi4HaRJ+Bka7jd6RL09npsy1NhG8EP3V2hsk/sDgSHYFIsvAu8oIshyvB/hoG+wB89JwKO4u0ABT86UGoYdeuTtBhbx0s6/gUT6ij50V0CCrNu2CXhtvHirI3Cp5vL9UF/i9vWAn8WWrIgxxZUm/EDd9eHhSI1tm+MS0IdpzPwyPhhG7ApmJddFJV8JYwlYNqvJXdSB/1s1ICQz9h9vCnCGmEnz01ruIRu06mWtDpJvoNY+JbZx7oaJKCTS6IQz2GgGYdtXNR9URyjgOQo62RD2mSnSs7Ps1CQxXoFGtHNCs8/3DNuAU97bn4UPgEJCLPS0cmk2BDtlRa1xkCMsTfVLdg/Cj4Q/xQIe6ShdQbu046okZBbtQhpBaFG3ougy9CiC3czUY5Ak3oJZidHgpQMU4UZpRchJMzDYHbx6DhyXUgjFcjqaMtPSZiEI+oI5rabsbcnJn5EtUcHrwBsEKjNCzQd7BlD/X/HeGaBA7m0eQZDqvU8c2GSI5InjJ8st4X4Ub6ETI6D9xbzxX1mAlrC1lcEjCRbohFHPLmzScX6W49aJtnpibSAaGHrAzABg0ipEGNxThRp9B0xob0hSn4WxRMSk4AyKFOvhcFQXtTqQjU9uEqDjN9dt2Em0AsldW9nCKehmEQJ9Qqtgy2Jo+nQHj24MMlT3MLVgwa3bdi6BLg3xZ3YiYnAEyfCeEzwIqyS5D1VJLJ76ZukKIw0StdoI74A1oliTbx3wGOZWuQup7nGdYy6awa49W5FEgF2QYkxfho1EHeOixG9XQ9+INvSAueZF0EO0tSmAqTlgyAT/xw310ebcCMCXlG8vDikfrCIB0sJvIaboGyyhgWV0094BBpDqKmB17jKfDoF4wUWzlgzpg6R8VQzDNoIB1pwxA0m54UIyfgTJCtNv0fCIDd0TvX2c8znejWmYlDQS+gUJnC5PJl+NkRNYgi2tjCJxxAMqvbg69JqWKXHJqk6aWHMIDI0okXuQgMqqGt+PXZkKHZCHijgrLojaoE5x3MBplhdypuX0Tlg591KImuo/OqDg4RfQk1QVujn5D1ecRVjg4oDsjN8pAp6/SAP9UZuev4G8TfuZsadIGsG36M9EEH8GxpPxh8GJJqURPzWTzS2hRsGeFOsEPNCJaKWRy43vAJiC+0WVVHFRfVB/lTtkA37DcQbqBH0lk9AS9xxGBUFHD7yQUITs87Z/48DHi/WfNDEEZ7b8aHjI1MJvLUqj1+Hm3JOcVwkIzugP/Ww0SdhZJpFETf4F7SoYFcNQWNgoJSEBosnW6xAwpkRNkzhPlTZWtq7hHKRFAVwsDkoEScNidfBhTxGYT5vvMBegvtq8dwrmc9aBiw53gwRBTShV7wSaXQ0TAS87SnXRJfPVAh4JAvAaRMSZ/8BCZZ3g6CAbbYQpDOaY+bt0QSJk2HyIi6BY/i2EkPSLBdD39cAdEDqKEH6eVUoi/P0no2fP9NtBNByKduPJuTl4fl7iVJwU9pR+Ks2qLBUtHRhEqqknQIw/SU3B4rwjw/2LK8rGcmyXUGSuUYKhhRippVi3MFjQjeTHwH0gQOg/AnXUF+Lzzz0Nk7CqVvRQAePQpA8YCy+iS9BB0NF0pgkMa7qCIE7ET++JmAdaPfgDH4/Yakx0UHrMWX5jaz7N7D1CsiiFw6BS0PaFD2euRDTvp7YHr4bxTIN0mk3vVvAAAJZklEQVRo3s2ba7BVVR3AfxfuBS4oEOK3nBzNUFKqMWQgEispRQTiJQqpY9MoWCE5lhR4xPAFEd26nL3++1xhgnSm6wcmJ9DIuMFYiU4oRIhpvILLoxmQ1wWFe08f1rr3nH3O3ms/Sc7M/9vaa5+19m/93wua6Y5iIsLbKI4jvIlwG810J+2vme4I41BsRnEcxWaEcZHnXkp/FGsRTqSUAziMjvTOJi5GeAzFfoQjCMtx+WTVuDyDEF5AaEWxDeF+VtCLLH4OVyGsQtiPYjuKB1lCvWeMUIfDnQibEQ4hrEEYSpGazL6b8DqKwwjrUdyo51YMR7EToVgmu1DczpSU0BQYguI9z9yK9ygwJNLzLpPNBy+mFsVKciEfNEc3FA8inCp79iyC44FhBf0RViO0l81/BGFSRrCs88wtHEWYVjHuJoT9Ze/vMAdyWCpoNCyTEPZW7N9bCFeD4lkUHT6bvBuH8ak0jTCnYuFF8645oc8+x6UIGzKBRcsB8lxvfWcDfVFs9IHtP+QZVLauEQgf+LzjtzTTI/F+uXwG4dWqPdOymgZ6doHt8gufMR3GUgxPBI2GZQrCPp+5zyLMBsUKyybvQZiQGBrFwoDTvtD6XJEahJkIZzIEph3hSavW1JBu9Xn2CIUy2BzGIJz2GfcqQu9Ee6VNXBAsRYQNNHJRmSZUFm26BWFELGhy1KK4IwAWvX+KH4UBo6Fx+WYiaJIC43C5WXQxU1FsYzmXXXDANDEIxZ8ssHiB0Xs7EeGYZfxWHL4UCZoctQjTjN8WNN8uHG6IAkwRYS+KibGhSQJMjlocHkdxzue5cwiHURwMFT/tpPgQh29fUMAIVyOsD4GlGpgl1OMyz+rjKbaR58tWaDQsdyG0WuY5iMN0ctRGBUbbcWFSLGiSAFNgCMKugOc2IgzFYbBV8lyL0BTgm61D6HdBAFPgGhR/DvifdmA0bL0RfhISGPwTYZQvNC3UophhDljQ860Id2lY9EddEUOl78NhcmRo4gLTQE+EvO8G6pB/UoyTOzTg1HyAcPPHDkw8WPyB0Y5qPcJcsz9B3207Djd5oGmhFuFbobA43FmCJS4wWvYhTIkETVxghFEm7vd774s0cXEMj78HCuXzQToQCuR8opn/FzAOgxE2xIAlGJhO8yQ8aoVGeAfhKyagqEO4x+RvgiDbj+IOLyx2YDriT5YCmEYuQngxYPxhljEqQVg/LOAE7eE5Bn8swLh8FmFjTFjswJSg+aHVEVa8i8s3EO6zwmJVCsHAbIpg26ZZoYkDjGJiwAlpR7GsKwcR56dN3CKEv1fIm7hMrRr/PJ9AaDbZ03LZQIFrUgPTxLUoXrPAcgbFJoSPYgOjE4q9EB4OyBGV8lGKo1Zf1ep2BAPzBC7TEQ7Es3EJgBEGomgJGLsT4boUmct6CgyoEr8Pm6MbS+nPr7jEIwUGeNaYBBjhOiQEFp2Mm4LQlgiYTmgUPwiBJkU0HASMw2MRQ64DXi86JjDapt4fkKQ7izA/cAFCHQ30ZAW9qmQJ9V3SbERHFV5ZQj05ulU4g3VVUu4wxgOmxpRI/mKB5TTCUoR+OIxOBQzAWnqieCgmNHtwouTbbMCUJ3Vs0Gg1N6MKmijAOFyO8HbAvG/RyKd8QOltQH7BFN3WmCLlywgvo3gF4RWEP6BYZ0LpdSj+iFshQmNXmL2KvuaUr/aI4jcIVyYApgaHzyH8NRAWxWkUP+/6D1kAUzLH37ean9J/2B29KBwGTHna2JYJ1AmzGbSUQRMFGMUDKM76jOnAZYHn9JfqHQ+jMilKnkV4tOsd2Tq9Nbh8HuFvVliEn3nyQlkBU6q8N4fA0o7wBEJd1ORaODCd0LhMDYXG4e4uaKIAoyOZoDnXIwz0/I9lXGZCxCxKBdvJc8V5iJI6YXk9FJYG+laE3NkA00wPhO+aFo2wvdiFy9ho3QlRgQmvZnbKIRPj10UEpg6HZ3xLAcIZHGZ5tIxOpbdmAMs5FD/2zJ0FMIvpQxNfQNhkSU20ISyqgiUrYBroicv3Ipmj2GYpDjCd0DhMNqWCYGhc7kXxbMQo6UrThORfDyn3Hxroi/B7a54omrzjmTcLYHQBcSSKN0JgeSYwCZkWGO27zDY9NHH3JEJ3QlxgyptsbNDojO2WSMDk6IYwK6BgeA7haY+NzXO96QI7bpzG00bFtyG0obrknEW7zKvyj9IDsxNhqyUaakN42pqxTgOMzsPMCYmODoXAtNfenZAEmE5odIl9bwJzsNAn8hlofJagzOMwT/TRwKUobkS4FcUtJoP5dQqMRrjZAL0jsnbJxiTZ1tyG4qnQ8kZSYKIl7f6FwxgcvoPwX2vyLh9UaE4KTEnTTEDFhCa4ljQuYMEdCM+zkj4xKsETUZyMrF3OLzCnEJ6MZFKSAKOTdY+ElgUUXzPavI4891nqdpbyQBpgOqFxGW/sX1pgeiOsClDpxxAmRKwh9TO5mOja5fwBcwrFwsgOa1xgotWQduDy1YrgIbwAKezDZWq0anVUYEqO8HiE3amA0Rt2gyUK22jM0NAQmWnVLkUf7XJ+gDmFw09jhcNxgGmOUaX206gt1OJydwg0+701wyyAKfk0twc2P0UFRjf1PBXgsLajOIbiqFW8Xf/ezWvk0+ex465cTuKyIJYZjQOM9lnmJm6eqmyiCqsZCtO0ecoKmM5oJ8/YUGjCenrzXBHw4dLmXfx9l+yBOYnwOItjwhIHmGB/r5SOcEPaM71tsdNNXTBoTf/Wty6E5QED5ieqDmun6jafu07xbg3oksGZDIHZEei7eIHZkgoYxUkUuUSwRAVGF2x/aVnrPxBGxr41oC/GtVoO3EMY9d/hcyVjZuKWghzdcBgTAE07DrND5/g1l5hUezbaxQ3RLqXay3rfLKjDVWWneyTK18k8gTA/8VWTUqnkqM9/+F3XZboiNTgsSn1bIOiqiX+p5iMUD4DiiyjerSK0/OJWck1zK8L7Pv2lgyNe7BqbsK+jUnZYfZdyzeZwr+edig9xWey5oFZgAC5rKw7aCRTzUsGifZP+CC9VzS3cA2UQCCMq0hnpLrF5A5ipFYFHB4o39B5qZ/UWhNeMt9xSFYKlgabAaNPtfwjFRgqMjjz3SvqY1oLWVKJYELlxXYeps4xaf9+UNwYG3G5YYzTNbhSPJDZD/m2cLxlw9+Iwt8p51m0nk0wZ4oBp70h3TdY79wRTPD1o2kSGU6Tmf6c1QskGG34nAAAAAElFTkSuQmCC",
-				LogoFormat:       "png",
+				LogoFrom:         &catalogv1alpha1.LogoSource{Bundled: "nginx"},
+			},
+			DefaultNamespace: &catalogv1alpha1.AppNamespaceSpec{
+				Name: "ingress-nginx",
+			},
+			ImageRegistryKeys: []catalogv1alpha1.ImageRegistryKey{
+				{Path: "controller.image.registry", Registry: "registry.k8s.io"},
+				{Path: "controller.admissionWebhooks.patch.image.registry", Registry: "registry.k8s.io"},
+				{Path: "defaultBackend.image.registry", Registry: "registry.k8s.io"},
 			},
 			ChartVersions: []catalogv1alpha1.ChartVersion{
 				{ChartVersion: "4.12.2", AppVersion: "1.12.1"},
@@ -95,8 +116,14 @@ func GetDefaultCharts() []catalogv1alpha1.ChartConfig {
 				Description:      "Argo CD - Declarative, GitOps Continuous Delivery Tool for Kubernetes.",
 				DocumentationURL: "https://argoproj.github.io/cd/",
 				SourceURL:        "https://github.com/argoproj/argo-helm",
-				Logo:             "iVBORw0KGgoAAAANSUhEUgAAACcAAAAyCAYAAADfuMIdAAAABGdBTUEAALGPC/xhBQAAAAlwSFlz\nAAALDwAACw8BkvkDpQAAAVlpVFh0WE1MOmNvbS5hZG9iZS54bXAAAAAAADx4OnhtcG1ldGEgeG1s\nbnM6eD0iYWRvYmU6bnM6bWV0YS8iIHg6eG1wdGs9IlhNUCBDb3JlIDUuNC4wIj4KICAgPHJkZjpS\nREYgeG1sbnM6cmRmPSJodHRwOi8vd3d3LnczLm9yZy8xOTk5LzAyLzIyLXJkZi1zeW50YXgtbnMj\nIj4KICAgICAgPHJkZjpEZXNjcmlwdGlvbiByZGY6YWJvdXQ9IiIKICAgICAgICAgICAgeG1sbnM6\ndGlmZj0iaHR0cDovL25zLmFkb2JlLmNvbS90aWZmLzEuMC8iPgogICAgICAgICA8dGlmZjpPcmll\nbnRhdGlvbj4xPC90aWZmOk9yaWVudGF0aW9uPgogICAgICA8L3JkZjpEZXNjcmlwdGlvbj4KICAg\nPC9yZGY6UkRGPgo8L3g6eG1wbWV0YT4KTMInWQAAFBlJREFUWEedWWmQXNV1Pnd5W3dPb7NoZjRC\nOyOhZSQhCwUMsnBsE7MEOxEhsfPHReEqHNuVyp9UORWVykmlKjhOubJUhYp/OFUOFZONuGIT5AQL\n44DBSCAJIQntK5qte6aXt90l3+2RhLEgODniTXe/fu++757lO99pGP0/zRKxz/36Hw9kQWlQFIMB\nzXi/JV0xXITMWIlLjGU6sUa0hE0bJslmCnlwZcnB56f3HHkqW1jlf7f/M7gv/PZf9Lcjf7Bt9KD2\nZZ0LXibhR5azgIwOGMc7y/Aft9YYg03k2ErKFSXYUcvL06aXZ9M2U5OrLjw9tWffPnV16RvsFwb3\n2c8+XuTcH86iwgojxdJMsAE8tcg9ITmXpBk8ZQmYjGEARsTJGM0ZObDEucHDjDbCUsJU1pDKnPNV\nfpLl+cVu979bTz31lL76qOv2C4Fz3poO+bjxgjXKl8NasiITknEhEEHSRmvbcxbAGQaIV43jH+E0\n/Mfde1zKnVuxE8u1Tn2dT3uxOsGT5Oj9yejFh5566F0AxdXX97Tv0C5R/I0vjM0Xvc069CdSTy7O\niEXkCzYyVPOWD9cjPE3PdZIEDjMukgs+WzgY3IbdW7gA3zGNk9oQruC94EckeB9cXVdcFE95M/pT\nG7e3973+TpjfF9zuHbvlKx/ZsCQtyVvTwN+oJB8wnm+EL/LAF3qkUgr6K4UoTjM9NddJU+upDgtM\nwkLVZb6OBfxCvRjDecQE8OHVmfsLoDzvBZ1RH97XDZeFZu5nWyrb5l8/uwDwPcHtgsfsznWjrYK3\nNfXEhjyURSs9VQiFrRZCGfhSpEqbVpym0800bSvKOxSpDvkmI2lyEiZnwqTkGW0RUKASLpw9R75j\nDHANvjRC+NhCFZ4NeVhIdox+fO7lE88gjd/DkPxDplTZFvtyYyZl1fo8h5NMMZDSJ8bjLNetNE9n\nlMymdJRxLmiEz0djtlEasq3Is7lE6psmL8bneaVzwVa7CqBrlHglFnuAzNU1P/YMCaxVKJVOgzQ7\nJnP74wfmquduAPfYrt2lZn1sIvHk7Znv91vJ875IshqQYee8m+T5XErpVMbSDtJ61LbCzfzy4Fox\nNTwm2v0V2y0zo3yL53dM0L7Eyo1jfOjtQ3zx5DlW6wgAK1sAZKb3bBQxjGPLMK0LAoEIU7M/ys0r\nN4R1/Z0Pr8o8f3MqvWErJSsVpakXA88t0+ok+Vyss+lcZDHzzRo+U/kUP7z2E3R020ZxcWIZn182\nyOLhAYqHBngyNGxaI2NmdskqmhpabFteh2R80VS6CLeOWCZR2Ai2wwgnWjARxwfwlbAmsjrtXgeH\nr9nkb/1JrVMsTsRSrDWelMWCtOXAE2Ay1urGqtHV6Rw80qQo38ovDDwsDm653X97y2K/u7zsUQne\n8CUZicsRJ5L47BVIhyWT1hfxTmUMXo6ZaJ9mAy2FqsW1zCfDXdH0DHnqPMisLsGx6h3P7drtXyjV\nV2aBty6X/oAXSl0u+jyQXLaSLG8jnM1c5l0W6TViqrJLv7Zxu7ywbajujcj6oMi8IiUJ2kCcUYqI\npeCPBK8KzAW3syJXpbrt9JfRKFosnDtD/W2DKikgP6+BcwWC6kGMrGc1iKZ3Fna5Fjn+WpIRrzMh\nbOh7PBSC54p0p6tUlpPuikgXhZKfoBMrN/Mr6ys866ewRHllEZnqIFk/JPQsHGBjtygeqhinjgHB\nAWTBqtJaO7n+bn1s7TCfi1JQTsbkdeLFvShdULYUuZa8fB3cvA5D5XlDiHtJeNwUfOE6DsUZgKEH\ndIyvJfhqnZ2sTvDLK4e87mJUCKoioaw5R7rdIqty4EFyA5TzRc8hOBSQthUaAz72s7S+lk+u2GrO\nDwcmkw2kiHsO+HvBfdiZIaasgG927/qOP7b5w0UbhCsSKcbJ90th6AGcJ3L0wlaSqhy5OsuKWZXl\n3t385NLN8vK6mszqmQsfXErdNtn2PNp72lu/h2rhUQvJjAMSxXVZBKd3zqYk50/SwPQlVonLDGx6\nvTgWbkUPkXyyPLeyUCjdAa66HclY4kJmAXqmuyhVyuQ4DBO2ywJdBAUMs0a9QEmBkBRwKJk0Ixt3\nyCYxELjufg0VrBdbZ0CD641LQByRyaJFer5epU6QIKwAfv3KnsF76MIxV9zcpZj5FAJxN2o5ZB5H\nsSIzXRPP4GE8z7m94fXlWMMW41YkGw3PzMxRNjNPugmPxYlbEVzqGv1VcO4Fe3Q5brOUTKuFa5uU\nN5pkm00v6M5H7p6WKGgnGhZuesfQcztcW7MaFLMeKJa56paoAde14RWcNja3El7zdS1teKvnT9fq\nWacui4MBGxonb2QN8cpihAxSLsnJonp61osL/mhFJk6Ri5xEaZC80dXEFo/jnkFZMLq8Yu5MbVE6\nHbR4qBR68fW8gyHvcrFp8713w3OjWpDMPO9UgF4eMl5QUEFprk2T0FCsMNubB4d+RZzdsGa0Nl5e\nt63Mt36UeasniFWGSaco6bkmwKDWPSQVcqv3gG5GJhfEBpdTOHEHFW69i8T4JvLK/VzkiQxaDaO6\nced8tAi0Im2IAoELnbsJPJuKzZs+uQMZOwIpIzPfO+sXwjhgLMKe7bwSeTM1+ZL4SuEhdWRi+8ra\nreW7Plb3brub2+W3EI3cRGJ0KfFiBUBiMnOzyKmEmITQRC6aXBIfHafozk+S/0sfJb5qHdnFK+G9\nZSRLFa/anSwXG5fNRSWnJ0VfwjwPOgYCCuCQc5nYsuX+ndD9YyhdH3rtrB8tgIPj7LQNEj43y++I\n3xrbOSpvHbvz9pvkbTv5MciQ//zRC7T/yJvkDwzSyM1riIVFMlcukWlcwd6RJDFopX8ZRR++l4Lb\ndtI5aIy9P36RfvrGEdLVfhpbs4ZF0kRi8oLsTM02zrDabKMwkBVNgq0hCIJnTujD7xaxcIeLhUsX\nxB7atcN9VYln/VU0M1xZcVOF1m8h8gu0/8UX6S8e/1P62h99lV760T6iICR//a0kl46jCAJULvIM\nYOTYSgo23Uas1EeHDxygv/za4/T4V/fQ83ufJeWjHia2Ud+q1X0rRHu0Hs9GXbRGN3v0cMBcX4ug\ntYtM6cglpCYFVkPdoioUdG1oYtHH0RjKVZ8q/biFUSQF1ct9VK+UKZRu0MLZqABFhu9lgJEGhYG5\nglfrxOqDve89pGGtr0T91TIVPQkHwD/FAfKqdb/is2LJxJ76GYmPNwZdls0jfxsgyJaLBgwS38CN\njPsQ04oHGoSZ2jjW1JoHCk4bJybo0c9/nr74xS/S1q1bFxZLE4QSfAfuc5QCyu1xn3X3wNauWUOP\nPPIIfelLX6bbb78DLANwHdBK0jWJxXa4NPwaC8OcqBdbb/3VCqIIaSbmE8nakM4MQtfzpBAx81Ta\n6uixvBGtLNmRSrlYo8ERKtf6admKFXTzzTdTf/8AUgyrn3mL0gMvkL1yCpMihhk0UxtAgfePkKgN\nUF+tdvWecRoaGiSOyqYTB2n24KHZIxdax1+WYxfn+wbymkk83A2QQLR58wNNKfWpTPMpFXhlBKQM\nsmNh4HPoKppVIsuNyZa3z1f6TXvQL1c9PjBCwN47nARTp49T+uIPSL21H3nSJh4htC4uXYyqoBle\nrpE3OPyue+jsUUp+/ANz+tjZE3vV6KETpaXNSHARkRIu45lCsRw48N3Oq6/+++zNm365xWUwBkrp\nt1AjkEq8gEJJpa8u8GJXzr6d1VAcpXS+j8Vtn2Up07NTlJ86Rumrz1N++EVi3SmSkUeOEhhCi5Ch\nGzTQQRDeHCSNz9SYInvyMHV/+lJ8/o3jp1+Y9V57rjZxNvf7TNlmSE1wsYCE1Ca4rue2b9kJpefX\nlcAkxFjBSb7Il8yB7GipXhfDMyKZj4emj/aVzx+vyvNHZXL0IKWHfkL67CFiWZNEiEQPfKwGHnV5\n5zgh65KZvET5uZNkzhwn8dbr7h598cSZ83u7/S/+c9+tx7qFiipxqEibOQbHXdaTqvdhwT70oRXW\nqEGRczaguahrBhmL6BcEyg5XH+FDrcwotTY7UxtNLgxF7bejfPYy6dY0oCToDADjA1gPEV5c6Hpv\nwU0Knus0iLcmKZi/RFl7Jj6topNPR5sOvhTeMjPKWmHBpFfLHhRurecrNb2wEuyJJ57ITaIuejo/\nL3Xehohj3cxQZnLtUc7LJvFVBF1SX9S15ULGPeSSh5IqSpJ9RRQBQpkhx1zFukaPV8hicgJHuO9L\nAXS7IoGDFcMsrQ9242JNFUnxSGc91Ys67w2KXqpznuaXroNz9u1vf7kVdPRpP7PnmDE67mai0Ulz\ngFQSmjXC5NQjQelZCkOEEIfAhr0ARFslUSiBRZDNBsBRETKMiBfKPe6zuM5dTxDcFgF06/gq5z42\n7lQJPjPQh09KWS/XV/xUn3wXOJgdiLPzQH1E5moGUaQ0UTLVUDXcsSDktvWc4kbdgAkROuelTIaU\nLV1H/qYdVFq5jkKQcXFsGQXrt5NZvYXyqA99NlsINcKumAR8ZBVA8d6vK07QYYyyyKRUd2SSHB2Y\n75z6eXD05//4e3EwE5+Ikuywn6ppoYwH8RdgTeSjm+KFwloYFFxKWfLxNoaeO9HSdKy8gtqb7yHv\nrk+T2f4AnVm0gd5MfJqHbPIAx/Eh1nL1ohPoEqwFZeQQQiBrE8o8b4e5Oh4m2ZvAMXsDOGffevp3\nm+Xp+YOeyg4H2jQ41BYWDzGls8RK50cwLAYKIA4QXurO0/nDB2jvT9+g7zd82l9ZS8/pRfTMkfN0\neP9+zBgzFKGKMfVhdXgb92OCQVw4YdRHI3KpqDoYqI+HndZr/OgbUA9oNO7Pe9krR/d2n1t3Twv9\n20Kh9HUpiOB3b5VslNfQ1JIKy2raDe0o5gDPnJ+box8eeov+9eXD9F+vHqK9P3ye2m++QhM0S2tr\nAUXYhHO3AJKW9eeO0sCFw3akGRvJ6mk7KWbJ0WAu2X9TPnfu6z/4OgaTq8X+Xub6x19/63ferqbx\n/jDJX8EweoalhNGaYTjBbYiOuwgFTWEQ0Hg1pAkzSYU39tHFvU9R/pPv03jrDE1UOVXRMTI34PRW\ntuQ8FpNvIJQzitXlIE1fL3S7ryx9snF6z1N7rv8k+76eu2Yvv/ZM966+e2ZOFOrzIc/saj5TXctn\nVvWLbNCNerlGg8drBCpZWi3Saow/t/RHdM+qQfrY6lFaUi87yY1NGIQQhYtqnjf+9HFVP3SsU3/9\nUjd6fVmaH17yd5ev7KE9bsnr9oHgnL1y9nvZZPXvm8Xim7MPBseCm1ljQ0nYm4ALsso6moUXGZVD\nnxbXCrR6sI/GByvUXwJtABhGS8gdC9nkckw4RXW2nXvPHktGnvvRyaFTB//twdY+2rfg2J+x9w3r\nz5rLfTu+lb1BfxXeZ04NVUxedsIBYcHwAl7B1JzlmmIcHhp7vVSgArpFjvNJhvlCAT580rseedqn\nVfnD6tzIk+Lbkdm409pdu5yT4Nd32wd6zu7eIVtPbqj7cmBpzmgHdOK9zGProZ/DVGmG0u11KLey\nYwkMRpQhEVMA0gAHYeNGXEwkuAAXgS+B0kZSqxCSNjW0Omki1g+uWJ9/88gRwH/HPhDcwysXF4sM\nBMbMZkisjSRpFDxehT9COAHE7AS5G9bdL8LwJsC5ELrDAe4RNXM/yzC0bOakKOjczrOcT6NtaKVk\ngApWpVy1ir/2m/G+fe+E9wZX/rxdfPS+gs/5kpIRyznT/ca3ZVsw94Pa70T6WKvANIaB7k0ET4CX\nnVCELfyF69xZDCuy98MTh2eL2MqLIhH/IjIxjRlm3hpzVpnsTOVv/6PhOLl3J+wDwV0zu2OHpI8M\n8bm0WywK8RXN7Odwt8ICKES0SWML8FoAL6G9wl34AiGFArMpN4RRjMeoBwzxtobv/4F78g8xFl2i\ny6csPfGqW+c6qGv2C4O7Zq441B/c/1VovkfQFFNs1P1K5KNqMYhTEY4K3K8QrtRAurG0vAUxZbQx\nca+1ShrAaPJksLj5++yxFxpXl31PW9BQ72O7d/9ZvVTyKmgQcaezbHrPnp3oYHDIV6CNyGtD8nWQ\ncwhkUMmgwwDb/Sq5sGNHMZBmXeaBAXUKudGB5EvxSA8tuk3PvQBNtWDf+MY3B7vdtC9JqL1nz2OT\nV0/f6LlHH/0bD3NLJcvU8jD0J+CAEZBoUyl1rJF7B9Zms2yXef4xKIhdkCaZ+78yiQ2GOjwcjGwq\nAgjLBX6y1BUhtVloA5O1I5tdCCRrQfXVUZJPfze++WtvDK9WBc3Wep5Yi02650zneX6IJ/mJLi2a\nvqFaP/OZ+xah1O+BjP+87/sPMCa2aaO2InG3QPTcEstgfEA31+OBy9osqmbklbuWlzJM0xClFFik\nF5yJpVjCfEpYgJqGDuS+THhYQLC9S7aSvFS6pR/f3eNb9RDa7SfAj9uE9D9kjNokPCZLfnLlBnC7\n7nuwjHU2cMbvRKmNI30GICj7LRNLU+avK0oxvpi1lpXz+ar79QXtSzoxZFQeh1YxYVEawOXQ5ZBX\nsbItUJvJoQhTTC4ht4VGODRwrrhkA+T07Zjx1mEOHUC6VJAxfRgNMiiY4740b94A7unvPd1tXjzT\nAn+2kiT3odXqWqvIXVgQ5NWpW11mZqMwn4sbsdP5WguCb7WNI4wdEOJ8Uns5RkBpLOKpeCtAG8ms\njdu5bha48XWxrzbH+wBIor9pyiFEVZ61PclerpXDfxoaqj/78F3bT9zQvsAC+uOf/vSbI4uqz0Kj\nHgJlIpmJ+vIODbdPZcvnjkxF7YsXJ7vphUuZnbZKZeC6CPlYSTWFs9YPTulyacb4UNo8aOSigkkD\nU6ZRUyk1LifqgmxNXlzdOjY51j6RVDC1hRCsoPDcCntkZLDyzL0P3nuALV+e/A+c6oK8Mbt9WAAA\nAABJRU5ErkJggg==\n",
-				LogoFormat:       "png",
+				LogoFrom:         &catalogv1alpha1.LogoSource{Bundled: "argocd"},
+			},
+			DefaultNamespace: &catalogv1alpha1.AppNamespaceSpec{
+				Name: "argocd",
+			},
+			ImageRegistryKeys: []catalogv1alpha1.ImageRegistryKey{
+				{Path: "global.image.repository", Registry: "quay.io", Repository: "argoproj/argocd"},
+				{Path: "dex.image.repository", Registry: "ghcr.io", Repository: "dexidp/dex"},
 			},
 			ChartVersions: []catalogv1alpha1.ChartVersion{
 				{ChartVersion: "5.5.12", AppVersion: "v2.4.14"},
@@ -118,8 +145,17 @@ func GetDefaultCharts() []catalogv1alpha1.ChartConfig {
 				Description:      "MetalLB is a load-balancer implementation for bare metal Kubernetes clusters, using standard routing protocols.",
 				DocumentationURL: "https://metallb.io/",
 				SourceURL:        "https://github.com/metallb/metallb",
-				Logo:             "iVBORw0KGgoAAAANSUhEUgAAAKAAAAAkCAYAAAAO7jHjAAAHn3pUWHRSYXcgcHJvZmlsZSB0eXBlIGV4aWYAAHjarZdrduO8DYb/cxVdAm8AyOXwAp7THXT5fSg7mcw3aTvT1ootRaJJEO8FcPB//P2Ev/HKKddQxZp21cir9trz4KLF16s/nynW5/N5jfcj/v/pfsj1/SBzq3Aur3/t/Y00uC8/vvCxRpo/3w/t/SS390TvBx8Tlrty5mJ/DZL7+XU/vQMJ3V8X2pt9DXXm13l9RNx+vIs9U39Ocv8PX29UI0tbGFVy9pJKfD7bK4Lyeg/upOfT7jiOUWqRwElKekdCQn7a3sc5xq8J+inJH1fhr9kv/fvk5/EeUf6SS33niItvHyT5PvlPir8u/BlR/vkBE9gv23m/z9ntHH/tblQlo/pmVAwf2bnfYeAk5eX5mnIYb+HanqNzNBi5AGfHFSfHSj1lUDkh1bTTSCf5c15pEWLNnsEk57xyee41MOp5gVoCI450spVedmngtrKHUridP2NJz7r9WW+lxso7MTQnJksP2P/iCP/u4Z8c4Zx1U5QexvYnV8SVL3MJ4yJ3PxkFIOm8cZMnwR/HG/74hVhQFQTlSXNjgyPO1xRT0g9ulQfnwjjh/FJFCrbfE5Ai1haCQQI1RU1FkqZoOVtK5LEB0CDyXGqeIJBE8ibIXEvRHCy3fNfmO5aesVmy5nsbb7oqKloMbHoZgFWrwB+rDQ4NKVJFRMWkBekytGhVUVXTa3LDilUTUzNr1m200mqTps1aa72NnnvBA6Vrt95672PkMFhoMNdg/ODOzLPMOmXqtNlmn2NBn1WXLF222upr7LzLxia2bttt9z08BccpvLq4unnz7uPAtVNOPXL02Gmnn/GJ2hvVX44/QC29UcsPUnecfaLG3WD2MUW6diIXMxDLNYG4XQQgdL6YxZZqzRe5i1nsGVFIJki52ISdLmJAWD1lOekTux/I/RZuQdpv4Zb/E3LhQvf/QC4A3a+4fYPavnVuPYi9VHhzGgvq47l3CSJ9tzzamrdckJM5SeDUXidCOcrE5Wyf6ezNiHwLy2in+iDcVXY0GbZGC72v2UXWSIPVqztZTn5qb3KLIzpPs7VlOHBPW+fIbVa2lXO7uOlMfD21HZZHrWf2sXwbnClHGUfC2z6QCKc87mfafagznjqJ9sjaYOTOpCBsJc9g1xeY+8g4F9AmqZ+ZhyXXzkpDsqy1N+E0O5q993OKE+DZp0gHM3gzJfS5lrFIcrjWXKR60XhWSX0oUJ9KLuoucR0CqpZmrdeHq7g0krbM73Za2Jn9dINzU8slBAVmJNy/dJK08/AplQJNmGVsWgL3eUbN8KTQEc1SpjBvD7P6HNqyps1X+UzNxpa70dJRQzkpOhRdEZGvknmT3niABq9aSbbMKUPCrhM2ZESQZ19akEJb2/ppfMVm1iGAlY8WafX0WY1pdqqSYKe2e1HlWu3Hxf96/phIxr4p2EexZWqcxjnXEZiyo9oZ6A4NCCpAmVV3I3FJISlIwfjcA6nbbAquO9Zz82M+9jm3W+zDW2G7978cVyvH53xyA6+T7YUE3RJntTDWzqXN4W4rn8EiVOa2UYzCE8p+QiN6JB3YZNkGmipyfJSrvCaYzaoN+Gk7yzrwx6nUabDTsSzBS65ux4DvSVkzt42wx/Q+48AZRhwOPhR7ZCqGjQi6BpDta6rDKPiLoJHlJYCTlrNkD/ODtM5Gfrm3SvvAKpNY28F3fKwVtB1aCldBjhmJLF/XP/s+dXUj+WUNBLwScWu+DLVzlTPy6lF2zHvu45UmYuL0GIUy/37Syl3Rea9kAwJuSEJI572THD2fK+KTIRJ6snUw4SUBDKpC5VRumDA/z7VR23NJY/G75/CbAzNegI9PlTFqsugDG4k06Xgx1jF2MIVlghQIBK+qZa26/f42WV3pstvdNbWI3o+OepttxyxllImKqRx0lXvQfwfsSGGgH/D3mHGjpUKWdl06hnbKRW6kwdcGI8UidbWbxzy6LNysQx78IIj6drLJCFuaHPx2w5gIoXo9TvNy4CDusYx6oCgptj2VGlkBDH433AuHbGmClyt6L6aH5mcw61lnV3z1PC6N0qDLphhy7Rc96kg1CDipW6gUSwu3iuJVqSntK/nxDGQIcd29IlXyqDcfqee9D1tfBsuIDk6eNfBXlpITAwLWWXLC5eIpurfpILjC2od0LoRPMad7ptw5H5Va56t0lM5AHK6ZVeSF+nUxPqKy6bXeHsRwacRbmXIeL8WkRKis5sqvLgJpQqCLVbW41TV4ncDv0ZtHOpfkk+DWIbe7kuEyHeBV4ihE0m6FpFYrsgFKuqjm/Ip07yY9Ot0IjrKQ15vUdPh/TOrnHH5nYKdddVhNidHbiczRF8WbzVcpFW+wGmE2nPC8pvVJ22N13MYIfBcVOMHDKDpqv0Ssvtahk9Lmvqm0XSqiblQKGrog3uiTE068cWjAzI7c2zqVTmKnWzU0L1FYXfdjwXQzzZZtfsR0s7GUtqfgR4q7CFxCK+xE8M9efETNs93NHd+RmrrZTcrx0JtRvkn29GnZUTAuSbGroa3KLzC7ISIOKoqxd7LC3xX87aXybcyiPLWM5b5PZYj/HUg/zpjh5ndw+CfpFDBGkZwcIAAAAYRpQ0NQSUNDIHByb2ZpbGUAAHicfZE9SMNAHMVfU0XRiogtiDhkqE4tiIo4ahWKUKHUCq06mFz6BU0akhQXR8G14ODHYtXBxVlXB1dBEPwAcXJ0UnSREv+XFFrEeHDcj3f3HnfvAKFeZqrZMQ6ommWk4jExk10Vu17RiwGEEEFIYqY+l0wm4Dm+7uHj612UZ3mf+3P0KTmTAT6ReJbphkW8QTy9aemc94mDrCgpxOfEEYMuSPzIddnlN84FhwWeGTTSqXniILFYaGO5jVnRUImniMOKqlG+kHFZ4bzFWS1XWfOe/IWBnLayzHWaI4hjEUtIQoSMKkoow0KUVo0UEynaj3n4hx1/klwyuUpg5FhABSokxw/+B7+7NfOTE25SIAZ0vtj2xyjQtQs0arb9fWzbjRPA/wxcaS1/pQ7MfJJea2nhI6B/G7i4bmnyHnC5Aww96ZIhOZKfppDPA+9n9E1ZYPAW6Flze2vu4/QBSFNXiRvg4BAYK1D2use7u9t7+/dMs78fhzNyr7JsFQ8AAAAJcEhZcwAACxMAAAsTAQCanBgAAAAHdElNRQfkCxEVLTTFBz0eAAAABmJLR0QA/wD/AP+gvaeTAAAIdklEQVR42u2cCWwVVRSG5xXaUlkEKRYQEIQCKUtoLchuoyxCQYVAIjsoQgoBZE1RJLIVKCJrFFBcQESIsogUMIIEbFUiWwkgiuACyNIACmUpy/Oc+E88TubN3Hl9A68yJ/kTOvfcO49535w55947T9M886wo2+NdNurykSJJ90MlScX0ds88cwO8SqQ00nrSQdIpkh8qIB0nZZMWkh4nRXgwehYK8JJIK0m3AdsNUi7pC9IqaDUpxwDlCQBb2gPRs2DAiyG9TboF7ST1IcWKR7FRkQB2Guk8QDxKau9B6JkT+OqSjgCg3aTmfDw+cZSTMe4jvUq6iug5nXNH7wp7ZgdOPdI5wMfQRCgUJVbtDZAz8nhLvEjomV3kyyNdJ/WzggX+sxEpqwbyq5M8nv3Kkb4GhIu9SOiaPU/6kPRKUQSQc74fAUlvu0hF7eUBKvtPVfDnqZo98E8LcSRcStoP5ZLaKfSJIe0Q/VjVXLi0saTapOp34GtcRPKTtpm0xZH24v85xuG4NUkHDNdK1z7STtISUk9c16Ci31LAMVkBJlaGqHgvcLWrcB4uYE4D3NohvPBf4cLr2qDQp6Ohz21SLRegmIbxj9xlAKuQbqJ9tsNx6xmulZV+JzVzCmAyCoXvVB6PiGanBYCsQXbgNmgxg/um4lxZIYyCOoBXAVI+Io+VbUKfKy4DOBXj//A/AfBNUi+DxpK2km7B5yKpspPo9ymmWpIV+/QzwMc6oAIUzrcWfRqHGED9Ecz/HmbhX5F0HdB94gHoCMC+Fn7Dhd8kVQCrAb4vHQCUawIgq3O5iikqYyTB/4MQRUEdwFyA50duEshGw4fzmgkOACxFakMaijzqBVISyWymIJpUiTQX4/9EehDHdFUw6cdjJZC6k0biPAMszhNOAEaSzsJvrSpMIwBDDzv/GvXT2L9VAPhYGxUh9mFqhqd7IkMMIIf+GwCqoYmvD+Cx/2DSRAUAi5PG4dHiN8kdObmvb+iTgi/8lvArMGi3oQ8/yk6IPsbz8OdODGMAfYj0jgDMQlHwgKL/BgsAObeLL12+ico4mejzWIgBZNuIvxeY+CaK3C9OAUCOOmvEF8DQZJB4Vn4e6TSO/4UopRsn4r8KaBm4Y6TjQpsM59oHSHIQOTn6pZNWkC5jnPwAN1Y4AFgNNz/7TVGNRIeQv6kUHzXxuPZbaIliFOwC/z4uAJiKv//AY0HaO2j7DH/bATgF7fzlvWjSXoaUDZ+DJufT+x9W+H+MBChm9hDG8JuAeycB7Bng0fso6XtxM1ZXgSAK1exmxamXGTbwsS6RyiqcOxH+Y10AMEbkIp0NOdw5HG+rAGCsiDyvW5y/qrjzn3WxCGktQKh8lwC8QDolxDf5eaQNN1EINlUtQMoCgtUKAJYh5SsAyBqlMF4N+L7vAoCaSP43i2NdcOykiFRWAA4RF76qzWfIgt8iFwGMBgBmoIfDPOAl0lua6oS+KoCIfsliOc1Ov1mtId8hABsacj227TiWKfysAFyGNr7DW1iopZjO2VpIAKNIT+Bzce73OeDOQm57DeMNuUsA8pOggxBP6PfADX9WPIJbqADIO1Yuk9YpAMjLdJtIE0lnFCDsYTNmXfhluASgntTz8RGkh0V1nKAI4HYHKwC6vikEgJxHHVU8z4gwLEJixec/hZTHEsAI7NfbEwiWZh0/Yr9nDLufF6LYuG4B4DarwobaOsFvoIsADhXFQbqoYjVFAHPQlodphXUKygwSQL5B/hQRdz6pN/K+ZMCZhLZwBVAT87D2vohsO7CWG2Xhs9kEsLOk0diiHwjCBhZjjoFPGxcBjMOKh1/kTgMcALgFbccxx+XmSsgCAXsVi8fzhTAHsI3wzVQBcDpAaG/zqJS6RpqF7fY+TE4fMvFbaRZZcd4cRNAyLgKoIXLJJLm8AwDnir6VXAZwP/wWWvjUwucMZwBThe8bKnlgY70YMMICUN41QMX5Ym3pK7bjDxZb8f0ALM7knJXRtsWFpTijcUJ/BhPGy0zarQB8UlzMCUF+tsno/7ON3zH4TbPweTnMc0C2mcJ3uOpqCOeAVxgMQ1ssihR9s0E7hWKFK+u5yBW532smsOqrIN0TU+a7DaCdWQHIlfwutF/WrJfB9HlGYyozSFTjVtF+K/z2aOZrvvGoLsMZQJ4augq/a8hrra1Ju/cYiK7GKAhQ0pEfDnPymiX6JmCZjyvmEqItHrAfdmE7VqgB1C++Xhzko5ipgsnuGFR+PA0zD37G/K2O9u/a7i5EBX0bUyfh1198ySswl8bXjSf1n8MUx0kxMV4YAA9p/+ycttJSzDsaAcw28V2DCH5bXMuXlL8BvFT+LSDsKt71GMdrxMGCgjF4/1/rEqUT9Ko7G+d5uogAqCHyGadHGLaLYgVEl1kBkS6+fKn9woc3PKzX/rv54KKY9+Pqt4mYaysMgCpi0Es6nIjWC7Zujr6Bpk8t19d58xHxGoXyVw7EryksBnzLQ7wlPw2PlTFB9G2LvjzBWsFmFWIgKuNfAEQe7vydSLg7AKRA0yy8fSsD5zP7vBGYYOY11XMYn6eQ5ogiaBL6tjI5Rze0pZm0cSSdJc5tp+kinaiI/18g3xn43Ly/s1hhIOmOHS28Plw/hAD68K6wH/lmtFa0rTiAjA76gtubPv698wIXIOyLHS9czaYUBkKMF42Npwzf3sI80j27dyDsjRfKGZqZpFJOocE4LcX8YI7KnkPPPNPhaSR+HYEfyePxA0WmL6OL41GYmM5CJC3A+8ORXuTzLJjH52jxo0MM1C7SHFTIA6FBeJ3zY8NGhTXYRaNFl4z3Lqpnzq1e00k6iP3xNluexdpvAR65vLzX0It4noXMmqeu0qNiCTyK+TdfekG8s+URbFr1eeB55plnYWd/A9wS11pWJohQAAAAAElFTkSuQmCC",
-				LogoFormat:       "png",
+				LogoFrom:         &catalogv1alpha1.LogoSource{Bundled: "metallb"},
+			},
+			DefaultNamespace: &catalogv1alpha1.AppNamespaceSpec{
+				Name: "metallb-system",
+				Labels: map[string]string{
+					"pod-security.kubernetes.io/enforce": "privileged",
+				},
+			},
+			ImageRegistryKeys: []catalogv1alpha1.ImageRegistryKey{
+				{Path: "controller.image.repository", Registry: "quay.io", Repository: "metallb/controller"},
+				{Path: "speaker.image.repository", Registry: "quay.io", Repository: "metallb/speaker"},
 			},
 			ChartVersions: []catalogv1alpha1.ChartVersion{
 				{ChartVersion: "0.14.9", AppVersion: "v0.14.9"},
@@ -136,8 +172,10 @@ func GetDefaultCharts() []catalogv1alpha1.ChartConfig {
 				Description:      "Trivy is a simple and comprehensive vulnerability/misconfiguration/secret scanner for containers and other artifacts.",
 				DocumentationURL: "https://aquasecurity.github.io/trivy/",
 				SourceURL:        "https://github.com/aquasecurity/trivy",
-				Logo:             "iVBORw0KGgoAAAANSUhEUgAAAG8AAAAyCAYAAABS1YVJAAAAAXNSR0IArs4c6QAAAARnQU1BAACx\njwv8YQUAAAAJcEhZcwAAFiUAABYlAUlSJPAAAAAZdEVYdFNvZnR3YXJlAEFkb2JlIEltYWdlUmVh\nZHlxyWU8AAAaG0lEQVR4Xu1bCXhWV5n+znLvvyX5syf/n0AgBAJhJ1AoUNJibe1CO1pra8da61L1\n0er4ODrPjI/LPLM48+gsjjO1Wn3cqpZ2WrXVSulCQxcQylKWAGVPAlkJWf793nPOvPdPKpSQhRbm\n0Zq3vQTuf+6553zL+73f/W9oAhOYwAQmMIE/E4ihn5caDAcf/OsELhYuufPCk+cWKF64gKX4YkPx\nCpxycPRnP5zAW4KXEZcGZfNCtqIKw2iZtv3Xkt8/j0j3moH+Z3Rn0zoi9wBGeU7U2fETuGBc/Myr\nqfGRNX0S13S9cd07lFJLTbSijC9bMU3MrZ9BeQWzKMPmsLRTZpxUEr47jau8bJzABeLiOq/ssqk8\nI68mZm4ho5cb7VhMpXeIFSt2sxtvDvCaWfkslBNiufkzWV7JHM6DFbqnN48o7WWf50Q3O88ExoWL\n4TxGxYsilBtZwsj8BRlzHbh4Ms4e5sp5RPcffEw++ps2YefPpGBQ0KRJL7OSsk5WUFxCvuBlLKdw\nIfFghA/EpTFeJlICx5+AE+stilZUUKiykuxim5KrsO4mkz1fXj6JfBURyivhFJ+ZJioWVFnlo+ll\njNraiKrw99xZFvW3ulTV4KdgcZTyKssoVMopdgXGe/OMjTfvvFtvFRS3whSaDOPTbYyx25ihGs7Y\nQRLmMdz9CePv3UH9/QP8U1+OsJ74apZRfgrnrhfVVRt1QUEPLywJs0hllOeCSoVvIXfYVO46ZFS6\nD3fI4FDZe/3Roc6mEt9sydl7GDPXCs6LdaCzhxILY1Qar5VGvJdJs5oRyzUB15DfrZBkyoMDOpmp\nr01TB6shw2vIH/VLSs3gmt2AeVYYZkoo53SCYrW9RMfH1AJvznmR+iC19C7mit/CybwHZ6pxdDDD\nnhbcPK6iO56ng+0dcFw2g/g1d1dzlVmllTudUinDHFS98tJmUVOxhXKLmkGlhaw0UscKS+cyGZjK\n+t1pLEMhQ74YKBUb+aOCoKKKhVLSVQjQKALXRYmQjHiEBROVwqJZxhioaoPzZBluZpNk81FKAqRl\ni5pd2SuOnZ7Ltb6SST0TDDWdDAuj9ruc+GRmTL6x3ROUavMCeFRcoPMQceUVtciuG/CPm5Fpi7DA\nDH6+KJl5SElrvT65tYXa3qgg+Yf+KorIWsy4yScue5hrcng8mUepzAAvDrdQJNpMRSV9rLDYYoGc\n+ZQTridpzwQjF5AokCRL4uR0w5F/DFjm5zlqDTG22HB6RdvsMQizJJrYq+HIqzDAxxnf6hpnHWNc\nMNJXw7Fz4egks8xevfnZdp4TnY0sgw31PHwmFaeNRmU2CBJR2HMazrSbmZWtoNhRs2+czmuQVFxU\nRrlilWDydk7sCtQ2r9o972P8Zw45jbp95xFw+DDViChkXz/WN5m0Xg4ayTBGz2C9zcR0JXPcuSYR\ny2WadlBt+VM8XNxGxSWFrLjIb2ID2rS3h1kiNY0H7DJTNC1DpdEY9Zzw7nFWTUAzQk2CGnAcHzyR\n/fNSobLSYkYvxE0qmVKHTIbtJW7CsMlSOCQKh/YaLvYZo/dwkkHstxYrCsJYScNY3AQj3gJnIphB\nnZQxZE7DSIdI0gmE+RTYqJw4bzFGtmCvqH8jY1x9nl28YLoS4gO40Y2IuAzX5knYbIPL0gepc0/H\n0LARIZ88vERo/bfGsuYja59HJXvKSOoRzExRRk0SGd2qpdVkqsr8wuerovYTwn344Z7MI2tt1te3\nkgWCy4lZJ4V2H3Z8qSeotaknO/GtJALPLSxzSEZdy0GQu83UsacTu7qUDhRWZNEC5dJq2AO0yQZw\nLgflo0xzc5Qpz0nkOW0fGWExUjUYN8AZ+RDHUW24g2vixqhWOK2Zc12DDI1qxTMIgmmofzGk1ENK\n2C9Q62ZPwI2IsTOvDlSZsi4zxD7GmKzHTeMwzkYl+e+pfefJoVGjwnfHZ6KIxGWciwrDGWqjOsA4\n+jtDWDPvYyTTmFu6Uvam6/wvOpPCL6nN6/dLGQ4wX2A+CX4lInKOd29LiC1qoH3QeafrA65iqxAI\n7xccgSFk96JfRFraxqCbtwijYwWnTFDGSbA8YrwAgewaI3bDGeuQXS2kmA/7NMjCUozOR1ZuZVzt\nY0oEjCBpND+M809TV2y7CNlpRqJIM5GDjE4gsHdpElupbUv30P1GxNjOy0RzwcvzUHDnYN0JZN4R\nJGwhllYjcipyTLBcUTiapljbeRvthg0bZKubP4W4XkDKPY668EPNzDHOrFVc2kuZ9B+jAraZ5flO\n8ir/gHvFOxW1OZN4KLJa+/1rSIiI0dSJzSJwTScic5OOQwx5KCoNkTI3ISjuYoJPxTqbWELs7vck\n+IWibF7IlzOlQvgjZT5WYTnzIumRa06XokS0m8LiNdhkJzF7G6XYduqZ2kJVbR0gzkMk1FE4tgx1\nvhq2OqDae17S4ZwmkmobCXsXtb+CnqHH1VVFXToROkABvhOlZZNJO5gn3HVx1GZBBM7TM5H65XDg\nJsP5w3AeGIJdzjlbDjVVQ9oiyp3aQ7Fmr0d7A4rvvVd09spqUMJSzNNpUeLRVEnyhO0WFGjbDuj8\n3Ja8Bfn7+spCPXzphnLTPnA9BXPfz6SvgXOvXTC/M456BtkewP1Jab6VEl6z5KE0QBZbDtppAE2B\nhU1j2Grf3tfXd6HOY1Z40mz0JR9GgNxsbO3TfakWSnSNIpLg2IGTSQRtH8Va+yjdCopDf9YFx6Y7\nUxTvjFOgNI8bHgQjHNKxA0cwNkH9HQPQBh4dDlK7Nz7eGqdezOHN5V07Dsd5GO+TfgQ1aaYZFFBp\nI2WstfDfY0iGgygv1Yy5dzFKf05El95EJUvKh67JIueJJ8C4+I+YH1kU1LpA0OLFTqKh7Fm2sPBH\nzD7d1HX1bbN4Ud0HnZZTHzcKTb7gOcaSW4iJtZrxR8nI38P53d4soKYza+ZxTdqk0BgmvYMZp/f4\n8ePY/AXDgL/zEQB1qFcLNVNV6FV9Q5+9ecjUfq3Ub9yAfRD/uujfqow9IRODEWKMDQPmUGGPj3o2\nn9CW7yHF3G+Aox9FsY4zplcY7X5IcnWnLF+4yh+dPYkqlwUaoYfTpcVt6XD4xUxB/rZEuGBQQXHO\nk6ECn7tsVR17df8txrJvJ1+gjqQ8hCT6nhH831Vh4llq2waqdnNQV3JxIAyQfmcDN4cAQKRqxJGw\nIxSBslsWyB5evfZGfBVHVZV/8Hw2BF4H/g4ljUMw1o55nmWM/Qp0t420SHnr9+iUqGY8jvTm8lNJ\nXU72unrvCUxuH02xj9Cd13j1a5RsQsh4473rqNJb47i6gLEH5UaDTOtaGG0GY6LDWObVwfRHXYl3\n9Br/5COo1/tQiDtgxjIsYwkK9CJsvoC77mnUp2760id7A0faj1iu3uesKu+h++4LCf+M5TyQeztk\n9Q1oHUqIi51G2o+Q5E+aE1W7qX99LEsp2TWUlsChK/E3G/v8/R9oM1CNbGb1zPAl8GoAouhUKpTL\nKeRU8zx3mkj6g9ryx2hLkY1eZxbLNbOs3IhURTNiFM7LteyqWp6fmKJ5iinjJpnkGkHaw4U+pJnl\nNcnV6L0u40FZbvzhFCVPecryPKjy20U1NRR2F3BpRa20TbKfhVlc1PJ+U6W3N2u6blmMms7/2Kuq\n6nl/si82FTabb0LoJXyTMpRcHh/rMdnZUXh+ROqL4YQ1cMr1UITbtOAP0Mmtp4Y+PYPCy/KIOwsQ\nwksR51ClDELQPHprij239vTRHERXFWpeop8iLeFKKDTXt4YpfgXGdiG5N2ltoF53eF8TDY/QsnlT\nmRGfxxx5UGrfou7t27LnC+rD3Kc+Cod+EoHlPU+F+mUdyBwvsz0BtVG4yQeZVilHBu/IKl6NQFNq\nP5eyQnN7PhRsxjjJX1rc2m8s//VKqxmg3+dA1xu5IU/l3gPeT8CrPyVf6BE63jiMlu2i2lolgh8x\nwod9m12C2K+0myyDgL6JmETAibWlLP27No9FzoNQ6ZyyDNk3KWldD3Lp5Vr/Qgk+Zqtw8Xi4Z0s/\nde94KZzvv99wdb8i/QLqYsdXYqkpRFM+TDTj7wzN/Jyg3DUfORUoBMsdgwj6tSH/f+n81M/huH2Y\nZVyF+o3I0qiXoR6LFMPBuB9Vk+FTYPBiwyE+DM/D1POx2WvhiL9ETf0Cfn4ewbQGx3Q4PaSJl2mt\nr0ZpvwMTLiGRhNE1mmhdxYR4B+Lh3VZmYDbmfqPNQHWuCC4Bg7wXpL4KHUI+ZZwkRlVg3qsR9FdC\nZk1Jp/tAz+eHm3YCLrEZYK6rmDYroSWilOiGChwdF895g1CnDrw8oD766kYq3fEgTd+5Zbrjm2TI\nfj/sc4Mm/y02sXd9ycGWOovWU9eKh6jjpb2gE+8h9JuCNhpOgxgikzQaAWPMDzQ538fxI45eysnI\ndkdqjjYFxmOSCRlBZtYiQxytnQ2YYC38vmPQ/xr1TVu4ziLHVVoPbIWTfw0nJmCoy41yrgULIED+\nAPi+v9ZwuRqKrpK0e9Lo1ItuKt6CeRWcYaHkCM6U6hH2iIHJwNO4rwvRhZ8Gt9SaekYe/zoutvMG\n8ffIoF3oCRu9jj7kIJITiGB4TDtS8P4pc9IpY54GJXx71Mc/Y8P7ChB74MaPBIyhfXlaGvd7oMXv\nGjf1A9Xf/xzl9/egyZe4PYbCoLAozNOE3vE+49I/aUf9nGTnaxjDoXi8/5Oo7xpCTVPXoSNcmcdB\nraBzVq6ZvBIRMNW7aRZVDT7u+Faiub7GIwD46yk7o9ZTwquNHncbULfKkFYu6vqI9QuUr3Fz79Gh\nZw+M54O1fgxcdOeVUEdOxNf+znLZ+QmfiF31HF3eysn9Ftb0b7DMNylEj9FnWmxzkN6tD9AHzR5a\nYB4en7o6L7IxgX0Y5qCAt6U7Xj1G2WPXURrYf4oOHXJgDJQh5mWeQE/Qbch9zLjpB0DzG6nn1RNo\nxtFqIDvBtZ5zszCWZxu0fGYP+sdNOD0AUTVXG7GCwlX52THJeBQUvJJxXoG4aEVD/nzq9N4WKvQe\ndXrN7wVhbP1xDi6i84yooJZKW+jrwEAfYaTeWyATM7+c+5l2Rq+sfToU+8Yj1PMddvvxjVTfrxCT\ny7WiD6GbvsudRVf07qKCoYnGD628DeOAwRnoU+pAQ4Mn/c+B17J7D/iRbqgnhyFcNpJn5DPAHOe0\nIFBc3o9kQdkpTP8UmvedaCPKcP56yXNnQckFuXFXGsYX4Vpki35R6NSu7LUqBDbgQ4EA2SPQbnFr\nxMzLjsFuhq1hDFwU51XRUX+UOi4DrXwWe/4ksiEPVL9OaPPi/oGKXlZb5Ls2kqm+rQqK83sFLptH\nh8Hq6yHMd8FCC0EZn4ccvVNvpqlmAw03/pAhhwMJkG3as/ISzuEsFosNHwsJDPcI2DKNFG+2CD3d\nWDDW4DyH1qWVEJuYqzeRUhCDcJbfXirLy5Yg096FrJyGYDhoNPut0xE7nL3Gg4N1Kc++XukCXds5\nI9cwTIT2FY7zjvHjLTuvgg5Nd3ngLi3Np0mYxYidY1jNTxGJPz7hlCMSmaK+ybUUs2+jtG8Nldth\ncHufnENPIyO+j72hppCC+W+kMN3rFtN1eg8VDk0/CFgGfw53Ch9AVGef0nu5he0bu7u7+1wK9ooc\nHIvrvcdtnOLDGv2xcHJrDyn9MgTRdhg6R2lzi1EGLYRYhbU5TLtbLC62EmUfew0iuy4c4FRQup8S\nXcODcghJ43JIlRBsAeGVTddx4QKdl1KUuzWrDItI50boBGgjeLfm+nZMhEabrROW/uYMXfZIB0U6\ns5fQrSANlsc1n8Y0ryKHZWsBHKj5AtrD2+i7yMBvw7SvwYmLkT93Y1W3p3fSHNRD7wkJUVdhAv5T\nWfOfjVBpBoRzEsZpBy8FMWB2eyocGfr0fPAECYwpR8jkEQEdCEUq6HFk0inO+EqUz3czzqL4aBek\nzoa0MGeyOTeQRii1Ifc6sa4cZcws29gjr0vwYqyrBqtDS+NlH3hpHBjbeQZ1xaNvDsVkEm44dbOo\npHiFn3fegQ18UTPWgHqwF+O+bUnxk+ZMZF8jZTNhCJ2eoRD3XhRC350Dfi3FWR69hEHfQUl6ECOx\nV7oD+vCz2MVqbCNUWeekSQuICrj5bLRuTkNEQlCAfg2hBvFrHCPf4TXv+PTsvQ1mrpd92fMj0XAW\nXuQPj/7T204gtV8ARb6WZWnL9h5joXmnjUj1jdnHeK+jdXMK69rLDNuLG/nh7He40qymyZO9un72\nukRO+YISlMeVED7zYWdPFWeyrULJ0IhRcPZE54dHWSwGsk9lhJpaGGj7itfTfAK7ex/YBxLN/FKS\n+u5J9fKTzYnSNtjonI2XenyO600K6eYdwwzDplKKz6a93KaHsNkHUCs2YWGTjaR76BR94Bdf2bco\nHHR9JgkpnRBny2jYLv8QRPpzmPQIhMF0bOnjqJqfpOK5KwiGwRgozbRXfSD/B68YBd6I149zoXxK\nHABjvACjZFkFjjyKHm5Tqn1Hc3bEGWA5WJd2GhGLR+GUaVjXPTxdcI8smb2MyuZPodK51bJ4wcqk\nYZ9GEtwNdq3K3haTgqKH2eh8GNt54qgiNWC4KiqynBXLhTP5Rkb2NLQmTdzi9zu65/5WZ/Ju+HKs\n3gQry9auEcFnUD+fS+tBkP+J4P5fFkB9CtCqqeXx2y+vG1goOOpC4hzndzXGrJTbiKT+ORn3MKhs\nMWjsC9wKfIEL+zoqqStGNCPjPUWH2sN1AP3weUSRBUHDfQguNOoU9FrSwQ/OIFHFuuG8p5G3TVC6\nfaixLwqm9+Kj4cb21mViG1AdsC59hHEJVSr+Wgv/3whu3Suk/14t5Bdx4WdBCYsGmVJ53ABpNb7O\naWznFQ+ktMWh0krLOQ80ONbhasZ7fxN0yv8lL136XBfNuegvBvGFdIJm0lqaRN9oOWod3nM0cFVB\nvr7cF1AQpdYw6k337T4qferHRrvfR6TvYtLyM26tQr25yrJkxFLSgcz3Gmcv8z0rnRNEtzKSCArD\nU9A2Ka2zY4Zj2zbH5ak9hpyX0bg3csaeybTlDL45cx6kuw4fljLzE6zrh3D0bgaPwYnvwA7vxhI+\nBKq8AutSRjne89Z9cCCo16vJbFzeG9t5tZQilX/MUOZgRu6JDeQ8kHuy7P3sYD0/3eT1N5cInJsB\nzq/tv+Oe6b7/WFse2tsc6IIA2E0Bdb5XAU26eddR7ZifMq2+Rq6+D6fWo0E4xqQ/zbmvz3vJCVmz\nHrbZzpk+Z446w5To5My8DMM9hXbgVQrw8wflyf19gpnHmevcr5zEJqJRHx6bdMvewzqd+TFz1de0\nNt/WRv8OGbYb2egp8Segn/4BzPCPcO4GBFsfRB8EHXbaNTTDKBiVxv6A8A0F5G9bTiK2AsupZsLO\nGNc+IITcqbTcjWJ9LuefhVuFLD+0Apz6CSyqG6r469S9feib8POh3rJK5SylMvN0UiygjKxAC5Im\n2+yR+c7zbnXeHmoc/mT/LDAqnFtBAasS1yQQzc0UCynyZWqE0aXK1VCFmcPU1fRG59QsKJEJOV24\nJmS4OJGRJ49S61nS/wwY1dUNPj1pajrnTbZRwUHhpfhzstC+Qq99UTbWospeo+QJn/TZHzbCuheU\nHAN7fl13Jn6FG4zKauNznqfOqpiPVKCITOlSpvKvQ42oBQP1I0M2k2CNbsIcptU7OuiR7BPes9Ag\nZXkfnMc+PqrzvC89/azEToqFitNqROQc9FUZk2CbaMC/DgLgADXEBuC4YbQ5AgQ1NDCM9yjQUH29\npG19YJoKrK/RW+O5RudUf4+gbS/CJiW45rxj3jq8L4b/vm6ong4631OcKcM/ij1/Cj7tQ8D9q+6K\n/ZLowAjfHw5inM47g6Kim3L7Wed0Jdz5UJCXQzx6b0snIEW2kUVPUSy+l06dfdOvcln++MqhzDsF\n5/3zOc7j2Sf1zL6CkVqFyjML8hQRz5u41FvQke1KV28/To1v319CCRTOrUxZ1icYEx9HuHRCeCHz\n0mNm3vhkzVlIJg9kdKK1zcTzm6D92iBxHfQ/BQiDWnR3czi3J5lglFOYuikWQ2Q1Gh6MRpC7S73L\n0SBvpMTJwUVh0SI3eiVx8T447Up0GSWc2DHN+FOk7F+b9i0vq/6203R8DIH/Jw6RGy5G+VkDWy5G\nTW6D+nzSxAsOjPUi0gU77wy8t5462k1JdI9R7n5uUGgZ+ixDc6F2kY2hHCooUVQQyQjvy1Bj5mX5\n3FVbKBzxU6hsqbTkjdDG3ou8MzBhh2H6t+jZfga18gp1bBl6QvP2Rj1q/MmQXIms+wDanAjstAO1\n+TEd3zyKLhjEBdPmiCidU0YiNJ1TZjnXdLnmIh89/GHo70b0Vmmjzbu4Fgliap1h9mT0fNdAE4dR\nC48YEhuJnK3kJFveSLlvE3j1nFuTLMYCPmNaYm00+EskEQoLbZaiXbgH9e4G1PhuMup/tF/9NzXv\nzn5ZORounvOGEJy8KOI6+jJFfAl0zjRIYO8xUoCYrkaP7qKevYYsTWluYmhwd7lkXiER2D7W+xp/\nsoDjQG+rNbPXMCFKmHIOGFcdQaX3Gstp6EdXIOsuM97vLRjnQfR791P3rldx5Zil4qI7L4v6eivU\nki5MSWspepirjeGL0XpGNbpbLGkfWtBNWuhnyNFN1LHLc9rbt6ZV1hXyjH0ncfkx1PY6pl30zToG\n16G6iByUCB9Kx0k0/esNc39Abfm/h04Ylzi7NM57HVVVfl+iqCIt1VxOshY+imvXbCcpXkNv6L2B\ndvGl+B8dGvyyJFavBF0PJkLv4tbA6HmeyIb5T6PO70dP/pTm9DjNyDl0Aa3QJXbe6/BeKO3mRaRE\nBvQ4+Esifz5gFLkxQLqzjCg1Ff+skkxCnRvNDO92KN1MSr1GXU1jf0F8Dv5/nDeB1+G9GS2pO0eQ\ndcJQRYVCpl2ahwETmMAEJjCBCbxtQPR/JL/q0UMMYAoAAAAASUVORK5CYII=\n",
-				LogoFormat:       "png",
+				LogoFrom:         &catalogv1alpha1.LogoSource{Bundled: "trivy"},
+			},
+			DefaultNamespace: &catalogv1alpha1.AppNamespaceSpec{
+				Name: "trivy",
 			},
 			ChartVersions: []catalogv1alpha1.ChartVersion{
 				{ChartVersion: "0.14.1", AppVersion: "0.62.1"},
@@ -157,8 +195,18 @@ func GetDefaultCharts() []catalogv1alpha1.ChartConfig {
 				Description:      "Flux is a tool for keeping Kubernetes clusters in sync with sources of configuration (like Git repositories), and automating updates to configuration when there is new code to deploy.",
 				DocumentationURL: "https://fluxcd.io/",
 				SourceURL:        "https://github.com/fluxcd-community/helm-charts",
-				Logo:             "iVBORw0KGgoAAAANSUhEUgAAADEAAAAxCAYAAABznEEcAAAABGdBTUEAALGPC/xhBQAAAAlwSFlz\nAAAWJQAAFiUBSVIk8AAADcBJREFUaEPtWWtwVdd1XvtxHvde6eqFnkhCyBIIJIxAGGyMjbETjPEQ\nJ07FxOPYnSQtmNDWdabTjutpKZ1J21A3oDY2djL+kQl+1KaOaxc/4hosGwxC4iXeICGwEHqht+69\n57X37jpXcmpjEEK6/On00z1zrs7ZZ+/97bW+tdc6F/4vgIyeE46qNUqjvPsOYfBKcFWzJdtrTzxf\nMTx6O6G4KSTmrb+QR1jytynXv0+1QAl4TrsSke2ePbT90NbpJ0ebJQyJJbFhF58/UHE3IcajlLIH\nCGW5/gj+IFLKXhDiEwX2tqh99IMTzy9LmFUSRmLu2pYiboYfpppRDcDmE0J06QyBFDYQpgHTkkFB\n/HNKKuu30nX//dC/5jSOPj4pTJrE0qW7zIE55cu4pj1KuflNQmiW9GwQzjAoKXCEkSH8E9VCQPUQ\nmsUblkp9LIX1atTp/ODUc7N74o0miEmRuG191wzJyXcIM79HGQoYuxP2AE7SwSUn4AoCtqtAZwR0\nDY3gG4Jw4Hpy3DpSqibpRt8iynq9oX33QXhjNbK+cUyIxNw/bEnlqUn3EMofoUxfTpiRKnzXcSMj\nDuOTwbPBAVKSGAzHJERtBcgF7/hkJFolCMxIQaPYDl6oVU70NWKod+ufze6ID3IDuCES1dWvszOZ\nd5UxPfAwEvgeo2y2P2VhD4FS/lxI/M92cZpKwYJSHZbdasKhcw583GiDJyUENIpP+ESwNaHoXklo\nFQOUZ19UIN8kwnqNtTmH920vjMUbjQNs9HxdlK2/mGGHy1YQTfsLdJ1HCeNTFa68cP0gI/EYISBx\nfpYrIR0t8ODCINw9xwRTA2i65MLlQQmMkvgxsn5IB4WvhAeUG2G8sACoUSaTDZJ/24872/ZvHsRr\n18V1LVHyp2eMZI/NJGY6uk7wYVzHGUqicN0ozsF34f/twhev7zaUqPjkVy8JQUYyg6gjYcf+WPyI\n4P2QeeWwvmUIulgAo1gQ0Bc7lHDecVVkG42phgO/nIqDXRtjW2LDBloUvfNuLZj2t5QZj6A/ZwvP\n930Lb44M/GV4aBAHhVyYyWHlgiDk4znqC5sTSA4QaOvxoKN/hDinI8+MwO/Ht4qLEc1B7etJhLB5\nhOkVoJHe0hnrmi8cqfFG2n4dX+nqSlQdqDIpsNsJCyzH+G8KZxDU7wlcAZyH6ymcLIWqEgNK8jh4\nOF8Ph/aJ5WYwWFRmQGYKi7dDyVwB7AA/SrqoMfQiblAeCC7EsZdYPJAy2uiqGJPEgaoDFgi5XzjW\nTulYNtNQhNzEO1+1gA9/wr4mZuZrcGe5ESfjYojyNeLiPT/M3jbDgMpiHcMtBSfe/iqgGn6SfKGD\niFkNyhN7TC+GcfvaGNudamtV8I5NbZrqOkgUiQCmEZSZ6RQH8lcMnRcPf+ojVtBworOn6TCnSI+v\ntO9ePnwdC4k+j+fOPgktnR6Kf6T9CLAxCspfJIbRCr93grBeB8va5Oj2x/u3FowZqa66GFdD5Q8O\nZrLwtHv8pI4oeh9Gp5BwUR+e714jk2RoV99d8tIZFGZxWDjTgKwUCkO4TzScceIR6lKvRCIeOOhm\njPk+hbsKNdF7knHz8+2p9uJ2/7KMWu+ioD+Pd34djDvEdhx+Mdpe13I6864HjjAJ6LQqm/DAFIIR\nxbcKxUjlR6feYQln2jyIOQrKCzjkZXDow2vvo2fuPm7DQEQCR6FT6psJFaenxFcfE8SLqJ6XHXt4\ni86a369/rnzcqciYmvg63hBHNmUcZwMDNdKOPIPRZDu6Wb8WmDKSE/mriuHVJyNQDwLn6X/HPQ4F\nPqJkP/zGd2xmgh7IQitwGxfhI+FENzrDXf945BdT9+zbvHjcG52PcbvT1TDvyUuzqDQfpoZZjZOd\n63fnxAbBwkxiejaHx+5NhjnTNWjt9uA3Hw1D4zkb/V4DM4i5E8EdEKBZee5/Ejn0et2lnAZ4g/gi\nu2HcoCW+ikM1eScvnqrfIp3YX0vhvYIrchnTKIwuycgHt0VU9xeHT5BgFsuMNPzOMIt13hNe5O+U\n0/8PdTW5dRMl4GNSlvgy5qzrKDYNttpVRrUrWEVxLkaAuwBmFwBucgCvfApw8BxqB0STzsWbroy+\neqQm//Do45PCpCzxZRzdmnMuktL5c9tx/gqPV3GyXWZIh3CqDqGw7ou533HcHVbMfiaN2n+fKAI3\nD0tb8x97duAn7zR4R5p6xUDtSdH81Euxn2Z/t718tEVCkTB3uhIvvt0WnJqVsiw5rM+3HHoWE8H3\nFtxCxtx5J4qbRsLHhg2KQhHo5SFwV6+euHCvh5tK4vcoOWPMvjeUjcLoPfF8NpZ/V8sgJ47EklCK\nLPhxU75iabmeFmg7sjnU5l+eu/bzqUQzv8uYtF3P29U45VdNsHHjaGY1eSQsOsXhb9d6+DZu8H/S\nifM3C//o1HT/cqAHehmoA0SSqE5E4e2D1anx9gnCpEks3bCLz32yr6hybWsp/svMgHEYLXJcSS9L\n0aQcNA8pKC8QAW61Y+bR6Fix5vvD5f0jTycGk3Ynv3xNJelPYEq6klBtpzPcu83tGYwZeSn5wrE7\nGl8o6Vqw7mgB6Nl/AlqwCCu2k+DaJ6QXO+96cL7xhdyu0a4mjAmRmL3hmB4cKs723H7r8L/ldS94\nsucBzOj+EsvJEqJkvQTxW4OL2j0/S2/F5qrqkYYpkF30GGa8y7EGzQTPZZj4DRCqnxfSPov17jES\nCh1s+GnQb3/DmBCJqjWnpvCkvHWSknzhif84uCX1d/Oe6i/hjD9CCH0Qs9QMIp1PhNe/qaGm+HR1\ntWJNWZezCXNzscYrRH3MBN0sIYRMwxQ8E7MqicH4qHKc/3JtvbbxheQbss64SVStaQh6oaK0EO8e\nlC19niyY/eeKsT/GW/3S835DlPduw6VdLZX5d8wyeGiVFBD2PGeb/7711ieasoxA+CHCtSRpWU1E\niFYsriJCOUmKhW7B7/OkcKoIJopIag9QucNK6Tp2YmOF/zLruhg3ico/ayrlesbjWL9ZKhJ9lR96\nud1b/KNvYan6A6XkLFz900J6HxJlfQbSauURsE2veKD218Ra/MOTeW5a9pPoQsul42Ahxj4Hxo+B\nZx1zveFjfWroQipNz9SN0BIlRRXFFFcS7zOq0U/H80ZwTBK+G5wuPGJ6SdzVBrVMjWZvAEVXYRm5\nC8u5Gp2mN8aiF9O1UPjb2NGDElQx1mxDuJ6nlDP8SZCwt3ajZtCKmq5Pu8VhogxcVQZGoAxT9Rmg\nvHR0qS4lVB0R8gOXsYaQNxh0mHm/kO5idM1W6pI3Q9k5p2o3kmu+shmzPA0seTysIOk+zQ3N14Xb\n6MTEXqJpOiVkJYr4HkWcdJ0HPlfO0F7lDnwAxDxMOffLuvlSqFyXioaOus2dWUt+FlYEbiV6IEaI\nu9sRsQ9BqJ04/GmsU9Oxv2XoaisYVQVSN5r6vd5dAdC7gPKlQESmF+k5c6m+5pq/Z4xJIm3RM5pG\n+ApGzHWEpwYFm/JZSl/HR45pnKWMF2K4XC5B3g+6UQp6isu8SBPx3L0i5u70CHyqTHa2c++/uBll\nT6RqodCPKKVrMQwvYTSURojRzrzBfRAY2gGS1QFhGcT/YYawuzQIREiA73FlpJkoPpSkjI4L9c9O\njER3/T+7WbdGu7lppGD0WMmZXWnrRk86t3cPRPo+5ISfU4wkMaJVoiJXKM28lzBzNhZ1yUyTDupY\nTS1eL3ui+4aDyUXdWGhHkEQGkrmTUPmQ4loVEXxYU9ZuPWbtUoy3oZvdQbm2HCTpNJX9STS1/9z0\nC9OHT5zYeM18a1zCXvL0YKblqBUo3tWEcD8k1oFt7RD950/Zum7zUG4hZbQSRT6HAinFHTtDMeAg\nZBTTqfOUyQbqyXp7sKslkFGIXORMocQDhNLluEmG0Z3ek1Zsq7JaTqvQtCWMGasUIXXc63t73+br\nvx0fd3TyNzjzcloFNcPVlLFvKCFCIMVZ7GG/HRs6Sb1Iq+CkByOYwSmfhpMrla5bgRopBqKyMW91\nFPCjWHnvpN1ndw4m5Q0ka2a51Nj30YVWYTBokc7gxgM1ubVVPxnMcGJSHL38u8Hx/PAybhJfAAeY\nooi7iEl2N2ZeFRjX8zAs4rz1dqLoWeEOtXClmlQwfNYb7h7WaGoqGLJUSb5IEbYQCeVhRDoDnL1i\nuwNvBWXAEJSulkIuYhDbvn9L7o7RocaNGybxBarWNKeQ5KwC4UVnoLRmMS1QBJ5TgClEGkYa/xVs\nj3CdJp0H9wkrsl+3s7q9lP5iSdQ3geoPYfgsVMJ5D+TgJhV9v9Uj35qKbYfqnwv34rRuqN6YMImv\nAPeT8oJYHnf6ChllWRwJCc+dp5SaQXkgQEB24KT/W1Lxdmj/z5uHFjw1lxHyQ9wck5RwXzhUk7N3\ntKcJITEkEFVrXtSEubKISpkDikc82+3TA8FUoMZSQsk3gKpZGEbbMAF8qe9452uh7EhAZWRmqZjo\nPrp1Wt9oNxNCwkjc/gefBUheaYUjnfsU4dNwM2whLLSLdpw/DenpYcHodxg3HpLSPY5pSU3Dlpxz\no49OGgmr7PZtXxzLb59ykEr6Drp0KyGwioD3C5k39Wmpk9QDW2qek250PfPkS7rGukcfSwgSZokv\nw6/2IpE59zAwHsekr8hz3dec7sivG7fl+i8JEo6bQuILVD59JtN0c2YoV+/1ZhtNB9YS/5eZ/8fX\nAfA/lWU54yZCHtwAAAAASUVORK5CYII=\n",
-				LogoFormat:       "png",
+				LogoFrom:         &catalogv1alpha1.LogoSource{Bundled: "flux2"},
+			},
+			DefaultNamespace: &catalogv1alpha1.AppNamespaceSpec{
+				Name: "flux-system",
+			},
+			ImageRegistryKeys: []catalogv1alpha1.ImageRegistryKey{
+				{Path: "helmController.image", Registry: "ghcr.io", Repository: "fluxcd/helm-controller"},
+				{Path: "imageAutomationController.image", Registry: "ghcr.io", Repository: "fluxcd/image-automation-controller"},
+				{Path: "imageReflectionController.image", Registry: "ghcr.io", Repository: "fluxcd/image-reflector-controller"},
+				{Path: "kustomizeController.image", Registry: "ghcr.io", Repository: "fluxcd/kustomize-controller"},
+				{Path: "notificationController.image", Registry: "ghcr.io", Repository: "fluxcd/notification-controller"},
+				{Path: "sourceController.image", Registry: "ghcr.io", Repository: "fluxcd/source-controller"},
 			},
 			ChartVersions: []catalogv1alpha1.ChartVersion{
 				{ChartVersion: "2.15.0", AppVersion: "2.5.1"},
@@ -175,8 +223,13 @@ func GetDefaultCharts() []catalogv1alpha1.ChartConfig {
 				Description:      "A Kubernetes-native job queueing system for managing batch, AI/ML, and HPC workloads",
 				DocumentationURL: "https://kueue.sigs.k8s.io",
 				SourceURL:        "https://github.com/kubernetes-sigs/kueue",
-				Logo:             "PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz48c3ZnIGlkPSJMYXllcl8xIiB4bWxucz0iaHR0cDovL3d3dy53My5vcmcvMjAwMC9zdmciIHZpZXdCb3g9IjAgMCA1MDAgNTAwIiB3aWR0aD0iNjQiIGhlaWdodD0iNjQiPjxkZWZzPjxzdHlsZT4uY2xzLTF7ZmlsbDpub25lO30uY2xzLTJ7ZmlsbDojZmZmO30uY2xzLTN7ZmlsbDojMzI2Y2U1O308L3N0eWxlPjwvZGVmcz48Zz48cGF0aCBjbGFzcz0iY2xzLTEiIGQ9Im0xNDEuMzAxNDIsNDUyLjU5NTQ0Yy40MTg4Mi40OTc1Mi44NjE5Ny45NzE2OSwxLjMxMTI1LDEuNDQ2MTYuNjg1MDguNzI4NjMsMS40MjkwOCwxLjM5NTM1LDIuMTgzMzIsMi4wNDk0MS0uNzU0OTYtLjY1NTQ4LTEuNDk5OTUtMS4zMjM5MS0yLjE4NTYtMi4wNTM5Ni0uNDQ3ODUtLjQ3MTA1LS44ODk3Mi0uOTQ0MjMtMS4zMDg5Ny0xLjQ0MTZaIi8+PHBhdGggY2xhc3M9ImNscy0xIiBkPSJtMzUxLjk4Nzk1LDQ1OC42NTk5OWMtMS4wNDQxMy42NjY0NC0yLjEyNzgyLDEuMjY4NjktMy4yNDQ5NSwxLjgwMzY0LS43NDU5OS4zNTc2My0xLjUxODQ1LjY1Mzc3LTIuMjkyOS45NTAwNi0uOTkyMzMuMzc1Ny0yLjAwMzczLjY5OTYtMy4wMjk5Mi45Njk3LTEuNDMxNzguMzg1NTItMi44OTA2LjY2Mjc0LTQuMzY0MDguODI5MzgtMS4wMjcwNS4xMTY4NC0yLjA1OTk0LjE3NzQ2LTMuMDkzODIuMTgyMDFsLTE3MC4wNTA2LjAzOTU2aC0xLjY2NTMxYy0yLjU0MzA4LS4wMTY1MS01LjA3MzA3LS4zNjU0NS03LjUyNTc4LTEuMDM3NzMtMi4yNjc4NS0uNTk5OTgtNC40NTU0NC0xLjQ2MDY3LTYuNTIyNjQtMi41NTkwMiwyLjA2Nzc3LDEuMTAwOTEsNC4yNTU3OCwxLjk2NDAyLDYuNTI0OTIsMi41NjU3MSwyLjQ1Mi42NzE0Miw0Ljk4MTQyLDEuMDE5NjUsNy41MjM1MSwxLjAzNTU5aDEuNjY3NTlsMTcwLjA1MDYtLjAzOTdjMS4wMzM4OC0uMDAzNywyLjA2NjQ4LS4wNjM3NSwzLjA5MzY4LS4xNzk3NCwxLjQ3MzYyLS4xNjc1LDIuOTMyNDQtLjQ0NTQzLDQuMzY0MDgtLjgzMTY2LDEuMDI3OTEtLjI3MjI0LDIuMDQwNTgtLjU5ODEzLDMuMDM0NDgtLjk3NjI1Ljc3ODAxLS4yODI0OSwxLjU0MjUtLjU5Njg1LDIuMjkyNzYtLjk0MzUyLDEuMTEzNDMtLjUzNTIzLDIuMTkzNDMtMS4xMzc2MywzLjIzNC0xLjgwMzY0LDIuNjk2NzgtMS42OTM2Myw1LjA5ODEyLTMuODE3MDQsNy4xMDg5Ni02LjI4NTk4bDIuMzM4NTgtMi45MTQ4LTIuMzQwNzIsMi45MTAyNGMtMi4wMDg5OSwyLjQ2ODY1LTQuNDA4MDYsNC41OTE5Mi03LjEwMjQxLDYuMjg2MTJaIi8+PHBvbHlnb24gY2xhc3M9ImNscy0xIiBwb2ludHM9IjQ3MS44NTMzMiAzMDcuMTM2MzEgNDcxLjg1Mzc1IDMwNy4xMzM2MSA0NzEuODUzMzIgMzA3LjEzNTE3IDQ3MS44NTMzMiAzMDcuMTM2MzEiLz48cGF0aCBjbGFzcz0iY2xzLTEiIGQ9Im04Mi41MTIyNiwxMDguNDg3NjVsMjIuNDk1NjctMTAuNzQ4NC0yMi40OTM2OCwxMC43NDYyNmMtNC4wMjUyNCwxLjk1MDIyLTcuNTA2NzEsNC43NTIzLTEwLjI0MjA2LDguMTU0MDgsMi43MzQ5Mi0zLjQwMDkzLDYuMjE1NjgtNi4yMDIwMiwxMC4yNDAwNi04LjE1MTk1WiIvPjxwYXRoIGNsYXNzPSJjbHMtMSIgZD0ibTQ2Ni4xMzM0NSwzMTkuMjE0NmwtMzkuMjMwNDgsNDguODAyNjgsMzkuMjI4Mi00OC43OTgxM2MxLjk5MDc4LTIuNDk0NTUsMy41NTUwNS01LjMwMTYyLDQuNjI5NDktOC4zMDY5Mi4zMzY5OS0uOTMxNTYuNTgxMTktMS44OTIwMS44MjQyNi0yLjg1MTg5LS4yNDM5Mi45NTkwMy0uNDg4ODQsMS45MTg3Ny0uODI2NTQsMi44NDk2Mi0xLjA3MzE2LDMuMDA0MzEtMi42MzYwMSw1LjgxMDM4LTQuNjI0OTQsOC4zMDQ2NVoiLz48cGF0aCBjbGFzcz0iY2xzLTMiIGQ9Im00MzMuNjIzNTYsMTI4LjM1OTY5Yy0yLjA4MTcxLTguNjk4MjgtNy45NTQyOC0xNi4wMDQxOS0xNi4wMDE0OS0xOS45MDcwNWwtMTU0LjcyNTA4LTczLjg3NTAyYy04LjEyMTc4LTMuODc1ODItMTcuNTU5OTMtMy44NzU4Mi0yNS42ODE4NSwwbC0xMzIuMjA3MjEsNjMuMTYxNjMtMjIuNDk1NjcsMTAuNzQ4NGMtNC4wMjQzOSwxLjk0OTkzLTcuNTA1MTUsNC43NTEwMi0xMC4yNDAwNiw4LjE1MTk1LS4zMzYyOC40MTgzOS0uNjI1NDUuODcyMzYtLjkzODk2LDEuMzA4NC0uMzIzMTkuNDQ5NTYtLjY2OTg1Ljg4MTYxLS45Njc3MSwxLjM0ODY3LS40NDg1Ni43MDM3Mi0uODQwMzQsMS40NDM0NS0xLjIyOTQyLDIuMTg0NDYtLjEyMDM5LjIyOTEyLS4yNjU2OS40NDMzLS4zODAyNS42NzU4My0uOTY5MTMsMS45Njg0My0xLjcyNjc5LDQuMDU1MTMtMi4yNDczNiw2LjIyOTA1bC0zOC4xNjQwMSwxNjYuMDM3NTljLS45NDA5NSw0LjE5NjE2LS45NDM5NCw4LjU0ODE0LS4wMDg4MiwxMi43NDU1OC4yNzU1MSwxLjI3OTUxLjYzNTQxLDIuNTM5NTIsMS4wNzc0MywzLjc3MTY0Ljc0Njg0LDIuMTAxMjEsMS43MzU2MSw0LjEwODQ5LDIuOTQ2NjcsNS45ODEwMS41MjE5OS44MDc0NywxLjA4MTcsMS41ODcxOCwxLjY3ODI2LDIuMzM5MDFsMTA3LjA4MjY1LDEzMy4xMzUwN2MuMDU2MzUuMDcwMDIuMTIyODEuMTMwNzguMTgwNzMuMTk5NTIuNDE5MjUuNDk3MzcuODYxMTIuOTcwNTUsMS4zMDg5NywxLjQ0MTYuNjg1NjUuNzMwMDUsMS40MzA2NCwxLjM5ODQ4LDIuMTg1NiwyLjA1Mzk2LjE3MDc3LjE0ODI5LjMyMDM0LjMyMDIuNDk0NjcuNDY0MzYuMTIxMzkuMTAwNzYuMjU5NDMuMTgwMDIuMzgyNTMuMjc4NzkuODMzNzkuNjY3ODYsMS42ODg1MSwxLjMxMDgyLDIuNTkxNDcsMS44ODUxOC42MzA4Ni4zOTczMywxLjI3NjY2Ljc2ODYyLDEuOTMzMjgsMS4xMTgyNywyLjA2NzIsMS4wOTgzNSw0LjI1NDc5LDEuOTU5MDQsNi41MjI2NCwyLjU1OTAyLDIuNDUyNzEuNjcyMjcsNC45ODI3LDEuMDIxMjIsNy41MjU3OCwxLjAzNzczaDEuNjY1MzFsMTcwLjA1MDYtLjAzOTU2YzEuMDMzODgtLjAwNDU1LDIuMDY2NzctLjA2NTE4LDMuMDkzODItLjE4MjAxLDEuNDczNDgtLjE2NjY1LDIuOTMyMy0uNDQzODcsNC4zNjQwOC0uODI5MzgsMS4wMjYyLS4yNzAxLDIuMDM3Ni0uNTk0LDMuMDI5OTItLjk2OTcuNzc0NDUtLjI5NjI5LDEuNTQ2OTEtLjU5MjQ0LDIuMjkyOS0uOTUwMDYsMS4xMTcxMy0uNTM0OTQsMi4yMDA4My0xLjEzNzIsMy4yNDQ5NS0xLjgwMzY0LDIuNjk0MzYtMS42OTQyLDUuMDkzNDItMy44MTc0Nyw3LjEwMjQxLTYuMjg2MTJsMi4zNDA3Mi0yLjkxMDI0LjkyMTc0LTEuMTQ4NzMsNjQuNTUwMTUtODAuMjk3NjIsMzkuMjMwNDgtNDguODAyNjhjMS45ODg5My0yLjQ5NDI3LDMuNTUxNzgtNS4zMDAzNCw0LjYyNDk0LTguMzA0NjUuMzM3Ny0uOTMwODUuNTgyNjItMS44OTA1OS44MjY1NC0yLjg0OTYyLjA3OTEyLS4zMTI1MS4xOTkzOC0uNjEzMzYuMjY4NC0uOTI4NDN2LjAwMzI3Yy4wMDAxNC0uMDAwNTcuMDAwMjgtLjAwMS4wMDA0My0uMDAxNTcuOTM3ODItNC4xOTQ0NS45MzYyNi04LjU0NDU4LS4wMDQ4NC0xMi43MzgzMmwtMzguMjI1MzQtMTY2LjAzNTZaIi8+PHBhdGggY2xhc3M9ImNscy0yIiBkPSJtNDkyLjAzMjMyLDI5OC42NTYwNXYtLjAwNjU1bC00MS43MDMxMi0xODEuMTQzOTVjLTIuMjcwMjctOS40OTE4LTguNjc4NjQtMTcuNDY0MTUtMTcuNDYwNDUtMjEuNzIxNUwyNjQuMDY2MzYsMTUuMTk1MTNjLTguODYxMzYtNC4yMjkzMS0xOS4xNTkyMS00LjIyOTMxLTI4LjAyMDU3LDBMNjcuMjU4OSw5NS44MzIzYy04Ljc4MTgxLDQuMjUzNTEtMTUuMTkyNiwxMi4yMjE1OS0xNy40NjcxNCwyMS43MTA0TDguMTYxMDgsMjk4LjY5Nzc1Yy0xLjg5Njk5LDguNDM2MTQtLjMxOTQ5LDE3LjI4MDcxLDQuMzc3MTcsMjQuNTQwODEuNTcyNjYuODc3NzcsMS4xODA0NiwxLjcyOTA3LDEuODM4NjUsMi41NDk2M2wxMTYuODI2NjIsMTQ1LjI1OTc1YzYuMTk5MDMsNy41Njc3NywxNS40NDcxOSwxMS45ODA2NiwyNS4yMjk3MywxMi4wMzkwMWwxODcuMzQ2NjgtLjA0Mzk3YzkuNzgwNC0uMDU1NzksMTkuMDI4MjgtNC40NjI1NiwyNS4yMzIwMS0xMi4wMjM2NGwxMTYuNzg0NzgtMTQ1LjI3Mjg0YzYuMDU3MjktNy42Mjc5Niw4LjM0ODQ4LTE3LjU4MTk4LDYuMjM1Ni0yNy4wOTA0M1ptLTIwLjE3ODU3LDguNDc3NTVjLS4wMDAxNC4wMDA4NS0uMDAwMjguMDAxODUtLjAwMDQzLjAwMjd2LS4wMDQ0MWMtLjA2OTAyLjMxNTA3LS4xODkyNy42MTU5Mi0uMjY4NC45Mjg0My0uMjQzMDcuOTU5ODgtLjQ4NzI3LDEuOTIwMzMtLjgyNDI2LDIuODUxODktMS4wNzQ0NCwzLjAwNTMtMi42Mzg3MSw1LjgxMjM3LTQuNjI5NDksOC4zMDY5MmwtMzkuMjI4Miw0OC43OTgxMy02NC41NTAxNSw4MC4yOTc2Mi0uOTIxNzQsMS4xNDg3My0yLjMzODU4LDIuOTE0OGMtMi4wMTA4NCwyLjQ2ODk0LTQuNDEyMTgsNC41OTIzNS03LjEwODk2LDYuMjg1OTgtMS4wNDA1Ny42NjYwMS0yLjEyMDU2LDEuMjY4NDEtMy4yMzQsMS44MDM2NC0uNzUwMjYuMzQ2NjctMS41MTQ3NS42NjEwMy0yLjI5Mjc2Ljk0MzUyLS45OTM4OS4zNzgxMi0yLjAwNjU3LjcwNDAxLTMuMDM0NDguOTc2MjUtMS40MzE2NC4zODYyMy0yLjg5MDQ2LjY2NDE2LTQuMzY0MDguODMxNjYtMS4wMjcxOS4xMTU5OC0yLjA1OTguMTc2MDQtMy4wOTM2OC4xNzk3NGwtMTcwLjA1MDYuMDM5N2gtMS42Njc1OWMtMi41NDIwOS0uMDE1OTQtNS4wNzE1LS4zNjQxNy03LjUyMzUxLTEuMDM1NTktMi4yNjkxMy0uNjAxNjktNC40NTcxNS0xLjQ2NDgtNi41MjQ5Mi0yLjU2NTcxLS42NTY2Mi0uMzQ5NjYtMS4zMDI0Mi0uNzIwOTQtMS45MzMyOC0xLjExODI3LS45MDI5Ni0uNTc0MzYtMS43NTc2Ny0xLjIxNzMyLTIuNTkxNDctMS44ODUxOC0uMTIzMS0uMDk4NzYtLjI2MTE0LS4xNzgwMy0uMzgyNTMtLjI3ODc5LS4xNzQzMy0uMTQ0MTYtLjMyMzktLjMxNjA3LS40OTQ2Ny0uNDY0MzYtLjc1NDI0LS42NTQwNi0xLjQ5ODI0LTEuMzIwNzgtMi4xODMzMi0yLjA0OTQxLS40NDkyNy0uNDc0NDYtLjg5MjQzLS45NDg2NC0xLjMxMTI1LTEuNDQ2MTYtLjA1NzkyLS4wNjg3NC0uMTI0MzgtLjEyOTUtLjE4MDczLS4xOTk1MmwtMTA3LjA4MjY1LTEzMy4xMzUwN2MtLjU5NjU2LS43NTE4Mi0xLjE1NjI3LTEuNTMxNTQtMS42NzgyNi0yLjMzOTAxLTEuMjExMDYtMS44NzI1Mi0yLjE5OTgzLTMuODc5OC0yLjk0NjY3LTUuOTgxMDEtLjQ0MjAyLTEuMjMyMTItLjgwMTkyLTIuNDkyMTMtMS4wNzc0My0zLjc3MTY0LS45MzUxMi00LjE5NzQ0LS45MzIxMy04LjU0OTQyLjAwODgyLTEyLjc0NTU4bDM4LjE2NDAxLTE2Ni4wMzc1OWMuNTIwNTctMi4xNzM5MywxLjI3ODIzLTQuMjYwNjIsMi4yNDczNi02LjIyOTA1LjExNDU2LS4yMzI1My4yNTk4Ni0uNDQ2NzEuMzgwMjUtLjY3NTgzLjM4OTA4LS43NDEwMS43ODA4Ni0xLjQ4MDc0LDEuMjI5NDItMi4xODQ0Ni4yOTc4Ni0uNDY3MDYuNjQ0NTItLjg5OTEyLjk2NzcxLTEuMzQ4NjcuMzEzNTEtLjQzNjA0LjYwMjY4LS44OTAwMS45Mzg5Ni0xLjMwODQsMi43MzUzNC0zLjQwMTc4LDYuMjE2ODItNi4yMDM4NywxMC4yNDIwNi04LjE1NDA4bDIyLjQ5MzY4LTEwLjc0NjI2LDEzMi4yMDcyMS02My4xNjE2M2M4LjEyMTkyLTMuODc1ODIsMTcuNTYwMDctMy44NzU4MiwyNS42ODE4NSwwbDE1NC43MjUwOCw3My44NzUwMmM4LjA0NzIxLDMuOTAyODUsMTMuOTE5NzgsMTEuMjA4NzcsMTYuMDAxNDksMTkuOTA3MDVsMzguMjI1MzQsMTY2LjAzNTZjLjk0MTEsNC4xOTM3NC45NDI2Niw4LjU0Mzg3LjAwNDg0LDEyLjczODMyWiIvPjwvZz48Zz48cGF0aCBjbGFzcz0iY2xzLTIiIGQ9Im0zMTguMzc4OCwzNDMuOTE1M2w4Ny4wMTIxMi04Ny4wMTIxOGM0LjEwMDE3LTQuMTAwMjMsMy45NTMzMi0xMC43OTE4My0uMzIyODYtMTQuNzA4MTNsLTg3LjAxMjE4LTc5LjY4OTY1Yy0yLjAyOTk2LTEuODU5MS00LjQ0NzE1LTIuNjkzMjMtNi44MTI5Ny0yLjY5MzIzLTUuMjQ2NCwwLTEwLjI0NTQzLDQuMDk3NDUtMTAuMjQ1NDMsMTAuMjAxOHYxOC42NTA3NWw0OC41ODcyMiw0NC40OTgzNGM0LjQ4OTg5LDQuMTEyLDcuMTQxNjIsOS45NjEzNiw3LjI3NTIzLDE2LjA0ODIxLjEzMzY3LDYuMDg2OTEtMi4yNTg4MSwxMi4wNDcwMS02LjU2Mzk1LDE2LjM1MjE1bC00OS4yOTg0OSw0OS4yOTg1NXYyMS44NTM3N2MwLDYuMTM3MjIsNS4wMjEzOSwxMC4yMDIxNiwxMC4yNTUyNSwxMC4yMDIxNiwyLjUwMTkxLDAsNS4wNTE1NC0uOTI4MDcsNy4xMjYwNy0zLjAwMjU0WiIvPjxwYXRoIGNsYXNzPSJjbHMtMiIgZD0ibTI1NC42MjM2MSwzNDMuOTE1M2wzNC4xMjY0NC0zNC4xMjY0NCw2LjEyMzY4LTYuMTIzNjgsNi4xMjM3NC02LjEyMzgsNDAuNjM4MjUtNDAuNjM4MjVjNC4xMDAyMy00LjEwMDIzLDMuOTUzMzgtMTAuNzkxODMtLjMyMjg2LTE0LjcwODEzbC00MC4zMTUzOS0zNi45MjI2Ni02LjEyMzc0LTUuNjA4MzktNi4xMjM2OC01LjYwODM5LTM0LjQ0OTMtMzEuNTUwMjFjLTIuMDMwMDEtMS44NTkxLTQuNDQ3MTUtMi42OTMyMy02LjgxMjk3LTIuNjkzMjMtNS4yNDY0NiwwLTEwLjI0NTQzLDQuMDk3NDUtMTAuMjQ1NDMsMTAuMjAxOHYxOC42NTA4MWw0OC41ODcxNiw0NC40OTgyOGMxLjA4NDkyLjk5MzY0LDIuMDU5ODcsMi4wOTA2MSwyLjkyMDU0LDMuMjY1OTMsMi43MDEyMSwzLjY4ODY5LDQuMjUzNDEsOC4xNjYyMyw0LjM1NDc0LDEyLjc4MjI5LjEwODY2LDQuOTQ5MjYtMS40NTMsOS44MTQ1MS00LjM1NDc0LDEzLjc3NDYyLS42NjcuOTEwMjgtMS40MDQ1OCwxLjc3MjktMi4yMDkyMSwyLjU3NzUzbC00OS4yOTg0OSw0OS4yOTg0OXYyMS44NTM4M2MwLDYuMTM3MjIsNS4wMjEzOSwxMC4yMDIxNiwxMC4yNTUxOSwxMC4yMDIxNiwyLjUwMTk3LDAsNS4wNTE2LS45MjgwNyw3LjEyNjA3LTMuMDAyNTRaIi8+PHBhdGggY2xhc3M9ImNscy0yIiBkPSJtMTkwLjg2ODQ5LDM0My45MTUzbDM0LjEyNjQ0LTM0LjEyNjQ0LDYuMTIzNDUtNi4xMjM0NSw2LjEyMzk4LTYuMTI0MDQsNDAuNjM4MjUtNDAuNjM4MjVjNC4xMDAxNy00LjEwMDIzLDMuOTUzMzItMTAuNzkxODMtLjMyMjkyLTE0LjcwODEzbC00MC4zMTUzNC0zNi45MjI2LTYuMTIzOTgtNS42MDg2My02LjEyMzQ1LTUuNjA4MS0zNC40NDk0Mi0zMS41NTAzM2MtMi4wMjk5Ni0xLjg1OTEtNC40NDcwOS0yLjY5MzIzLTYuODEyOTEtMi42OTMyMy01LjI0NjQsMC0xMC4yNDU0Myw0LjA5NzQ1LTEwLjI0NTQzLDEwLjIwMTh2MTguNjUxNTJsNDguNTg2MzMsNDQuNDk3NTdjMS4wODUzMy45OTM5OSwyLjA2MDUyLDIuMDkxMzIsMi45MjE0MywzLjI2NzExLDIuNzAwNTYsMy42ODg0Niw0LjI1MjQ3LDguMTY1NTgsNC4zNTM4LDEyLjc4MTExLjEwODY2LDQuOTQ4NzktMS40NTI1OSw5LjgxMzYyLTQuMzUzOCwxMy43NzM1LS42NjcyMy45MTA2OS0xLjQwNTE3LDEuNzczNjctMi4yMTAxNSwyLjU3ODY1bC00OS4yOTc2MSw0OS4yOTc2NnYyMS44NTQ2NmMwLDYuMTM3MjIsNS4wMjEzOSwxMC4yMDIxNiwxMC4yNTUyNSwxMC4yMDIxNiwyLjUwMTkxLDAsNS4wNTE1NC0uOTI4MDcsNy4xMjYwNy0zLjAwMjU0WiIvPjxwYXRoIGNsYXNzPSJjbHMtMiIgZD0ibTEyNy4xMTI0NywzNDMuOTE1M2wzNC4xMjcyNy0zNC4xMjcyNyw2LjEyMzI3LTYuMTIzMjcsNi4xMjQxNi02LjEyNDIyLDQwLjYzNzQyLTQwLjYzNzQyYzQuMTAwMTctNC4xMDAyMywzLjk1MzMyLTEwLjc5MTgzLS4zMjI5Mi0xNC43MDgxM2wtNDAuMzE0NTEtMzYuOTIxODMtNi4xMjQxNi01LjYwODgtNi4xMjMyNy01LjYwNzk4LTM0LjQ1MDE4LTMxLjU1MTA0Yy0yLjAyOTk2LTEuODU5MS00LjQ0NzE1LTIuNjkzMjMtNi44MTI5Ny0yLjY5MzIzLTUuMjQ2NCwwLTEwLjI0NTQzLDQuMDk3NDUtMTAuMjQ1NDMsMTAuMjAxOHYxNjYuNzAxNzdjMCw2LjEzNzIyLDUuMDIxMzksMTAuMjAyMTYsMTAuMjU1MjUsMTAuMjAyMTYsMi41MDE5MSwwLDUuMDUxNTQtLjkyODA3LDcuMTI2MDctMy4wMDI1NFoiLz48L2c+PC9zdmc+",
-				LogoFormat:       "svg+xml",
+				LogoFrom:         &catalogv1alpha1.LogoSource{Bundled: "kueue"},
+			},
+			DefaultNamespace: &catalogv1alpha1.AppNamespaceSpec{
+				Name: "kueue-system",
+			},
+			ImageRegistryKeys: []catalogv1alpha1.ImageRegistryKey{
+				{Path: "controllerManager.manager.image.repository", Registry: "registry.k8s.io", Repository: "kueue/kueue"},
 			},
 			ChartVersions: []catalogv1alpha1.ChartVersion{
 				{ChartVersion: "0.13.4", AppVersion: "v0.13.4"},
@@ -193,8 +246,20 @@ fullnameOverride: "kueue"
 				Description:      "Nvidia GPU management for Kubernetes",
 				DocumentationURL: "https://docs.nvidia.com/datacenter/cloud-native/gpu-operator/latest/overview.html",
 				SourceURL:        "https://github.com/NVIDIA/gpu-operator/",
-				LogoFormat:       "png",
-				Logo:             "iVBORw0KGgoAAAANSUhEUgAAANAAAADQCAYAAAB2pO90AAAABGdBTUEAALGPC/xhBQAAACBjSFJN\nAAB6JgAAgIQAAPoAAACA6AAAdTAAAOpgAAA6mAAAF3CculE8AAAAUGVYSWZNTQAqAAAACAACARIA\nAwAAAAEAAQAAh2kABAAAAAEAAAAmAAAAAAADoAEAAwAAAAEAAQAAoAIABAAAAAEAAADQoAMABAAA\nAAEAAADQAAAAAChXGSsAAAIyaVRYdFhNTDpjb20uYWRvYmUueG1wAAAAAAA8eDp4bXBtZXRhIHht\nbG5zOng9ImFkb2JlOm5zOm1ldGEvIiB4OnhtcHRrPSJYTVAgQ29yZSA2LjAuMCI+CiAgIDxyZGY6\nUkRGIHhtbG5zOnJkZj0iaHR0cDovL3d3dy53My5vcmcvMTk5OS8wMi8yMi1yZGYtc3ludGF4LW5z\nIyI+CiAgICAgIDxyZGY6RGVzY3JpcHRpb24gcmRmOmFib3V0PSIiCiAgICAgICAgICAgIHhtbG5z\nOmV4aWY9Imh0dHA6Ly9ucy5hZG9iZS5jb20vZXhpZi8xLjAvIgogICAgICAgICAgICB4bWxuczp0\naWZmPSJodHRwOi8vbnMuYWRvYmUuY29tL3RpZmYvMS4wLyI+CiAgICAgICAgIDxleGlmOlBpeGVs\nWURpbWVuc2lvbj4zMDQ8L2V4aWY6UGl4ZWxZRGltZW5zaW9uPgogICAgICAgICA8ZXhpZjpQaXhl\nbFhEaW1lbnNpb24+NTQwPC9leGlmOlBpeGVsWERpbWVuc2lvbj4KICAgICAgICAgPGV4aWY6Q29s\nb3JTcGFjZT4xPC9leGlmOkNvbG9yU3BhY2U+CiAgICAgICAgIDx0aWZmOk9yaWVudGF0aW9uPjE8\nL3RpZmY6T3JpZW50YXRpb24+CiAgICAgIDwvcmRmOkRlc2NyaXB0aW9uPgogICA8L3JkZjpSREY+\nCjwveDp4bXBtZXRhPgo7ZhTGAAAonklEQVR4Ae2dCbxd09n/17lDcm+EDERaQoOkZqpCVIVEBoSq\nILRqeg1taQ01JCQdrr4Rgr831WrrLW2VlhpepH8liSloqlT8VfGqUCFU0ETGe3On8/8+J2df++y7\n91p7n3vOufec+6zPZ5299nqe9axn/fZ61rTXXscYdYqAIqAIKAKKgCKgCCgCioAioAgoAoqAIqAI\nKAKKgCKgCCgCioAioAgoAoqAIqAIKAKKgCKgCCgCioAioAgoAoqAIqAIKAKKgCKgCCgCioAioAgo\nAoqAIqAIKAKKgCKgCCgCioAioAgoAoqAIqAIKAKKgCKgCCgCioAioAgoAoqAIqAIKAKKgCKgCCgC\nioAi0PsQSPW+IpemxJctMGe1p80vSpNb+eWSSpnn5kwy+5ef5rkaV+Xe6p0ioAgkQUANKAlayqsI\nBBBQAwoAoreKQBIE1ICSoKW8ikAAATWgACB6qwgkQUANKAlayqsIBBBQAwoAoreKQBIE1ICSoKW8\nikAAATWgACB6qwgkQaAmCbPyRiMw81HzmXTa7NiaNsOr0maHtDHpVJU5qipl1rS3mVR1ytS2V5na\ndJupTVWbfqbNbM/9cAM/20GGwz+ScG10DkrpiQioAeXxVK5fbOrf32AOMu3mACr/aCr/6JZWs5Un\nql0CKXPzNRPNg16c69rwuKnb2Gb2Y/vPFzGkA/EHIXeQK53SuxcBNaCY+DcsMNs0pc1RGMeX3l9n\nxlPB6yUplbwgrmGcaULQU1lvMKiapjYzNt1ujiePKWS0dUEyUiEFRUANyAInlbj/hlYzNZU2p28w\nZgysmzbfFspqbHmPM62QHxHfkDbnNi8049qMOZdh4peJq7YkVVIJEVADCgF7xgLzOXqaCze0mONp\n+Tcrgb2EaPFJVEOKwaIxj4qfuchs17rRfBOdvo5uHcPGT7g1VEoENrWopcyxB+d1+QLzRVr5GVTM\nyV1Vk+36v6pPm8uaqk1/+ov2uhbTvLHKbBw+0Kz5xijT0lX50js2tpjz0fUSjKns5kqV8jmDGhA1\nefpCcxgVcQbDo4OTVmwA/Bdpnk1Xmeeq0uYf7dVmWU3aLGtLm6OZv/x3iLw20ryTTpk3GBC+Rpqn\nq6vNk1eON++G8Dqj0H0AQ8yL0f1iDKmfM0EPYVAD6iEPIl81qHCpyx8xx1LJL6fi7RtXDg9+HbwL\nSf+g6WPmXzPOLA9Lm/iDupR5nZdy91RVmTtnTzB/C5Npi5NldFYC58JzjI2vp9DUgHrKk8hDj+nz\nzcEYzX+R9PMxk7fxwB+m1/jlsOHmwfNHmo2udIkNyCeQHupF3hVdVTfe3J2d//io9iDD0Mkshd9I\n+YbbObuXqgbUvfjnlftlj/PCssVcS+9xfCwBKfMxQ6wb61Lmpw2TzHux0mSZumJAvnxe42Xs7Poa\n8zuWuWVVLpab87TZfOV6MxcjOiNWgm5gUgPqBtDzzZIJd01jK5PttPk+c53M+xuHrJU84DmD+5mf\nTT/IrHXwhpILZEAZ2ejyJrrP7jfJ/CpJj0RvdDSLIrdQ5h63WlcpBlTxe+GmLTQHsFq1hLnOVS7j\nYejUzIO9vqqfGcGBF9fkazyhFtWFSIxnR5Lf3LjQLKIXHRFX1FWTzDw2B+1DmZ6Jm0b5kiFQsQZ0\nw+um7/QFZk6q3fyJCrinCxYq2dO1tWYvDOfiq8eYVS5+F709ZWTe9ApGuZjrQ/j7JA/ul3KVhYjE\njnIclG42L7Lydh5hRLmdLHLsONgczJ68n7i5lSMpArEeQlKh3c1/2UKzDz3ObcwBdnfqkjKNVMVp\ncyaYG6nYJEnuLn/c7NLeavanUu/FkvLeLDbshZR510wyZ0dJa3jGbNG41oyB/3DSHU7GsXuWjMyU\nWVRbbU5j+XtZVB7BeIaV8gL2x+TX7S/QwboijrWqKAOSVplx/4VUkqvxfYIVKHjPQ3ylOm1OnH2Y\n+XuQ5rrP7FZgMYJ8jsPv0olfNpNaDCjIz1BzV/YbXIhOp1KOuiA99D5lPoJ/ypyJ5ulQekgkRjQJ\n+Xej8xYh5JJFqQGVDOp4GfFOZ8t26XXS5og4KXiA99QPNqc1jDJsc4vnWIzo39RszmZfzbdIsZM1\nVUID8mTNeMQMbWs351PBv+Oas0kaWsBmhmdnMd+5zZPhus541Hy+rZVleWOGuHiLRa8UA6qIOdBl\nj/AZQDsLBfGN54dXTzQnxDWehvlm68vmm1mNzeZtjOd6KpXdeLpQ63iJuoJ52Mw+1WZPjGOhSxRG\n0IddD79hvncl5Y81opg93ixhvjcG5uUu+Uq3I1D2BjRtvjmTj9SepvJsby9qprVup+X7JhX0B1yp\ne3YnCxEMrWY2GvNPDGcmCUq252zWePPGnMPMJFPFuxyZpzkc5Z/B8OymhjQpYrhZh5rXUn3MOHDI\nawtRjCx6BUsssHsiEnelTTU7Cm5At5ulFY6hYxtV6xSM56YYvLI/7sjlb5qXmZfMQn68PWYps57K\nvkw8rfv6uD2CTR8+yvsVM/4DkfeWjU9o6Hk2S903x8336nFmKZ/AihGtcMlWejgCsbr88KTdFytv\n2v+93tyFBofH0oLehoKeivHc7uKXeQ7vjWSl6nQXr9CpfK+wknZzVY1Z1KfK/M3bMYABno2M/yJf\nWaBYBM9dVx9mno8jM4wHvbba0GzmQ3NuP5Il66snmfPC5ITFTX/M7M0+h0XoOyCMXow4cNNVuGIA\n65I540nz6dYm80ea28+5eD06D+tCjOdH3n3UdeYCM4ozDe6gJR8RxeOLX1JVbaZfPSHz0ZsvelMw\nY0CB3djo8QybRS+6aoL5c6cEMSKQOYByP0xFPyAG+4xrDuPlcUzHHO9Q3l09jHw6peK7SjGgshrC\nZT4mazJ/Smg8N8YxHuYPX2Wz2VNO45H5SJU5j201+0UZj1Q/ehxE5Tqp+Kyw/Yl52+W5lHh3LFev\nTtWbyVS+V5wpUuZKyjTFyZdloHd8jKHnRXH5lW8TAmVlQK3NjJjS5jNxHx7Dp8X1teZCF78sFLCD\n+XdUcOv7F+QtrakyBzAv+UnDpq9EI0UzZoQ9xG1aKZs9bYG5LoTqjKL8TIli7M/blM9+ToFZhgZZ\nfGjnrAd1iRAoKwOiBX6bavlQghI+781JotKw/HuFLBRE0b14rOHZ+j7mC/l8q+PJyLnyARw9xDdz\n4hw3vOvara3J/AVDH+1glbnZE3W1bJ6N6Vh8kAWZsviWKGaRSsJWVgYkiFCR5UHHdV+56a/OMb1z\n+ZvMltQPMBMxxo/iZhyHj15vLvMa5z49kTXtETORd12LGRju4JINRm/R8051NR6eHBqR72OU8nJY\nXUIEys6AWF1aQAV5MU45mYQM+edKe6uKrDtcsmjNlzUcYNa4+Pz0sDmQn54N96X3u81l5NJTsSn2\nj1Ry5yoZuq6u5uituMaO8VyA3CtCdNOoGAiUnQFJmeRrzRhly7DQyluXc+smsoom725sLm2ObFhs\nBttY8qVh5Hu/uZJtOyFO5iX0UNdThp9RyWXuY3U0Bs0Yz3Fx9/ax8ibHZM21ClWiFYGyNCD51JmW\n1r0StanoY2SrTxQKDbIYkLJXIip5n6Z10Turo2THjUf+9zGUnKFk5n3UQnM/u8pDjSsoG+NplxfF\nsydljr8Kkjvdk985LFvrJw6dkEkWUZYGJJWeVa7vxS0qZ1PPsPEOac/8m/ZKGw+V/DzXUMuW3krj\n7DnmNnJGQ8ZNe9wMY9+dbE/6khdnu4rxoN8ZLLLIy2WnY9h2EYb5U/IkqbquIFCWBiQFZin5f3j6\nz8YqfMp8WT4/iOK99DCzHiB+HEWXeCrztgy1zrXxdIWG/GNloUBe5qaaOSaLoV1MeW0MaU/lpemt\ncfizCwb/Jw6v8rgRKFsDkqJxFtsFtKHUNYejpeVsgNk2rro+5jqGha6Nld9j6OOcyNvysdLazC3s\nhFhEgT5t5csS0beVRuRkXuj+1sWfmU/NNz/FUK9w8ebQN+3v+zgnTm86EChrA6IXeoaS3N5RGkuA\ninME39qMi2Jh1WodJ+BcHEWXeGRsyUwj9gKGTVYEbTuMp18ELTeaHREMY09kx/aduYTOd9fzbxKN\nCzMf0Z3TmRodIw1KVYozwWv5tCIV/58moiVWHqWsDUgeR02dmc7DXR3n0bS1mR8zOY9czaIl/z2y\nHrPJooKfwy7wWB/t2eR0hUavs7wqbcbIMNYlR/YOrliX2Sh6rIvXTyeP5+uN2Z/vpl6QcxXYDnUU\n/3H0ZeKX+vl6e7jsDWj2weZf9AzWnsN7yFT+3fmi1LqsXVtjzsWI1nlpwq7I+aV8ORpGK3Ycuj3D\njoj94uzsznx52mSeA5/IVcgwfTGSe+u3NAcHz8KTU37qh5nd0eFieFaFpe1tcWVvQPLAmEDfwgOV\nvwJxOpZu//O7j0Z/UZr50Czl/JThU63t5uGizodCSkIZf7PtDmYsw833Q8g5Ueh2XmurWYzxbJtD\ncNwwZLuKl9VTo77WbdjdNNMbXV+/uRmBPnOZg3b5oHyHSj2aXBEGJAjzdeXZPFD3bgGWjJtbOaDQ\n8uUmw5Z7pSJZnxyfU7AU/AeZX1j5CkMUg5nKfOc017HCcjYEG1UfQLcbSNM3bvbS6zIHPBHjmUGY\nTtbuGg40K9HnO1W1Zjc477dzVy61YgyIryvf4kXi2TEf1ZimheZSGy87FL5LRZIP2GxuDPOLBfLv\ndTamvGlSkTmcpF8fsyu97D0uOZxIdAj75V4k1dEuXj+dcr7KgsT+cd8j+dPKV63oNoVvoyaKHD+t\nN4QrxoDkYWUrQNhfinR6lrx5vFIqXCdCNqKBl7VsyPwKleK5KB6JZ4h0EMf6vMB7pkJ/CvAa23LG\nXcPRWAzZrMvImc/b2VVOmR5LOmSjfHdRzv1ZkOhS5Zdvo+q3NZ+jQl3Rm4Z1jHoqy8mQ6v21meXt\nvVwlo/KsqK4z+8hCRBRvZp6TNvOpmNZPCACynfdSP+fT7mulNyTd2QyjYhmzP2/kvCx7/T4/3tx5\nAqeb+mlhYfLZk3xugvaFMHpUHGVvZcPrNBYjOnZARPEmjWfr1AQOepnPODCygZaGibnU/kll9zT+\nijMgAZhKtT3DmGep9M6VMh7kCxwif4jtHOxr55vN+I7hVirEca4HKBUTvjuoOcvpES538Wfo/AsE\nD+Je0t7O596LuCLC7mSvHH9BSUeZ2U0duTQfJgX5r2I8p8RZyQtLb4uTf8BobzF3U4JRNj50UAOy\nAdTdtMvnm9GsuD2BEdW5dKESLtxxS3Ok7a8XkZPCML9LxfgB8hhdORxzF47ebeDMtrG0xlKZtmZo\nsxWW0Zf8/s39h8w7/s4Xrov9h5E4pBoZri15xJxGr3MFsoa5+HPoGCYVdy5/lzKDYaH8K3hBHe/H\nvoROv0aoc+e6GlBBoS+OMCr8CVT8O6n01Fm744HKXOBrVCyORoh2sleNddtfIXOPaC4oeZ5MGiVT\nDPjyheZ4erX/hGfnKL7IeD7ZYGXxdFYYn4jkyZMgn3psWMeSNr1aXBGVYkCRY9S4QPRkPllUwHLO\noTLTMNodFfQEhkT3ymGKNs4rJ5m/9tuWv4SsMt+hEqyw8RaCJsNHNoB+g4/qXsJ47kJmIuOh/HKY\n5E1b9jN7FsN40GvqhrWcn5fAeAqBS0+R4WyZe4qiXdGDCvgtDCTety8p82i/WnMMPZF1N4Lo0/BX\n069xFTu005kz4D6bo2MXe6DMP0ykzclY/hnIH5gjO/7NUxyjdQGG80L8JPE4s/rNBdeD46XI5aqU\nHqhXGJA8OoxIPl2em/sYw+94uK/ygvDYq8aZ/w3n6Bw7/VFzIGtmJ0E5lHx2TTqEk9XDFWvZcsN+\nM4xmCkazQ+dc4sWgvxy+cmk+73VcOWQWaNoz88DT0TPvEYwakAvpHkin1TyDYdDPqZy1MdRbS+v9\nH7Te98bgzWG5/EkzpH0jf33CEVxUlDcgvsfmz3XUtvWt1aYP4cHoMRg9toImc6lR8MobfffiBExR\njrxkU+31Q/ubay860H2edpScsHj5yI/vlGZAOxPDiXOUcpiYjjg1oA4oyisgLzx5uXIvFTbWdz1M\nvG+tqzWXMKRjJTu+Y25wFkbyi/gp8udkGLGcvuBH7E/776SHn7hynTHf7AFe8ncrp8JrnR+6ZPnp\nlWJAid4f+AEo17CcGcB+sQP5zPtBKsVwVzkwgtM4K/soDOIS9on92sVfSjqV8CWGatftOMjcYVuC\nT6pTA/sEGx9hSbrdXMCS5Lik6XsTP41X73Sy6ZLvg26m9MfERYAK+wKAXVXHsK5BDiOxuGL1QOS/\nCj3mtVeZ314zwf3/QRYVO5F4OTuwqYUhWtp8O07j0klAgohK6YF6rQF5zzpT0WVbvhzsEd+9xtDu\nOl6E3s1EPfRjvkIaEJVNXrzez27pe3YYaB4tZG8jRaYx+QJndssQ7ZSEOEjyvJwaUF6w9cxE311g\nRrakze20uon2ZtH6cFq3WUiLfXdVPzPP/+/eXTKgTf8vtITKvIQ8Ftf1MU8yB7O+4E2KrMxt2Klx\nErp/tdi9TZhuakBhqJRxnIz7+cThVMZlV1Jxt0lcFNkmk2bFLWWW4F+gYtYi5xgqykCug5DnLVqs\npuf6mDgZimWu8MteuNcZli1hGW4J++Gkxym4y+xTazZfJb+TyN++k6LguecKVAPKxaNi7uTNP7X3\nUlpl8fEO+AgrfeBFKgaVGS5TcRBbGid5zlxo9m5LmcNZEJBvhBLt2C6mlpViQL1uFc5VKeSMOHga\nZj5qftHCKhRLBWdy79wc6ZJbKsORfWlNG8xE9D6Cl8eHodenSmeyLhQqj64GFPFMrxyfOSNuGjsE\nfsD3RSdhALIdaJ8I9m6Llu1EGz82+7Lr+1CGkIc3rjX708XlvUOg2wpSphmrATkeXPaN/i2w3ZJZ\nrUqbKfxTwmQq6e6OpAUny5Dse4+bzza3mAMYD45mUHhA40o+qMsePF+ysWHBS1a+AtWAEjw7Jvd/\nhl38tOxHe0dQaSczRJLVu08lEOVkzWwHajEj061mJEvmO5PP51nZk95FFiQ2jcrUYpw4FpshM7Et\ndia9QT4GNaCq3ezCCtuurOTtwhiqmZ7hEd7drOUox7V1zZwYNMA0bWw2fdkLV8dXm3Kaj5x0OoTh\n1xAGXcMIj2So+FnsYiQWku8O7LKAu1IWEdSAilTduvQeqEg69SSxlWJAOtnsSbVKdSk7BNSAyu6R\nqcI9CQE1oJ70NFSXskNADajsHpkq3JMQUAPqSU9DdSk7BNSAyu6RqcI9CQE1oJ70NFSXskNAdyIU\n75G9zbuOPxRPfNlLfr3sS6AFUAQUAUVAEVAEFAFFQBFQBBQBRUARUAQUAUVAEVAEFAFFQBFQBBQB\nRUARUAQUAUVAEVAEFAFFQBFQBBQBRUARUAQqAIGedKjI0DzxlMOdNuIb8c15yOjvSMP/S+X1b2+b\nO+TKCajeX6TIP+bVWfjlYHkpn9+50vh5JSzYCE6FcvJvFrb6swG6V74kecoXAjYs/LIEl3yeuV9G\nxYTFELrq5Y+B/45/AH8xPs7hhy/CZ8v3HehJ3QEksMlsgu43sLMc/FKeoDudCFseYTSpcKvx/8K/\njL8DPw0/Ee9qSGDJce9yF5aHF/e5HO74Nxc55Hry5fqX+GIrn9MPTCHDfwW6IyzwNUBz5Zf0nwx+\n6JAZ/MzhbAd/oQzIVk5pfG7Gb4+P44plQNIA2vQM0vaMo2yxeHrDB3X7At4f8ffgw4YG98UA97AY\nPH6WI/03IeH/CcRJpehuJ0MyOUhf/pn8vG5SRv49Is6owa+e9N7d5nqDAXngHkdgPt4/dBKaDOHe\nlIDFHW6hBUmfJmKfYKTvXuZU83z3PS0oJ6begJ/bDYqJASd1J5Ogb9JEheLvTQYkmB2MvzIEPFcv\nNIY0cf8raDK8tsn1Iuj/DtGhp0VdgEKXlFApmYOdmEd+8tcz0jh2i+ttBiQgfwsvwzq/cxmQtHDj\n/Aks4aTDN4uobidJYzOiRFqI8YgRhbkPiFweRsjGddswrsaiVE8jrUIhGf54zmvla4nYwouMcZVG\n4zL8VB/vnwmvwA/1xQWDMg96MBgZuO/D/YRAnP9W5jr3+yOyYa8sIaS8ox4n5TW+1KKbHFg/En8Q\n/hC8K19Jcy1+Cj7oXGmD/K572/DttyRuwcuKYZgbS+RO+DfCiL0lTiqXze9iAWJLaDIBlQojQNvk\nCG0NXiqH3/2cG1u6f/iZI8JiPDYZz0SkkxbUli6fVTipdDYnFe7/4m35Ck0arW3wQfcuEba0SZax\nd3XI2hu6rLbZ8psNveSuUoZwMqeQXkRaqP3x7+FtThYSxgYYXMM4abl3DKQJ3pbT8E1a66PwPw4W\nInAvdeSEQFyhb6UBiXKyyCP+JfzfopiIPx1fbaEXhVQpBuQH5wVuvu6PiAiLofnd49ys9keEhF2r\ncfkaUKGHQyGqR0Z9B8qzkdRNBNt7NEdSJ7kWjlMsXLf6aLf7wsGgrH668A+m6fJ9JRqQgCJzFde5\nY0MC6MmWENccR+ZBUW4EBOmlopy0oEsjiDI06S4nQ7QrHJnv46B3hSz/Hh58Fp68VgK/826yYdv2\nIFtP5hNTuGClGpAgNM8B09Yh9OALziDLoURIixnmXK2fTXZ39kBSloX49WGFysZJBZcWvhjOVukf\nJkNZ3PHcuwSe8G5CrpOJC5uvhbAWJqqSDegVB0RhrZ48sCZLOllmlRWsMNcVAwqTV8o4WXhxDeO2\nLYJC2yFzkkWuf/jmsd3mBUKuMgf6j5D4okVVsgHJJNnm+oUQpRVeEBLvjwqbB4lhHeJnCoRFF9sE\nuDuHcJ6q73uBiOuAiPiuREtlj6qDq6D9IUS49OTBnel+tjO4KVmPHqW8X6FyDctSdT7OtRoXNg+a\nQEZ9LJnZhm+WZCUlufAqtAFJJbf1FndC3xiCgOg5LyTei9qRwKHeTbGvlWxAtlbKhqs8HJm8Rrm9\nIQTnA67hm8soo/IqZXzULgBPB1lkKaQbj7DhFoG/sdBut9CEZJtXOZImI1eyAYW1XnHQWQnTkw7G\nYC8kk9co9x6EZ6KIPSg+bFHFr97H/psChG2V/DXk2zCbD/0jiw5ToA220AtGqmQD6gpIrh7jcJ9w\neeNuW/m5H3pPmOP4VO4UlHqwX6fY3IhCGpBU7mNyxefc/SbnrvONLHr8vnN0R0xfQqd03BUxoAYU\nDq6r0k8kmYeda/gWZ/5TsklveHEzK4sDI2gSLQ3A2xZ6UpJUbqnkUe5JCPJhn80vikqcjbf1cI6k\n8ck18Vl7FedySvscPrhbwQNBWlBpsf+CtxmQDAddDxqWbu+hvidKWJy8AF5joSclnelI8JSDHoe8\nB0wH4G1DwThyrDxeK2pl6qXEOMO4rcBmtAWfedBsCxJe0u7sgS5DiQmeIhHXJRHx+URLwyMbQ0vh\nit4LqQFFP8Y4BiRzIRuGcYZvokF3zJFkyPZz/FWigMP90UFPQi56pfYpcyJh1+qijz15sCZ5kl6T\nQlaCXsXvGlFiaUltE9V10GWLTBxXjB5oABnv7stc3lOJ0ch+PdlNcRw+7GUy0TluPXf35sTkfyP5\nfSX/5IlTivFIfjcnThkzgRqQHSjpQWZGsMi2Eds2lIegN0WkLUW0zM1s87O4OvwaRjGioMvH6Kci\nZIugIN/9h4STvm8SoxzkkxEMSo8XNKDNiJPeSRoZ+SbqdXxezjb8yEtghSVyDeNsxY07fBMZ3TGE\ns+nu0d4hMMO7CVzz0dk2fJPGZgR+WEIvK6I2NxqiLCh4Tr4Fk1VWGVnIu8Jr8WPweTk1IDtsz0N+\n284SSpUH82AopXwiV6Lq8fhCrb7tjCwZOkY5mWflk5c8o39GCc3G+w33aOLuxn8WPwp/K/4CfF5O\nDcgNm7RWSd0jJFibNFEP4peeRyr7swXU6QyHrDscdBv5HhsRmv+9k8wFpYFrxcsL8KfxNfi8nBqQ\nG7YkQzFPWj5pvLTdeV1N5t/H74aXBZRCOamgp1mESWPTlR7bZUCDkX9sNn+Z85yKlwWeq/G34X+J\nz8vlbXl55VaeiaSF+gi/VUz12+CbF5PXY8tnQu6lzecq8xdZGJBJu/Q2L+OfxMvChxhRod1RCBxq\nESq9fKOF7iJJT/k2XnYuRLmzIEgvJ2U+Gf91vKxIzsLLM87LlfrB2ZSstxGhySQzycRVylZnkdkO\nTbryOK4vTHF7a9Ex6epbDWlqLYqIUQZXp6qJk+FIUidDl5akiUL4BVtb/fE/L9FVyhjlRCcpY1ec\n4Gd7RvJcghh2JT9NqwgoAoqAIqAIKAKKgCKgCCgCioAioAgoAoqAIqAIKAKKgCKgCCgCioAioAgo\nAoqAIqAIKAKKgCKgCCgCioAioAgoAoqAIqAIKAKKgCKgCCgCioAioAgoAoqAIqAIKAKKgCKgCMRA\nwPZJbozk+bE0NDRUzZkzZ9u2trZBW2+99evLly+3fg8/aNCgAS0tLfJZdY6rqqpqXb169apUKhX5\nqXf//v0z/3tTX1+/4cMPP1yXIyB7s8022/Rbs2ZNf7m95JJLPkI/+dw7kfPK1NraumW/fv2WiV42\nAV0pU1TasPxGjRq18oknnpBPpo0t3c4777zq+eefT/Sp94ABAwbxDGsHDhy4NvgM/ZiG6RWM22KL\nLda99957G4LxhcJV5OZTxqA+3Xo/dOjQzfr06TOrtrZ2JT6d9W3Evdi3b185ZzrUQZ/n4/fSedcW\n6H/DT02n0zkNwtSpU6t96X4SKpxI0k7z+Orq6naI4guLjyhTGpkv4b8SlkbioOVVphhpPVwyVxqO\n0Z4OjjxboS/Fz5TK76WJug4bNqwezFYLbqTpdKoNcTM8TONchd+fV5FwTVRGvz5RYdshDFFp8oof\nMWJE35UrV95PJZ+JAP9RrFXE7dXe3v4QIM7JQ3gN6ffE34URyhFFJXOWMhn02QN/Bzqdl4dC3VGm\navTdCT+LnvoJKZtN7w8++ODL0LcQHtIcJwZl409CKyKuicoYR2fbSSlx0sfikW549uzZv4d5giRg\nyPU2oN/DEOw9DOcYor4o0cRNw4ieb25uvkv4QpwM18734uGXk1j2I+5Iwtvjv0aFfXbjxo03eDzF\nulrK9BZ6jMJL79OH8v2IMn1EmeRIpTDXlTLlpA0TXlNT80ZI/EfEf8kXn2LouTM4TkbvqcTvt2zZ\nMjnytgNrH68XPMULcN0ia1B3enHV1dW3En7Mu5crw71fI1/yeQ366RLnc+9IuIC4FqKMPvW6Mciw\naLyvG3+W8a4cdNfhqPRHeXQq22sdhGyAuMxwh+u7QZrcy1gc2v+KDK4veDzFHMK5ygR9DPpszOr0\nrlQMTy+55lumOGn9+fjDrjyzsm/J6rzcn9YflnklPC1ZvqXZq/NgRPJ/Pssrx/GGumLjKpmih7OM\nocqFROY81BB6QaJoeaZ4gmh5pjNhX+ndy5UeQ06L/Akt06tc2zCokRIf12Un7JkjeGnh9owzho8r\nO4rPVaampqan6GGlFZYhzjYsmhwYJSssvjvKlNXjcbmi87abbbbZp8J0Y0Hnq8TL6OXf+IuFB/5J\n3oKN3Ofrio1rVi9nGePqXxIDwjC2zyq0noqVUT6oIA/lPIY5u4nHoF4P0l33PEBvzF69atWqnB7O\nlTYfepwyIbfjhFIqxrCk+ZS6TKIf5TrE05OefY0X9l8ZlmaGbzQQ948cOfIhaHKaaU3WsPysicOl\nwDVOGeMqXhIDoiJsLgqh+AdxFUvCN3bsWGkNpVUUt6qxsTFy+LGJpeu/ccpEBZNjZDMO/s28cJxr\nqcuEfimGT2MxjhNFP57Vi2HLygx/doW8b7YMd7/88svN8D4g955hZWl5XYqJa9wyJlFcKl4pnJdP\nzlG6PLAJFKrTcI24J+mJ5LzmHEd8NcuyXm8mwwZZtt5p8eLFl3IdKsw8zF/ItQQutEz+fJmYdxwl\nizF5/H4WKUPeZSLtVswpnsoR+MlNmh7h4E9uPwmRbiDpbvJi0C3FsPlQ4nfy4rhe4Qv7g97iwco9\n9tjjMd4dCeZ3k/ZUmPYVA+PZyVA8X+fhlFNX/MJi4tqVMvqzs4Y9Za1MBSBWZ2VkXuh58mixzgT4\nTu9KeKDfhqeTARE3FPCWeemDVx7kq7ws/OGKFSuCpGLch5bJnxH6tFI+L8rj9+69a1fKJGdjH+QJ\nSnCV9zxyuHrG8Ry8oFwbwf8ihtH3+SMlTFnE0L4mYcr2gPfidbvttlvIqp0M92RZWwws550O90mc\nh1NOXfELiIlrXmX05xMnXCoDassqU6z8WgH1dozn2xjP+jgFLwCPs0xUuI7yUkkjK0SELnHKtI5y\n3xiR3hbdRLqFHgN6foGw/PvERhZ5dmOe+pZH81/p/Q+BNzMCIP3dHm3p0qUb6XnmQTuZuJO4zoTe\n0XJ4fDGvhcI1rzLG1LGDreMBd8QUJ9CSFZvzbwlMQE9jtekMofGSdXeGHM85sl/Jg/mGn4f3GW+x\n6vaSPMQS9Txe9qFl8ohyRbe+9JheVEfAi8he8y4TWKxhuHRZQJ7zlnQrSXe0x0ivcg4G/lPu+1L5\nj+D6M4/mv8IjvYs4aaT+4R9OQ3taDAj/GTE06E/g83EFwTXfMuajcNHT0DrdJ+v/+EYAlnlLJ8dD\nnJTlkXc5U/0M0roJjWvoeyA/bzBMurXZtPcEad499OuFR3zwHZXHE7zGKRM8x3hyCResTMjKC4+o\ndPLmH9p7oivXd8N2Ffi37nhliroi45YgXnJPvPM9EDzOugJPYlzjlDFMZ1dcqVbh3s4qwrpBnfzZ\nUidHCzbJi6T1WOKFC3B9MStjtGV7ykHCQ77vBN9RReVPQ+AsE2lP8NIzNIqcu3k83XWV3puyXyf5\nU65t2Mojc9Acx04D6bFkjuN0yDhu+PDhOaMNZ6IsQ7FwjVPGuDr6+UpiQExKH/IyBaA5/q5f4ul9\nDuNygYR5kG8wBn9TwoVwyPuzyCHfYUx0r5fdCX65tGbTuN8vG7fYT7OFXWVC7hTyzBgQOvxzw4YN\nruGpLbui09hRLatyH0lGNGbTBw8eHDQWb/gm73z2oUx7Bz2YnCfpcQNYApe9coldMXGNUcbE+pZk\nDoRBzMdIfkmFOgO/K/OCF6lgD6PtP/B788CkdZOhXRoAz+TB5DsBRUSu4236rPXr18tO7c9AOfeB\nBx44iKHHQvJoJu4QvLdDYA15T89NHX1nKdMbyByNH09qKVMLeX2rkGXytCKP/uCa2QngxQWvzMN+\nS/nfD8YH72XxhWcyF5mzoG25bt26i7g2CJ/sMGBVTho5aeDuY/70/yQcdAzzXkfObOLlvZ8YnOx/\nTOSKiautjImU7A5m2QvGA7ojatxM/EYqQ6YXCupHurzG/J4cerz9kfGuJe/V0GVTayIXo0zyqUan\nZXrJpCtl8tJaytPxSQNlH+0VykvHNXQuKd8LIfPjrNw1m2+++ZaSludyvpcX4YwheTKDV2TfluVt\nwfCG+OnQnHMg4S8mrlFl9OvZY8Pydp0HcA5AvgLIbVmgV3A/D79HlOJCF16uoQ8+Kp0/XoYkyJCd\n0bL5sT0r723ub+MDuG38vEnCEWVqRO7DzPdkNSrUQc+7TF5aKYPLJzEgURTZ8r1WRi7hayWO++ey\ncR9md0hIdKjj+U720ovh+ZmQF8uAJE0xcQ0ro1/PsgjLhL4Qmw/zKeyQIUP6Mx4emE9aWxopE8b4\naYZBoSuNtrRKi0ZAcY3GRimKgCKgCCgCioAioAgoAoqAIqAIKAKKgCKgCCgCioAioAgoAoqAIqAI\nKAKKgCKgCCgCioAioAgoAoqAIqAIKAKKgCKgCCgCioAioAgoAoqAIqAIKAKKgCKgCCgCioAioAgo\nAoqAIqAIKAKKgCKgCCgCioAioAgoAoqAIqAIKAKKgCKgCCgCioAioAjkjcD/B4YUFUi8FfALAAAA\nAElFTkSuQmCC\n",
+				LogoFrom:         &catalogv1alpha1.LogoSource{Bundled: "nvidia-gpu-operator"},
+			},
+			DefaultNamespace: &catalogv1alpha1.AppNamespaceSpec{
+				Name: "gpu-operator",
+				Labels: map[string]string{
+					"pod-security.kubernetes.io/enforce": "privileged",
+				},
+			},
+			ImageRegistryKeys: []catalogv1alpha1.ImageRegistryKey{
+				{Path: "operator.repository", Registry: "nvcr.io", Repository: "nvidia"},
+				{Path: "driver.repository", Registry: "nvcr.io", Repository: "nvidia"},
+				{Path: "toolkit.repository", Registry: "nvcr.io", Repository: "nvidia/k8s"},
+				{Path: "devicePlugin.repository", Registry: "nvcr.io", Repository: "nvidia"},
+				{Path: "validator.repository", Registry: "nvcr.io", Repository: "nvidia/cloud-native"},
 			},
 			ChartVersions: []catalogv1alpha1.ChartVersion{
 				{ChartVersion: "v26.3.0", AppVersion: "v26.3.0"},
@@ -204,7 +269,9 @@ fullnameOverride: "kueue"
 			},
 			DefaultDeployOptions: &catalogv1alpha1.DeployOptions{
 				Helm: &catalogv1alpha1.HelmDeployOptions{
-					Wait: true,
+					Wait:    true,
+					Timeout: &metav1.Duration{Duration: 20 * time.Minute},
+					Atomic:  true,
 				},
 			},
 		},
@@ -216,8 +283,16 @@ fullnameOverride: "kueue"
 				Description:      "Falco is a cloud native runtime security tool for Linux operating systems.",
 				DocumentationURL: "https://falco.org/",
 				SourceURL:        "https://github.com/falcosecurity/charts",
-				Logo:             "iVBORw0KGgoAAAANSUhEUgAAACQAAAAtCAYAAADGD8lQAAAAAXNSR0IArs4c6QAAAARnQU1BAACx\njwv8YQUAAAAJcEhZcwAACxAAAAsQAa0jvXUAAAqPSURBVFhHxVgJWJR1Hn6BgQHmYpgBr91qq618\ntLS2RQ1JybRyfXRVUNTSjk1DUzrcjl0TLYtNc9PSjA7t0RBkOJQozUSU+8o81qNWy1uOgblnmIFh\n9v0+PiMDE1vN93nmGeZ/fe/3O98/EJFWrMUnO/q0/7i28EfyumBoFWtw/fWVyC59jGN+7VPXBv5Q\nN/mhzeeGRtsHau1SZBU9LM1dQ6Rtuw25lQWoOOvDjsPnkFU+WZq5hkgvugVbqopEUtsPncbmyvHS\nzG8Kf+kbmHLvd7Ban0L9mQpowvogOPQdGErHSLPXEIaiO/D5N1WopKW+2HccWaUPSDO/CTosdB7x\n9+6Hx5QIY903CI+4HiGKVcgqu1+averoSPHkQhlu9o+Ar9mK6Q84kFE8GCrFR9D17IemhsOwmuYg\nYXihtPqqocNCA2SDEaYqhC5yGVZ+oUZCTAVcttm01HfQRfRlXK1AZvFQafVVQwehZncIZAE3QBue\niOu0ryMvLxRxw4pgt89BQ+0PdN8dUKneg2H3PdKOq4IOQgGhJXA5UuBytSBM/zS8ka8hlaQSYnbA\nbktEQ90p6HrcDmXYKmTs+rO064ojQPoGsj5uxX3jqklRDnnIYH6iERAgQ9TIUsz8yxGMnXEUXs+9\ndOkt8Lbchb8+UgXD2lpp9xVDByEB+ektGDW2krGuQKiCpIL5CQT6PlCKuQ8exsRpx+FtiyGpW+F2\n34m46RXIXFsv7b4i6LqRZn6lQYAqBTpdIrytblisy2E3vyFmX1bxVKgY4GptBBrri5l9iZg64qC0\n8/9G5zokYNJIC4y2l/nAjyELkkMd9iIUmgVYR2UQF7MRFssLJGJioMeQ3DvYUHCrtLP7yCgYgM3l\ng6RfP+JiUkMY9yEtX4tQ3QrWounwNLfAZk5B2LbXELu4lSVgJtSat0hWhcbGrbA3zcWUEcfat18C\n6cXDoVa8yzhVw2b9DIGtyzAm+iRnfJ0JZZfNYnUehebmdZgwKB9phXoolSug1U1Ds7OVGbcIE4a8\nwZU+bKlMZM97C0p1KEnlweqah4ejT7QfdBEItSxE8T5fph9PoI9IwWk/ALtpMctMdmeXBQYORc8+\nExAS+j5rzjhMizUCrc/D3GRAiFLGwxaxlcwX144btIYEF8Bq9iA8fCw08uXYWNJbnOsK6TtioCAZ\nDcnYzE401h1Dk7EELe7vEBCsQmZmQGdCTs+HqD1zkAWyDxQMXkPxQxg3pA4m03y2kDwoNTJa7FU2\n3SSu9sPEe96m5RbyLd0I002EMng5rXBd+2E/wcadg6AOfwfhPUjGehYtriSE+A+HyTESY+6Ox96o\n9Zg0ySut/hmyS+/D53v/i4oz7Pj8zihub65pX92IzdVbUXbSh20HbMguny2Ox8cHILf0FXx1uAVV\nVAmbq/4ujp+HYdedyN9Tg3Ket3VfPXIq46WZTug6yyZG70Szazaz7ATCI2+GSrkKhpIYTBv5PVod\n82Cs3clgVkKlWUL3PQGDwYu9Z96ExbyUbtiJAL9q6STg0+23Q07Nru/xJ2ZmA1z2JMamAeu/VPAF\nhyKzsKe0shvILRmNbftPiNros5qDogIQsIlpvqWa6vK0YKkGZBdPF8eFC8OHW3qIfwtIK7yN60pF\nS28/aERWyaPiuNC886pXoOT4aeTXJItj3UZO8QQedkoklVezj1l4lzi+saAfSZahpo5u2N/IVH5I\nHD+PTYU3U6fvRPkp6vRDJuRWzBTHhf6YU/42dh/zkVAbz3hGHJfQtct+igkxOZS282Ex1SKy1x3M\nwlSaeaBYnR2WRLq1iulrhdfdLO0gmd1/gDx0NSJ6xcJhs7GQJmP84A+QmhqIiB7JCAufh1ZPG+rO\nvg5z/Vppl4hLExIQN2QTbLYXmd4N0Pe6G0GMiYzC/kgYsQ824wy4nXEIaioS16bv/j3JrGTMjGK8\nOGGyvoqJ21aRtB96DljI+vM8//aH2bwcRmMKHhltFfdJuFil7gp+dNcTUKmXMvW1DOxKuHwzMDnq\nW2ke2LC1F5S61dDpxzMpPLA6FiEu6l+c8SGnLJn7FkIW6M+0fxvH619G0mh3+8YOdM9C7fCx5nwE\nm+kV1hwnAuU3IdAbIc0JAaxnnfm3SMbt9MJhTSGZFHEuu5K9UL0AcjnJWFZjz9cLRDLJycFddovL\nRm7FLORWTYbP1/5CaWla1pb12HWUAXykhbXqdY4KD/JDTslzvOe5UPw9k6KacZSpEfcYqgeh4NsV\nyK0eLf6+YlhXGMZ0XiuSKTjSysxaiuTMIHEuu3QOM8zObGLZ+HodM0wvjguKc/vBb7DfIpSTNeKY\nhMtxWWdsYD1RB6ewHTxG4cZcM69Gfd0iLJ7kYWo/SRcuYe9TsF+l09UvYdZYIzJLBnJ8DZv1QJw4\nehw2h0E6TcSv99+qQiX6yJdAE54EPwpPq+l9uC0viVrKUDYDWu1yqk4d6s/lwNkyF1OHnkVGaX8o\nQz5mOYhiuTiJZt6UJ8RslU4U8essJAi1XkELKc7mIoAS12T6BM2t/2wnUzQFavUyKFQkU5ePJtMz\nIplPWbsU8lToI6NgNp6Bs4kt5EIyAi7fQqk1gdB7kkUVKZfLqACoIG3zMGNkI2NpIom8x64fSQt8\nSY00k+Mn8emu2xGmZj+LjKaMqWemPY34mAtcdR6XZ6HkZH/oPP9gLXqBFwAZTI1ZqDuWJJIxlIxD\nqIryQh8JY/1OWByzRTJZ7GdajgtkTE1NLAfPXYyMgO5bSCAz4MEXKNJeg1JFMg25aLTPxmOxtbTM\naKrG1bzP3cBuXwSzcxamRR9BesFNdKtgsVGwWsy8dD6HSdHrpBO7RPcsFJ8ZgH73PwuFMpm3V8Ey\nn8PhTBLJGEpHsAJT4gpk6sthpzsEMuu/jGQxXMagHwVzo5kKcf6lyAi4NCFBfMX9LpExs5j6J5hx\nsR1N5iRMGXYK6bti2JtW0k1/5HV7DxvwPEwefkDcJw++AW2+MZDJwJJwiM23UwB3hW4Qmvc3BuQS\nElLA2LALdmsSHuXtwlA0iLVkFTt3P9TX7ofJMgdTY2ukXYDd8QP8/AzweIAg+UA25MdZRNkqfhkX\n3lx/DkPhkzR5Ci+FbKZ1ZXDaZvOhh0RJqtB8yBvsADScOwKXbSamDC+XdrUjL82JMQlfw9d2IzTa\n/ryWD4V/axvuiypDfn6btKoTLm4hQ/HjUOvf5GHhtEANXJ6nkBD7H/E/bCHqD5g1A9FQdxQOx1OI\nH1Ym7boQD484AUvTM2g0bmP8BTHWXoau31xptkt0nWUZhQm0yru8meppgb186BNIGLYHG2kZQV/3\n6H0Pmhq/Zz1JRPzQ7dKuiyOr+Eaqg4+okWLZXqw8byVaWndD5rVAH3EEsf3t0souCAlqMLznJgbq\nLTh3Zi+zZhYmD6vCyg1q9L0zFZG9E1B7+jgzjTfV2Hxp16Uh6PCQMLpZH0MVSd/4n0VQcDPLxHsY\nP2S5tKoLl8nldnhbT9AyhbCznghkBAT3dcHtaeCd7QBM5ssjI2DyiG/hNifyRTYz0F389IbD3hte\n6qyfoGuXCV3cYm/D05N+NKWITWUhOFsnx7PjzdLI5SOvJhQe93WQB8qYiXZoXOcw+rxyBP4HwY5i\nOPiDfBsAAAAASUVORK5CYII=\n",
-				LogoFormat:       "png",
+				LogoFrom:         &catalogv1alpha1.LogoSource{Bundled: "falco"},
+			},
+			DefaultNamespace: &catalogv1alpha1.AppNamespaceSpec{
+				Name: "falco",
+				Labels: map[string]string{
+					"pod-security.kubernetes.io/enforce": "privileged",
+				},
+			},
+			ImageRegistryKeys: []catalogv1alpha1.ImageRegistryKey{
+				{Path: "image.registry", Registry: "docker.io"},
 			},
 			ChartVersions: []catalogv1alpha1.ChartVersion{
 				{ChartVersion: "6.4.1", AppVersion: "0.41.3"},
@@ -233,8 +308,10 @@ fullnameOverride: "kueue"
 				Description:      "AIKit is a comprehensive platform to quickly get started to host, deploy, build and fine-tune large language models (LLMs).",
 				DocumentationURL: "https://kaito-project.github.io/aikit/",
 				SourceURL:        "https://github.com/kaito-project/aikit",
-				Logo:             "iVBORw0KGgoAAAANSUhEUgAAAE4AAABOCAYAAACOqiAdAAAABGdBTUEAALGPC/xhBQAAACBjSFJN\nAAB6JgAAgIQAAPoAAACA6AAAdTAAAOpgAAA6mAAAF3CculE8AAAABmJLR0QA/wD/AP+gvaeTAAAA\nCXBIWXMAAA7DAAAOwwHHb6hkAAAAB3RJTUUH6QEeCSYLK+SI1QAAGzhJREFUeNrtm2l0XdWV53/n\n3Hvf/KSnWZaNLVuejYWNDQZjB2PmwQydkAQISSDpcicrZDUQqigSikpCqooQKlNTSYAkQFFMSSBm\nNGAwNnjC8ywPsiRbs5705vHee05/eLJJ+kOvBluGrPZ/rfdN7zzt393n7uHsA6d0Sqd0Sqd0Sqd0\nSqd0Sv8fSZyIRSLljQipywwR/IKQ5ijDlHvmzGt6ITaYV2vff5UF532GbdsOY0gxPpdzL1Ea4Q+Y\nr7muOpzNtn/SDD6WjONdYNSoGdTUVliD0ezdmXTx37LZ/IW5QqG583DUHBpMtHj91flErIDP5ytP\np4u/cmy+oxFXmIblVJQHPwj4awqZ7MAnzeEj67g9LhScgJRyejbrvF1eVlZfXekhmxygPy6iwbLw\nl0PB4MZkMhF1XeeMXMZ9x+8vrzQND5lMf87j1Uu0cDY5jg5pIQGJ1gpXOfg8XlVVXRFLxPP5/1z2\nea5Z9P1PmtVfSR7vAj5/iEAwXOYqHbrhS9excvnd/PTvvfg9TmUqnnp6oH/g1lQ8i3aV0MoV8+cs\n4foF38MygkIanjl2wVzmFs3VbtFY5RTFKtc2VuFaqwp5Vvf3xB9z7ELTf7vwd8yateCTZvVXOu6t\nGgzWYxjGmHQ6d/PiWRnvNWfvoMZ/iJlnVIu9rbavq89p9Id8GyTath311XGRef7muksJeCpF+8DO\nM/OF7HSFqEBTqaFSa12ptahEU6kVzYYQ4Rkzxr86NJhRiWTPJ83rxIEzZATbcbJK6TMHevom79jS\nTnNY8ZnLq0jGHUxlVyWTqinnyJTjuItPq5jtn173GcLeermrc01gvMhye80kDhfThMeN4p57vsWV\nSy5i3JQZ9PVFSScTRCJlz/r9vnxff/snzeuYzONd4NrPXcwLf3hzqKIyfNuBbryHuoyLpvtzjGuO\nceeXLL56keLK2/TinricBYRMD/jCUO0Lc3rT2YwfsvlWxXjeTnaRHlXL0qU3kleweksbmz7YSl9X\nh+n1mlLK4/5XP13gXln2Fh4Po4v5wjVer9GTzbnOY2sNc8G4Xiad58WHQ4W3jJCpKrNFxYyFHr54\ndwjleFlSXIr3jZnIR98AXVovZzus2d3FutW7SEQTaKUje/e2X5crFFJSjBEajRBCWJY5ZHn0O7bt\n2IV8198euGw6D9DouvJ+pQmiNYeGTG75rwq+m8xw1bQ8P7oQhnKCf13hwx+WVI820EoQ+a9dGO/t\nwXY16KPsBK4riA+lsPMFHMc5TWjxiCWPJgACVwth22p9sWivA22fdGonApyrNEKA0hqlNaDJ2bBr\nQPCz1zy8ul5w2QybS5oylAdM3A0H0b94Fdexke9sZaCln42JENGihV+IUoI07H2uFjSNcrn3prTw\nezRCgl2Q3P9MiL1dlvJ5TK20plD8GwBXWTkdhCgTWnxVKVWmcf+QTuc0wJxAhMlWCDfswZ41Htc0\niO86TPFQD57JQRbU+xlzaBDx5BtIVyEVxBwfa2M+4q7EdFx6BtMUikW0UmitqbIkV9cEKG+Ks7Nf\n84d3A+SKMmVaxlPZfFt6QuMc2jo6P/3gHEcBlOeyxduVchuFIS8SyLfR2pjjL+fKQA2+aWM559F7\nsRwX/cAfsN7djhp1Gd+eM4CV2I5WAlxByhakHAOkRipJb98gTz3zGg2N4xFCgNDopAe1YixkOtnY\nlueHz/uwLHODabK/tn6qoTXOSaf2ccBJaYDWaA0mkoAyzpcea6EOmvIFJ8aL8SjTM/C8cvA9/Cpy\nRysy4IWXNyLzRYSrsW2FA7zW62N/0YcKCGTOoKv9MI/++BfcctftCGmAEGglcQsmbByHpy9BrZUk\nqdSFxSKBeCx7OVok/ybACaXRWuBqWBCq4l/rpqAvmyvFly8EpdAaAkE/ZWUh9FAK9/OfwbE11Fdg\nbNjD3j/uYeNQEBdBd16izhtN1RVjCD69C6NlAIREAEIIpJAMZoO8tGcGV07o5PKAZGpzmNtbo+L9\neK7R543cZxgy5w+EngG5O5lo+fSCU4qSx6GoMCzmBCowewuwYj+CkicqDcreDG39UHAQGnTIh9k5\nQNL1cCDrQRmawOIxRBaPpXxWNddXfJnL4kmUhuqGUbQMtiOAvGvQngxTKHioi0DZuQPcenqCM2N2\nw+pdzh3b2yxXSHeTQO4+adQ+DjjDNAGZNYvFw/vyycYH+vYxuT/GtNX9OEJT43cYE3AwhABD0nko\nSV/OQgJSQk/Oi+MBmisIX9VI5bRqFs0aT3jBDDoHknyw5wj5go0uPSEEYAFSlNIR4S/ylUtS3FJZ\n4PbfSLYdMksP5mgo/rSCG9VUy+7NOwcrK2u/2ZrO/vLevn0XNMsCZ2k/FTrCueVeFAWUlAgh2Dxo\nsS6hiIpBNAq0JuZVTLqkiQlzxuD3GFSVBQn6PAwlMihXDUMofTLk2KxamZFPMEYZyPWnoZMG7rzu\nY3mfUpIT1FocOXC7N6/gvh/+mB/c+792V1VV3iIz2Z+1FDsv3k9X8CJjDmZqLJvSoHBBCBRwyOhl\nuVqLNxjE7/OjtObM8kVcds5UBBpTlpo0R3GhQQgwhCSm0yxzNhLv92AFyjinzEvVnipU0xB1EUVj\nnSsKrnFOXod96BmWYXicUJn3Hcdx+zo7No4YuI/9mJ56ehlfunEp1VX15Ylk8jrl6v8ICb/fg1Ha\nZlIcW7yoXdIUuPW2pXz9K9fiMQWRSJiyoI9AwI/P5wXgYFeUdza3Uiw6bHx7K6tf/zOdnW0IKTGl\nIGQonmj2c8HCOOZZvaSUIJY1+c6jEfXaJlO4yhCW5d1YHglc7biqNzqwfcTAfezK4Us3XgNAKulL\nGEIccJSrrvrSpVRUlPGrh5/g6393ExOnT2ff4QHKggGkEMydO5PZzRPZt2cf99/3E7q7+wgEPNz6\n9Ru5/PLFaErRtJDLsX7V6zSMjvDgQw/j9XopOA7vrdvJPb9/hHvmpbje51DhQsRn8y83IifVhfjp\nK8G8afHz3t7O3vPmn8unEtxRCShFU+CM5qmMGVPHrx9+kgXzz2TeovPZ1NLFRWdNIhzwAJquI118\n7dY7mTJ1Ig/+5Hs8/vjz/I+ld/P0sw/T0DQRrTQ9HQP0dXcxbfJcLlg0j2effYm8GaZqXBN7U4IX\n5XnEK0fhatDCILl1O2tbjmCY5nMVVcEXQmEPa9a+NGLQ4AR0gJVSqOEIOJDI0jmQRgGOq6mvCnPu\n6WOIBD34LInPMnl7xXts3LyLqdMmMXlKE1OmTKSrs4/nn38FpUr1bl93FNt20R1RBh55lQe++xB/\n/tOrFItFpBCsCl3Aj+vv5Ef91/DPq2bw/VdqWb/fWhkK+74fj2Vyt9/530cUGpwAj9PDbQ2BoLWj\nl/54Dii93MN+D2F/JXq40hACooMxtIafPPgor736LgcPtqFwiQ4M4bgKEEghEFJSbO+l4rn1LMlV\nsM8wSoFDgETh0ZrkW2uJ/fopLK9ne6Sy7FuOrdomT6jn7+/89oiDOwFnDj58fn/KMIzMytfe4JXn\n/gRAMpmmvz9KV1cv8fiHVdGsM6YTCoWIxROsWfsBff1RwGDq9ClYpRwRKU2EEAxkcxxoG2Ka48dy\nNcViHmEYmJFarEAE64JFiNNnY9vOxEwqdas07Kq9+w+z9JvfGHFwx906Hz26iXHjq4cGo5mJ6XT6\nzFw2gxSSbdt288wzy3j88ef584uvUT+qlsmTJzB6dD2JRIZt2/biOA6GYTJ/4XzuvGspHr+Ptp4Y\npteikHTpTcRYHm/nbQbpiEU5tP8gmaZmwldfSXDCGKxxp6FmzKDYOeRxOg6fY9v2NNMytmzeuG/w\njNlz6OluGzFwx71Vk4kE6VSy2ikWZ4+qr+Wb3/oK4yeMxbZtpJAIActeWsH//PZ9lJWXsXDB2fzg\nB7ez4Pxz2LylhUh1JedfcA7Tpoyjo3cIAVSPqmL8xGl0th1gz0BHqX4dyhNPp6i++AYC5Q0UO9Nk\nhxJ4ghE8l16Niuek2rn2KqVYWSxm9itXjRi0EwIunSogBI1F151yww3XsvTvbiSdTjNu3Bg6O7vx\ner1MmNnMPf/wAN+54wc89vuHmDljCtddfSHXXn3hcN0LqWyeRDqH6zokohmcYpFSlSUQGqQ/glU/\njtOaZ2PJMIdXbmVg0xZwikjlQDyKacgXAwHPf3o9VezbN3KpCJyAd5zjuNi2I0GI2vpq3nrzPe67\n7xcUbYcHHniU3/7+j/gDPhZddQUHWrt49tkP0wShNVorbMdh455ONu7tIpPKsXvNXtJDKY6m0BqX\nqilncfodvyBYO4muFesZ2rYbsX0D+s+/185LTyJ62naEwoF/VI4c/M5dS8llYyMK7gTkcaVMDgRD\n6TyfaW7msvIaNrR0M+WcBYTCATp6EximgZQmuWz+L74s6I6m2NzSRTxTpPdIlEPb28jGUujeOKRy\nw3+oGaUDXBKtp/+dbg63vIKT2oHhFH5r2Nk3tRCmYZkHooO7D8w7+yq+e88dIwrthIAzTA9CoJyi\nrQ+2dpKyoay2nrbuOJ6yCMlsjoHWbhKxJEop4qk8uzv6UK4CAQNDGbr6U/S099N9oIvevhRD5WXU\ndsYQhaPNXQFJm8DOOFV7EpidHbiZA9r0eFa7Wj5vO4coDh/ZbPjglRGHdkLABYI+pJStKpHZ887r\nK872hsuoaRgFGhLxFLFoDCklmWSKQq5Ay8EjPPWHt3BdhQZMy4vPE+TgtkMMJHPk/QEOjK2HWAa7\n24tIlAp/eyjP4K4OBoqtFMhgecx1ZRXhlY7jMjR4Ulj9lY67FzN18mJa9u+jPBxZks3lHwFdb5jW\ncJtDlRqfQqC1xnFsTNPEMs1SJ0QrqusamTZ7MfFwgPb6SspTNt01YaRro958mfymNSgUMyouYmbk\nAl7pfBBH5Ns8XnFzUu9fG6TBmDZjiitAb9yw8m8HHMCkyQuZOn2KXL1y3aJ8vnCXQEwUQgjluo40\npGU7qsZ13bDP4wevn6JZSh81Gu/YifgWXU4+4KXoNTAUKMDXF8ddvZzCnk0oFGVmLWGrlu7cbhC0\na+1uBS1A4POZm/1+z6O5XLHP4zXG5zLO55TCh1YIIdBCCylFj+mRTwG5XKbjuG0+IXMFB/a/x6WX\nnacSid3vjGs8e0PA7y/3WT6RSGXcUNhntOw99JCW8guzZs4nf/os9tWWAxolAY+HfMCPNiWGcnFz\nDloJVNFGOw6WadI0eTxerwcN1IlmtKZRoBv1MP6uru5rE/H4lIqI/954ovjvypXXloJW6QEJpTFN\nq2V0Q9VreTfWJ405WJbfVcrWbS0bPpbNI9o2NUUdjr4bQ/zsd4Zp3fLwr39MZuE8fptNky66KEAo\nF60V0oT8QJaBTT2Qy+Hp6MVdt4KKbB8vLPsd4yecRjabRyuNGG6pCynx+718956f8NvfPpUzhLFP\naU6/omK0+YXmMxA3L0b4PPDWNta9uz79SE/LOqFxTMPYXFnl/6GrKPb07Px4to0kOCFNcN8/VpzX\n1ZaxaFIDY5MpHhhKkJUalSvgZDJIS6I8BlbrQZx33yAfT6CzKUR1GYFQgPfW7+P15x4jFRsk66kk\nb/oIiiBXXn0pyZxLRWWN/8xzz521fvVqpvuD3BxuQPlrYMlZiI4ik9e3hqLBxMUvJnopKtdSypHK\n/fh+M7IjQFoA+WG3FuwoFonYBVLaQfoEpmHgaAfT1kgDcG3UB2uY4td89balvLPifTZv3YXjuBxp\nPcjNC9+jVnTyaNP9vFx2CfqJ5Uw62EMiU6CqroaLr13CkfZu/BkN3YOoR5ej6yO4dp65dfV8V0B/\nsUBcqPLWZOosw2MeAdrnL1jC2vdf/vSAc7SD15iLrXahgT8le0i3PcEOOYUqD1yfXIYsFnhTzqdO\nD7HdrqYnl6a2sYYrlyymq7ObzVt2goBMQfD4O16MQohdVWtIWd0E2uNwYd3wAblGmgbNsxdQtq+D\nI7k09QkXed9T6Duvw24cxYSfvcB/jJ7JG5nomXf37nnDI/gNcPvo+jEf2bYRBae1osYXoSsLAsXS\n+JPcFO1jq9GMpaKcnVmLMCSLPG8RFhnaMhG+Jmw2bOzjs9d+k+jAIIZlIIRAKYu170fIxG1y5W3k\nvJ2YwapSP/DDQSa8Xg9PxDp4tbeLJ8edTQMCoUAunkV3dz93/ttD7MtnDEdpv1dIL8DYsWM/sm3H\nXav+32QYks7MIEdnuBpG30Ro1nOc1/hFzrbbQZpoJKPtTsqcGBPUIYIqwRmzTufxJx/kc9dfhh5u\nclSYHn7fNJvV087j1gfupXb5k3iuuXT4OPHokxIIAQm3SEvW5bUuP1llIB9bjtp+kMqzpvBPDTP4\nclUJVDafTUMt4fLQR7ZtRD1OonBpODbSoP1N4B2LW3RgykOII79EpNajEQitMaWgLizYcKSbJ5/8\nI1u37MKQAlMK4vkcvxtsx0gn2PHCK2TXrcfXGkUtOg+tS51jROmAUaBwlWAgL1COi+yMop9dTSAc\nZJ6/ks5CBgG4rutIUcY/33fbpwuc6woIDEJueC8VD6G61qK7X0c0Pw3lsyC9FZ3LgkfiNTTfmJcj\n+e4Bnn8iRjxrU1kdpjuaYszMJvpuupp8Ic8ZSjMbhWd0E42TGtm7dXMppRGCippyfAEfOQFaC7pS\ngolhgbW9rZTESBOhSj5qGpZZtOP89N9/x+133PrpAafREDIgC+Cih36DTGYwLA8quxNkAa39kEyh\nQ4CAc6bneLha8ujAxazYMcDuHRt46KHHGD32NBRyOIMrvdZy+SwbVnWxf/duAqEQlsdiypyJbN5Y\nRWz3YRKu5I1+L/U+lwqPSw6X3kKWbjsHaJTSCrwfy7aRzeMAbZvDECV/Dn8WT7Cfi4aeQO/+BkYu\nic7nyRlB/Kk0jmvhqdTUVkvmBmYSmltN9uEk61a8jxj2IC1K3iKP9uq0wh8MMe/8CzAtC2FIEBKN\nxpAujQHwSA1CsiMX5ytHtjDo2mgBhikHXNf6yN424uD+6ieEpBicTywwxLJymyGzmhsO/5KD4Tk8\n3fBNvtD+CFvKz2Fmcgu9nol0uDOotzSfveWrbH9vG07BRmsNw+OyxyKp1lQ0VDH9rOkgOHaiBuCV\nivlVLkGzBK6ooccukkZpr0c+5fUZTwjhks9/bKtGShL+4lLHJOnSHlnEO/JcCqbFptAiBq1RdAZP\n42BkNoNWFX4nAbaHsw/ECKsEDRPqKatcgGu7JU7HJkzEMXCW1yIQ8h/7HSFKjdVjozhHJ53QCKEx\nBFvKyv13KaWjifiB43GHkZHl8xFwNDmvtwtppI90DYVEwiDtLRX52yrORaAQStEVGE2FhLOCQTyu\notKbJJMpUMwXSkYPn8cJBGgx3MuzqK6poKE6hAY6+2JEB5LYheIwptI3jrmg1mgt0UJ50pnCxVq5\nTjAwgUgktCGVyrQlU62fDnB1dRHOm1fD/oMTH9h3oGP8H59bdVO1UUntRbNLE+pCg2mA3wOxFKOF\ny11jqjElrJSal7ce4MjBTnw+H4Y0StuQo9tREYgEWHjxmcw7fyqOo+jujbFl5Xb6D/chBaAlecfF\ntcAQYGFQZpgI5cw08jxpa0FBKzcWz9xgF52PdJY4ouAOd2xjyVWfZ/Pzm9Jl4ZoPcutX3jRgF4i1\n9KK1QrsuwcY6Gr64gL6HfkOkr5/iYz9iU2s/69e08MGqVbQd2MPsOQuprKxBa41CDDuQZtvaNXQe\n3MQVC6ax/dAAPX0DfLBmOV2d7VSJMAVl8FKvhyvqsowPukz3hPnTaWfhCo1HSLE8PcAP+lpEIVdw\nPmqj6KRM45WXT0NKOTedTK/QoapyXVlfeg9pjac8SHDqGFJrNxDKpDh/8UKiiRx9PUN0tXeQTSeo\nqW3A5wuAFB8O0CEYjPYiDJfFFy1kMFkgkUizf/tO8oUcfuHhYnMuZ+gmzgwVmFleZELIRSDYXUzw\nYrKHbbkUL6d784bFdWiW54rt/882nZQLUhqFUtpRoEaVBxjVUM6+HbtJpeLkgOy2dTQ3n07zovmk\nMgVMw0NlTSWZVJx0Ko5tOxSLCYZifX+xqmLq1MnMntNMZ38C1ymlKNI0EAVBjiI79SHGyzrWJ/3k\nlKbSU8SWNqtSA9zXvx8hRNTvNZ+LVATWFW2b3Ee4b3xywCmFFgKtFKfPncPia67lV/f/mGQqPhz7\nNJdffgHf+/4ddEXTHOqK09k3xItPPMWR9nbGN81AKYf4loFSSkIpup6/aB73P/A93t1+mEyuSEdr\nG7/+lwfJZtJIBN1ulKf121wsz6IiN5aHurK8oj5gwC0gpZH3+YzbRtWXvzA0lCpGh1qGo/GnCBzi\nL94JQqClxNVHzf9w6FkIAaaBNgXKELjDFf7RE331f8xH6+GsRBgSbUhcWYqkliF2hsOBdQXbqR3K\npa/YSaunX8dJu7lsK4VXCsJJ+bxmzO83Vw7F0sXB2L6PBO2kgSvNkAi0ENT0xpmxpZ0Z6XLKRBMx\nkaJL9YMUGH1xIm/vYNxgirJ4lvd6SrMkTbECZdqHKxsBQZ+I0esOoAV4ElkmrNlLIVsk2NWLP1fA\nVu6bsXjhO6eNrS6PRhM/35M/cvMeDktTy1ikIvSPQcShvr5tpLMf36aTAk5IY/iKkUFDaw/z49vZ\nnWqg2xjDDg4cA2e191L9q5eozhaRjsHrAz0gBHOiKaYSIWjMRiBZzx563X6EEPiHEsx4aQ1GymZc\nMkUolcUfCoRzqX2kkoFEIOC7wzKMl31eT6VGJ5VS/aCP26aTAs6xHYQotY62lvt4bHyEZX2b6cn1\nkCIHCLZtbuUtdzvn24r2QYPWnI99RQuEYk11gG06x7pkaYo8rjOAZNfODh59ayeh2ZPRGg63Hmag\nz0BqxwC4+SvX84uf/9MQ8KdE6sTadJKuHWu0piCFyK3v66jYnU8ymO6joDIgBKZhJNavXR/+h20t\nsjFfIJGFhDLpJoEhZX519KBXay1iamC4WSkwTSu9Y+uOwJEjvdIb9iGEIJNMEXOyGCbdAJ2dI3cB\n+KTkcbV1MykvD5l9vYnbs5n891zXDQzXkMIw5fZQyH9fLlv8WqFYvFJrLY4WmKYh9/l95k+KBfV1\n23HnMZzAGabcFQh47yvk3S8Xi4UlSishS8thmnK5x298w3VV57LXn+Hiz5zztwsOYNy4s7E8lre/\nd2huIZ+vUFojhND+gNWSSMRbq6vra3K5/JmO7VhojTQkAb/v4GAs1VJdFWnMZLIzXMcVQgqCId++\ndNI+UBbxV2cy2TnKcSwhQErD8Xq9W7WiL1CRoPvwyb9yfkqndEqndEqndEqndEqndEqn9KH+Nyks\nOvH4jHNvAAAAJXRFWHRkYXRlOmNyZWF0ZQAyMDI1LTAxLTMwVDA4OjU1OjQ1KzAxOjAwYN64vQAA\nACV0RVh0ZGF0ZTptb2RpZnkAMjAyNS0wMS0zMFQwODozODoxMSswMTowMLBna4UAAAAASUVORK5C\nYII=\n",
-				LogoFormat:       "png",
+				LogoFrom:         &catalogv1alpha1.LogoSource{Bundled: "aikit"},
+			},
+			DefaultNamespace: &catalogv1alpha1.AppNamespaceSpec{
+				Name: "aikit",
 			},
 			ChartVersions: []catalogv1alpha1.ChartVersion{
 				{ChartVersion: "0.18.0", AppVersion: "v0.18.0"},
@@ -249,8 +326,10 @@ fullnameOverride: "kueue"
 				Description:      "K8sGPT Operator is designed to enable K8sGPT within a Kubernetes cluster. It will allow you to create a custom resource that defines the behaviour and scope of a managed K8sGPT workload.",
 				DocumentationURL: "https://docs.k8sgpt.ai/getting-started/in-cluster-operator/",
 				SourceURL:        "https://github.com/k8sgpt-ai/k8sgpt-operator",
-				Logo:             "iVBORw0KGgoAAAANSUhEUgAAADIAAAAyCAYAAAAeP4ixAAAAAXNSR0IArs4c6QAAAARnQU1BAACx\njwv8YQUAAAAJcEhZcwAAFiUAABYlAUlSJPAAABVuSURBVGhD5Vr5b1zXdf7e/maf4XBfREkWSUmU\nKVuWLO9LHMdbnDiG6wZIUjRImwItCjRA/5SiRYGgQZO2ceGmrZ2t8pLElhdFcrxosSRKIsV1OORw\nONubefvrdx8l2ZIdL7F/ao9xMdTM473n3HPO931naPxfMenS6+dlMpfCleBKcqlc4gyfq3NpiZ9D\nrojrc7PPIxCDa0AspTjQawwP9cnpTJ+aSGYk1dRFaJHneYHtWGG7VfHWlsvu8sIqfH+Zv7PE1eb6\nzPZZAilwjZo7du1Re3qmtJ7Bcb1/ZIvaVexVM5m0nEqrsm7IEe89cr0wsFpB2Gq0g0a16q6tLLqr\npXNBtXK8ffrcSfjNGe5V5vqDs/SHBMKS0cb1bdvvS0zsvic7ddNOc2JPQR8cSauFLkNJZyDrOotK\nhSSzykIeEfmIQhehYyNsWnArVd8rL1v27PSGdeKti50zx191lxZeDKz629x/Y/OYT2efNpAtWnfv\nA+mb7/ly5ra796Z37hoxx3bISld/7HToOYgcLp9OBwF3lxmUAdnQoSQ0SBpbhoExJoQdB0F1Ffbs\nDNoXTpfbb//23eaRwy/acxee5TnvcnGDT26fNBDRtPvSN97yZO6u+x7N3nHPeOqGA7GTXq0Mb2UV\n3uo6b7uF0KaXQYiINSWJ3TUFkmlCSSehFPJQi93QigNQsylIIi6b3b9Rg336HTSOHi41jr7yXOPI\n4afgtF/mb3/i/hEI83GWUIzkF/JfeOivu7/2jSeKjz05ZG7fCre8DOut42i/dQru9BKCUgOoEYws\nbtlmWbX42ggQbrCcKjX45Qq8Uhn+2gqCehWBx4ChQVZFkCaMoa0wt45n1Gx2QlLk65z1shO123N8\niKF+vH1cIAkl1/VQ14Nf/V73k39yX/6+L6XYuGgeewOdYycRLFlQwyKM7A4k+3YjMSDWTpgDO/i6\nHWbfdTCL26FntkDVeiC5OoJKHc7CHNzSPIJOC7LImJ6IS1PJZhjMdZpS6N4Czxt319bc0GpcoB8C\ntj/SPioQQ88X788/9NjfdD/5jbvS+27SnHPTaL58FNGyCzNNR3snoOUHIBmkDSliOQWI+CobBm85\nDTWXYQlloaaLXH3Qsr0wMv3Q1DyihgN34SLc1QWyTwiJICHJOvcyYA6PSlqhtxeus91fXW4FreY5\n+uNsuvXh9vsCkaCat+Xu/8r3io9//QuJ8QnFPnEKzpuzkOw0tGSRj0Twa6u82Qtw5t6Fc/E07Itn\n+PNpdBbOwF4+D7e2wqYPISdEMBobPgEl1QW1MMg9eiB7CvurzDKd4wW4cUZkheCgqDCHRqBkct2B\n1RywF+ZWI8cWmRFk+qH2+wKZyN/7wF/mH37sq6nJG7TOqWk4b5+D5CXimhZo486cQzhPPltrQmn4\nUNiWCgtAbhFqN+rwV0vwlllClQX4HSIqnVMYkEAywRZyKgOjewSqUSBQVGAvnGcwDpR8AYpmQFIU\n6INDfNX6vI1K1pmfO4/QZ/o+3D4skJy558ZvFh78yrey+2/PO+dm4J6YhexqbGIbwWIJ6kaItDaK\nbHEK2d69yPROIdUziWSBvZLfhkRuG0yTt+6xJ9jcnXlmrLqASFWgZgrsC5PIxsuVZej5XmiZPvjr\nddjz0/TIg9bNfpIJ14RtvWdQCl2vL6is+G5pkQ+guunm1XZtIKoxuOX2rvsf/rPUvpv3oO3DOX4e\nwWqNN+1At3SkzW1IFydg5IdY8yyTFCE1VYCWyEE22RdGCorB3kh2sR8GkUizRMIEy2ce7eWTFFku\n4XeQz6RizhEEoOTy0NM9BIJ12CxVJFSWX5HwzXJMsRyzOcNbXen1SksLQat+in5+gGOuDaQ/vf/m\nb6dvu/tBrdBnOu9ME5nWIVseVCskQvEmublr19CuzsBaPYN25Sw6tQvw7bV4N0UVepHVw8aXWSLi\nts3cCHQlB79SRqd0BkHU4U0PQU1kYraPIu6dzUMxWWZl9sz6RSjFHHmni+cxqEyOes3N+uurduf8\nmTPcXsiZq+z9gUipPXtuSe6/9dvJ0bHt0boN5wSzUVqA1LSh+CkEjgZnrcHbZQ9UXRKZx4ZugZgP\nu7YAp1NinXt0KANFSYjDWUEWg9OZwREYiX6W0Aqs0knAZFn1bmHPJcn0zAwJVM0WIUe8qOVZBtuC\n2tPNvdIkXo1Zz0juynLamZspMSsn6K+36famvT+QvtQN+x8je39JUTOpDlHKPstSIGOrDhvQY4m4\nW6BH7AVtCgnjepj6JHR1B5SgF0HLg12Zg1O/ECOQznJT9Aw1ls8eJaexhHRmRzO64FWX0V4/T8hN\nxsFIksKgHfIJS8nk7VO+OKszvFk2fN9ADDAyEc/fWM96a6WaPXvuGP29SpNdCYSKdSqxd//Xza27\n9pCJlcZrL8CdnaNG34Ns+mtIp29DIjmBRGKUyNwbo42qM/16N3RjgM09Ck0agNdsolXlBcgtllQf\nnyNnsLFDn5BGxNLS3VDlVFxirleBxkAEz8SyJvDYZ0lWk0lYn+NbDah9PeyTLCuaYEPV41VWbXd+\n5jRheZZui7kmtsuBKOkDt9+R2Db+BJu3p3P2FDpvn4HaIVNnvwU9cTufEI4nuISqDRFGorZFdkmA\nLB3VyMJMEE5llk+rhU79XaKUjURhC+E0zUAIy4HDvtEJDAWEbYu9RV8MmQpgK8uHJWbbcRmJ3goa\nDXhWmVnToPX0Exw49hDCqZphvfv2gl+tsD7f02KbgXQhk5q68wGjf/h+dFyzSQ3lXDSga3cgme0n\nSs6h0z6NZpP84arQ6JggrSiiXhJszhUyKFmWGHSRnw/BrZdgW2d52yazMBizdhS48bOSwsD1FPtr\nCbZdhjHIbDJTQjFL4toJvex/eBtLiBQb+tAQSyu9Gcjqsm7PnFtx5i4cpedXoHgzEC07QuJ7UM0V\n93cWFtX6m9NI+EWM7xzC3ql17BqbwWDPPB05j0Z9DY6XobOEXuokns4NxDwkJIof17uisxTCFJzW\nPLxoCaZgcgYYBxJ6dJR8YmYRNAm3rUVI+Ty0rkFejs7tuIcssq7FvRT6Naj9LOUs+Ydzjr9RVTvz\nM9VOae4wOh0xZcYWB5JI5cYTO6e+GGnmrtqJU4pCRr7r4DZ8589vwR89fj3uvmMnbr1lDBM7IkLv\nWcwvLMLq5FlKzJbCO2QAcTfTQhEMndD0IjybArE9TdJLxxpLIiJFDFwiEUqKSei12FNUw0YArW+Y\nyJQDG4dbSewTlU5zPLDXofSQl7qIYElRck04M9OtsLT0uletCA0Wm/iyAFJWAHWUtypVOapWcPu+\nbfiL796PJx6/E1NTB7Bj7EbsnjyAR7/8CL7zp7fitgMr1Hmvolkv8wZVlpQYV+KdmBg2bUgEoiMm\nyVP2u2BvUK44Vb5HUSgQiuUlpkaNpKnr+U1Zb9UY4OYWIrtCoijsOynQ4n6JBEQL8kxS9mcyKcJm\nNx8kAmxaHEhUSKU7lpWy19ekgayJx7/yMB54+KtIpfvjhy6bohVx4OC9eOKx67FjlBDa4CzCGxT1\nLw6/bGJOF/9UWX4Km18gmU8SBXtIyJJYJbOnFDa4IlCNcCuaPxJjcTyNCZ/FdEkEY3bFNCmQT3wk\nESyUZFqLVGL75hcfsYlA5Ija2ao3dKnTkseGB7B37w1IpvObT1xjmpbDTftuwJ5daR5G3nDbLCeR\nkfhOaDyNWQnFhKhQsshdLKGAZGrxM8p1ce1s5CjkzyrlvpZiOYX8vINQ9JsINr4UhkKnBahwNokD\nEVvzTfKNwSIwRDYuo+6V0xG0LUnxPfQWcshmqFI/wnL5HnR3U85rFgJCqkCY91ucG84lEuWFJDFb\nDCoSM/z7jQ+JMoufESnknC+AQ2SCb24+IHqJ/8VBiEPE22LFdvWhIpBQinzhjRMELrPchi82/Qhz\nXBu248WZkGNnxLvC/TiE+HAp4tZxjYks8CW+aWGbz7z39OW7vPQOH+MdXDKBhHyJN7j0+0Q9is0w\n9Jmm94nHeBepHbQ0U7e8wA9n5y5iYWGee1zZ7RrzcHH2HC7M1OD5PUQn1jkJctPEYcIZBsIfw4Cy\nP2ixWVjvJLn480v7imDjZ+lLJJ4XkMuyE19aCIvi3yeJMhMSy0s0v9hT6Lew3fIk323ysStTYxxI\n1GrXE4lknYUXXpiZxXOH/gdnz4ivmK4dyEKUlk7j0KHf4tQZQqyxi6hj8LBNhn/PLgXiU1wGa/Fk\nqJJbhPGO40DFiljKgdBhrHRJp7IW6CcCER/zNfKoHkIXkkm000jA/P1AzEStJmWBU+E/rwjHOBA2\n2romY91MmkGrY+OZn72AH/zgaZw4cQKt5hra7SrXOmZnf4ef/Odz+OkvmqjWb2SvXMf5m79PjRSf\nfikYcbPsZXguCU1aofLlzJ7oouOXblhkIBaKNluDF0vZI5ucPkX5haKcBL8TMJwWNRsVQ4YjMIcx\nQVd+o07Gr1bc6upqfNgli7s+298TyL2Du1giN/vtpllbarK8klhZU7FMcrxw/hSOvXGcAbyDX77g\nYWX9DqRztyKZSvJQkd33Gk/ID0Uy4bXX0Gy9hCi7jvz2AzAzoyxv3j5rXDC4cMypU9LXziIqmkhu\nvz4WjyJLfCB+dZY5u8gbMHeOQ+sf4Y1JaJ9802688sKRzpmTh3jcFYkSZ2Tjpptavu3NslbXjN4e\n3kAKi4tt/PL5AP/8lIHv/8jH93/Ywc8ODWJp7YtIF+5EKkP854C0yerCBNxyLudgJWR7a+M1uNI0\nUsPbYWa3sER4ywJeRdKYDXG0Z5G5gzpH2yGq1iJ7ghfCIET/BE3evE0STbKEi2R1QyVxUkiura67\npSUx8tbFqZdtEzKefjqwK0sXQs89q+QKvtGbhJluISL8rW/sxmL5UdSdb1IJfx09/cxEkkNTZLF8\nBLYTIlnbgg9URShYF831o7C812AMZJEbOcDPckyEEKp0VDQunQ06TdjNeYR6wOeofsUc4roxb4j+\ncCvzHK6alPEsy1w2bh+vvAhncbYUWhvHuRkZ9j3bDIRmv3VshrB2lKxZ0ymbKZU4d5/lxidgqC1k\nElnOJBkOU0QgUZGRQURNcSV4f0QkNoVnraG+/iIa7Z9DH1DRNXYXjPRW8oAgtM0pUJSU4A6bMqlj\nL0MZGIDRQ6kvEEvwhQjStjiJitk9gj4yCjkppsgA7XOnos6Zd867y8viu+ErjS7sSiA00TyvE2bm\nZM7KSpFqM2FTlfJmtOdg1X/COf0Ib3EZgUtIJcHFA5NnwW0vorH2GirlH6PlPwdzNI3uyfuQLO6J\nITTwN7MRZ46lF1gbaJTegJ8KkJzYT8lBIPD8TYj2QzjliywrBjlYhDG6ne/rcEqrsM+fnWufPnGE\nm5WEw++3KxQvLKVnHV9Xtqj5rl2RohiweFCR420xCz9aQce6wLn8Ihyb0sQWP59Fu3OSSPgO3IiX\nlKshtXUruq67kwPVeJylSEgTwdgqa50DVdRpozb7MhqdM0hM7kV28m4GSAhnwGJ48iolWOdfQVjw\nkbppH5LXbWPjh6i/8lLYfOm551tvHvkXuvqB77euCqRTLXfUTNZTe/ompJQ+6tMJmYNUpmcSqaEx\nztDUTPIafKnE1xWE2ioiswol58Ec6CY67Ud+5CChti+ewUW2RHML8ScmSKFg6xdfRXXtdahj25Db\n/0D8LUvkBxSQnEXaHVjTr3Mg4zy0bxLpvTeRgwx0Lsyi+vOn55uvvPjDoFl7ga5eo3euCYQWJs3O\nRqBn8tT/e9TufLqztoiw1kIyP4bsyH6kBsaQ6BmG2TuMBCExzX+nh65HqneS/TDE+qdaFYrnEiMr\nHI/FNypBu8UgXkG1/Cow0o3cwUf4+xPMhBiVRSe7sM4dRav8O6jjA8gevA3mcDdRqoWNQ79o1Z5/\n9ied6ZNP0cer+OOyXRsIsd2xI9euqIXuPq27e5dSyKh2dQnu6jJlsk7kGoKZG4WRHYSe7OXqg2YW\nWfspwqsQh+xBEpqoTJn9INFRZ/0iNmZeRG3jDcjbhpG79VEkRyYJEkQ8wfLMVGv6GBpzr0HZkkH2\n7ns2S8oOUPvNr8P1Z596qfnG4X+gCBRyY5N1r7EPBCIsaLXWIymsqanMkD44vE3pLcp2o4TO2nn4\nrUr854DItukknRAsLGSFQBzBJQI/yAdBm9MhA2gsvYH6yhF05FXoO3che/NDSG2ZJDjxOaJx0Gyw\nnH6L1vzrUEZSyDCI9O6dsbuNw6+j8sy/HW++/Iu/D1utF3nAVUj1fhO64veZoU/sfiC37+Dfmtt3\n3S5phuxeWEC0TAILMkikh2EwG/Gtc1C7LALFXB54HU6ENTicCj2pCak7i9TOfUjvOgijSPYmwQfi\nzwrMtDV3nBPkWSijWWTvuQvJPZOxu41XGcR//Gi6/vx//51TLv2Im1/FG9faRwUiLJmY2P1Iev8t\nf5XYvedWxczp4UodwWoTkcUe8ELIvH0J4q/QnHN4yUIjRSqv09QgFwowhsdY62MwCgN8TuG016RW\nYrbKs+iUThE4qjAmr0PmDvbE1hFmyEb98BFUn/nx6Y3f/Pwf/dLSv9IPIRA/0j4uEGEJfWzs3vT1\nN343ufuGe83hHVnx9yO/vIGIh8qg4FPTXEmiE3nA4FSYSnGu5iKBquwfmcNV0G7CXSuTCGfgtchF\nUgtqfw6JPTuRmJqExoHOXVxD7de/6mwcevat5mu//idC8X/x/A/99v1a+ySBCNPUQmF/5uDtf2yO\n737EHBnboRO55JAIZVFqN9kfrpgIRYNn4r9gCUkh+iawOyTABhV2k7TSJlyT+DhdGttGSIYT0Mjs\noeWi9SZ76ZWXy81jLz9vHX3p34N2+yXuIGaOT2SfNJDLti09ecODiQO3PpwY2zmlprMjcioryWJc\n9SkxuOCxuMjSECpWEKLCjhZVl6QqzmeoYoly/cMgX7E862ifPwfr5Im15huvn7aOHf6VffH8MzxH\nfEn9Aa74KPu0gQhLqSPbdyX6eu/Vtu64JzG+c6exZaSgZLpTimHqQhDGs5GYt1X2DglNTlKTJU1m\nSfwtPoBfawbeSrllT5+std45Ot8+8bvXqJ+eD6z6W9z/E5XStfaHBHLZurTBHaOp8R1TlP1TUqFr\nXO/qGVWzuW45nU0piaQq66YEShPK94isHYadVifYqFbthblFp7R81l8pnezMTp+A3RL/C8cK13uD\nzae0zxLIZRPD+CBUdUjfNtFrbhnpU1P5XhhmRqbaizguh3bbD62GFdasildeWrGXL65SdArhJzST\n+J7oM9vnEchlE3uJJdo8QeTmUBPqIHHSBFuKv5ULGSxITdz8hzL0/3MD/hdsMb2xyUi3gQAAAABJ\nRU5ErkJggg==\n",
-				LogoFormat:       "png",
+				LogoFrom:         &catalogv1alpha1.LogoSource{Bundled: "k8sgpt-operator"},
+			},
+			DefaultNamespace: &catalogv1alpha1.AppNamespaceSpec{
+				Name: "k8sgpt-operator-system",
 			},
 			ChartVersions: []catalogv1alpha1.ChartVersion{
 				{ChartVersion: "0.2.17", AppVersion: "0.0.26"},
@@ -264,8 +343,14 @@ fullnameOverride: "kueue"
 				Description:      "kube-vip provides Kubernetes clusters with a virtual IP and load balancer for both the control plane (for building a highly-available cluster) and Kubernetes Services of type LoadBalancer without relying on any external hardware or software.",
 				DocumentationURL: "https://kube-vip.io/",
 				SourceURL:        "https://github.com/kube-vip/helm-charts",
-				Logo:             "iVBORw0KGgoAAAANSUhEUgAAAC8AAAApCAYAAAClfnCxAAAABHNCSVQICAgIfAhkiAAAAAFzUkdC\nAK7OHOkAAAAEZ0FNQQAAsY8L/GEFAAAACXBIWXMAAB69AAAevQE8A68TAAAAFnRFWHRDcmVhdGlv\nbiBUaW1lADEyLzI4LzA5g0A1/AAAACV0RVh0U29mdHdhcmUATWFjcm9tZWRpYSBGaXJld29ya3Mg\nTVggMjAwNId2rM8AAA34SURBVFhHxVgJeFRVlv5fvVf1ak1VFhKykpUQhJAIIR0iKiq2wAgiqJ/o\ntLafMmNgBBfaD9xGoZXWtm3bnm6nRT6nuxlGnaa77XFBoRHZjKHZtySQjex7aq969d6dc6sqIhhM\nAT3f/PluXtVdzvvPueece24J+PsgYW2pOD3ZolUmGzENDMWCTkiFBo0BLUzDoXY/vmp2a3teOYr9\nNN8XWXZluCLyGSakr50uVE1KwpIsm5CdqKdOTYCqAqpGwnWASH2ijkGjvg4XcNaNIzU9eGflV9pG\nmj0UFnSZuGzyz12LJf+QIqwtNulyhUER7k4RwT4RmpcYh0gsmRwSg2CkD4khSCkhyGM0WE0MXo+A\nfd3a3zafZqs2nsGOiMRLx+WQF167EesWWaQ16V0yeuv1CPXrSNAoosj6QloIKAzAmKXAIQF1vXD9\npg6Pvnpcezs665IgRp8x47VS4YX73JanrQct6GswgPlGpR0B3wknva7JANWjg5d2IzMBcoFFmGcS\nhYY93exIZGLsuCTyy/XGu344aHnd1ioLQ0EGXZjRZaCPAqFXgjc5hIwEpkvWCze0+qTP6oa0juiM\nmBAz+Rwg5UnNtLlYMST0Uxohz74CkNJu8huKEV+6grwEZtQzlvN+E3uPBim0Y0PM5J+EuWoB5Dvd\n9OLLtPcFICkeCYyCW0cxEC8Jea4g+/JgP+qjE0ZFrAaUr4b+DhN5txLt+PuA8mmtDHezhEwHw02p\n4t3RgZgQE/kZ0BelQCjyR79HIIDsRs1NzQk9qXWx0OXbq4c3PM+AwPmzaAO0o8bwOZBhY9OoJyEy\nMDpiIj8bUrYDglWhN0VezImrGIQHTTPKcWbuXAyMTQwTuxAizfXDhaaJ43Fm/nx0FGTTWq7osPPR\ns5t6eiQk2ZA8OwNp0YFRERP5ODCrgV5HmxwGHUVw6rxofXoVDv7oUawd48BPZlfiTFYyjKBcHgVX\nVCFrNyy6Dc2/+Cl+lpGC5yunYt+kPMjfcECm6aB2SBBFZiqywxTtHhUxkSfSoW/YiRb5MTS+CMH5\nt+KZquWYWVgEZ1ISXvNwt9B9LZTvTr/JAtMjVVj7zHOwen3ImzQZL/Z1wS9I5ErDIKlD5FwqNJ8a\ne7aJifxJsB7KMiHuAhHoYPT50HTyJKxjx6K9vw81u/dA7uulXeH7EgF3MhP976yrR1CWIVDbs+sL\naB0dkJnu63kcoiLAGxQGqwcwEO0aFTGlylqo/sWQl2RCFxcJWgnmoV4o/b1QiybgT++9h6tOn8Z6\nxJPbCGRRIezr3LNNoSCU+joYppbi4y92Qtm9F78gR0ylGeciREBcooajjsDhVw/hl9TxTb0uipHT\nwwjYDOvvF0G+pzfsOJGFdMSgz2CEM6gilxQi26JDr6HBHIKdSoF8JkbdzAeXZEAHFcjjyL9t1MPP\ni3P7KMCSH8SGca41j27HS9HuURHzIUUWHSqBtCQBOt2wxTSysV1lSCYxnEo/VZG/S3bhC5OfShiN\nXEvEGOrne2EkW6aSq9CRRKqcI86VSxQEHDUHu14xKivb2iiJxYiYfJ5jEwI7diG42UifucYR+4O2\nnlsxIqjVGEKTPwTHWfouqnBQRHppJq+BuAvxeefSbUQGl+cVGD7t1X5WXY2m8ECMiNnyBHYQSnUx\n9HOmQBrj+Zp+xIU4eYEJOGFX0GdmyCJrz3abwMt5nhT5nGHSHHw1zzbxtPLPLPCX5SHvKvp6Ls/G\ngEshTxkbrp0IbC2DoTIXIlXnLJzXOCn+jCN/zlQlBMic6f0yLKoAK42eUzMC/t1M/XYi/gmCOxfD\ndR91xewuw7gk8ndAX3IzjHnboAxQwE0eB9HCSfDUwAlxBZIVESVuAzJJET52IaiWpPTJT13N9TEC\nmxbBfQ91ceKWpTBWVEJ/dTVCXfT9/GpkBHxb+giYAIz/V1jXToY0h7bZFgRjrdCUPjADlQ3kIrow\noeH8FnYhasNKDYP38SzTTSOfI7j1KfiWZZM+j8Hy/UmQ7k6HrswOQdyJ4Id3wb2Ypn+nAqOSvwmG\ngjUwfTAL+gn8xX5qfBHP5TxrNBARlZ750U280EWGwS3OSZ+llkFzbURsEMxNsmRS3malyPCRVIlU\n7yGJT8E75/cIfBJZPTJGcxvpxzC9cyuMM8jKCIa7uA8LYReR6JlMLyNrXZT0MPgu8N1JpPkWelLK\nlSjFmmWo8glRwWd2I7yKD/F0NiTRHMpKhi0I/ndk9cj4TvL3Qr7+bhhfMEETesguzrCVNRIconpS\nQwdRbqMnJ8RdhbdhJfjuXLitBmp8947QejqbcYwS6Wlqtbk5sK5+BQ2nDyOjr40ykIkMw3JboW6v\ng9YaWf1tfCf5FTAtvx76GXtkDQcSLPD4nDgA/5FtCPb0gCUbaHkONR6YXprPFUmgz1yB4TasAE+L\nVGYQMQG8KOZKeOizn9YPKgE4O88itbEOnWQBC1m/iPFffJD0ByjvhgWMgO8iLz8B81oHfKnb8rPh\nmXsXdtUe+XF1MLRhMWw33gDT2AIiIfOJZPNGIsbjgdcsnPAAfe4iZcbQd+5ivHQ4TXvWTrOoxEYK\nfS4gC+fTMTUhqEBpq0NPMID6tCyIZKRcNURr5QkUT7WUfY5xQhfiouRvprS4EPKqISh63UAX9jfV\n/3HDTX1VG0/YNs6BoZwfPBqRoNeilaLhGD1Lw2pw9xDQTH08OAtJQT6X93XS/DO0Ry6jhK+mlcPV\n2wZ/yINBqjanMxs0poc6RCrLejRarCjzM+7/FXS2fNwP8rQLwN81IubCUJEKZm4lQQ2ORPVYT+dL\nK9433FwIcaaHigJ+7WswCvi3vAy8LXjJhiIPQqI37CKBFvLZL7mfD4Ordpief1VVqmWsOISQtp+u\nOQeeeBq7JozD9ygWuAT3Tbei4/4q7EA/yqFL/xUc/1kG/VURKedwUctXwfxcDnwFNZUzMXD7/Xs3\n7vp03VMwvjxZ0BcdSElAu+rBNkp2tSpVLYEQbifPN9M6TtxJim2Bb5MF5u1TIc7lZz4vyUL0V2M1\nIfH7C6Hu+BADAX/fXzW8mOr3Fcc31ZnzydJNEJv3uvsbSuqPpw76vejWS5gd0sYWQ15YAMFWDjGn\nCqYfTIU0TZwHU3o9Qi5OeBhlwLSHYHkhQC7T7OzDZ4M9z+a39wzeC+lVp0Ey7F+2Eh8FvRgQRcR3\ndWAK2fQa8l0fefcQ/a8mZ/gl2DI60DqmQHyQygCBF2TcUoepYNPsDiheD8YVTpY/Hz/5pZzdn3ZM\n9Htn8VtYDizKW66OlamD/d39FntRi81sHDIZcKNXtVVS9quEYUEJ5IpGhHLEpdDv/BEs11A9LqdA\nsk6DWLgClpdLoOVunVyMvUyr33zy8PIVMD48G/pb+lQ3tp8+hjbnILILJsLd2ow5moFuzSqOp6Wg\n/oEqbG1v3vK5c+D1BoQ8t8Jwew6kRF5dmol+TcitdHc0iYH0bOSWVuiSWhocP+88uz4lb8ISLSPL\nWtndRd5u8C5FcJkl4PuIDHTmc7ujPzDY7lEplF0Q3MehHPgL1AfxMkwLDsPOXHQ8NMLkbYQjEMQY\n1gUr+2liciizsHQ+GUzaB+upNmsmezE1Q7uTCsWXKROupvYE9M5uJLJBxLH9iVnsxbv/aahk4b1T\nwltIeAXGX7lpfIDeoSCBbYatZq6Ar9ZYbOxNycB/IlNuAyquLimv+k1aJmuDxM4iQV0K0x1REcOw\nUEvIByWhyJEB8TOEajsR+puiF6YrVxWnxLudolMhf0ag90uf67FdfZ2b18B8/20QH6iJN+G343KO\nFLjdlkqdaDwRCr77PVidpRCzeV3f4+vFH4/V/GT7qSP/xYVztCE0RJH2AxYXp2N0k0rTNPV1BJ43\nTr/u6ryAPz5FDem64pNy/txwatVEjU3vKi3PK+roFMoYbqD6/+ghqKejonjS8lHW4UcKz76RgD0F\nVvdBWsoWT+Wsswcbj7Yc8Dn/5y1oT+4EPimBYeJjkN/OoPvrNk9XbVdb87EbF9wzdWfSmF11jXXv\n/AssD9Ety9gohShIAzVvMvYwifz6etpDdxSrDmXK0kfGe12DKO1ut9NNd986zbl+Qlf7HGtphSOu\n/Noc+UjN4IYfrn42t6X2FoOzOyXBYjdf79cWFEOytUBp6QSlnkvBHIro7bAfp4se20sXuZuBVzdB\n1/jvVrsTWVkztiPuTxocrF60sqcqZ7uSy66tiC49DyXAjHXp4wIHjfGMLoesBo72ieQCaVStPmK2\nfrDRale3QMceB9ZNIhdaFp+0dd3YDNZCc/3kagdg9zwJIw2fj2+lSvIpeSXMJfdDfug+GN8shyGj\nngz5H/D+nMrf9InQXfNJ0PfQbUPehDshrj6UnY0PcjJwvLP18ZqTh7ZExZwHstrZKa4hgz4p6bq2\nwmJUdHTYMiDlvw3l19UKXS2DgS9UCGco5aXuBnbv8nvfSHc7j+5H6NQJhPYFIexuh3pw3zkXGhGW\n9TD/bjfiAicpAKthC70H0+4HoHuAxoQVwPwHgRsKYSjcD0e3oktgHyaksVnjr/o1Hw9LuDgMC2fN\n2/DagiXsS8nI+gQ7+wPi3rFDzomOXzGkh2G87gkYFlGKmZcOFFMfL1++AWvSDjiqGW3nR4mZbN7Y\nVP6bOs8EsUD4R+DZdXMXd75/zS3MCZnuxXbP8zCsjI7/n0B4HMZrN8H22DHYmxilvr0wq8tE/RuY\nOpUfrJeEu557Y/yispn//Ayw4V0Y9j0L8S3qvuhpf6UQV8O0fgPMyluQgy9A/O0MYGZ07P8JwP8C\n2etnVXSkp1kAAAAASUVORK5CYII=\n",
-				LogoFormat:       "png",
+				LogoFrom:         &catalogv1alpha1.LogoSource{Bundled: "kube-vip"},
+			},
+			DefaultNamespace: &catalogv1alpha1.AppNamespaceSpec{
+				Name:   "kube-system",
+				Create: ptr.To(false),
+			},
+			ImageRegistryKeys: []catalogv1alpha1.ImageRegistryKey{
+				{Path: "image.repository", Registry: "ghcr.io", Repository: "kube-vip/kube-vip"},
 			},
 			ChartVersions: []catalogv1alpha1.ChartVersion{
 				{ChartVersion: "0.6.6", AppVersion: "v0.8.9"},
@@ -280,16 +365,27 @@ fullnameOverride: "kueue"
 				Description:      "KubeVirt with Containerized Data Importer",
 				DocumentationURL: "https://kubevirt.io/",
 				SourceURL:        "https://github.com/kubevirt/kubevirt",
-				Logo:             "iVBORw0KGgoAAAANSUhEUgAAADIAAAAyCAYAAAAeP4ixAAAAAXNSR0IArs4c6QAAAARnQU1BAACx\njwv8YQUAAAAJcEhZcwAADdQAAA3UAe+RuhUAAAAGYktHRAD/AP8A/6C9p5MAAAAHdElNRQfiBxMG\nNwoTiwHQAAAAHWlUWHRDb21tZW50AAAAAABDcmVhdGVkIHdpdGggR0lNUGQuZQcAABIsSURBVGhD\nvVkJdFzVef7ve7NpNi2jZbRZki1vYBvbQIQBmwQwmLDYYCBw0tKktITSNukWDi09lJacHiAJbWko\nKSc5JzlpScA2ZkkAAwEMsYPBNthY1r5rtIw0kmbf3nu33/9mJORFSyD0O76e0bv33fd//37fCPoc\nIKUsxsdl+LzeIFqNhwQxXsO1fUKIHgxc/v3i90YEQqsYlfh6dUbSLZ3xeNOBybCzLxZXC61W46LS\nYm2t29VVZLE8ByJ7sbZFUZR07u7Pjs9MBAIpGH58vTpL9JX+VPril8cmPD/v6qVjYyHKahpBYKrx\numl7XQ3dUu3X17jdrW5V2Yt7doNUG0bK3Owz4FMTyVvADyG2pg3j9t5ketO+iUnPs509dCQ4Tqms\nRmSxECl4hJREui4VPG9JoYdubKinnf5ybY3X2eFVLSYhjFYQTpqbfwr8zkTyBNiFtmZgge54YvMb\n41POp3v66YPgGOka7MIEBLbmMRsGQkPX8VBBtV4P7ayrpev8ZbS+yNNabDEJPQ/FnMBI5G5YPBZN\nBMJb8gSuBoGbexPJzfvGQs5fdPfTIRAwdAipqhjQ+0LIWQjEJNV63LSzvpZ21FTROe6CtlKr9QWs\n2JsnFMvdsDAWJMIWwEc1xra0lDf3J1KbXpmYcj/d0U1HRoOkMQG2wGIInA6TUM5KtR4XXd9QR7fW\nVhGSQkexRX0RK3aBTPNiCM1JJO9CS7DJ1qSUt3bFE5t+HQo7n+lGDIyOUUbTJVktApGcv+NTgiWA\nZaARiYeKahDajhi6ubKM1nk9XSVWC1toF0YzYijKt5wNZyViGIYPHzuSkm7riMU2vzUZsT/T3UuH\nQEBqcAkrB/FnJHA2zLicQX6Ph25CDN1QXU4bQSjvcs9CsUcwkElOxRlEYAW/JuX9iIE/fmlswvkM\nstChsfGcC1ituSz0eWPG5TQQctFNcDlODBd63Cfcgh4CEY4hZJVPcIpUIGFDlvzm0eDow/905Jj6\najhG2DJnAfE5WGAxQB1CMaIqt4v+4eIL6A/Kfa2FivqnmDkAMqZ4jFOkA5GyqXTqy7uOnVBfeXu/\nLjXNIKfz/5cEq3b2YC+w22komTAebztBR7XMKkPKayGrA7MzOF3C2kA0suI9xANlMoJiUYkg5EDM\naWbekfPtRYPX8n0c6AwW2rw2/bxZA27mK3ZLsgv5USxCKDJrsdpj3pfHDBEw5K2Wj8Ripb3hMGZU\noUxOykJd18sdDumzWOYcpRglqkXCAXP+vRAgvEOQLEMPZuXlfA9ICGlI2F+6MVwY5ncI5rGput/r\nlE6LVXTFYxTKppfhLm6LZsDCm0CmsoP7Az/96Pjf37Nrr8ik07Cq1bh200X6TevPU1PZDLG+Z26Y\nBQsquIbk+dJwkH41GFCkiowwlztKgxRdytsaavWtFWXy1eGg8vzAoGJVVLqupspYW+gWkGXGUHZF\nkS2ZhNGmJdQ46UqDu5Dur6lPXOj23oEY4QbUXGkqkYELjngms7I7NCWyGdRuVGkN7qWEQmJ7WbH0\nOp1cGOcEFCdXet16G6zYEY0JstnyM6cho1FjkVf+2bJ6cUlpiVLvcenvBkbEiiKP/O76c6imoMDU\nAO/Hn1Cu/G5/l3IiGBcq1BhKp2hM0wowtQoDAUQQ9lTXqgin0svbxkahNOge1VqC3f6ubmXvyfYZ\n589gbjCRlOixqC+RpB58hkAcS8X5xYXKjbXVqKRIdrzH6cA1VUh5Q02lPK/Iy8YVA7GkkjB0yuqG\nMpxIislMRiL9G7wf43BkSuyfnBC4JuygkkBKDkhdoMatgsz23MZ5IrjAmzaGUsmKtvEJjg82kZkx\nQrGE2P3xCQrGY6ZkE+mMfKKj27j74GH5F4ePyXsOHZWPnGiVgWRCuiwWsQMtxmponFPmGUBwryj0\nyu1VfuGxWpXRVIp2DwzQVDYrjkSi4r5jJ+nej07o/9bepXdEonI4lZIvjo/KoXRK2FCAVYikQxk9\n6QTFDX0leHLhNjFtERV+ubJvKlw4MDmFq7iDLcuELAq919mjvtHebRJxoqZoqL6vDwTol70D4tW+\ngHiytYteDIyY8+ug6R1LagyLUE61CjIP+/uO2mo6r7gQdpHyVyNB4zfBcQE3Fiko7s3AiPKj5jbl\n3sPH1L86+rH857Z24yAs4sC8Nd9Js8DwCArrWjW24KA3MU3ElpFiVedYyJGAq8y03/wBLYfiMWXP\n8WYKp1KGF3/fWlcrzq0ol2aj6HZSDK6xq3+I+uFysArcq1Ks9RUZZnqdBkgVIvtdhgB3w23HM1lj\nd1+AJpJI8ypCFfMuh52uaWyQl1dV0utoSPcEhyll6AIKyG/CQS1oNJWkYS3Dx2l2LzPOzRX4oySr\na42dE5N4Nh4+TYStgqBHFqLfdHcrb3X1mAF4TqGXrq+tgj8iY/AakDs8PiH2BoZZ0fIcr0e5saaS\nrLCA2TvxfviHGFT2j44rH4Uj9MPOHnEwOA5/4QyHTbNZ2lZRZjyxcR39tGkD3bSsRvpcdqHAfzjk\npsHOEsbafk1zoEfhgP+ECFA3lUnXtuFoat40TYTB31mD0aiy69hxiqXT0LqqXFdVQfVeFwomiINs\nNJNVdvcNio5YXLIr7IQLbSovRR6Fe/GAPJphyCfau8U33jsqftDerUQ4NvkIgD2KHXbjjmV1RoPL\nqVYVOERjiZsceI6OPJzLXzkouCWN9QPZNCeAFbhUZF7n/4Cl3ZOT/v5QKP/nLOStwt7+Tme3eKen\nNxcLhV5xTWUFx0HuMTD/h+OTynNwMRZ4tdetXOEvBwl4IKyyHRZ85MLzDMSQ8cHIOAWTONXyQYx1\nhuq9tbLC2OQrNuX5IBI2jseipja5Rs2GWZ5Qiwbicbi0sQQOsISv84sDJYsSgPrhHjMrOlaedrMJ\naG54KgyrNJtW8VgtsEolVTiduQyFJJCApl4YDFB/MmlksHFfHMIiy60p9Mh/XL2C/mb5UnHP8gZZ\n7ipgs+dJaFTqdBq3LKmhModDSXEimRijvlRS4QA/U5LctUAqQYNapgpfl/JV5ueE5Rt7psIikUrn\niJwN0B4KMu3v7BTvDQyaVrmgpEhcxUE/HQcg+/FURDw/OCxfGBoxfjkwJGxWlW6ur+G6wRuLMATP\nQKMzEmZ12lJWIjejOPKfJ+NR42B4UuhwccSYuWQ2+DauzGMI+ICWdetCcMAjR0pZPJ6Mr2gLBnHu\nhkBzEckLOhCaUHZ/3EzJbNYoc9iVa2srqbjAbnCwEoSOY+WTXX3iX5rbRTAeVzb6y+UNVVWmUL3x\nhPFcf4CmMtlcbLBTYl9ufiKaJiPZrP7y+BgNZdJKAVI/SzIrPGZgwUwSCsE6AeWuxCWzHagcnAov\n6eLTH9M9m1tNAwUS/k9vtnWIw4Eh89KmkmLlkrLSXJfMT4XAnZGY2hwcVwuRTm/DgYjjhWPtpaFh\n+dtgKHc85vTDisP11wJB5dsfNtMDra3y7akQ2TBnQzCcjQSDb0cnQD3JhIjq+nKZkKY5VwUiMVff\n5GQu+OYDk0QP1Ts2jmrfTFnUj2pXgfhytZ8LZY4Mr2Eh8a/G5TTQT5nW6IzGjF0onrFsVjEPajjq\ncE3YUFGqL3O7EVtD4n+HAgpqkmLnQjwPOErQPFNfMk7D2Uw9FVAVE/lC1+SEEk6gEDLV+cAZDC4B\nbYjXW9rFh6jmKgT/UkUZnV9aYpgaZjAZi4p2O0uHJqeMlkhM++/uPnkkNAkS6PMwTVqWtpSX6v+x\nYS091bTBuKahUi9B3VAhw3TnOx8sUNZoMkFj2TQ6fjpXiWezVx3vH1D1DHx8ISImIAWE6cI5fk9z\ni4SPyqUup3JDTZW0Wiw5Mlw3YPqReEJ5tKVDufP9D+nHXX1Kkhmi5eEA99isxh31NXQpgvySshLR\n5C+hAhs6bk4EczrVJ7AiTiKQuS+VQtWlJgU98PK+sRAHfU6TCyJnlYyWFa+1tIrjwyMSDZ24vNyn\nrOFmMZUhNyr6haWlWoPHY/RHospvR0bVSTSGM+++EKgXlfrkF8tLucEVx6MR0ZqIqdxtc6u+GPBO\nWSitN5lQkerPVwxkCwsqsanFxYKfhXhqGR4Rz584ifokUQA9AoLxrLGz2i9/3LRBPrhutbHUgyMq\niLKrmUBseO12Y2dNFVWjgnOcvTU5Tm3IcFz8FuMT0zCgVJeq8jvluOK2Wl/94uqVhsIPgqYWZxUA\n7pXGwWvfyVbREhyTBei3Liv3iSvrqvSv1NUQiqAFbiMgLNwNSjJTIgDFNfl8xhX+MniZIjoTcXkw\nPIXawkfyuTPVbPBOCXQPJXYHbSoqQZZTXlXsFsv3bt+4fuT69edxFc7592LA8QQXOz40Qi+1tJiX\ntpT5xPfXnys2lfpMlzmARrIlmsh1t7NERCwYRThGw52NNyZC1JlKmB3uYqzBJNKmjFLctqSeNro8\nJ7HPbvXBBx8c8BU43HWlvos/CAypo/wybq5j6unAw7VUUnA7srmhXlaj6y232wVbZyCRNB452SaO\nTczKVByHhiFG02kaTWeNlkTceCsS4pR7Sqs+F3gL7i6iOCVu81fTn5T5x4oV9SFFUd7kLhlNpHzy\noqrKXfddfSWVFqGZ5FZlMTCtYqWj/QHxcms7tkGvAPDnK8Mj9NYolMJrTK8CCQjhc9hlWjOUpzq6\nrE/09ahjWloUIN7MJQuAbYoCKNeX+OhOf3WmXLH8EJee5TlTDWA0io9Hr1vRePCvr7icfc7M8wvG\nC2vYZqVYMileQtAPTIVNv8R5Xu5BF5xI86EpH+RIuWs9HvnY+euM769fI9eUFUkV5dtMNLzPAmBJ\nYiBRZnOIu2uW0iqbYw909l8Y5m8pM/YEmeNuVX3oaxvX9dx+cRMcEUSmC9x8MK2i0vv9g2J/d7dp\nlX3Do+LdURxhOYHwPPZxICV/fWmd8Yc4XX5t2RLaVlshnXar6SoLgUkguMmKY9ad9UvpC/aC93Dp\nYZAYMRcAM0QYmHij0uV87G+3XBrZcu5qJDWQXehBPI8YiCQSyq9b2un94Li+Dy6V5DeVTITnkXKb\nykqNa6sr8AihDGdSIiQ1fIWMmF6IShYk0rDGzpo6urawpNuuiO+w4vPTJk4nwrv/bI2v+Cf3fmkL\nNVT6ES+L+J0yb5U3unrEt985QAcnppBL+U0NdGkqguuM22hwFpgWezs8aZyIR4UV5SXveHOCT6wc\nF5f5K8VXyyqiRUL8O2Tcl5+ewSlEGFiE0xU9fsXS+pfv23YluR0F/B6YJ3IL5gKsEohG1XdPtijj\nkQiRPZ/5+D7EwYGJCfGzvkFjz/CI9spYUKQNKRyo4wtZI4q60+j2iq9XVFOtavkJ5PsfVnh+egZz\nSmcYRtNEOvPUv75zYN1jr7xmBvWC3XEupiTV1UvyoyHlvilflwS/iLZZdL/PKZ1Oq2pR+C3V3DRY\nsBgKtNtqo/saV9E1nsKX0aD+JUh051acijMsMg344KESu+17f950fnDHBRsQbTi2LlQsmag0BE1O\nwCUT3JrkJ9i5iKZQLzI4L9nQuprBMQeYRArP4hbkj+ob6HK39xh2+s5cJBhzEmHgxj0NXu8PvnXJ\npuzGpfU5MgsFP78diMUEhcO51x/sWvyhCllVXCDLnfbpGJ8TKGzIUhrdUFtP2wt9wQIhHoEsnKnm\nxEJEOEc/dcmSmp/ff81V5PeVLFws2SqalrdKSpp/Q7sum0X6XA62NLxsfmVEs1l5QXE53eHzp8oU\n9T8hxwsY8940LxEGF0t0pY9uW9H4zt+h8lv4dLdQ8LNLRaOCopFcU6QI6XPbpNumKuiUc2tOA+/G\nI4JCvMTlEndV19Jym+0XIPCjvELnxYJEGCDTDPM+/NV1a7q+cdnmXHOJfmlON2Mi3EmzVeIpchbY\nZLHbBq8z+yHTrc42IuihHDjm3r1sJW1yut8EAXapmaI3HxZK4zNAc9nrslgyjT7fllAma28fGCQ9\nk+Y3jcJ8rzV7MAkcvCgW5zRseKp85C6wKGkckfm9Ff80wR3srCETMBW39XchQ93oKe5A8r6fE07+\n8QtiHv84E0jJ/Lvdt/qi8W8+8+FHRQfbO9SMrgtljpcFEoTs5aVa+cplsgApN8uvgQB2tdmP5pix\nqqpxKZrBq4tLA15FeQCWeBpjET1SDr8TEQb/RIePrXCRu+KZzFZN1x14IBqzM4EijtOtIs244qDi\nsp6bYZeeeTZaZu4fk+jHuGI/jv3exsgtXRSI/g/DKwCpei2vAQAAAABJRU5ErkJggg==\n",
-				LogoFormat:       "png",
+				LogoFrom:         &catalogv1alpha1.LogoSource{Bundled: "kubevirt"},
 			},
 			RepositorySettings: &catalogv1alpha1.RepositorySettings{
 				BaseURL: "oci://quay.io/kubermatic/helm-charts",
 			},
+			DefaultNamespace: &catalogv1alpha1.AppNamespaceSpec{
+				Name: "kubevirt",
+			},
 			ChartVersions: []catalogv1alpha1.ChartVersion{
 				{ChartVersion: "v1.1.0", AppVersion: "v1.1.0"},
 				{ChartVersion: "v1.7.1", AppVersion: "v1.7.1"},
 			},
+			// KubeVirt and CDI take a long time to become ready, roll back
+			// instead of leaving a half-installed release behind.
+			DefaultDeployOptions: &catalogv1alpha1.DeployOptions{
+				Helm: &catalogv1alpha1.HelmDeployOptions{
+					Wait:    true,
+					Timeout: &metav1.Duration{Duration: 20 * time.Minute},
+					Atomic:  true,
+				},
+			},
 		},
 		{
 			ChartName: "local-ai",
@@ -299,8 +395,10 @@ fullnameOverride: "kueue"
 				Description:      `LocalAI is an open-source alternative to OpenAI’s API, designed to run AI models on your own hardware.`,
 				DocumentationURL: "https://localai.io/docs/overview/",
 				SourceURL:        "https://github.com/mudler/LocalAI",
-				Logo:             "PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiIHN0YW5kYWxvbmU9Im5vIj8+Cjxz\ndmcKICAgdmVyc2lvbj0iMS4wIgogICB3aWR0aD0iMTAyNHB0IgogICBoZWlnaHQ9IjEwMjRwdCIK\nICAgdmlld0JveD0iMCAwIDEwMjQgMTAyNCIKICAgcHJlc2VydmVBc3BlY3RSYXRpbz0ieE1pZFlN\naWQiCiAgIGlkPSJzdmcxMiIKICAgeG1sbnM9Imh0dHA6Ly93d3cudzMub3JnLzIwMDAvc3ZnIgog\nICB4bWxuczpzdmc9Imh0dHA6Ly93d3cudzMub3JnLzIwMDAvc3ZnIj4KICA8ZGVmcwogICAgIGlk\nPSJkZWZzMTIiIC8+CiAgPGcKICAgICB0cmFuc2Zvcm09Im1hdHJpeCgwLjE3Nzk5MjI5LDAsMCwt\nMC4xNzc5OTIyOSwtNDEzLjUyLDE3MzcuNTcyOCkiCiAgICAgZmlsbD0iIzAwMDAwMCIKICAgICBz\ndHJva2U9Im5vbmUiCiAgICAgaWQ9ImcxMiI+CiAgICA8cGF0aAogICAgICAgZD0iTSAwLDUxMjAg\nViAwIGggNTEyMCA1MTIwIHYgNTEyMCA1MTIwIEggNTEyMCAwIFogbSAzNTk2LDQ4MzEgYyA2MSwt\nMzcgMTU1LC0xNzYgMjA0LC0zMDQgMzUsLTg5IDc1LC0yNDQgOTAsLTM0MiAxNCwtMTAxIDEyLC0y\nOTcgLTUsLTQ1NCAtMTYsLTE0OCAtMjMsLTMwMSAtMTUsLTMwMSAzLDAgMTQsMTQgMjUsMzIgMTAs\nMTggNTUsODAgOTksMTM4IDE3OSwyMzYgMzE3LDM4MCA0MTYsNDM0IGwgNTMsMjkgLTYsLTMyIGMg\nLTEwLC00OSAtNTYsLTE1OSAtOTgsLTIzMyAtMjAsLTM3IC00MCwtNzYgLTQ0LC04NyAtNiwtMjEg\nLTYsLTIxIDI3LC0yIDU2LDMzIDE5NSw3MSAyODEsNzcgODAsNiAyOTAsLTE4IDMxMCwtMzUgNCwt\nNCAtNTUsLTM5IC0xMzEsLTc4IGwgLTEzOCwtNzEgNjAsLTEyIGMgMjY3LC01MCA0OTYsLTE1OSA2\nMjcsLTI5NyAyNSwtMjYgNjMsLTY5IDg0LC05NSBsIDM3LC00OCBoIC02NSBjIC02MCwwIC03NCw1\nIC0xNjksNTQgLTk0LDUwIC0yNTUsMTEzIC0yNjQsMTAzIC0yLC0yIDE3LC0xOSA0NCwtMzcgMjYs\nLTE4IDcwLC01NSA5OSwtODIgbCA1MiwtNDggaCAtNjI4IC02MjcgbCAtMTQ5LC02MCBjIC04Miwt\nMzMgLTE0OSwtNTkgLTE0OSwtNTcgLTgsMjQgLTY5LDEyOSAtMTM1LDIzMiAtMTA2LDE2MyAtMTYz\nLDI3MCAtMTkxLDM2MCAtMzIsOTkgLTQwLDM0NCAtMTUsNDY1IDI4LDEzOSAyNTcsNzcwIDI3OSw3\nNzAgNiwwIDI1LC05IDQyLC0xOSB6IG0gMTk5OCwtMzA2IGMgLTEsLTIzOSAtMzMsLTM3MiAtMTM2\nLC01ODAgLTcxLC0xNDIgLTIzNCwtMzc0IC0yNjMsLTM3NSAtNSwwIC00OCwxNiAtOTUsMzUgLTQ3\nLDE5IC03OSwzNCAtNzMsMzUgMzMsMSAyNDksMTU4IDIzNiwxNzEgLTEzLDEzIC0yMDQsNTggLTMw\nMSw3MSBsIC05MiwxMiA3OCw4MSBjIDE4MCwxODQgMzI3LDM1NyA0NjQsNTQ0IDU1LDc1IDExNCwx\nNDggMTMxLDE2MyAyOSwyNiAzMiwyNyA0MiwxMCA2LC0xMCAxMCwtNzkgOSwtMTY3IHogTSAzNDMz\nLDgxMDUgYyAtNiwtMzkgLTUsLTQwIDMxLC0yNCAxNiw4IDIzLDE3IDE5LDI4IC00LDExIC0xLDEw\nIDksLTMgMTUsLTE5IDEzLC0yMSAtMzUsLTQzIC0yNywtMTMgLTUxLC0yMiAtNTMsLTIwIC00LDQg\nMjYsOTcgMzEsOTcgMiwwIDEsLTE2IC0yLC0zNSB6IG0gMjY5OSwzIGMgMjAsLTM3IDE5LC0yNDcg\nLTIsLTM1MyAtMjUsLTEyNCAtMzksLTE3NSAtNTAsLTE3NSAtNSwwIC0yMCwxMCAtMzUsMjMgbCAt\nMjUsMjIgMjUsOTkgYyAxNCw1NCAyNSwxMTggMjUsMTQyIDAsMjkgNCw0NCAxMyw0NCA3LDAgOCwz\nIDIsOCAtNSw0IC0xMCwzMSAtMTAsNjEgLTEsNDAgLTYsNTcgLTIwLDY3IC0yOSwyMiAtMjAyNSwy\nMSAtMjAyNSwtMSAwLC0zNCA3MCwtNDE0IDg0LC00NTIgMjQsLTY3IDQ0LC05MSAxMDQsLTEyMCA1\nNiwtMjggNjAsLTI4IDI3OCwtMzEgMjQzLC00IDMyMSw3IDM5Myw1MyA1OCwzNiA4NSw5MiAxMjcs\nMjU4IDIwLDgyIDQ1LDE1OCA1NSwxNzAgMTYsMjAgMjYsMjIgMTExLDIyIDEyMywtMSAxNjgsLTEw\nIDE2OCwtMzUgMCwtNDMgLTgsLTQ3IC02NiwtMzcgLTY0LDExIC0xMTQsMyAtMTQzLC0yMSAtMTMs\nLTExIC0zMSwtNTYgLTUxLC0xMzIgLTU5LC0yMTggLTExMiwtMjkxIC0yNDIsLTMzMiAtNjcsLTIx\nIC05MSwtMjMgLTMwMywtMjMgLTIxMCwwIC0yMzYsMiAtMzAxLDIyIC0xNjAsNTEgLTIzMCwxNjgg\nLTI2OSw0NTAgLTIwLDE0MSAtMjEsMTQzIC01NSwxNDMgLTM2LDAgLTM4NCwtMTEzIC01ODgsLTE5\nMiAtMjE1LC04MiAtMTk4LC03OSAtMTczLC0zMSAzMCw1OSA5NSw5MSA2NTEsMzE3IGwgMTM1LDU1\nIDEwODcsMSBoIDEwODcgeiBtIC0xNTE2LC0zNTAgLTExNSwtMjIzIC01NSwtMyBjIC0zMSwtMiAt\nNTYsMCAtNTYsMyAwLDMgNTIsMTA0IDExNiwyMjUgbCAxMTYsMjIwIGggNTUgNTQgeiBtIDI5NCwy\nMTggYyAwLC0yIC00NywtMTAwIC0xMDUsLTIxNyAtNjAsLTEyMyAtMTEyLC0yMTcgLTEyMiwtMjIx\nIC0xMCwtMyAtMzEsLTcgLTQ2LC03IC0zNywtMSAtMzYsMSA3NiwyMTkgMTE2LDIyNSAxMjAsMjMw\nIDE2MywyMzAgMTksMCAzNCwtMiAzNCwtNCB6IG0gMTAyOCwtNCBjIC0xLC00IC0yNSwtNjMgLTUy\nLC0xMzAgbCAtNTAsLTEyMyAtMzAsNyBjIC0xNywzIC0zMyw4IC0zNSwxMCAtMiwyIDE4LDU3IDQ1\nLDEyNCBsIDQ5LDEyMCBoIDM4IGMgMjEsMCAzNywtMyAzNSwtOCB6IG0gLTUwNywtMjQxIGMgMjc1\nLC02OSA0NzEsLTE0NyA1NTEsLTIxOSA3OSwtNzEgOTEsLTEzNiA0NiwtMjQyIC0zMywtNzYgLTM0\nLC03NiAtOTcsLTE3IC0zMiwyOSAtODgsNjggLTEyNyw4NyAtNjMsMzIgLTc2LDM1IC0xNTksMzUg\nLTk5LDAgLTEzNCwtMTIgLTE5MiwtNjggLTM3LC0zNSAtNDAsLTUyIC0xNywtODYgMTQsLTE5IDIy\nLC0yMiA0OCwtMTYgOTAsMjAgMTE2LDIyIDE3MSwxNCA2NywtMTEgMTY0LC01MyAyMjksLTk5IDEy\nOCwtOTIgMTE5LC0yNzAgLTE3LC0zNDMgLTM2LC0xOSAtNTcsLTIyIC0xNTIsLTIyIC0xMjksMSAt\nMjI0LDI0IC0zNTIsODYgLTg4LDQyIC0xMjcsODEgLTE0NCwxNDMgLTEzLDQ1IC0zNiw0NyAtODMs\nNiAtNDQsLTM5IC02OCwtMTAxIC02NCwtMTY1IDMsLTU1IDE4LC02NyAzOCwtMzAgNywxNCAxOCwy\nNSAyNCwyNSA2LDAgNDEsLTIwIDc4LC00NSAxNDcsLTk4IDI1NCwtMTM5IDQxNywtMTU3IDEzMCwt\nMTQgMjQ1LC0zIDM1NCwzMyA3NiwyNiA5MywyMCA4MiwtMjkgLTExLC01MiAtNzAsLTEwOCAtMTU4\nLC0xNTIgLTE3MSwtODMgLTMzNSwtNzkgLTY3MiwxNiAtNjYsMTkgLTE1OSw0MSAtMjA1LDQ5IC05\nOSwxNyAtMzI2LDIwIC00MjAsNCAtMzYsLTYgLTEyMCwtMTEgLTE4NywtMTIgLTY4LC0xIC0xMjMs\nLTMgLTEyMywtNCAwLC0xIDI2LC0yNiA1OCwtNTYgMzEsLTI5IDc3LC03MyAxMDIsLTk2IGwgNDUs\nLTQyIC02MCw2IGMgLTc2LDkgLTIxMiw0OCAtMjc0LDc5IC01MSwyNyAtMTM0LDg4IC0xOTMsMTQ0\nIC0yMSwyMCAtMzgsMzQgLTM4LDMyIDAsLTMgMTcsLTU2IDM4LC0xMTcgbCAzOCwtMTEzIC0yNSwx\nNyBjIC0xODAsMTE2IC0zNzAsMzQ3IC00MjEsNTExIC0yNiw4MSAtMzQsNDkgLTI4LC0xMTEgNywt\nMTg2IDc3LC00NzAgNzgsLTMxNyBsIDEsNTUgNjIsLTEyMCBjIDExOSwtMjI5IDIxNiwtMzYxIDQx\nOCwtNTY2IDIzMiwtMjM1IDQzMCwtMzk1IDYwNCwtNDg5IDYwLC0zMiA2MCwtMzIgMjAsLTI2IC02\nNCwxMCAtMzA4LDM2IC0zMzUsMzYgLTY2LC0xIDQxNCwtMjY2IDU2NSwtMzEzIDQ0LC0xNCAxMjUs\nLTMyIDE4MCwtNDEgMTA0LC0xNiAyNTUsLTI2IDI1NSwtMTYgMCwzIC0xNiwxMiAtMzYsMTkgLTM0\nLDEyIC0yNjgsMTYzIC0yNzgsMTc5IC0yLDQgODEsNiAxODUsNCAxMjEsLTIgMTg5LDAgMTg5LDYg\nMCw2IC00LDEyIC05LDE0IC0zMSwxMSAtMjc1LDQzOCAtMjU5LDQ1MyAyLDIgMzgsLTE0IDgxLC0z\nNiA0MiwtMjIgNzcsLTM4IDc3LC0zNiAwLDMgLTExLDI5IC0yNCw1OCAtNTcsMTMwIC0xMTMsMzUx\nIC0xMzAsNTE5IC01LDUxIC0zLDQ2IDM3LC02NSAxMjQsLTM0NiAyNTksLTU2OCA0MjIsLTY5OCBs\nIDUwLC00MCAtNTUsNyBjIC0zMCw0IC03NSwxMSAtMTAwLDE2IC0yNSw1IC02MywxMiAtODQsMTUg\nbCAtMzksNiA0MSwtNDkgYyA1NCwtNjQgMTU5LC0xNjIgMjE0LC0yMDAgNDEsLTI4IDIyNCwtMTIz\nIDI1OCwtMTM0IDgsLTMgLTMwLC0zIC04NSwtMSAtNTUsMyAtMTExLDEgLTEyNCwtNCAtMjIsLTkg\nLTIwLC0xMiA0MCwtNTEgOTUsLTYxIDIyOCwtMTIwIDMzNywtMTQ5IDg5LC0yMyAxMTgsLTI2IDM1\nNywtMjkgMTUwLC0yIDI1MiwwIDI0MCw1IC0xMSw0IC03NCwyNSAtMTQwLDQ2IC0xMDQsMzMgLTI2\nNiw5NyAtMjg3LDExNCAtNyw2IDEwMSw0IDEwOTUsLTE0IDQwOSwtOCA0MjgsLTkgNDIzLC0yNyAt\nMywtMTAgLTksLTI2IC0xMiwtMzcgLTUsLTE1IDIsLTE5IDQ4LC0yNCAyOSwtNCAxMTgsLTcgMTk4\nLC04IDk3LDAgMTM2LC00IDExNywtOSAtMTYsLTUgLTM1LC02IC00MywtMyAtOCwzIC0xNCwwIC0x\nNCwtNiAwLC04IDYwLC0xMSAxOTgsLTExIDEwOSwwIDIwMSwtMiAyMDQsLTUgMywtMyAtNCwtMjMg\nLTE0LC00NSBsIC0yMCwtNDAgLTg3LC0xIGMgLTQ3LC0xIC0xMzUsLTMgLTE5NiwtNSAtNjAsLTMg\nLTMxNSwtOSAtNTY1LC0xNCAtMjUwLC00IC02MzcsLTE0IC04NjAsLTIwIC0yMzUsLTcgLTU1NCwt\nMTAgLTc2MCwtNiAtMTk1LDMgLTUxMiw3IC03MDMsOCBsIC0zNDgsMSAtMjAsLTI5IGMgLTEwLC0x\nNiAtMTksLTMyIC0xOSwtMzUgMCwtNyAxNzEsLTE0IDY5MCwtMzAgMjA2LC02IDM4MywtMTUgMzkz\nLC0yMCA5LC02IDE3LC0xOSAxNywtMjkgMCwtMTkgOSwtMjAgMzYzLC0yMiAzNDAsLTEgMzI2LC0y\nIC0yMjgsLTEwIC0zMjQsLTQgLTYwNiwtMTIgLTYyNSwtMTYgLTI2LC02IC0zOSwtMTcgLTU0LC00\nNSBsIC0yMCwtMzcgaCAtMTM2IC0xMzYgbCAtMjAsLTQ0IC0yMSwtNDQgMjAxLC02IGMgNDc5LC0x\nNiA5NzgsLTI0IDEzMzYsLTIyIDIxMiwyIDI1NywxIDEwMSwtMSAtMTc3LC0yIC0yODksLTcgLTI5\nNywtMTQgLTcsLTUgLTIwLC0zMSAtMjksLTU3IGwgLTE3LC00NyBoIC03OTcgLTc5NiBsIC01LC02\nMiAtNSwtNjIgLTQ1LDMxIGMgLTcwLDQ4IC0xNzQsMTM0IC0yMTksMTgzIC0yMywyNSAtNDMsNDUg\nLTQ0LDQ1IC0xMCwwIDE5LC0xNDggNDQsLTIyNyA1NSwtMTcwIDEyNiwtMzAyIDIyNiwtNDIxIGwg\nNDUsLTUyIC01OSwyMSBjIC0yMTgsODAgLTQyOSwyMTUgLTU3NSwzNjcgLTk2LDk5IC04NSw5OCAx\nNSwtMSA5MywtOTQgMTk2LC0xNzMgMzEyLC0yNDAgNzcsLTQ1IDIzNiwtMTE3IDI1NywtMTE3IDYs\nMCAtNSwxOSAtMjQsNDMgLTE5LDIzIC01NCw3NCAtNzksMTE0IC0yNSw0MCAtNTMsNzQgLTY1LDc3\nIC0yNCw2IC0yMTcsMTc4IC0yOTAsMjU5IC0xMjIsMTMzIC0yMjAsMjg5IC0zMTUsNDk4IC0zNCw3\nNCAtNjcsMTUwIC03NCwxNjkgLTEzLDM0IC0xNCwzMyAtMTQsLTQ0IC0xLC03MiAyMiwtMzczIDM0\nLC00NTYgbCA1LC0zNSAtMjUsNDAgYyAtNjcsMTA0IC0xODYsMzYzIC0yNDAsNTIwIC01MywxNTgg\nLTE0NSw2MDMgLTE0NSw3MDQgMCw1OCAtOCwzMSAtMjksLTk5IC00NywtMjkwIC01NCwtMzc0IC00\nOCwtNjIwIDUsLTIyNSAxMywtMjk0IDUyLC00NTUgNSwtMTYgNSwtMjcgMSwtMjQgLTMsMyAtMTUs\nNDYgLTI2LDk1IC02MywyOTAgLTU2LDYyNCAyMCwxMDYxIDExLDYxIDIwLDExNCAyMCwxMTggMCw0\nIC0zNywtNDQgLTgxLC0xMDcgLTQ1LC02MiAtMTExLC0xNTEgLTE0OCwtMTk3IC0zNiwtNDcgLTcx\nLC05NCAtNzgsLTEwNSAtMTIsLTIxIC0xMiwtMjEgLTEzLDIgMCwzOSAyOSwzMTIgNDYsNDE3IDIz\nLDE1NSAxMjUsNzE4IDEzMCw3MjcgMTIsMTggLTExLDMgLTEzMCwtODggLTY5LC01MiAtMTI2LC05\nMiAtMTI2LC04OCAwLDQgMTMsNTAgMzAsMTAzIDQxLDEzNCAxMTIsNDMxIDE1NSw2NTEgMjAsMTAy\nIDQ0LDIxOCA1NCwyNTggMTEsMzkgMTcsNzIgMTYsNzIgLTQsMCAtMTAyLC02MyAtMjAxLC0xMzAg\nbCAtNzIsLTQ4IDQwLDg3IGMgODcsMTg4IDE5MSwzOTcgMjA2LDQxNCA4LDkgNDcsMjcgODYsMzgg\nMzksMTIgMTQwLDQzIDIyMyw3MCA4NCwyNyAxNTksNDkgMTY1LDQ4IDEzLDAgLTE5NiwtNjkgLTM3\nNSwtMTI1IGwgLTg4LC0yNyAtMzAsLTU5IGMgLTE3LC0zMyAtMjgsLTYzIC0yNSwtNjYgNSwtNCAz\nMzIsNjMgNDAxLDgzIDE3LDUgMjUsLTMgNDQsLTQyIDczLC0xNTEgMTcyLC0yNTYgMzA1LC0zMjMg\nMTA1LC01MiAxNzAsLTY0IDMzMSwtNTcgMTQ4LDYgMjM5LDI3IDM3Miw4NCA3NiwzMiA4NCwzOCA2\nMyw0NCBsIC0yNSw3IDI1LDEgYyA1MiwyIDE2Myw2NiAyMjEsMTI4IDc1LDc5IDEyMCwxNjggMTU5\nLDMyMCAxMiw0NyAzMyw3MyA1OSw3MyA5LDAgODcsLTE4IDE3MiwtMzkgeiBtIC0xNTcxLC0xMyBj\nIDAsLTQxIDM3LC0xNjYgNjcsLTIzMSAzOCwtNzkgODIsLTEzMCAxNTYsLTE3OCA3MiwtNDcgMTM3\nLC02OSAyMzAsLTc5IDY2LC03IDY4LC03IDIyLC05IC0xNTQsLTQgLTMzMCwxMDAgLTQwMiwyMzYg\nLTQ4LDkxIC0xMDQsMjgzIC04MiwyODMgNSwwIDksLTEwIDksLTIyIHogbSAyNDAwLC00ODMgYyAx\nMDIsLTIwNSA2MywtNDE4IC04MCwtNDQxIC0xOSwtMyAtNDcsLTEgLTYyLDUgLTI4LDEwIC0yOCwx\nMiAtMjgsOTcgMCw0OCAtNSwxMTYgLTExLDE1MCAtMTAsNTcgLTksNjYgOSw5MSAzMiw0NiA3MCwx\nMjggODMsMTc4IGwgMTIsNDggMTksLTI0IGMgMTAsLTEzIDM2LC02MCA1OCwtMTA0IHogbSAtMTUz\nMCwtNiBjIC0yNSwtNCAtNzYsLTcgLTExNSwtNiBsIC03MCwxIDgwLDYgYyA0NCwzIDk2LDcgMTE1\nLDcgMzEsMCAyOSwwIC0xMCwtOCB6IG0gLTIyMiwtMTYgYyAtMjEsLTIgLTU1LC0yIC03NSwwIC0y\nMSwyIC00LDQgMzcsNCA0MSwwIDU4LC0yIDM4LC00IHogbSAyNzkzLC01NDUgLTE4LC0zMyAtMTE0\nLDEgYyAtNjMsMSAtMTU1LDIgLTIwNiwzIGwgLTkyLDEgMTAsMzAgMTEsMzAgaCAyMTQgMjE0IHog\nbSA4MDEsLTMxNSAyNiwtNSAtMTYsLTQ0IC0xNiwtNDQgaCAtMjUxIGMgLTEzNywtMSAtMzczLC0y\nIC01MjIsLTMgLTMwNiwtMiAtMjgwLC0xMCAtMjQ3LDcwIGwgMTUsMzQgNDkyLC0xIGMgMjcxLC0x\nIDUwNCwtNCA1MTksLTcgeiBNIDQwMTUsNjA5OCBjIDc1LC0xMTMgMzQ5LC0zNjMgNTE1LC00NzAg\nbCA3NSwtNDggaCAtMTEyIGMgLTYxLDAgLTExNCwzIC0xMTYsOCAtMyw0IDQwLDYgOTUsNCBsIDEw\nMSwtMyAtODQsNTYgYyAtMTMwLDg4IC0yMDYsMTU0IC0zNTIsMzA0IGwgLTEzNCwxMzggMjMsLTkw\nIGMgMTIsLTQ5IDE5LC04NiAxNSwtODEgLTExLDEyIC02NSwyMjQgLTU4LDIyNCAzLDAgMTcsLTE5\nIDMyLC00MiB6IG0gLTEwNzMsLTQwIGMgLTgsLTggLTksLTQgLTUsMTMgNCwxMyA4LDE4IDExLDEw\nIDIsLTcgLTEsLTE4IC02LC0yMyB6IG0gNDM0NiwtMzIgLTIyLC00NiAtNzgwLDIgLTc4MSwzIDc3\nOSw1IDc4MCw1IDEzLDMzIDEzLDMyIGggLTc3MCAtNzcxIGwgLTExLC0zMSBjIC02LC0xNiAtMTIs\nLTI4IC0xNCwtMjYgLTIsMiAxLDE4IDcsMzUgbCAxMSwzMiA3ODEsMiBjIDQzMCwyIDc4MywyIDc4\nNSwyIDIsLTEgLTcsLTIyIC0yMCwtNDggeiBtIC00Mzk4LC0xIGMgLTcsLTkgLTE1LC0xMyAtMTks\nLTEwIC0zLDMgMSwxMCA5LDE1IDIxLDE0IDI0LDEyIDEwLC01IHogbSAzNzYwLC0zMjIgYyAtMzY4\nLC03IC02OTAsLTcgLTY5MCwwIDAsNCAxODgsNiA0MTgsNSAyMjksLTEgMzUyLC00IDI3MiwtNSB6\nIG0gMjIzLDAgYyAtMTMsLTIgLTM1LC0yIC01MCwwIC0xNiwyIC01LDQgMjIsNCAyOCwwIDQwLC0y\nIDI4LC00IHogbSAxNzAsMCBjIC03LC0yIC0yMSwtMiAtMzAsMCAtMTAsMyAtNCw1IDEyLDUgMTcs\nMCAyNCwtMiAxOCwtNSB6IG0gMTU1LDAgYyAtMTYsLTIgLTQwLC0yIC01NSwwIC0xNiwyIC0zLDQg\nMjcsNCAzMCwwIDQzLC0yIDI4LC00IHogbSA2MDUsMCBjIC03LC0yIC0xOSwtMiAtMjUsMCAtNywz\nIC0yLDUgMTIsNSAxNCwwIDE5LC0yIDEzLC01IHogTSA2MTczLDU2MDMgYyAtNDYsLTIgLTEyMCwt\nMiAtMTY1LDAgLTQ2LDEgLTksMyA4MiwzIDkxLDAgMTI4LC0yIDgzLC0zIHogbSAxMTQsMSBjIC0z\nLC0zIC0xMiwtNCAtMTksLTEgLTgsMyAtNSw2IDYsNiAxMSwxIDE3LC0yIDEzLC01IHogbSAyMTEs\nLTEgYyAtMzgsLTIgLTk4LC0yIC0xMzUsMCAtMzgsMiAtNywzIDY3LDMgNzQsMCAxMDUsLTEgNjgs\nLTMgeiBtIDE5MCwwIGMgLTIxLC0yIC01NSwtMiAtNzUsMCAtMjEsMiAtNCw0IDM3LDQgNDEsMCA1\nOCwtMiAzOCwtNCB6IG0gMjI5MiwtMTIxIGMgMCwtNCAtNywtMjYgLTE2LC00OSBsIC0xNywtNDQg\nLTU4NSwxIGMgLTMyMSwxIC01ODYsLTEgLTU4OCwtMyAtMiwtMiAtMTIsLTMwIC0yMiwtNjMgbCAt\nMTgsLTU5IC03NTQsMyBjIC00MTQsMSAtNzU1LDQgLTc1Nyw1IC0xLDIgNywyNyAxOSw1NSBsIDIy\nLDUyIGggNDIwIDQyMSBsIDI3LDUwIDI3LDQ5IC0yNyw0IGMgLTE1LDIgMzk1LDQgOTExLDQgNTE1\nLDAgOTM3LC0yIDkzNywtNSB6IG0gLTc2NSwtMzk1IGMgODMsLTEgLTIyMywtNCAtNjc4LC01IC03\nMzUsLTIgLTgyOCwtNCAtODM0LC0xNyAtMywtOSAtMywtMTkgMSwtMjIgMywtNCAxMDAsLTEwIDIx\nNCwtMTQgbCAyMDcsLTcgLTIyMiwyIGMgLTEyMywwIC0yMjMsMSAtMjIzLDIgMCwxIDUsMTUgMTEs\nMzIgbCAxMSwzMiAyODYsMiBjIDI4OSwzIDk0OSwwIDEyMjcsLTUgeiBNIDcxMTUsNDk4MCBjIC05\nLC0xNiAtMTgsLTMwIC0yMSwtMzAgLTIsMCAyLDE0IDExLDMwIDksMTcgMTgsMzAgMjEsMzAgMiww\nIC0yLC0xMyAtMTEsLTMwIHogTSAzNjI1LDQ1MzkgYyAxMywtNDYgMjIsLTg1IDIwLC04NyAtMywt\nNCAtNTUsMTU5IC01NSwxNzQgMCwyMCAxMywtMTEgMzUsLTg3IHogbSAtNTgsNDQgYyAtMywtMTAg\nLTUsLTQgLTUsMTIgMCwxNyAyLDI0IDUsMTggMiwtNyAyLC0yMSAwLC0zMCB6IG0gLTEzLC04NyBj\nIC00LC0zMSAtOSwtNTQgLTEyLC01MiAtMiwzIC0xLDMwIDMsNjEgMywzMSA5LDU1IDExLDUyIDMs\nLTIgMiwtMzAgLTIsLTYxIHogbSAxNDMsLTE2MyBjIC0zLC0yIC0xMiwxNSAtMjIsMzkgLTI4LDY5\nIC0yNyw3OCAwLDE5IDE0LC0yOSAyNCwtNTUgMjIsLTU4IHogbSAtMTYzLDAgYyAtNiwtNzYgLTgs\nLTgzIC0xNiwtNTUgLTUsMjAgLTUsMzkgMiw1MSA1LDExIDEwLDM5IDEwLDYyIDAsMjMgMyw0MCA2\nLDM2IDMsLTMgMywtNDUgLTIsLTk0IHogbSAyMjU0LDUwIGMgLTY1LC0yIC0xNzEsLTIgLTIzNSww\nIC02NSwxIC0xMiwzIDExNywzIDEyOSwwIDE4MiwtMiAxMTgsLTMgeiBNIDM4NzcsNDAxMSBjIDMx\nLC00NyA4NCwtMTIwIDExOSwtMTYyIDYzLC03NiAxNzEsLTE3NiAxODAsLTE2NyAyLDMgLTUsMjcg\nLTE2LDU0IC0yOSw2NyAtNzAsMjQwIC02OSwyODkgMSwzMyAzLDMwIDksLTIwIDE2LC0xMjAgNjIs\nLTI3MCAxMDcsLTM1MCAxNSwtMjYgMTEsLTI1IC00NywyMCAtOTYsNzYgLTE5NCwxODcgLTI4MCwz\nMTcgLTcxLDEwOCAtMTgwLDMwNCAtMTgwLDMyNSAwLDQgMjgsLTQzIDYxLC0xMDYgMzMsLTYzIDg1\nLC0xNTMgMTE2LC0yMDAgeiBtIDI2NjYsMTcyIGMgLTEzLC0yIC0zMywtMiAtNDUsMCAtMTMsMiAt\nMyw0IDIyLDQgMjUsMCAzNSwtMiAyMywtNCB6IG0gODAsMCBjIC03LC0yIC0xOSwtMiAtMjUsMCAt\nNywzIC0yLDUgMTIsNSAxNCwwIDE5LC0yIDEzLC01IHogbSA3NCwxIGMgLTMsLTMgLTEyLC00IC0x\nOSwtMSAtOCwzIC01LDYgNiw2IDExLDEgMTcsLTIgMTMsLTUgeiBtIDgxLC0xIGMgLTEwLC0yIC0y\nOCwtMiAtNDAsMCAtMTMsMiAtNSw0IDE3LDQgMjIsMSAzMiwtMSAyMywtNCB6IG0gOTQ0LC0xMCBj\nIC05NSwtMiAtMjQ4LC0yIC0zNDAsMCAtOTIsMSAtMTQsMyAxNzMsMyAxODcsMCAyNjIsLTIgMTY3\nLC0zIHogbSAxMDI3LC0yOSBjIDMsLTIgLTMsLTIwIC0xMiwtMzkgbCAtMTcsLTM1IGggLTQzNiBj\nIC00MTEsMCAtNDM2LDEgLTQyOSwxOCA0LDkgMTIsMzAgMTcsNDYgbCAxMSwyOCA0MzEsLTcgYyAy\nMzcsLTQgNDMzLC05IDQzNSwtMTEgeiBtIC0yNzM1LC04MSAtNjEyLC0zIC0yNywtNjUgYyAtMTYs\nLTM2IC0zMCwtNjMgLTMyLC02MSAtMiwyIDksMzQgMjYsNzAgbCAzMCw2NiA2MTMsLTIgNjEzLC0z\nIHogTSA0NzE5LDM5NjEgYyA1NiwtMzQgMTM0LC03OCAxNzQsLTk4IGwgNzIsLTM4IDQ1NSwtNSA0\nNTUsLTUgLTQ1MSwtMyAtNDUxLC0yIC0xMTIsNjAgYyAtMTI0LDY3IC0yOTEsMTY4IC0yOTEsMTc3\nIDAsMyAxMSwtMiAyMywtMTAgMTMsLTkgNzAsLTQzIDEyNiwtNzYgeiBtIDY3NCwtNTggYyAtNywt\nMiAtMjEsLTIgLTMwLDAgLTEwLDMgLTQsNSAxMiw1IDE3LDAgMjQsLTIgMTgsLTUgeiBtIDI5MCwt\nMTAgYyAtNywtMiAtMTksLTIgLTI1LDAgLTcsMyAtMiw1IDEyLDUgMTQsMCAxOSwtMiAxMywtNSB6\nIG0gNDk1LDAgYyAtODEsLTIgLTIxNiwtMiAtMzAwLDAgLTg0LDEgLTE4LDMgMTQ3LDMgMTY1LDAg\nMjM0LC0yIDE1MywtMyB6IG0gMzY1LC0xMCBjIC0xMywtMiAtMzUsLTIgLTUwLDAgLTE2LDIgLTUs\nNCAyMiw0IDI4LDAgNDAsLTIgMjgsLTQgeiBtIDEwMCwwIGMgLTcsLTIgLTE5LC0yIC0yNSwwIC03\nLDMgLTIsNSAxMiw1IDE0LDAgMTksLTIgMTMsLTUgeiBtIDQ1NSwwIGMgLTgyLC0yIC0yMTQsLTIg\nLTI5NSwwIC04MiwxIC0xNSwzIDE0NywzIDE2MiwwIDIyOSwtMiAxNDgsLTMgeiBtIC03MjUsLTcw\nIGMgLTE4LC0yIC00OCwtMiAtNjUsMCAtMTgsMiAtNCw0IDMyLDQgMzYsMCA1MCwtMiAzMywtNCB6\nIG0gLTM4NSwtMTAgYyAtMTAsLTIgLTI4LC0yIC00MCwwIC0xMywyIC01LDQgMTcsNCAyMiwxIDMy\nLC0xIDIzLC00IHogbSA4MCwwIGMgLTEwLC0yIC0yOCwtMiAtNDAsMCAtMTMsMiAtNSw0IDE3LDQg\nMjIsMSAzMiwtMSAyMywtNCB6IG0gMTM5LDAgYyAtMjAsLTIgLTUyLC0yIC03MCwwIC0xNywyIDAs\nNCAzOCw0IDM5LDAgNTMsLTIgMzIsLTQgeiBtIC0yMzUsLTE1MyAtNDA0LC01IC0xMywtMzIgLTE0\nLC0zMyAzOTIsLTMgMzkyLC0yIC00MDIsLTMgLTQwMiwtMiAxNCwyOCBjIDgsMTUgMTYsMzUgMTks\nNDUgNSwxNiAzMiwxNyA0MTMsMTUgbCA0MDgsLTMgeiBtIDE1NzgsLTMyIC0xOSwtNDMgLTMxOSwt\nMyAtMzE5LC0yIDE1LDQ1IDE0LDQ1IGggMzI0IDMyMyB6IG0gMTIxNywtMzg4IGMgMTgsLTEwIDQw\nLC0zMSA1MCwtNDcgMTcsLTI2IDE4LC04MiAxOCwtMTA4OCAwLC05OTcgLTEsLTEwNjMgLTE4LC0x\nMDg4IC05LC0xNSAtMjksLTM1IC00NCwtNDQgLTI2LC0xNyAtMjA3LC0xOCAtMzY3MywtMTggLTM0\nOTcsMCAtMzY0NywxIC0zNjczLDE4IC0xNiwxMCAtMzcsMzIgLTQ4LDUwIC0xOSwzMSAtMTksNTUg\nLTE3LDEwOTQgbCAzLDEwNjIgMzAsMzEgYyAxNywxOCAzOSwzNiA1MCw0MCAxMSw1IDE2NTYsOSAz\nNjU1LDkgMzU4NiwxIDM2MzUsMSAzNjY3LC0xOSB6IgogICAgICAgaWQ9InBhdGgxIiAvPgogICAg\nPHBhdGgKICAgICAgIGQ9Im0gMzU1Miw5NTU3IGMgLTcyLC0xODggLTEzMSwtMzg1IC0xNDMsLTQ3\nNiAtMjMsLTE3MSA0LC0zMTMgOTEsLTQ4NiA1MCwtMTAxIDE1OCwtMjUyIDE3NSwtMjQ4IDYsMiAy\nMCw1MyAzMywxMTUgNzEsMzU1IDcwLDcyMSAtNCw5NzUgLTI3LDkzIC04OCwyMzMgLTEwMSwyMzMg\nLTQsMCAtMjcsLTUxIC01MSwtMTEzIHoiCiAgICAgICBpZD0icGF0aDIiIC8+CiAgICA8cGF0aAog\nICAgICAgZD0ibSA2ODk2LDY2NzQgYyAtMywtOSAtNiwtMTggLTYsLTIwIDAsLTIgODksLTQgMTk5\nLC00IDE5MCwwIDE5OSwxIDIwNCwyMCA1LDIwIDAsMjAgLTE5MywyMCAtMTcxLDAgLTE5OSwtMiAt\nMjA0LC0xNiB6IgogICAgICAgaWQ9InBhdGgzIiAvPgogICAgPHBhdGgKICAgICAgIGQ9Im0gNzMx\nMyw1MzkzIGMgOSwtMiAyMywtMiAzMCwwIDYsMyAtMSw1IC0xOCw1IC0xNiwwIC0yMiwtMiAtMTIs\nLTUgeiIKICAgICAgIGlkPSJwYXRoNCIgLz4KICAgIDxwYXRoCiAgICAgICBkPSJtIDc0NjMsNTM5\nMyBjIDE1LC0yIDM5LC0yIDU1LDAgMTUsMiAyLDQgLTI4LDQgLTMwLDAgLTQzLC0yIC0yNywtNCB6\nIgogICAgICAgaWQ9InBhdGg1IiAvPgogICAgPHBhdGgKICAgICAgIGQ9Im0gNzYxOCw1MzgzIGMg\nMTIsLTIgMzIsLTIgNDUsMCAxMiwyIDIsNCAtMjMsNCAtMjUsMCAtMzUsLTIgLTIyLC00IHoiCiAg\nICAgICBpZD0icGF0aDYiIC8+CiAgICA8cGF0aAogICAgICAgZD0ibSA3MzIzLDUyODMgYyA5LC0y\nIDIzLC0yIDMwLDAgNiwzIC0xLDUgLTE4LDUgLTE2LDAgLTIyLC0yIC0xMiwtNSB6IgogICAgICAg\naWQ9InBhdGg3IiAvPgogICAgPHBhdGgKICAgICAgIGQ9Im0gNzQyOCw1MjgzIGMgNywtMyAxNiwt\nMiAxOSwxIDQsMyAtMiw2IC0xMyw1IC0xMSwwIC0xNCwtMyAtNiwtNiB6IgogICAgICAgaWQ9InBh\ndGg4IiAvPgogICAgPHBhdGgKICAgICAgIGQ9Im0gMTUwNywzMTEyIGMgLTE2LC0xNyAtMTcsLTEw\nMiAtMTcsLTEwMTEgMCwtNjY1IDMsLTk5OSAxMCwtMTAxMiAxMSwtMTkgNjIsLTE5IDM1OTgsLTE5\nIDI3NzcsMCAzNTkxLDMgMzYwMCwxMiA5LDkgMTIsMjQ5IDEyLDEwMjAgMCw5ODYgMCwxMDA4IC0x\nOSwxMDE4IC0xNCw3IC0xMTQ5LDEwIC0zNTk0LDEwIC0zNDUwLDAgLTM1NzQsLTEgLTM1OTAsLTE4\nIHogbSA2NTMsLTg5MiB2IC02NDAgaCAyMDUgMjA1IHYgLTEzNSAtMTM1IGggLTM1NSAtMzU1IHYg\nNzc1IDc3NSBoIDE1MCAxNTAgeiBtIDEyNTgsNjI2IGMgNjcsLTE4IDExNCwtNDkgMTUwLC0xMDEg\nNTYsLTc5IDU2LC03MSA1OSwtNjAyIDEsLTI3MSAtMiwtNTI5IC03LC01NzQgLTE0LC0xMTggLTU0\nLC0xODEgLTE1MCwtMjMxIC00MywtMjIgLTU1LC0yMyAtMjkzLC0yNiAtMjgwLC0zIC0zMTMsMiAt\nMzg0LDYzIC0yMiwxOSAtNTEsNTggLTY0LDg3IGwgLTI0LDUzIHYgNTcwIDU3MCBsIDI4LDU3IGMg\nMzEsNjQgNzksMTA1IDE1MiwxMzAgNjcsMjMgNDUwLDI2IDUzMyw0IHogbSAxMTQ0LC0xNSBjIDI2\nLC0xMiA2NSwtNDIgODcsLTY2IDU4LC02MyA3MSwtMTE4IDcxLC0yOTcgdiAtMTQ4IGggLTE0NyAt\nMTQ4IGwgMyw5MiBjIDQsMTAyIC05LDE2NCAtMzYsMTcyIC05LDMgLTYzLDYgLTExOSw3IC0xNTgs\nMyAtMTQzLDU2IC0xNDMsLTUwOCAwLC01NjcgLTE2LC01MTMgMTQ4LC01MTMgMTQ0LDAgMTQ2LDIg\nMTQ5LDE0OSBsIDIsMTA2IDE0NiwzIDE0NSwzIHYgLTEzMCBjIDAsLTcyIC01LC0xNTMgLTExLC0x\nODEgLTE1LC03MiAtNjQsLTEzNyAtMTI5LC0xNzQgbCAtNTUsLTMxIGggLTI1NSBjIC0yOTEsMCAt\nMzAxLDIgLTM3Nyw4OSAtNjgsNzcgLTY3LDY0IC03MCw2NDkgLTQsNTY4IC0xLDYxMiA0NSw2ODcg\nMjksNDcgMTExLDEwNSAxNjEsMTEzIDE0LDMgMTMwLDQgMjU2LDMgMjE5LC0xIDIzMiwtMiAyNzcs\nLTI1IHogbSAxMTA5LC00MTYgYyA0OCwtMjQyIDExNSwtNTc5IDE0OSwtNzUwIDMzLC0xNzAgNjMs\nLTMyMCA2NSwtMzMyIGwgNSwtMjMgaCAtMTU4IC0xNTkgbCAtMjIsMTMzIGMgLTEzLDcyIC0yNSwx\nNDIgLTI4LDE1NSAtNSwyMiAtOCwyMiAtMTYzLDIyIGggLTE1OCBsIC0xMCwtNDcgYyAtNiwtMjcg\nLTE4LC05NiAtMjgsLTE1NSBsIC0xNywtMTA4IGggLTE0OCBjIC05NCwwIC0xNDksNCAtMTQ5LDEw\nIDAsMTAgMTc0LDk1NyAyNDUsMTMzOSAxOSwxMDEgMzUsMTg3IDM1LDE5MiAwLDYgOTUsOCAyMjcs\nNyBsIDIyNywtMyB6IG0gNjg5LC0xOTUgdiAtNjQwIGggMjE1IDIxNSB2IC0xMzUgLTEzNSBoIC0z\nNjAgLTM2MCB2IDc3NSA3NzUgaCAxNDUgMTQ1IHogbSAxMzE1LDYxOCBjIDMsLTEzIDUyLC0yNjQg\nMTEwLC01NTggNTcsLTI5NCAxMjQsLTYzMyAxNDgsLTc1MyBsIDQzLC0yMTcgLTE1NSwyIC0xNTUs\nMyAtMjYsMTUwIC0yNiwxNTAgLTE2MSwzIGMgLTE2MCwyIC0xNjEsMiAtMTY2LC0yMCAtMywtMTMg\nLTE2LC04MyAtMjgsLTE1NSBsIC0yMywtMTMzIGggLTE1MyBjIC0xNDIsMCAtMTU0LDEgLTE0OSwx\nOCAzLDkgNDgsMjQ3IDEwMCw1MjcgNTMsMjgxIDExNyw2MjEgMTQzLDc1OCBsIDQ3LDI0NyBoIDIy\nMyAyMjMgeiBtIDc2NSwtNzUzIHYgLTc3NSBoIC0xNTAgLTE1MCB2IDc3NSA3NzUgaCAxNTAgMTUw\nIHoiCiAgICAgICBpZD0icGF0aDkiIC8+CiAgICA8cGF0aAogICAgICAgZD0ibSAzMTEwLDI1OTMg\nYyAtMTA4LC03IC0xMDAsMzYgLTEwMCwtNTEzIDAsLTQzMiAyLC00NzkgMTcsLTQ5MSAxNywtMTUg\nMTk0LC0yNiAyMzgsLTE1IDU3LDE0IDU1LC0zIDU1LDUwNiAwLDUzOSA2LDUwNyAtOTAsNTEzIC0z\nMCwzIC04NCwyIC0xMjAsMCB6IgogICAgICAgaWQ9InBhdGgxMCIgLz4KICAgIDxwYXRoCiAgICAg\nICBkPSJtIDUyOTcsMjIwNyBjIC0zMiwtMTc3IC01NywtMzI2IC01NywtMzMyIDAsLTkgMjQwLC0x\nNiAyNDAsLTcgMCwyIC0yNSwxNDIgLTU1LDMxMSAtMzAsMTcwIC01NSwzMTggLTU1LDMzMCAwLDEx\nIC0zLDIxIC03LDIxIC01LDAgLTM0LC0xNDUgLTY2LC0zMjMgeiIKICAgICAgIGlkPSJwYXRoMTEi\nIC8+CiAgICA8cGF0aAogICAgICAgZD0ibSA3Mzg2LDIyMDggYyAtMzIsLTE3OCAtNTksLTMyNyAt\nNjAsLTMzMiAwLC01IDUzLC0xMCAxMjEsLTEwIDY4LC0xIDEyMywxIDEyMywyIDAsMiAtMjUsMTQw\nIC01NSwzMDcgLTMwLDE2NiAtNTUsMzE0IC01NSwzMjkgMCw4MCAtMjIsLTggLTc0LC0yOTYgeiIK\nICAgICAgIGlkPSJwYXRoMTIiIC8+CiAgPC9nPgogIDxwYXRoCiAgICAgc3R5bGU9ImZpbGw6I2Zm\nZmZmZjtzdHJva2Utd2lkdGg6MS42MTUxNCIKICAgICBkPSJtIDM4OC4zMDMzLDEwMzIuMjk0OCBj\nIC0yMC43MTA5NiwtMTcuMzAxIC00NS4yNzY2OSwtNDMuMjQ0MyAtNTguMzg0NzQsLTYxLjY1ODkg\nLTExLjA3MiwtMTUuNTU0MTggLTMzLjQ2NjE0LC01Ny44MDg5NSAtNDMuMzk2MjUsLTgxLjg4Mjk4\nIC0zLjAxODE5LC03LjMxNzA3IC02LjAzMjcyLC0xMy4zMTMzMiAtNi42OTg5NywtMTMuMzI0ODcg\nLTEuOTUyNDcsLTAuMDMzNyAtMS40MDA3OSwyNC43NTEwNSAxLjI5MzQ4LDU4LjExMTY1IDEuMzc3\nNjYsMTcuMDU4MTUgMi4yNzI0OCwzMS43MTE5NSAxLjk4ODQ4LDMyLjU2Mzk1IC0wLjc4OTcyLDIu\nMzY5MTcgLTEyLjgwNTA4LC0xOS42MjY5OCAtMjMuNTMzNjUsLTQzLjA4MjE4IC0xMi45NjQxNCwt\nMjguMzQyOCAtMjAuNzkwNDksLTQ5LjU4NDk3IC0yNi40Njg4OCwtNzEuODQxNjcgLTYuNzY1MzQs\nLTI2LjUxNyAtMTcuMzc0ODcsLTgwLjYyNTUzIC0xOC41NjE1MiwtOTQuNjYzNjUgLTAuNTU0OTIs\nLTYuNTY0NjQgLTEuNjQyOTUsLTExLjkzNTcxIC0yLjQxNzg2LC0xMS45MzU3MSAtMC43NzQ5LDAg\nLTAuOTUyODcsLTEuODE3MDMgLTAuMzk1NDcsLTQuMDM3ODUgMC41NTczOSwtMi4yMjA4MyAwLjM4\nOTUzLC00LjAyNzU4IC0wLjM3MzAyLC00LjAxNTAyIC0wLjc2MjU0LDAuMDEyNiAtMTMuMzc4ODgs\nMTYuMDc5MTMgLTI4LjAzNjI5LDM1LjcwMzQ4IC0xNy42MjksMjMuNjAyOTUgLTI2Ljg0MzE2LDM0\nLjcxNjkgLTI3LjIyMDk3LDMyLjgzMzU3IC0yLjA3MzI1LC0xMC4zMzUwNyAxNi4wNTMwOCwtMTI5\nLjQ4NDYxIDI5LjgyMTIyLC0xOTYuMDIzNCAxLjUxNjU5LC03LjMyOTQyIDAuNTQ1MjMsLTYuODg0\nMDMgLTIyLjcyMjgzLDEwLjQxOTE1IC0xMC4zNjMzMyw3LjcwNjYzIC0xOS4yNjA3NCwxMy41OTM3\nNCAtMTkuNzcyMDMsMTMuMDgyNDUgLTAuNTExMywtMC41MTEyOSAxLjM0NDMxLC04Ljc2MzczIDQu\nMTIzNTYsLTE4LjMzODc0IDcuMjA3MDIsLTI0LjgyOTQ2IDE4LjE5NzUzLC03MS4zMDkyOSAyNi44\nNDAzMiwtMTEzLjUxMDA3IDQuMDkzNDQsLTE5Ljk4NzM4IDguNTQxOTYsLTQwLjU4ODUzIDkuODg1\nNTcsLTQ1Ljc4MDMxIDEuMzQzNjEsLTUuMTkxNzkgMi4yMDA2OCwtMTAuMTY2MzcgMS45MDQ2LC0x\nMS4wNTQ2MyAtMC4yOTYwOSwtMC44ODgyNyAtMTAuNTg4MjUsNS4wMzY5NCAtMjIuODcxNDcsMTMu\nMTY3MTQgLTEyLjI4MzIxLDguMTMwMTkgLTIyLjU0Nzc4LDE0LjU1ODQgLTIyLjgxMDE0LDE0LjI4\nNDkyIC0wLjI2MjM2LC0wLjI3MzQ5IDYuMzA2ODcsLTE0LjY3MDEyIDE0LjU5ODMsLTMxLjk5MjUy\nIDEyLjM0NjM4LC0yNS43OTM5OCAxNS42Mjc0MSwtMzEuNDEyODIgMTguMTI1MiwtMzEuMDM5Nzgg\nMi40MzQ4NSwwLjM2MzYzIDMwLjgxOTQyLC01LjE0NDAyIDcwLjAzNzc3LC0xMy41ODk5MSAzLjg4\nNTU0LC0wLjgzNjc3IDQuNzUzNDEsMC4wODczIDExLjA1MDIzLDExLjc2NjE2IDE1LjQ4ODY3LDI4\nLjcyNzEyIDM3LjQwNjUsNDguMjMyMTUgNjUuMzMzMTEsNTguMTQwOTUgMTcuODQzMDksNi4zMzEw\nMSA1OS4yMTUyNSw1Ljg2OTc3IDg1LjA2NjM0LC0wLjk0ODM1IDE3LjAwOTE5LC00LjQ4NjEyIDQw\nLjYzMTk0LC0xNC41ODA2MSAzOS43MTQ2OCwtMTYuOTcwOTIgLTAuNDQ1NywtMS4xNjE0NiAxLjky\nNzU3LC0yLjY0NTg4IDUuOTYxMywtMy43Mjg2NCA5LjY4ODMxLC0yLjYwMDYgMjQuNzgzNTEsLTEy\nLjk0MjYzIDM0LjU5ODgxLC0yMy43MDQzNSA5LjY1MDA1LC0xMC41ODA1NSAxOS40MjIxNCwtMjku\nNjI4NzUgMjQuMzc5NzEsLTQ3LjUyMjAzIDMuNTcwMTEsLTEyLjg4NTUyIDcuMTYzMDcsLTE5LjYy\nMDc5IDEwLjQ2NjgxLC0xOS42MjA3OSA0Ljk0MTcxLDAgNTUuNzI1OTQsMTMuMzYxMTYgNzMuOTg4\nNjYsMTkuNDY2MTcgMjcuMzkyNTgsOS4xNTcwMSA0NC41NTQ0OSwxNy42NzgwOCA1NS4xOTk3LDI3\nLjQwNzIxIDEzLjg2NTUzLDEyLjY3MjMgMTUuNjYyMTQsMjMuNzExNjcgNi45NTQ0Niw0Mi43MzE3\nNiAtNC44MzM3MSwxMC41NTgyMyAtMi45MTI2NiwxMC44MjQ1OSAtMTkuNzE5MDQsLTIuNzM0MiAt\nNS4xOTEzMSwtNC4xODgxNyAtMTMuOTIwMDIsLTkuODIwOTggLTE5LjM5NzEyLC0xMi41MTczNSAt\nOS4xNzM2MiwtNC41MTYxNSAtMTEuMjc5MiwtNC44OTk2OSAtMjYuNzE5MTcsLTQuODY3MDIgLTE5\nLjgyNzQ1LDAuMDQxOSAtMjYuNTI4NDksMi40MjI3NyAtMzUuODE4MTcsMTIuNzI1ODIgLTQuNjI2\nMjcsNS4xMzA5NCAtNS44ODM0Miw3LjY3NzA2IC01LjEwODg3LDEwLjM0NzA4IDEuMzc4NTgsNC43\nNTIwOSA3LjA0MjMxLDkuNDQ5MjYgMTAuMjU4ODcsOC41MDgwOCAxMC4zNzg0MiwtMy4wMzY3NSAy\nMy42NjIzOCwtNC4xNjE2MSAzMS42Njg0MSwtMi42ODE2MSAxMi4zMjg2NCwyLjI3OTA3IDMwLjgz\nOTcyLDEwLjMyMzc4IDQwLjI2Njc0LDE3LjQ5OTQ5IDE5LjQ4MDc5LDE0LjgyODQ5IDIwLjk3NzIz\nLDQwLjM2Njg3IDMuMjIyODMsNTUuMDAxNTQgLTguNzQ2MjIsNy4yMDkzNyAtMTcuMzkxNDMsOS4w\nMjg3IC0zNy41MjM2Miw3Ljg5NjU5IC0xOS4zOTA5MywtMS4wOTA0MSAtMzUuNTQ2NzYsLTUuMTA2\nMjQgLTUzLjAxODUzLC0xMy4xNzg2OCAtMTYuNDg0MTksLTcuNjE2MTggLTIyLjg3NTM1LC0xMi45\nODQ2OCAtMjcuODYyNTMsLTIzLjQwNDI4IC01LjY3MTE5LC0xMS44NDg2NSAtOS4yNDczNCwtMTIu\nNDI2MTIgLTE4LjY2MDI0LC0zLjAxMzIzIC00LjE5MDcsNC4xOTA3MSAtNy43MTE4NSw5LjU4MDc4\nIC04Ljc4NTY5LDEzLjQ0ODg3IC0yLjIyNDQ0LDguMDEyNjggLTIuMjk3ODMsMjAuMTg3ODUgLTAu\nMTQxMTUsMjMuNDE0MjMgMS4zNjk3MiwyLjA0OTA2IDIuMjI1OCwxLjczNzY5IDUuNTUwNzksLTIu\nMDE4OTMgMi4xNjIyMiwtMi40NDI5IDQuMjYzNjMsLTQuNDQxNjQgNC42Njk4MSwtNC40NDE2NCAw\nLjQwNjE4LDAgOC4zNzcyOSw0LjYzMjA0IDE3LjcxMzYxLDEwLjI5MzQgMzEuNzk2NzEsMTkuMjgw\nOTggNTMuNzE2NTMsMjUuNjAyNjggODkuMTA5NzgsMjUuNjk5MzkgMTUuODg5NSwwLjA0MzQgMjIu\nNTM0OTMsLTAuNzQyMzggMzYuODgyMDksLTQuMzYxMiAxOS4xNTAyNywtNC44MzAzMyAyMi45MDM4\nNiwtNC42NDE1NCAyMS4yMTczNSwxLjA2NzA3IC0zLjc2NTQ2LDEyLjc0NTU5IC0xOC42NTc1MSwy\nNC42ODExNiAtNDAuMDI3MzcsMzIuMDgwODcgLTI2LjgyNTU3LDkuMjg4ODUgLTQ0LjQxMTk1LDgu\nMTAwNDkgLTEwNC40ODIxNiwtNy4wNjAxMiBsIC0zOS41NzA5OCwtOS45ODY5OSAtMzYuMzQwNjks\nMC4wMTAzIGMgLTM5LjE2NDY4LDAuMDExMSAtOTMuNjQxMTEsMi4yMDkyNiAtOTMuNzM3NDgsMy43\nODI0MyAtMC4wMzI2LDAuNTMxODQgNy40MTcyNiw4LjAzMzgzIDE2LjU1NTIsMTYuNjcxMTEgbCAx\nNi42MTQ0NSwxNS43MDQxNCAtNS42NTI5OSwwLjAzOTQgYyAtNy4zNjE1MiwwLjA1MTMgLTM0LjAw\nOTk4LC03LjEwNjYgLTQ0Ljc2OTA5LC0xMi4wMjUyNCAtMTAuNjUwNjUsLTQuODY5MDQgLTIwLjU1\nMTcsLTExLjY3NjEgLTM0LjcxOTU4LC0yMy44NzAwMyAtNi4xNDIwNCwtNS4yODYzIC0xMS40NzQ4\nNSwtOS4zMDM5NiAtMTEuODUwNjgsLTguOTI4MTQgLTAuMzc1ODIsMC4zNzU4MyAxLjkzMDA2LDgu\nNDg3NjIgNS4xMjQxOCwxOC4wMjYyIDMuMTk0MTMsOS41Mzg1NyA1LjM4NDczLDE3Ljc2NTY0IDQu\nODY4MDIsMTguMjgyMzQgLTEuNTIwMTcsMS41MjAxNyAtMTguNjU0OTcsLTEyLjE0Nzg5IC0zMS40\nOTM2NCwtMjUuMTIxNzggLTE5Ljk1Mzc5LC0yMC4xNjM5NiAtMzcuOTA2OTIsLTQ2Ljk0OTk5IC00\nMy43ODQ4MiwtNjUuMzI2ODcgLTEuMDgwMjIsLTMuMzc3MjQgLTIuODAzNzYsLTUuODUxMTggLTMu\nODMwMSwtNS40OTc2NSAtMi43NzgzOSwwLjk1NzA0IC0xLjk1NzM2LDM5LjEzMzEzIDEuMjQwMjYs\nNTcuNjY5NjEgNS4yMTM2LDMwLjIyMjk1IDEwLjYzNzA2LDQyLjM0NjU3IDExLjk0NDc5LDI2Ljcw\nMTQzIDAuNTAxMzUsLTUuOTk3ODkgMC44MjYyNywtNS41ODMzMiAxMi40MDE3MSwxNS44MjM3NyAy\nMS43MTk5OSw0MC4xNjc5OSAzNC45NTczNSw1Ny43OTcwNCA3MC45Nzc3MSw5NC41MjU1NyAzOC4x\nMDQ4NSwzOC44NTQwMSA2OC4zNjUzMSw2NC4zNDA2MyA5Ny43NDE2OCw4Mi4zMjIxMSAxNS42Mjk2\nNyw5LjU2NzA3IDE1LjczODY4LDkuNTIyMyAtMTQuNDY4NzQsNS45Mzk0IC0yMy4yOTMyOSwtMi43\nNjI3OCAtMzIuMzAyODQsLTMuMDI4NzMgLTMyLjMwMjg0LC0wLjk1MzQ4IDAsMy45MTk3MyA1MS41\nMDQ3MSwzMy40MjM2OCA4Mi40NjE3NSw0Ny4yMzczMyAxMC45MDg0MSw0Ljg2NzU3IDI1LjAzMjk4\nLDkuNjQ0NTUgMzUuMjk0NTQsMTEuOTM2ODUgMjQuNjYzNDMsNS41MDk0MiA2My4xMzk2MSw5LjEw\nNDMyIDYzLjEzOTYxLDUuODk5MjcgMCwtMC43MTEzIC0zLjMxMzgyLC0yLjkwMzI1IC03LjM2NDA1\nLC00Ljg3MTAyIC00LjA1MDIyLC0xLjk2Nzc4IC0xNi41NzUwMywtOS41NjI0MyAtMjcuODMyOTMs\nLTE2Ljg3NzAzIGwgLTIwLjQ2ODg5LC0xMy4yOTkzNyAzMy43MjQ0NywtMC44MDc1MyAzMy43MjQ0\nOCwtMC44MDc2IC00LjMwOTU4LC01LjExOCBjIC04LjI0MTgyLC05Ljc4Nzg3IC00NC42MjE3Niwt\nNzIuNTY3MzMgLTQ0LjYyMTc2LC03Ny4wMDIgMCwtMC42MDI3MiA1Ljg4OTcyLDEuODY1NDYgMTMu\nMDg4MjcsNS40ODQ4MSA3LjE5ODUzLDMuNjE5MzYgMTMuNDg0MzgsNi4xODQ1MyAxMy45Njg1NCw1\nLjcwMDM3IDAuNDg0MTUsLTAuNDg0MTUgLTEuMzQ4OTksLTYuNTI2MjkgLTQuMDczNjcsLTEzLjQy\nNjk4IC05LjUxMDE4LC0yNC4wODYxOCAtMjEuNTI2NzQsLTcxLjA2ODkzIC0yMS4zMDI5OCwtODMu\nMjkxMTQgMC4wMzU4LC0xLjk1MzY1IDQuMjcyMzEsNi45ODY3MSA5LjQxNDUyLDE5Ljg2NzQ3IDEy\nLjQ2Mjc1LDMxLjIxOCAxNy42NTU2Miw0Mi4yNzM5NyAyOC4yMzM3OSw2MC4xMTE2NyAxMS4xMDE2\nNCwxOC43MjA0IDI1LjE1OTg5LDM2LjMyMjggMzcuMjQ2OTcsNDYuNjM3MSAxMS4wMDQ4NCw5LjM5\nMDgzIDEwLjc3MTg2LDkuNDU2NiAtMTYuNzE4ODcsNC43MjM1OCAtMTAuMTYyNzgsLTEuNzQ5NzUg\nLTE4LjgwNjc0LC0yLjg1MjQgLTE5LjIwODc4LC0yLjQ1MDMzIC0xLjI2NTY0LDEuMjY1NjMgMTcu\nNDg4MjUsMjEuNjE4NTMgMzAuNDIwNzMsMzMuMDE0NDggMTEuMzI4MDIsOS45ODIxMiAzNC40MjM1\nLDI0LjM4NzMgNTEuOTgwNzEsMzIuNDIxNTIgbCA2Ljk2OTY5LDMuMTg5MzggLTExLjUwOTkyLC0x\nLjAxMTgzIGMgLTEzLjM2ODc3LC0xLjE3NTI1IC0yNi40NTIxMywwLjM4Mjk1IC0yNi40MzE3OCwz\nLjE0NzkgMC4wMjcxLDMuNjc3OTMgMjYuMDczNzcsMTguNTM5MSA0OC40NzUzMywyNy42NTc5OCAy\nNC4yNjEwNCw5Ljg3NTg1IDMwLjg0MzkzLDEwLjgxMjUyIDgyLjMzNzAzLDExLjcxNTE1IDMwLjAw\nMTc0LDAuNTI1OSA0Ny4wNDg1NywwLjI2OTAyIDQ2LjAzMTU3LC0wLjY5Mzc1IC0wLjg4ODM4LC0w\nLjg0MDkgLTEyLjg4MDgsLTUuMzU5MDUgLTI2LjY0OTgzLC0xMC4wNDAzMyAtMTMuNzY5MTIsLTQu\nNjgxMzUgLTI5LjM5NTYyLC0xMC41NDY5NSAtMzQuNzI1NTgsLTEzLjAzNDcgbCAtOS42OTA4Niwt\nNC41MjMyNSAzMy43MTA1NCwtMC4xMzg5IGMgNDMuMzg1ODgsLTAuMTc4OCAyMDcuMTQzMzUsMi43\nOTUxIDIyMC4wNDE3OCwzLjk5NiBsIDkuODY1OCwwLjkxODYgLTAuMTc0OTgsNS45NTI3NSAtMC4x\nNzQ5Nyw1Ljk1MjY4IDguODgzMjIsMS4wNTE2NSBjIDQuODg1OCwwLjU3ODQgMTcuMTAyODUsMS4x\nMjM1IDI3LjE0ODk4LDEuMjExMzIgMTAuMjk3NSwwLjA5MDEgMTcuODc1OCwwLjc5MDUgMTcuMzcy\nMSwxLjYwNTY4IC0xLjcyMTgsMi43ODU4NyA0LjQ0MDYsMy4zOTk2NyAzNC4zNDkyLDMuNDIxNTcg\nMTYuNTgzNSwwLjAxMiAzMS43NjIxLDAuNDUzNzUgMzMuNzMwMSwwLjk4MTM4IGwgMy41NzgyLDAu\nOTU5MjUgLTMuMTAwMyw2LjA3NzI1IC0zLjEwMDUsNi4wNzcxNyAtMjcuMTI3NiwxLjA3OTAzIGMg\nLTEwNy4xNTk0OCw0LjI2MjI1IC0yNTEuNTA2MDUsNi41MjcxNyAtNDE4LjQ2MDkzLDYuNTY2MSBs\nIC0xODEuMzY0NzMsMC4wNDIgLTMuMzg3Niw1LjM0OTgyIGMgLTEuOTYzMzMsMy4xMDA2NSAtMi43\nODQ4MSw1Ljk0MjkzIC0xLjk1Mzg4LDYuNzYwNDMgMC43ODg1NCwwLjc3NTggMjEuNzg0NSwyLjE3\nMzcyIDQ2LjY1NzY5LDMuMTA2NSAyNC44NzMxOCwwLjkzMjg1IDY3LjAyNjYxLDIuNTAzOTUgOTMu\nNjc0MjgsMy40OTE0IDI2LjY0NzY1LDAuOTg3NDUgNDkuNzI0LDIuNDc3MSA1MS4yODA3NSwzLjMx\nMDIgMS41NTY3NSwwLjgzMzE3IDIuODMwNDYsMi41MDE3IDIuODMwNDYsMy43MDc5MiAwLDMuMjAz\nNjMgMy42MzMwOSw0LjEyMTg1IDE3LjQ5NDg3LDQuNDIxNzggNi44MTE4OCwwLjE0NzM3IC0xMi40\nNjYxOSwwLjc4NTQgLTQyLjg0MDIsMS40MTc4NyAtMzAuMzczOTksMC42MzI0OCAtNTcuODI0NDUs\nMS42Mzc1NSAtNjEuMDAxMDMsMi4yMzM1IC00LjM4Njg2LDAuODIyOTggLTYuNzM3ODgsMi42MDc5\nIC05Ljc3NzcsNy40MjMyOCBsIC00LjAwMjExLDYuMzM5NzUgLTI0LjM1MDY3LDAuNDQ1ODcgLTI0\nLjM1MDcsMC40NDU4OCAtMy4zMzgwNyw3LjcxNjA3IGMgLTEuODM1OTQsNC4yNDM4OCAtMy4wMzE4\nNiw4LjAyMjMgLTIuNjU3NjIsOC4zOTY1NSAxLjAzODc2LDEuMDM4NzUgOTUuOTIwMywzLjg2NjU1\nIDE3MC45NzQ2NSw1LjA5NTY1IGwgNjcuMDI4MzgsMS4wOTc2MyAtNC41MzMyMSw5LjQ0MDEgLTQu\nNTMzMjIsOS40NDAxNyBIIDU4My4xNDc0NyBjIC0xMjcuMTM4ODEsMCAtMTQxLjE1NzMsMC4yNTIw\nOCAtMTQyLjA0MDkxLDIuNTU0OCAtMC41MzkyMiwxLjQwNTEzIC0wLjk4MDM4LDYuMTI5NDYgLTAu\nOTgwMzgsMTAuNDk4NTEgMCw0LjM2ODkgLTAuNDgwNCw3Ljk0MzYgLTEuMDY3NTcsNy45NDM2IC0y\nLjYxMzg3LDAgLTI2LjgzOTkxLC0xOS41NDA0OCAtMzkuNTQyOCwtMzEuODk0NyAtNy43MTY4Nywt\nNy41MDUxMSAtMTQuNDE5NDcsLTEyLjgzNDgzIC0xNC45ODY2OSwtMTEuOTE2OTggLTIuNTYxNzEs\nNC4xNDQ4NyA3LjY5MjcxLDQyLjk1OTk4IDE3LjQyODY1LDY1Ljk3MTE4IDIuMzM3NzMsNS41MjUy\nIDQuMjUwNDMsMTAuNDMxMyA0LjI1MDQzLDEwLjkwMjIgMCwyLjQwODcgLTQuMDQyODksLTAuMjY2\nMSAtMTcuOTA0OSwtMTEuODQ1OCB6IgogICAgIGlkPSJwYXRoMTMiIC8+CiAgPHBhdGgKICAgICBz\ndHlsZT0iZmlsbDojZmZmZmZmO3N0cm9rZS13aWR0aDoxLjYxNTE0IgogICAgIGQ9Im0gMzU1LjA1\nNDA2LDQyMS42MjIyNiBjIC0yMi4xNTMyMSwtMi41NDg2OCAtMzkuNTkzMTQsLTE0LjMwMTk4IC00\nOC42Mzg5NywtMzIuNzc5MjYgLTYuMDQwNjksLTEyLjMzODg4IC04LjkwNjEyLC0yMy4yMjc3IC0x\nMy4yNTQ3LC01MC4zNjg3NyAtMS44NDEzLC0xMS40OTIxNyAtNC4yMDQ0MywtMjEuOTI3MDIgLTUu\nMjUxNDEsLTIzLjE4ODU1IC0xLjMzMTMsLTEuNjA0MTIgLTMuODY0NzIsLTIuMDIyNzggLTguNDI2\nNzksLTEuMzkyNTggLTkuMDcsMS4yNTI5MyAtNjYuMDg5MzYsMTkuOTY4MDYgLTEwMi4wOTgwMywz\nMy41MTEwMiAtMTYuNTA0NTQsNi4yMDc0IC0zMC4zODA4NiwxMC45MTM1OCAtMzAuODM2MjcsMTAu\nNDU4MTggLTAuNDU1NCwtMC40NTU0IDEuMTQ5NTUsLTMuNDIwNzIgMy41NjY1NiwtNi41ODk1OCA1\nLjY3MjIsLTcuNDM2NjMgMjcuOTMwNCwtMTguMjA4MzkgOTEuMzg2OTQsLTQ0LjIyNjMxIGwgNDcu\nNjg0MzYsLTE5LjU1MTE1IGggMTkxLjU2MjY3IGMgMTc2LjQ0OTcxLDAgMTkxLjczNzU2LDAuMjEx\nMzUgMTkzLjc3OTM3LDIuNjc4ODIgMS43MDA4MywyLjA1NTQyIDIuMTk3NDksOS4yOTA4NSAyLjEz\nNDE0LDMxLjA5MTQ5IC0wLjA2OTgsMjQuMDMyNiAtMC42NTk2NiwzMC43NzgwNSAtMy44MjYxOCw0\nMy43NTY1MSAtNC45OTkxNSwyMC40ODk4OSAtNS4wNDQ0MSwyMC41NTU5NiAtMTAuNzI5NjIsMTUu\nNjY1NzQgbCAtNC42NTY5NSwtNC4wMDU3MiAzLjYzMTUxLC0xNS4xMTcwOCBjIDEuOTk3MzIsLTgu\nMzE0MzkgNC4wODc2OCwtMTkuMTE0NTUgNC42NDUyNiwtMjQuMDAwMzUgMC41NTc1OSwtNC44ODU4\nMSAxLjUyNTQ1LC05LjI0NjY5IDIuMTUwODIsLTkuNjkwODUgMS4yODIwOSwtMC45MTA2MiAtMS4y\nODAzMywtMjAuNDk2MDkgLTMuMTY5NywtMjQuMjI3MTMgLTIuMDI4NDIsLTQuMDA1NjIgLTUwLjQ1\nNzg0LC01LjAzMTMgLTIxMi4wMDQ4NywtNC40OTAwMSAtMTA0LjMxMzkzLDAuMzQ5NTEgLTE0OC42\nNTIzNywxLjAxODA5IC0xNDkuODk0NTIsMi4yNjAyNCAtMS45NTQ1NiwxLjk1NDU2IC0wLjE4NzYs\nMTQuMTAzNDcgOC4zMTEyMiw1Ny4xNDQ1OSA1LjcyNzE4LDI5LjAwNDQ4IDkuNTYyNDksMzcuNTU4\nODIgMTkuNzIwODEsNDMuOTg1NDUgMTEuMTY3MzUsNy4wNjUgMTkuODE0MDEsOC4wNDcwNSA2NC41\nMDUwMSw3LjMyNjIyIDQ0Ljk3Njg0LC0wLjcyNTQzIDUxLjk3NzI5LC0xLjk3NTA4IDYzLjI4Njgs\nLTExLjI5NzM0IDcuOTQyMDUsLTYuNTQ2NSAxMS4wMTg2MSwtMTMuMjM2NCAxOS40MDc0NSwtNDIu\nMjAwNzMgOC42ODU3LC0yOS45ODkzIDEwLjA0NzIyLC0zMy4xNjExMyAxNC43NDAyMywtMzQuMzM4\nOTkgMi4wMDQ3MiwtMC41MDMxNiAxMS41ODI3OCwtMC42Njc0NCAyMS4yODQ1OCwtMC4zNjUwNyAx\nOC44MDMsMC41ODYwMiAyMy4yODYwNCwyLjIyNjE2IDIxLjc4NDA1LDcuOTY5NzUgLTAuNzg5Mjks\nMy4wMTgyOSAtMS43Nzc0NSwzLjE5NTgzIC0xMy4xNTgxNywyLjM2NDIyIC0yMi42NTk4MSwtMS42\nNTU4IC0yNC43MjQzNCwwLjMwNzAxIC0zNC4wMjE1OCwzMi4zNDU0OSAtOS44MzIxLDMzLjg4MTU2\nIC0yMC4xNjQ4OSw0Ny4yNjQ3MSAtNDEuNzQ1NTYsNTQuMDY5MzQgLTkuMzExOTcsMi45MzYxNiAt\nMTUuNzEzMTgsMy40OTQ3NCAtNDUuNTU5NzQsMy45NzU1OSAtMTkuMDk5MDYsMC4zMDc2OSAtMzku\nOTM3OTgsLTAuMDQwMiAtNDYuMzA4NzIsLTAuNzczMTggeiIKICAgICBpZD0icGF0aDE0IiAvPgog\nIDxwYXRoCiAgICAgc3R5bGU9ImZpbGw6I2ZmZmZmZjtzdHJva2Utd2lkdGg6MS42MTUxNCIKICAg\nICBkPSJtIDIyNS43MjUxOCwyNzcuMjc0MzggYyAtMS44MzYxMywtMy43MDU5NSAtMTEuMDgwMzUs\nLTE5LjA5Mzk0IC0yMC41NDI3MiwtMzQuMTk1NTIgLTMwLjkwMjA1LC00OS4zMTg1IC0zNi42NjQ4\nNCwtNjQuMTU5MzUgLTM4Ljg4NDQ0LC0xMDAuMTM4OCAtMS4zNjQwOSwtMjIuMTExNTkgMC45NTEx\nMywtNDIuMDY1NzEgNy4zOTkwOCwtNjMuNzcwMzA4IDEwLjAxNCwtMzMuNzA4Mzk2IDM5LjMzNSwt\nMTEyLjc1OTU4MSA0My4yOTEyNiwtMTE2LjcxNTg0MiAyLjY1NDQsLTIuNjU0Mzk4IDExLjEyMTc1\nLDMuMjc1ODQxIDE5LjYwMjQyLDEzLjcyODg2NCAxNi4xNDMxNSwxOS44OTc1NTEgMjkuMDE4NzYs\nNTAuNTkxOTI4IDM3LjQzMDIyLDg5LjIzMDQ3NSA0LjQxMzExLDIwLjI3MTg1MSA0LjcyNTczLDYx\nLjg2OTI5MSAwLjc4MjkzLDEwNC4xNzY2NTEgLTIuOTg4NzIsMzIuMDY5OTMgLTMuMzE0NTcsNDQu\nMTE4NzkgLTEuMTcyMDYsNDMuMzM5NjQgMC44MTQyNCwtMC4yOTYxIDYuNzM0MzEsLTcuODA2NTEg\nMTMuMTU1NzEsLTE2LjY4OTc5IDIwLjI2Njg2LC0yOC4wMzY5MyAzNS40ODUzOCwtNDYuMjU5OTkg\nNTQuNzI2MzQsLTY1LjUzMDc5IDIwLjMyMTgyLC0yMC4zNTMzNCAzNS42MjE3MywtMzEuNDIxNjQz\nIDM1LjYyMTczLC0yNS43Njk2IDAsNC43NzUyMyAtNS41MjY1MiwxOC4wOTIgLTE2LjU3NTE1LDM5\nLjkzOTcxIC01LjQ4MDk0LDEwLjgzODA2IC05LjU1OTU1LDIwLjExMTM2IC05LjA2MzU3LDIwLjYw\nNzM0IDAuNDk1OTgsMC40OTU5OCA1LjU1OTA4LC0xLjE5MzQxIDExLjI1MTMyLC0zLjc1NDIyIDE4\nLjk5ODA0LC04LjU0Njc1IDMwLjU3NjI5LC0xMC43MjY3IDU2LjM4MTA5LC0xMC42MTU0NCAxMi44\nODA3NiwwLjA1NTUgMjcuNzM2NTIsMS4wNDQxOSAzMy4wMTI4MiwyLjE5NyBsIDkuNTkzMjUsMi4w\nOTYwMyAtNi4zNjI5NiwzLjk0ODk5IGMgLTMuNDk5NjUsMi4xNzE5NSAtMTQuMzU3OTQsOC4wMDkx\nNCAtMjQuMTI5NTUsMTIuOTcxNTMgLTIwLjM4OTIxLDEwLjM1NDM4IC0yMS4wNzA1OCw5LjExNzc3\nIDguOTQ1MzcsMTYuMjM1MSAyMy44Njc3NCw1LjY1OTQ3IDQ4LjUzODg0LDE1LjIxNDQ3IDY2Ljc3\nNzA2LDI1Ljg2MjQzIDEzLjAwODU5LDcuNTk0NzcgMzcuNzQ0ODEsMzAuMjA4OTcgNDYuMTM4NDEs\nNDIuMTgwMzkgbCAzLjgyMzc2LDUuNDUzNjQgLTEwLjM1MDE5LC0wLjY2NDIgYyAtOC42MTA4LC0w\nLjU1MjU5IC0xNC4xNTAxOSwtMi40NTE0MyAtMzIuOTYyMTcsLTExLjI5OTEgLTQyLjE5Mjc2LC0x\nOS44NDQxMiAtNTIuMzcyNSwtMjEuMDI1OTggLTMwLjQ2Nzc0LC0zLjUzNzI4IDcuOTYyMTYsNi4z\nNTcgMTUuNDM4MSwxMi43MTY2MSAxNi42MTMxOCwxNC4xMzI1IDEuOTUxNTMsMi4zNTE0NSAtNy40\nMzE2OCwyLjU3NDM2IC0xMDguMzY0OCwyLjU3NDM2IEggMjgwLjg5NDQ3IGwgLTE4LjUwNDAyLDcu\nNDQ0MzcgYyAtMTAuMTc3Miw0LjA5NDQxIC0yMS44MzkxNiw4Ljc2MTg4IC0yNS45MTU0NCwxMC4z\nNzIxOCBsIC03LjQxMTQxLDIuOTI3OCB6IG0gMTcuNzU5MjcsLTM5Ljk3OTk4IGMgNS44MDQwNiwt\nMjEuMjc0NDggOS41NDg4MiwtNTMuMTMwMTUgMTAuNDM5OTYsLTg4LjgxMDAxIDEuMjc1MjYsLTUx\nLjA1OTg3IC0yLjg0ODAyLC04MC40ODk0OCAtMTUuODgyMjUsLTExMy4zNTgzODggLTYuNjc0NTcs\nLTE2LjgzMTUwNiAtOS40ODI3NSwtMjIuMjA0ODY2IC0xMS42MDQ1MywtMjIuMjA0ODY2IC0zLjQw\nMTk5LDAgLTIyLjMyOTY4LDUwLjk4NDE1MiAtMzEuNDc5ODUsODQuNzk0OTU0IC0zLjg3MDk1LDE0\nLjMwMzU4IC00LjM2MDQyLDE4LjkyMzE3IC00LjI3ODM2LDQwLjM3ODU0IDAuMDg0NCwyMi4wNTEw\nNyAwLjQ5OTAxLDI1LjQ2MDIzIDQuNjE2NjYsMzcuOTU1ODQgNy43Mjk0NCwyMy40NTYxNyAyMy4x\nNjA3Myw1MS42MTU0OCAzNi43OTQ4Miw2Ny4xNDM4NSAyLjczMzAxLDMuMTEyNzMgNS43NzgyNCw1\nLjM5MTE5IDYuNzY3MTgsNS4wNjMyNSAwLjk4ODkzLC0wLjMyNzkyIDMuMDcwOCwtNS4yNjEzNSA0\nLjYyNjM3LC0xMC45NjMxNyB6IgogICAgIGlkPSJwYXRoMTUiIC8+CiAgPHBhdGgKICAgICBzdHls\nZT0iZmlsbDojZmZmZmZmO3N0cm9rZS13aWR0aDoxLjYxNTE0IgogICAgIGQ9Im0gMjIzLjc5OTk2\nLDI3NC4wMDkxMSBjIC0zLjAxNTc2LC01LjQ2MzM3IC0xMi43NTU3MSwtMjEuNTYyNDMgLTIxLjY0\nNDMxLC0zNS43NzU2OCAtMjguMDQ1NTYsLTQ0Ljg0NjAyIC0zMy42NjcxMiwtNTkuNzg1NiAtMzUu\nODU3NjMsLTk1LjI5MzM3IC0xLjk4ODc3LC0zMi4yMzc1NSAyLjI5NTg1LC01Mi41NzMzNyAyMy43\nOTE4MSwtMTEyLjkyMTQ3OSAxMS4zODkxMywtMzEuOTc0MDU3NiAyNC43MDkxLC02NS4zNzUyNDYg\nMjYuOTc0MDQsLTY3LjY0MDE4NCAyLjU4NTEzLC0yLjU4NTEyNSAxMS42MTE4OSwzLjg1MzM0MiAy\nMC4wMDM2NiwxNC4yNjc4OTEgMTUuNjg3MzksMTkuNDY4NzMxOSAyOC42NDE4NCw1MC41ODY5NzMg\nMzYuOTUzNDcsODguNzY2OTYyIDQuNDEzMTEsMjAuMjcxODUgNC43MjU3Myw2MS44NjkyOSAwLjc4\nMjkzLDEwNC4xNzY2NSAtMi45NTgyMSwzMS43NDI1MiAtMy4zMDI3Miw0My42MDg4MyAtMS4yNjYw\nNCw0My42MDg4MyAwLjc2MjU2LDAgMy45MjgxLC0zLjgxNTc2IDcuMDM0NTQsLTguNDc5NDkgMjMu\nMjM4MjksLTM0Ljg4NzgxIDcwLjI2NjE4LC04Ny4xMjI3OSA4OC4wODM3MiwtOTcuODM2NjQgNy43\nNDk0OCwtNC42NTk4MyA4LjQ3OTUsLTQuODI3MTMgOC40Nzk1LC0xLjk0MzI0IDAsNC42NDEyMSAt\nNS4zOTE5OSwxNy43MDEzNiAtMTYuNTIxMSw0MC4wMTY0MyAtNS40MjYyNiwxMC44ODAyNyAtOS40\nNTIyMywyMC4xOTYwMSAtOC45NDY1OSwyMC43MDE2NyAwLjUwNTY1LDAuNTA1NjMgNC40NDM5OSwt\nMC44NjE4MSA4Ljc1MTg5LC0zLjAzODc5IDQuMzA3ODcsLTIuMTc2OTYgMTMuNjQ3MDMsLTUuNjc4\nMTkgMjAuNzUzNjUsLTcuNzgwNSAxMS4xNTYxOSwtMy4zMDAyOSAxNi4yMzA0LC0zLjgxMDk4IDM3\nLjE0ODI3LC0zLjczODc4IDIzLjcwNzM5LDAuMDgxOCA0NC41NDI4MywyLjg4MzA3IDQxLjc2MjU4\nLDUuNjE0ODMgLTAuNzYxMjIsMC43NDc5NCAtMTAuNjQxMDEsNi4xODQ3NyAtMjEuOTU1MDgsMTIu\nMDgxODMgLTExLjMxNDA5LDUuODk3MDggLTIwLjk5MDQzLDExLjQwMDUyIC0yMS41MDMwMiwxMi4y\nMjk4OCAtMS4wODY2NywxLjc1ODI2IC0yLjM2Nzg1LDEuMzQ5MzEgMjMuNTYyMDIsNy41MjExNyAy\nMy45MTA3Miw1LjY5MTI3IDQ4LjU4Njg2LDE1LjI1NzI1IDY2Ljc2MTI5LDI1Ljg4MDczIDEzLjA3\nMDczLDcuNjQwMjIgMzguMTY0MzQsMzAuNTQ2ODcgNDYuMjA3NDQsNDIuMTgwMzkgbCAzLjc3MDUs\nNS40NTM2NCAtMTAuMzUwMTksLTAuNjYwNDcgYyAtOC41NzMzMiwtMC41NDcwOCAtMTQuMDEwMjQs\nLTIuMzk0NjQgLTMxLjY2OTg4LC0xMC43NjIgLTI4LjE2MTcxLC0xMy4zNDMzNyAtNDUuNDg0NTEs\nLTE5LjMwMTE3IC00My44MjQzNSwtMTUuMDcyNDQgMC4yOTYxLDAuNzU0MjQgNy40NDMxLDcuMTEz\nODYgMTUuODgyMjIsMTQuMTMyNDkgbCAxNS4zNDM4NSwxMi43NjExMiAtMTEyLjI1MjM3LDAuODA3\nNTggLTExMi4yNTIzNiwwLjgwNzU3IC0xNy43NjY1Nyw3LjM5MDMxIGMgLTkuNzcxNiw0LjA2NDY4\nIC0yMC42ODg4OSw4LjUzNDcgLTI0LjI2MDYyLDkuOTMzMzkgbCAtNi40OTQwNiwyLjU0MzEgeiBt\nIDE5LjY4NTYsLTM2LjcxNDcxIGMgNy4xMzkzLC0yNi4xNTgyOCA5LjkwNzg0LC01My42MjEyNSAx\nMC4wMDAzNCwtOTkuMTk5NzcgMC4wOTYxLC00Ny4zNzMyNDIgLTEuMjk3NDUsLTU5Ljc2MTI3IC05\nLjc0NTM0LC04Ni42MjkyNDMgLTUuMDYxODIsLTE2LjA5ODc1MyAtMTUuMDQyNDgsLTM4LjU0NDI1\nMSAtMTcuMTM5MTgsLTM4LjU0NDI1MSAtMy41Nzg2NSwwIC0yMC42Mjk3Niw0NS41ODA0OTggLTMw\nLjgxNDQ1LDgyLjM3MjIzNyAtNC43NTU3NSwxNy4xNzk5NTcgLTUuMTM1MTksMjAuMzkyNjM3IC01\nLjA1NTA5LDQyLjgwMTI1NyAwLjA3ODgsMjIuMDQ3MzkgMC40OTMwNiwyNS40NjIzMyA0LjYwNDIy\nLDM3Ljk1NTg0IDIuNDg0NjksNy41NTA3OSA3LjQyOTk5LDE5LjU0MzIyIDEwLjk4OTU1LDI2LjY0\nOTg1IDExLjY0NzE3LDIzLjI1MzQ3IDI4LjUyMzU5LDQ2Ljg4NjU4IDMyLjUzMjQ3LDQ1LjU1NzI2\nIDAuOTg4OTMsLTAuMzI3OTMgMy4wNzEzMSwtNS4yNjEzNiA0LjYyNzQ4LC0xMC45NjMxOCB6Igog\nICAgIGlkPSJwYXRoMTYiIC8+CiAgPHBhdGgKICAgICBzdHlsZT0iZmlsbDojZmZmZmZmO3N0cm9r\nZS13aWR0aDoxLjYxNTE0IgogICAgIGQ9Im0gMzkyLjM4OTk2LDEwMzUuODk5OSBjIC0xNS4xMzU3\nNiwtMTIuMjc1OCAtMzkuMzA1NDMsLTM2LjA0MzQ4IC01MC42OTg5MywtNDkuODU1NyAtMTguMDIy\nMDcsLTIxLjg0ODAyIC0zMy4wOTYxMSwtNDguMjc4MTcgLTU2LjY1MjEyLC05OS4zMzEyOCBsIC01\nLjU4OTIzLC0xMi4xMTM1NSAtMC41MTU4OCwxMi4xMTM1NSBjIC0wLjI4Mzc0LDYuNjYyNDggMC42\nNzI0NSwyNy4yNjE2OCAyLjEyNDg1LDQ1Ljc3NTk1IDEuNDUyNDEsMTguNTE0MzUgMi4zMzk2NCwz\nMy45NjM1MyAxLjk3MTY1LDM0LjMzMTU1IC0xLjIxNjE4LDEuMjE2MTMgLTE2Ljk4NjUzLC0yOC44\nNjI2MiAtMjYuNzU3NzIsLTUxLjAzNDk0IC0xMS42OTIxMSwtMjYuNTMxMTggLTE4LjM3MTY5LC00\nNS4yMzE3NiAtMjMuNzg3NDYsLTY2LjU5Njg2IC02LjIxNjk0LC0yNC41MjU2NyAtMTYuODA2MzMs\nLTc5LjIxNTM3IC0xNy45NDM4NywtOTIuNjcyNDcgLTAuNTU0OTIsLTYuNTY0NjQgLTEuNjQyOTUs\nLTExLjkzNTcxIC0yLjQxNzg2LC0xMS45MzU3MSAtMC43NzQ5LDAgLTAuOTUyODcsLTEuODE3MDMg\nLTAuMzk1NDcsLTQuMDM3ODUgMC41NTczOSwtMi4yMjA4MiAwLjM4OTUzLC00LjAyNzU4IC0wLjM3\nMzAyLC00LjAxNTAyIC0wLjc2MjU0LDAuMDEyNiAtMTMuMzc4ODgsMTYuMDc5MTMgLTI4LjAzNjI5\nLDM1LjcwMzQ4IC0xNy42MjksMjMuNjAyOTUgLTI2Ljg0MzE2LDM0LjcxNjkgLTI3LjIyMDk3LDMy\nLjgzMzU3IC0yLjA3MzI1LC0xMC4zMzUwNyAxNi4wNTMwOCwtMTI5LjQ4NDYxIDI5LjgyMTIyLC0x\nOTYuMDIzNCAxLjUxNjU5LC03LjMyOTQyIDAuNTQ1MjMsLTYuODg0MDMgLTIyLjcyMjgzLDEwLjQx\nOTE1IC0xMC4zNjMzMyw3LjcwNjYzIC0xOS4yNjA3NCwxMy41OTM3NCAtMTkuNzcyMDMsMTMuMDgy\nNDUgLTAuNTExMywtMC41MTEyOSAxLjMzNzkzLC04Ljc2MzczIDQuMTA5MzksLTE4LjMzODczIDcu\nNjM1ODQsLTI2LjM4MDggMTUuNTQ1NjUsLTU5LjcyMDQgMjUuMzk3NDYsLTEwNy4wNDk1MSA0Ljkw\nMDEzLC0yMy41NDA3IDkuNTMyNDksLTQ1LjM0NTEyIDEwLjI5NDE0LC00OC40NTQyNiAzLjIzNDMy\nLC0xMy4yMDI4MSAzLjM3MzIxLC0xNC41MzYyOCAxLjUxNDEyLC0xNC41MzYyOCAtMS4wMjkyNCww\nIC0xMS4zMDA3Myw2LjIxNjggLTIyLjgyNTU1LDEzLjgxNTExIC0xMS41MjQ4Miw3LjU5ODMxIC0y\nMS4xNjA1NCwxMy41OTQ1MyAtMjEuNDEyNywxMy4zMjQ5MiAtMC4yNTIxNywtMC4yNjk2MSA2LjIz\nMzU3LC0xNC41NDg5MSAxNC40MTI3NCwtMzEuNzMxNzggbCAxNC44NzEyMywtMzEuMjQxNjEgNy4x\nNzA2NywtMC40OTM4MSBjIDMuOTQzODgsLTAuMjcxNjEgMjAuOTA5MTIsLTMuNDc0NzEgMzcuNzAw\nNTUsLTcuMTE4MDMgMTYuNzkxNDMsLTMuNjQzMzEgMzEuMDgyODcsLTYuNjI0MiAzMS43NTg3Niwt\nNi42MjQyIDAuNjc1ODgsMCA0LjIzNzUxLDUuNTc3ODEgNy45MTQ3MywxMi4zOTUxMyAxNi40MjMy\nNiwzMC40NDc2NyA0MC4yMDk1Nyw1MC42MDYyIDcwLjM5ODQzLDU5LjY2MTU4IDExLjIzNjc3LDMu\nMzcwNTYgNDcuNzg1NTEsMy43NjMxNSA2NS4zMjg4MSwwLjcwMTc0IDIwLjg1MDI2LC0zLjYzODQ5\nIDU1LjcxNjc0LC0xNi42NTQ1MSA1NC4zMjU0MSwtMjAuMjgwMjQgLTAuNDkxNDEsLTEuMjgwNTgg\nMS4wNjkwNywtMi40MjM5OCA0LjE0ODc1LC0zLjAzOTkyIDguNjgzMjgsLTEuNzM2NjUgMjIuNzc3\nNTgsLTEwLjkzNzYxIDMzLjgwNDYzLC0yMi4wNjgxMyAxMi4xNjIzOSwtMTIuMjc2NTEgMjAuMDI2\nMDUsLTI2LjYyNzcgMjYuNDM2MDIsLTQ4LjI0NTc4IDQuMzQ4MzksLTE0LjY2NTMyIDcuNzI2OTYs\nLTIxLjExODA3IDExLjA1NzEyLC0yMS4xMTgwNyA0Ljk0MTcsMCA1NS43MjU5MywxMy4zNjExNiA3\nMy45ODg2NSwxOS40NjYxNyAyNy4zOTI1OCw5LjE1NzAyIDQ0LjU1NDQ5LDE3LjY3ODA4IDU1LjE5\nOTcsMjcuNDA3MjEgMTMuODY1NTMsMTIuNjcyMyAxNS42NjIxNCwyMy43MTE2OCA2Ljk1NDQ2LDQy\nLjczMTc2IC00LjgzMTM4LDEwLjU1MzE0IC0yLjkzNTUsMTAuODA4MjkgLTE5LjU5NTYxLC0yLjYz\nNzMzIC01LjEyMzQ0LC00LjEzNDg5IC0xMy45MjkzNywtOS43Njc3IC0xOS41Njg3MywtMTIuNTE3\nMzUgLTkuMzg4MzYsLTQuNTc3NTcgLTExLjYyMzI5LC00Ljk5OTM2IC0yNi40OTA2OSwtNC45OTkz\nNiAtMTguMzY5NzUsMCAtMjUuMTc0ODUsMi4wNTkzMSAtMzMuNjI4ODcsMTAuMTc2NSAtNy45MDQ2\nNyw3LjU4OTczIC04Ljg0OTIxLDEwLjAwOTQ3IC02LjAzMjk4LDE1LjQ1NTQ2IDEuMzI2NjIsMi41\nNjUzNiAzLjM3MzIxLDUuMDMzMTMgNC41NDgsNS40ODM5NSAxLjE3NDgxLDAuNDUwODEgNy41NjEz\nMSwtMC4yMTk5MiAxNC4xOTIyNCwtMS40OTA1MSAxNC42NTc3OCwtMi44MDg2OCAyNC45ODQyMSwt\nMS4zODkwOSA0Mi4xOTg2OSw1LjgwMTEgMjMuNzk5NDUsOS45NDA2MSAzNS4yNzA3OCwyMi45NDg3\nNSAzNS4yNzA3OCwzOS45OTU5OCAwLDEwLjU4NTgyIC00LjEwMzQxLDE5LjMwNzg5IC0xMi4yMzgz\nNSwyNi4wMTMzOSAtOC43NDM3OSw3LjIwNzQgLTE3LjM5MTc1LDkuMDI4MSAtMzcuNTIzNjIsNy45\nMDAxMSAtMTkuMjgyNjgsLTEuMDgwNDEgLTMwLjQ3OTcyLC0zLjY0MTY5IC00OC4yMzY4MywtMTEu\nMDMzOTggLTE5LjAwMDQyLC03LjkwOTkxIC0yNi40MTcyNywtMTMuNjM4OTEgLTMyLjEyMzMsLTI0\nLjgxMzA0IC02LjQyNjEsLTEyLjU4NDI5IC05LjcwNzU3LC0xMy4yMjYyOCAtMTkuMTgxMTYsLTMu\nNzUyNjggLTQuMTkwNyw0LjE5MDcgLTcuNzExODYsOS41ODA3NyAtOC43ODU3LDEzLjQ0ODg2IC0y\nLjIyNDQ0LDguMDEyNjkgLTIuMjk3ODMsMjAuMTg3ODYgLTAuMTQxMTUsMjMuNDE0MjMgMS4zNjk3\nMiwyLjA0OTA3IDIuMjI1OCwxLjczNzcgNS41NTA4LC0yLjAxODkzIDIuMTYyMjIsLTIuNDQyOSA0\nLjMzNzUyLC00LjQ0MTY0IDQuODM0MDIsLTQuNDQxNjQgMC40OTY0OSwwIDYuNTgyNTksMy41NTk2\nNyAxMy41MjQ2Niw3LjkxMDM2IDMzLjc1ODU0LDIxLjE1NjkzIDU2LjI2NjA0LDI3Ljk0MDA1IDkz\nLjEzNDUyLDI4LjA2ODA5IDE1LjkyMTc4LDAuMDU1MyAyMi41MDU3OSwtMC43MjA2OSAzNi44ODIw\nOSwtNC4zNDY4NiAxOS4xNTAyNiwtNC44MzAzMiAyMi45MDM4NiwtNC42NDE1MyAyMS4yMTczNCwx\nLjA2NzA4IC0zLjc2NTQ2LDEyLjc0NTU4IC0xOC42NTc1LDI0LjY4MTE1IC00MC4wMjczNywzMi4w\nODA4NiAtMjYuODI0NzQsOS4yODg1NiAtNDQuNDU1MDQsOC4wOTk5MyAtMTA0LjQ4MjE2LC03LjA0\nNDE5IGwgLTM5LjU3MDk4LC05Ljk4MzMgLTM2LjM0MDY5LDQuOGUtNCBjIC0zOS4xMTExLDVlLTQg\nLTkzLjY0NzksMi4xOTU3IC05My43MjYzMiwzLjc3MjYyIC0wLjAyNjUsMC41MzE4NCA3LjQyMzQs\nOC4wMjY5OSAxNi41NTUyMSwxNi42NTU5IGwgMTYuNjAzMjksMTUuNjg4OTMgLTUuNjUzLDAuMDU0\nNyBjIC03LjQxMTY4LDAuMDcxNiAtMzQuMDE1OTQsLTcuMDk0MDUgLTQ1LjAyNTEzLC0xMi4xMjcy\nMyAtMTAuMTg3MzIsLTQuNjU3NDQgLTI1LjAwMDU2LC0xNC45NzEyMyAtMzcuMDA2MjYsLTI1Ljc2\nNTgxIC00LjY5ODMzLC00LjIyNDM3IC04Ljg2ODQyLC03LjM1NDY4IC05LjI2Njg1LC02Ljk1NjI1\nIC0wLjM5ODQzLDAuMzk4NDMgMS44ODg5NSw4LjUyODcyIDUuMDgzMDgsMTguMDY3MyAzLjE5NDEy\nLDkuNTM4NTggNS4zODQ3MywxNy43NjU2NCA0Ljg2ODAxLDE4LjI4MjM0IC0xLjUxODM0LDEuNTE4\nMzYgLTE4LjY2MzcyLC0xMi4wNTE2MSAtMzEuMjM5MTUsLTI0LjcyNDY5IC0xOS43MzE5OSwtMTku\nODg1MjQgLTM4LjE3OTU1LC00Ny4zOTg0NCAtNDQuMDExNywtNjUuNjQwMzIgLTEuMDY1MDQsLTMu\nMzMxMjMgLTIuNTczOTUsLTYuMDU2NzggLTMuMzUzMTMsLTYuMDU2NzggLTYuNjAzMSwwIDAuMTY0\nMTMsNzMuOTUyNTggOC4xMjkxMiw4OC44MzUzMSAyLjUwMTg3LDQuNjc0NzcgNC44NDQwOSwyLjQ5\nMDU3IDQuODQ0MDksLTQuNTE3MjcgMCwtNS4zOTA2OCAxLjEzMjk1LC0zLjg2MzM4IDEyLjI2NjM2\nLDE2LjUzNTk0IDIzLjE5ODg3LDQyLjUwNjQzIDQwLjk1MDA3LDY1LjMyMzMxIDgyLjQzMTMyLDEw\nNS45NTQ4OCAzNS4zMzgyLDM0LjYxNDM3IDU2LjUzMjU4LDUyLjAyNTAyIDg1LjM3MjA5LDcwLjEz\nMTA3IDE2LjIxNTAyLDEwLjE4MDA1IDE2LjU3NDM0LDEwLjAxOTE3IC0xNC41MTc3Miw2LjUwMjEy\nIC0yNC40MTE0OSwtMi43NjEyNyAtMzEuNDk1MjcsLTIuOTcyMzIgLTMxLjQ5NTI3LC0wLjkzODEg\nMCwzLjkwNzggNTEuNTAxMzksMzMuMzY2NDUgODEuNzk2ODIsNDYuNzg3NDggMTkuMzY4MjEsOC41\nODAyMiAzMC4xMjQ1NywxMS44NDM2MiA1MC42NDQ4MiwxNS4zNjUyNSAxOC4yNjcwMywzLjEzNSA0\nOC40NTQyNiw1LjA5MTkgNDguNDU0MjYsMy4xNDExNSAwLC0wLjgzMjU4IC0zLjMxMzgyLC0zLjEy\nMzY4IC03LjM2NDA1LC01LjA5MTQ1IC00LjA1MDIyLC0xLjk2Nzc4IC0xNi41NzM5NSwtOS41NjI0\nMyAtMjcuODMwNTEsLTE2Ljg3NzAzIGwgLTIwLjQ2NjQ0LC0xMy4yOTkzNyAzMi42NzU5MiwtMC40\nMzcxOCBjIDIxLjIyMjQ5LC0wLjI4NDAyIDMyLjY3NTk0LC0xLjAyNDg3IDMyLjY3NTk0LC0yLjEx\nMzY1IDAsLTAuOTIyMDUgLTIuMTE3NjcsLTQuMzU5MzcgLTQuNzA1OTMsLTcuNjM4NTIgLTguNDY2\nNjksLTEwLjcyNjY1IC00Mi4xMzMxOSwtNjkuNDkzNDUgLTQyLjEzMzE5LC03My41NDU4MyAwLC0w\nLjYwMjcyIDUuODg5NzIsMS44NjU0NiAxMy4wODgyNyw1LjQ4NDgxIDcuMTk4NTMsMy42MTkzNiAx\nMy40ODQzOCw2LjE4NDUzIDEzLjk2ODU0LDUuNzAwMzggMC40ODQxNSwtMC40ODQxNiAtMS4zNDg5\nOSwtNi41MjYyOSAtNC4wNzM2NywtMTMuNDI2OTkgLTkuNTI0NTEsLTI0LjEyMjQ1IC0yMS41NTE1\nMiwtNzEuMTcwNTIgLTIxLjI5MTkzLC04My4yOTExNCAwLjA0MTgsLTEuOTUzNjQgNC4wNzc2NSw2\nLjYyMzMxIDguOTY4NDUsMTkuMDU5OSAxOC40MzEsNDYuODY3MjcgMzcuMzczODUsNzkuMTAyMTMg\nNTkuMTU1MjYsMTAwLjY2Mzg5IDYuNTczMzksNi41MDcxNSAxMi45OTY0MSwxMi40OTIyMyAxNC4y\nNzMzOSwxMy4zMDAyIDMuNzQyMzUsMi4zNjc5OCAtMy43MDA1NSwxLjg1MDcgLTI0LjIzMTc0LC0x\nLjY4NDEyIC0xMC4xNjI3OCwtMS43NDk3NSAtMTguNzkyMjEsLTIuODY2ODggLTE5LjE3NjQ4LC0y\nLjQ4MjY1IC0xLjIzNzQ4LDEuMjM3NSAxNy45MTA2MiwyMS45OTc4IDMwLjA4NTA5LDMyLjYxODE3\nIDEzLjk3NjIzLDEyLjE5MjE1IDE5LjEwNDI3LDE1LjU0MTczIDQxLjA2NzkxLDI2LjgyNDggbCAx\nNi41NzA2OSw4LjUxMjY1IC0xNy4yMjM0NywtMC4wNDM1IGMgLTkuNDcyOTEsLTAuMDI0IC0xNy44\nMzEyNywwLjU3MjQgLTE4LjU3NDEzLDEuMzI1MjUgLTMuNDEwNTcsMy40NTY2OCAyNi44MzM1Mywy\nMS4zMTE0OCA1MS45NDkwMiwzMC42Njg0OCAyMy4xNDk2Myw4LjYyNDcgMjkuMjUwMjIsOS40MTY3\nNyA3OS4xNDE5NiwxMC4yNzU2IDI5LjM0MDEyLDAuNTA1MDUgNDYuMjM1NjYsMC4yMzc4MiA0NS4y\nMjM5OSwtMC43MTUzNSAtMC44ODgzOCwtMC44MzY5MyAtMTIuODgwOCwtNS4zNTE4NSAtMjYuNjQ5\nODMsLTEwLjAzMzEzIC0xMy43NjkxMiwtNC42ODEzNSAtMjkuMzk1NjIsLTEwLjU0Njk1IC0zNC43\nMjU1OCwtMTMuMDM0NyBsIC05LjY5MDg1LC00LjUyMzI1IDM0LjcyNTU1LC0wLjEzOTIgYyA0My45\nMzU1OCwtMC4xNzYxIDIwNS45MDIwNiwyLjc4MTM4IDIxOS4wMjY3NiwzLjk5OTM4IGwgOS44NjU4\nLDAuOTE1NTIgLTAuMTc0OTgsNS45NTI3NSAtMC4xNzQ5Nyw1Ljk1MjY4IDguODgzMjIsMS4wNTE2\nNSBjIDQuODg1OCwwLjU3ODQgMTcuMTAyODUsMS4xMjM1IDI3LjE0ODk4LDEuMjExMzIgMTAuMjk3\nNSwwLjA5MDEgMTcuODc1OCwwLjc5MDUgMTcuMzcyMSwxLjYwNTY4IC0xLjcyMTgsMi43ODU4NyA0\nLjQ0MDYsMy4zOTk2NyAzNC4zNDkyLDMuNDIxNTcgMTYuNTgzNSwwLjAxMiAzMS43NjIxLDAuNDUz\nNzUgMzMuNzMwMSwwLjk4MTM4IGwgMy41NzgyLDAuOTU5MjUgLTMuMTAwMyw2LjA3NzI1IC0zLjEw\nMDUsNi4wNzcxNyAtMjcuMTI3NiwxLjA4ODYzIGMgLTEwNS4xMTU4LDQuMjE4IC0yNTEuNTQ3NTMs\nNi41MTEyIC00MTguNDYwOTMsNi41NTMxMiBsIC0xODEuMzY0NzMsMC4wNDU3IC0zLjM4NzYsNS4z\nNDk4MyBjIC0xLjk2MzMzLDMuMTAwNjUgLTIuNzg0ODEsNS45NDI5MiAtMS45NTM4OCw2Ljc2MDQy\nIDAuNzg4NTQsMC43NzU4IDIxLjc4NDUsMi4xNjY2OCA0Ni42NTc2OSwzLjA5MDgzIDI0Ljg3MzE4\nLDAuOTI0MTUgNjcuMDI4MzksMi40OTY4MiA5My42NzgyMywzLjQ5NDg1IDI2LjY0OTg1LDAuOTk4\nMDIgNDkuNzI2MTgsMi40OTQ2NSA1MS4yODA3NiwzLjMyNTg3IDEuNTU0NTcsMC44MzEzIDIuODI2\nNSwyLjQ5ODI1IDIuODI2NSwzLjcwNDQ4IDAsMy4yMDQzIDMuNjM0ODEsNC4xMjIzIDE3LjQ5NDg3\nLDQuNDE4NCA2LjgxMTg4LDAuMTQ1NSAtMTIuNTQ0NDYsMC43OTgzIC00My4wMTQxMiwxLjQ1MDU3\nIC0zMC40Njk2NSwwLjY1MjI4IC01Ny45MjAxMiwxLjY1ODg1IC02MS4wMDEwMywyLjIzNjggLTQu\nMjAzMjIsMC43ODg1NSAtNi42MDc0MiwyLjY0NDEzIC05LjYzMDM1LDcuNDMyNzMgbCAtNC4wMjg3\nLDYuMzgxOSBoIC0yMy45MzA3OCAtMjMuOTMwNzkgbCAtMy43ODAyNSw4LjA3MDk3IGMgLTIuMDc5\nMTQsNC40MzkxIC0zLjQ1MjA2LDguMzk5MjUgLTMuMDUwOTQsOC44MDAzNSAxLjA4MTI5LDEuMDgx\nMjggOTQuNDk4ODMsMy44OTEzIDE3MS4wMjM1MSw1LjE0NDQ4IGwgNjcuMDI4MzgsMS4wOTc2MiAt\nNC41MzMyMSw5LjQ0MDEgLTQuNTMzMjIsOS40NDAxOCBIIDU4NC4xMDUyNiBjIC0xMDYuODE1Mjcs\nMCAtMTQwLjU2MzIzLDAuNDYwNDIgLTE0Mi4wNDA5MSwxLjkzODE1IC0xLjA2NTk5LDEuMDY1OTcg\nLTEuOTM4MTcsNS43OTAzIC0xLjkzODE3LDEwLjQ5ODQ0IDAsNC43MDgxIC0wLjUwMDIzLDguNTYw\nMyAtMS4xMTE2LDguNTYwMyAtMi40OTM1OSwwIC0zMS4wOTQ5OSwtMjMuMTEzNjIgLTQwLjgzNTQz\nLC0zMy4wMDAyNyAtMTIuNTA4MTMsLTEyLjY5NTg1IC0xNS4wNDM1NCwtMTMuMzk0OTIgLTEzLjU4\nMDQsLTMuNzQ0MyAyLjEzNTc5LDE0LjA4NzMzIDguNjg5MzUsMzcuMTg0NDcgMTUuMDQ2NzYsNTMu\nMDMwNDcgMy42MDk0OSw4Ljk5NjcgNi41NjI2OSwxNi42NDQzIDYuNTYyNjksMTYuOTk0OCAwLDEu\nOTg5NyAtMy44MTA5NCwtMC4zNDM1IC0xMy44MTgyNCwtOC40NTk4IHoiCiAgICAgaWQ9InBhdGgx\nNyIgLz4KICA8cGF0aAogICAgIHN0eWxlPSJmaWxsOiNmZmZmZmY7c3Ryb2tlLXdpZHRoOjEuNjE1\nMTQiCiAgICAgZD0ibSAzOTQuMDk0NjQsMTAzNy40MTMyIGMgLTEzLjY1NTk4LC0xMC42ODM3IC00\nMy4yMzQ1NiwtMzkuNjg1NDMgLTUzLjM5ODg4LC01Mi4zNTc0MyAtMTcuNzg4NzksLTIyLjE3NzY1\nIC0zNS4zMjA4OSwtNTIuOTkyOSAtNTIuNjg1OTYsLTkyLjYwMzQgLTMuNTI1NzksLTguMDQyNDcg\nLTcuMTc1NiwtMTUuMzQ5NSAtOC4xMTA3LC0xNi4yMzc4NyAtMS45ODM3NywtMS44ODQ1MyAtMC44\nMjQxMiwzNi44ODc4NSAyLjA0MzAxLDY4LjMwNyAwLjk4NzksMTAuODI1NzIgMS40OTY3NywyMC41\nODE0MiAxLjEzMDgzLDIxLjY3OTIgLTEuNTgyODksNC43NDg3IC0zMC43OTc0NiwtNTYuMjI5ODMg\nLTQxLjQ1NDEyLC04Ni41MjU3IC0yLjc2NzcsLTcuODY4MzMgLTYuODc3ODcsLTIxLjU4NzE4IC05\nLjEzMzcsLTMwLjQ4NjM4IC02LjIxNjk0LC0yNC41MjU2NyAtMTYuODA2MzMsLTc5LjIxNTM3IC0x\nNy45NDM4NywtOTIuNjcyNDcgLTAuNTU0OTIsLTYuNTY0NjQgLTEuNzA3ODksLTExLjkzNTcxIC0y\nLjU2MjE4LC0xMS45MzU3MSAtMC44NjM3LDAgLTEuMDg4MjYsLTEuODUyNiAtMC41MDU4MywtNC4x\nNzMxOSAwLjY2NDk0LC0yLjY0OTMzIDAuNDQ4MzIsLTMuODAyOTIgLTAuNTkzMjMsLTMuMTU5MjIg\nLTAuOTAyMzUsMC41NTc2OCAtMTMuMDE2MDEsMTYuMDYzMzcgLTI2LjkxOTI0LDM0LjQ1NzEyIC0x\nMy45MDMyMywxOC4zOTM2NyAtMjYuMDAxNzgsMzQuMTY5OTIgLTI2Ljg4NTY3LDM1LjA1ODIyIC01\nLjE5MDg2LDUuMjE3IDguMDIwNTgsLTg5LjA1ODMyIDI0Ljc2MjU3LC0xNzYuNzAyNzYgMi42MTM4\nOSwtMTMuNjgzNzEgNC40MjE2NiwtMjUuMjEwMzIgNC4wMTcyOSwtMjUuNjE0NyAtMC44MTc4LC0w\nLjgxNzc5IC00LjU1MTY0LDEuNzA2NTggLTI2LjQ2ODYxLDE3Ljg5NDgzIC04LjI3Njk0LDYuMTEz\nNSAtMTUuNDQ5MywxMC43MTUxMyAtMTUuOTM4NTcsMTAuMjI1ODYgLTAuNDg5MjcsLTAuNDg5MjYg\nMS43MDc2OCwtMTAuMTkxNjggNC44ODIxLC0yMS41NjA5MSAxMC4wNDcyOCwtMzUuOTg0NDIgMTUu\nMjg2ODUsLTU4LjUyMjY4IDI5LjM0OTc0LC0xMjYuMjQ5NTEgNC4xMjU4MiwtMTkuODY5OTEgNy44\nODcsLTM3LjEzMTc0IDguMzU4MTgsLTM4LjM1OTYyIDEuNzI3ODMsLTQuNTAyNjcgLTMuNjI0NTEs\nLTEuOTMyODggLTI0LjEyNDI0LDExLjU4MjU4IC0xMS41MjQ4Miw3LjU5ODMxIC0yMS4xNTU1Miwx\nMy41OTQ1MyAtMjEuNDAxNTYsMTMuMzI0OTMgLTAuMjQ2MDUsLTAuMjY5NjEgNi4xOTU4OCwtMTQu\nNTUwNCAxNC4zMTUzOSwtMzEuNzM1MDkgbCAxNC43NjI3NCwtMzEuMjQ0ODggNy41NDUxLC0wLjU5\nNDUxIGMgNC4xNDk4LC0wLjMyNjk3IDIxLjE1ODg2LC0zLjUyODYgMzcuNzk3ODksLTcuMTE0NzMg\nMTYuNjM5MDUsLTMuNTg2MTIgMzAuODU2NjksLTYuNTIwMjIgMzEuNTk0NzgsLTYuNTIwMjIgMC43\nMzgwOCwwIDMuMjcxODcsMy44MTU3NyA1LjYzMDYzLDguNDc5NDkgMTkuMDkxNDQsMzcuNzQ3NDYg\nNTEuMzY2ODYsNjIuMTM4NDYgODcuMTAzNjgsNjUuODI1NTQgMjguNzAxNjcsMi45NjEyNCA2NC4y\nMzQ5NiwtMi40MzcxOCA5MS42NzkyMSwtMTMuOTI4NDEgMTEuMzIxNTEsLTQuNzQwNDYgMTQuMzgz\nNjUsLTYuODEzNSAxMy4xMDk1MSwtOC44NzUwOSAtMC40ODY4MSwtMC43ODc2NyAwLjM0MDUyLC0x\nLjQzMjEzIDEuODM4NTEsLTEuNDMyMTMgNi4zNDI0OSwwIDI0LjIxMzYxLC0xMC44MTM4MyAzNC41\nNjk1NCwtMjAuOTE4MDYgMTMuMDgxNSwtMTIuNzYzNTcgMjAuNTg0NzEsLTI1Ljg3NTYzIDI3LjYy\nNDA0LC00OC4yNzM4MSAyLjg4NjYzLC05LjE4NDg2IDYuNDg4NDYsLTE4LjIzMTEyIDguMDA0MDUs\nLTIwLjEwMjgxIGwgMi43NTU2NCwtMy40MDMwNiAyNS42NTEyLDYuMTc2NzQgYyA1MS44MDg3OSwx\nMi40NzU0MyA4OC40ODMwOCwyNi44NTgwOSAxMDMuOTU0MzEsNDAuNzY4MDcgMTMuMzk5NCwxMi4w\nNDcyMSAxNS44NjIwNywyMS43MDM1NyA5LjcyMTAxLDM4LjExNzAzIC01Ljg1MzE5LDE1LjY0NDAz\nIC01Ljc5Nzc3LDE1LjYyODkyIC0xOC42MzM4Myw1LjA4NDI5IC02LjE4NDg0LC01LjA4MDc2IC0x\nNi4zMTIzNiwtMTEuNjcyODQgLTIyLjUwNTYxLC0xNC42NDkwNiAtMTAuNDkyNDYsLTUuMDQyMjcg\nLTEyLjM2NTA2LC01LjQwODkgLTI3LjQ1NzQyLC01LjM3NTg1IC0xOC40OTA2MSwwLjA0MDUgLTI1\nLjc4OTA1LDIuNDg3MjQgLTM0LjIzNTExLDExLjQ3NzA4IC02Ljg5NjMsNy4zNDAzIC03LjU1MDA0\nLDEyLjE2MTEgLTIuMzUzODQsMTcuMzU3MzEgMi44NTY3LDIuODU2NjkgMy43NzM5OSwyLjg5ODY5\nIDE2LjI1MDU2LDAuNzQ0MDQgMTUuNzY0MzksLTIuNzIyNDQgMjQuOTYzOTEsLTEuNDUzNjQgNDIu\nNDY2MDYsNS44NTY5MiAyMy42OTAzLDkuODk1MzEgMzUuMTY0MzEsMjIuOTMxNDMgMzUuMTY0MzEs\nMzkuOTUxNjYgMCwxMC41ODU4MiAtNC4xMDM0MSwxOS4zMDc4OSAtMTIuMjM4MzUsMjYuMDEzMzkg\nLTguNzQzNzksNy4yMDc0IC0xNy4zOTE3NSw5LjAyODEgLTM3LjUyMzYyLDcuOTAwMTEgLTE5LjI4\nMjY4LC0xLjA4MDQxIC0zMC40Nzk3MiwtMy42NDE2OSAtNDguMjM2ODMsLTExLjAzMzk4IC0xOS4w\nNDc0OCwtNy45Mjk1IC0yNi4zNzc0MSwtMTMuNjE0NTQgLTMyLjUxMywtMjUuMjE2ODMgLTMuOTc3\nMzIsLTcuNTIxMDMgLTYuMjA5NzQsLTEwLjA5NDY0IC04Ljc1NjM3LC0xMC4wOTQ2NCAtNC43NzY4\nMSwwIC0xNi44NTA3NCwxMy4xMDIxOCAtMTguOTExMDgsMjAuNTIxNiAtMi4xNDYwMiw3LjcyNzk2\nIC0yLjE3Mjg3LDE5LjkxMjc2IC0wLjA1MDgsMjMuMDg3MjQgMS4zNjQwMywyLjA0MDU0IDIuMjgz\nMDYsMS42NzE1OSA1LjgyNTk0LC0yLjMzODc5IDQuODAwNTcsLTUuNDM0MDMgMS44ODcwOSwtNi4z\nODAyMyAyNi4yMjAyOSw4LjUxNTM4IDI2LjgzMzU3LDE2LjQyNjIxIDUxLjIwNjA0LDIzLjA2MzA1\nIDg0LjY5NDQyLDIzLjA2MzA1IDE3LjI0NjE5LDAgMjMuNzE4NiwtMC43Mzc2MyAzNy42MzMzNiwt\nNC4yODg4OCAxOC40MjMxOSwtNC43MDE4NCAyMi40NjQ4MiwtNC40NTEzMyAyMC43Njk0MiwxLjI4\nNzMzIC0zLjgyNzI5LDEyLjk1NDg0IC0yMC40Mjc0OSwyNi4wMTgxMyAtNDEuNjExNzksMzIuNzQ1\nNzggLTI2LjgzMjUxLDguNTIxMzggLTQzLjUxMDI5LDcuMjcyMyAtMTAyLjg5Nzc0LC03LjcwNjQ3\nIGwgLTM5LjU3MDk4LC05Ljk4MDY2IC0zNS41MzMxMiwtMC4wNDMxIGMgLTM1LjQ0MDU2LC0wLjA0\nMyAtODguMjc2MTMsMS45MDYxOSAtOTIuODcwNjYsMy40MjYyMiAtMS42NjgwNSwwLjU1MTg1IDIu\nODU5OTIsNS43NzQyMSAxNC41MzYyOCwxNi43NjU1NCAxNi44MTU2NiwxNS44MjkxMSAxNi45MTM0\nOSwxNS45NjQ1MyAxMS41NzUwOSwxNi4wMjI3NSAtNi4zNDQxNywwLjA2OTEgLTI3LjMyNDM0LC01\nLjA5NzgzIC00MC4zNDI0OCwtOS45MzU1NyAtMTAuNjkwMzEsLTMuOTcyNjggLTIzLjE1OTYyLC0x\nMi4xMDQzNCAtMzkuMzMzLC0yNS42NTAzOSAtNi4zMzMyMSwtNS4zMDQ0IC0xMS43NjI3MiwtOS4z\nOTY1NCAtMTIuMDY1NTksLTkuMDkzNjYgLTAuMzAyODgsMC4zMDI4OCAyLjA2MjY5LDguMzU0OTcg\nNS4yNTY4MSwxNy44OTM1NiAzLjE5NDEyLDkuNTM4NTcgNS4zODQ3MywxNy43NjU2NCA0Ljg2ODAy\nLDE4LjI4MjMzIC0xLjUxODM1LDEuNTE4MzYgLTE4LjY2MzczLC0xMi4wNTE2IC0zMS4yMzkxNSwt\nMjQuNzI0NjggLTE5LjczMiwtMTkuODg1MjUgLTM4LjE3OTU2LC00Ny4zOTg0NCAtNDQuMDExNzEs\nLTY1LjY0MDMzIC00LjQ4OTY3LC0xNC4wNDI4MyAtNy40MzAyMywtMi40NzE4MSAtNS43NTk1Miwy\nMi42NjM1MyAxLjMyOTMsMTkuOTk5MDEgNi4zNDUyNyw0OS44NDYyNSA5Ljc0Mzg0LDU3Ljk4MDE2\nIDIuODU1MzEsNi44MzM3MSA1LjYzNTc2LDUuNjUzMDggNS42MzU3NiwtMi4zOTMwNyAwLC01LjQ0\nODU4IDAuOTUwNTcsLTQuMjA1NzQgMTEuMjgyMTgsMTQuNzUxMjEgMjEuOTcxNTUsNDAuMzE0NDYg\nMzYuOTgxNTUsNjAuNDc2ODkgNzEuMDE4ODIsOTUuMzk3MjMgMzUuOTk1OTIsMzYuOTI5ODIgNjcu\nNTQyMzYsNjMuNTU5NTEgOTcuNjMsODIuNDEzNzYgMTYuMzYyNzQsMTAuMjUzNjMgMTYuNzIwMjQs\nMTAuMDkwMiAtMTQuMzc4OTUsNi41NzI0IC0yNS40ODg3OSwtMi44ODMxNSAtMzEuOTY0OCwtMy4w\nMDgzMiAtMzEuMTYyODIsLTAuNjAyNCAxLjY4MjAxLDUuMDQ2IDY5LjY0OTA5LDQyLjc4NjM4IDky\nLjUzODIyLDUxLjM4NDA4IDE1Ljc3MjQ3LDUuOTI0NTUgMzIuNjM2OTksOS43NTA5NyA1NC4wOTcz\nNSwxMi4yNzQyNyAyMi41NDQ3MSwyLjY1MDggMzMuOTI3ODgsMy4wMTI5OCAzMy45Mjc4OCwxLjA3\nOTQgMCwtMC43MTEzIC0zLjMxMzgyLC0yLjkwMzI1IC03LjM2NDA0LC00Ljg3MTAyIC00LjA1MDIz\nLC0xLjk2Nzc4IC0xNi41NzM5NiwtOS41NjI0MyAtMjcuODMwNTIsLTE2Ljg3NzAzIGwgLTIwLjQ2\nNjQ0LC0xMy4yOTkzNyAzMi42NzU5MiwtMC40MzcxOCBjIDIyLjE0Mzk3LC0wLjI5NjMyIDMyLjY3\nNTk0LC0xLjAwMjA3IDMyLjY3NTk0LC0yLjE4OTU1IDAsLTAuOTYzODIgLTEuODM4NjYsLTMuOTM3\nNSAtNC4wODU5MSwtNi42MDgxNyAtNy42Njc2MywtOS4xMTI0MyAtNDIuNzUzMjEsLTcwLjQ0Mzcx\nIC00Mi43NTMyMSwtNzQuNzM0NjEgMCwtMC40NzM4IDUuODg5NzMsMi4wOTk4NSAxMy4wODgyNyw1\nLjcxOTIgNy4xOTg1NCwzLjYxOTM1IDEzLjQ0ODMsNi4yMjA2MSAxMy44ODgzNCw1Ljc4MDU2IDAu\nNDQwMDUsLTAuNDQwMDUgLTEuMzkyMDksLTYuNDg0NTggLTQuMDcxNDIsLTEzLjQzMjMzIC05LjY5\nNDI5LC0yNS4xMzgxMSAtMjEuNDkzMzUsLTcxLjUyOTk2IC0yMS4yMDI4OCwtODMuMzY1OTggMC4w\nNDc5LC0xLjk1MzY1IDQuMjU2OTYsNi45ODY3MSA5LjM1MzM5LDE5Ljg2NzQ3IDE4Ljc4NjY2LDQ3\nLjQ4MTczIDM1LjczMDc2LDc2LjA5Mzk0IDU5LjA1NzI1LDk5LjcyNTQ2IDE3LjAyMzgzLDE3LjI0\nNjQ4IDE3LjM4NzkxLDE2Ljg2MDMgLTExLjAyNjM2LDExLjY5NTQzIC05LjY5Nzk0LC0xLjc2Mjcz\nIC0xNy45MTE1MywtMi45MjYxMyAtMTguMjUyNDEsLTIuNTg1MjUgLTEuMTQ3MiwxLjE0NzIgMTgu\nNjE0MDcsMjIuOTM4NDUgMjguOTEwNjEsMzEuODgwNCAxNC40MzQ1NiwxMi41MzU2NSAxOC45Nzk4\nNiwxNS41MTY2NyA0MC40MDAzLDI2LjQ5NjkgbCAxOC45OTQ0NSw5LjczNjU3IC0xNi44Mzc2MSwt\nMC4wNDU3IGMgLTIwLjc2MjQ0LC0wLjA1NjIgLTIzLjQ4NDkyLDEuNjIwMzggLTEzLjkwMTg2LDgu\nNTYyMzggMjEuMjQzNDIsMTUuMzg4NjUgNTkuNzAxMjUsMzAuMDg2MjUgODUuMjE3NywzMi41Njgg\nMTguODMyNDIsMS44MzE2NSA4NC40OTU3NywyLjE1MTc1IDg0LjQ5NTc3LDAuNDExOSAwLC0wLjY2\nNzM1IC0xMC4zNTcxMywtNC43MDg1IC0yMy4wMTU3OCwtOC45ODA0MyAtMTIuNjU4NjUsLTQuMjcx\nOTIgLTI4LjgzMDI1LC0xMC4yMzE0MiAtMzUuOTM2ODgsLTEzLjI0MzQyIGwgLTEyLjkyMTEzLC01\nLjQ3NjIgMzUuNTMzMTIsLTAuMDc5OSBjIDQ2LjM1NDIyLC0wLjEwNDE4IDIwNy4wNzQ0OSwyLjg3\nMzg1IDIyMC4wMDA2Nyw0LjA3NjQ3IGwgMTAuMDMyMjIsMC45MzMzOCAtMC4zNDE0LDUuOTM0OSAt\nMC4zNDEzMiw1LjkzNDkgOC44ODMyMiwxLjA1MTY1IGMgNC44ODU4LDAuNTc4NCAxNi45ODIyNSwx\nLjEyMzUgMjYuODgwNzMsMS4yMTEzMiAxNC40MDQyLDAuMTI3ODggMTguMTgzMywwLjY0MzUgMTgu\nOTI3MywyLjU4MjQ4IDAuNzgzNCwyLjA0MTUgNS45OTk4LDIuNDIyNzIgMzMuMTUxMSwyLjQyMjcy\nIDE3LjcyMTgsMCAzMy4zNTAzLDAuNDMzMiAzNC43Mjk5LDAuOTYyNjMgMi4yMTM3LDAuODQ5NDUg\nMi4xNDM1LDEuNjc4MiAtMC41OTYzLDcuMDQ4NzIgbCAtMy4xMDQ5LDYuMDg2MTggLTI2LjMyNTIs\nMS4wNDY0NyBjIC0xNC40Nzg4LDAuNTc1NjMgLTU2Ljg1MTMsMS43NDYzIC05NC4xNjEwNSwyLjYw\nMTUzIC0zNy4zMDk3MywwLjg1NTIyIC0xMDQuMTc2NjUsMi40MTE1NSAtMTQ4LjU5MywzLjQ1ODQ3\nIC01Mi4zNTQyOCwxLjIzNDA1IC0xMjkuNDI5MjcsMS41NDQ1NSAtMjE5LjE0NTI3LDAuODgyOSBs\nIC0xMzguMzg4MTgsLTEuMDIwNiAtMy41NzM4LDUuNzgyNTggYyAtMy44NzQ2Niw2LjI2OTMyIC0y\nLjcxOTM1LDguNjA3ODIgNC4yNzgxNyw4LjY1OTUgMS44NDExNiwwLjAxMzUgMjEuODI4NTUsMC43\nMzA2NSA0NC40MTY0MSwxLjU5MzQ1IDIyLjU4Nzg0LDAuODYyNzIgNjIuODczMjIsMi4zODg2NyA4\nOS41MjMwNiwzLjM5MDkgMjYuNjQ5ODUsMS4wMDIyMiA0OS43MjYxOSwyLjUwMjMgNTEuMjgwNzcs\nMy4zMzM1MiAxLjU1NDU3LDAuODMxMyAyLjgyNjQ5LDIuNDk4MjUgMi44MjY0OSwzLjcwNDQ4IDAs\nMy4yMDUxMiAzLjYzNjcxLDQuMTIyODIgMTcuNDk0ODgsNC40MTQ3MiA2LjgxMTg4LDAuMTQzNDgg\nLTEzLjMxNjgyLDAuODE2IC00NC43MzA0MiwxLjQ5NDUzIC0zNy41MjgxNywwLjgxMDYgLTU4LjY5\nNTA2LDEuODg3ODIgLTYxLjcyMTA1LDMuMTQxMyAtMi41MzI5NiwxLjA0OTE3IC02LjA2MDY2LDQu\nMzc0MDcgLTcuODM5MzIsNy4zODg3IGwgLTMuMjMzOTUsNS40ODExNSBoIC0yNC4wMDU1MSAtMjQu\nMDA1NTIgbCAtMy43ODAyNiw4LjA3MDk3IGMgLTIuMDc5MTMsNC40MzkxIC0zLjQ2NTg1LDguMzg1\nNDUgLTMuMDgxNTksOC43Njk2OCAxLjA2NzE2LDEuMDY3MTcgOTcuNzA2NDEsMy45ODkzMiAxNzEu\nODYxNzMsNS4xOTY3NSBsIDY2LjIyMDgyLDEuMDc4MiAtNC41MzMyMiw5LjQzOTA1IC00LjUzMzIy\nLDkuNDM5MDUgSCA1ODUuMjkxMiBjIC05Mi42NzAxNiwwIC0xMzkuOTU2ODQsMC41NTY1NyAtMTQy\nLjA0MDkyLDEuNjcxOSAtMi41MDM2MiwxLjMzOTk1IC0zLjEyNDEsMy40MjUwMiAtMy4xMjQxLDEw\nLjQ5ODQyIDAsNC44NTQ2IC0wLjU0NTExLDguODE4OSAtMS4yMTEzNSw4LjgwOTcgLTIuMjk4NDMs\nLTAuMDMyIC0yNy43MjYwNSwtMjAuMzY3OTUgLTM5Ljk2NjksLTMxLjk2NDA3IC02Ljc1MDk2LC02\nLjM5NTQgLTEyLjk5OTc4LC0xMS42MjggLTEzLjg4NjI4LC0xMS42MjggLTMuMzY3MzUsMCA1Ljk0\nNTg4LDM4LjUxMzk3IDE0LjA2MDMzLDU4LjE0NTE3IDkuNTQ3MDIsMjMuMDk2OSA5LjUxMzk5LDIz\nLjE3NDQgLTUuMDI3MzQsMTEuNzk4IHoiCiAgICAgaWQ9InBhdGgxOCIgLz4KICA8cGF0aAogICAg\nIHN0eWxlPSJmaWxsOiNmZmZmZmY7c3Ryb2tlLXdpZHRoOjEuNjE1MTQiCiAgICAgZD0ibSA3MTIu\nNTI4NzYsNzk1LjA1NzUyIGMgLTE0LjIyMzI0LC0wLjM4MTMgLTE4LjkyOTcsLTEuMDE3MjIgLTE4\nLjM0NzU2LC0yLjQ3OTIgMC40MzA2MywtMS4wODE1IDIuMDMxODYsLTUuMDU1MjkgMy41NTgyNywt\nOC44MzA3MiBsIDIuNzc1MzEsLTYuODY0MyBoIDc0LjkxNDk0IDc0LjkxNDg4IGwgNS4wMTM0NSwt\nOC44ODMzIDUuMDEzMzgsLTguODgzMyBoIDE2MC41NTI0NyBjIDEwOS4yODksMCAxNjAuNTUyNSww\nLjUzMjI4IDE2MC41NTI1LDEuNjY3MDMgMCwwLjkxNjg3IC0xLjA1MzIsNC4xODc1NSAtMi4zNDA0\nLDcuMjY4MTcgbCAtMi4zNDAyLDUuNjAxMDggaCAtOTUuMTM4OSBjIC01Mi4zMjY0LDAgLTk5LjU0\nNDA1LDAuNDYwNDIgLTEwNC45MjgxNSwxLjAyMzIyIGwgLTkuNzg5MjMsMS4wMjMxNSAtMi45NDQ4\nNywxMC4yODI4IC0yLjk0NDg4LDEwLjI4MjggLTExNC42OTUyNCwtMC4zNDcyNSBjIC02My4wODIz\nNSwtMC4xOTEwMiAtMTIzLjMwMzk3LC0wLjU3ODEgLTEzMy44MjU3NywtMC44NjAxOCB6IG0gMTgw\nLjI0MDk0LC0xLjQ3NzM1IGMgLTEuMTEwMzgsLTAuNDQ4MDUgLTIuOTI3NCwtMC40NDgwNSAtNC4w\nMzc4NSwwIC0xLjExMDM4LDAuNDQ4MDUgLTAuMjAxOSwwLjgxNDY1IDIuMDE4OTIsMC44MTQ2NSAy\nLjIyMDgzLDAgMy4xMjkzOCwtMC4zNjY2IDIuMDE4OTMsLTAuODE0NjUgeiBtIDE3LjY4NTgyLC0w\nLjg2ODUgYyAtMS41MDc1LC0xLjUwNzUgLTIuMzY4ODcsLTEuNTA3NSAtMy44NzYzNywwIC0xLjUw\nNzQzLDEuNTA3NDMgLTEuMDc2NywxLjkzODE1IDEuOTM4MjIsMS45MzgxNSAzLjAxNDkzLDAgMy40\nNDU1OCwtMC40MzA3MiAxLjkzODE1LC0xLjkzODE1IHogbSAzOC4wNTU0NiwtMTYuOTM4MTUgYyAt\nMS41NDQyNiwtMC40MDQyNSAtNC40NTE0OCwtMC40MTg4NyAtNi40NjA1OCwtMC4wMzIyIC0yLjAw\nOTEsMC4zODYzMyAtMC43NDU2NSwwLjcxNzA4IDIuODA3NjIsMC43MzUgMy41NTMzNiwwLjAxOCA1\nLjE5NzEzLC0wLjI5ODE5IDMuNjUyOTYsLTAuNzAyNDUgeiBtIC01Ny4yODE0OCwtMi4yODc4NyBj\nIC0xLjI3NzQ3LC0xLjI3NzQ4IC0yLjYyNzQsLTEuMjkxNDMgLTQuNzY0NjcsLTAuMDQ4NyAtMi42\nMTQzNSwxLjUxOTU3IC0yLjM5NDQ2LDEuNzM5NDcgMS43ODI1MiwxLjc4MjUyIDMuNDc0NjgsMC4w\nMzYgNC4yODIwNSwtMC40MzM1IDIuOTgyMTUsLTEuNzMzNCB6IG0gMzAuNjEyNzUsMC42NDI2IGMg\nLTEuOTk4NzUsLTAuMzg0OTggLTUuMjY5MzUsLTAuMzg0OTggLTcuMjY4MSwwIC0xLjk5ODc1LDAu\nMzg0OTcgLTAuMzYzNDUsMC42OTk5NyAzLjYzNDA1LDAuNjk5OTcgMy45OTc1LDAgNS42MzI4LC0w\nLjMxNSAzLjYzNDA1LC0wLjY5OTk3IHoiCiAgICAgaWQ9InBhdGgxOSIgLz4KICA8cGF0aAogICAg\nIHN0eWxlPSJmaWxsOiNmZmZmZmY7c3Ryb2tlLXdpZHRoOjEuNjE1MTQiCiAgICAgZD0ibSA4NDQu\nMTEyMSw2MTguNzU3NCBjIC0xLjg1NjYzLC0xLjE3NjAxIC0xLjczODEzLC0yLjY3NTUyIDAuNzIw\nMTUsLTkuMTEyMzcgbCAyLjk0MjQ3LC03LjcwNDc0IDkwLjQ3NjQsMS4xMzcwNCBjIDQ5Ljc2MTk4\nLDAuNjI1MzcgOTAuNzM0OTgsMS4zOTU2NSA5MS4wNTEwOCwxLjcxMTczIDAuMzE2MSwwLjMxNjA4\nIC0wLjI4MzIsMy40MzgyNCAtMS4zMzE5LDYuOTM4MTYgbCAtMS45MDY1LDYuMzYzNDcgLTQxLjI2\nODY1LDEuMDYxOTEgYyAtNTguMjQ5NDMsMS40OTg4NSAtMTM4LjA0NjczLDEuMjc0NjkgLTE0MC42\nODMwNSwtMC4zOTUyIHoiCiAgICAgaWQ9InBhdGgyMCIgLz4KICA8cGF0aAogICAgIHN0eWxlPSJm\naWxsOiNmZmZmZmY7c3Ryb2tlLXdpZHRoOjEuNjE1MTQiCiAgICAgZD0ibSA4NDQuMDQyNjUsNjE4\nLjcxNDQ4IGMgLTEuOTUyNjMsLTEuMjM1ODcgLTEuODU4NjUsLTIuNTg0NzUgMC42MzQ3MiwtOS4x\nMTM0NSBsIDIuOTI2NDMsLTcuNjYyODggOTAuNTYxOSwxLjEzODExIGMgNDkuODA5LDAuNjI1OTYg\nOTAuODIwNCwxLjM5NjcyIDkxLjEzNjUsMS43MTI4IDAuMzE2MSwwLjMxNjA4IC0wLjI4MzMsMy40\nMzg0NiAtMS4zMzIsNi45Mzg2MyBsIC0xLjkwNjcsNi4zNjM5NSAtMzkuNzk1MTMsMS4wNTc5NCBj\nIC01Ny4wOTIyNSwxLjUxNzc2IC0xMzkuNTM4OTIsMS4yNjU1NSAtMTQyLjIyNTcyLC0wLjQzNTEg\neiIKICAgICBpZD0icGF0aDIxIiAvPgogIDxwYXRoCiAgICAgc3R5bGU9ImZpbGw6I2ZmZmZmZjtz\ndHJva2Utd2lkdGg6MS42MTUxNCIKICAgICBkPSJtIDM4OC45MDAzLDEwMzMuMDg1NSBjIC0yMi45\nNDA4NywtMTkuMjYwNiAtNDguNjg0NTIsLTQ2Ljk4NDA4IC02MS43Mzk0OSwtNjYuNDg3NjggLTEw\nLjYzMjY3LC0xNS44ODQ3NyAtMjYuNDM5ODQsLTQ1Ljg0Mjg1IC00Mi4xNTA2OSwtNzkuODg0OSBs\nIC01LjU5MDU2LC0xMi4xMTM1NSAtMC40OTM0NCwxMC40OTg0MyBjIC0wLjI3MTQsNS43NzQxIDAu\nNjgxMywyNi4zODM1NyAyLjExNzEsNDUuNzk4NzUgMS40MzU3OSwxOS40MTUyNSAyLjI3MDMyLDM1\nLjY0MDYgMS44NTQ1MSwzNi4wNTY0IC0yLjMzOTU1LDIuMzM5NTUgLTMxLjE1NzczLC01OC42MDUy\nMyAtNDEuMzc4ODgsLTg3LjUwODEzIC04Ljc1NzA4LC0yNC43NjI5NyAtMjQuMjcxMTMsLTk0Ljc3\nMzUyIC0yNi44NDMxNiwtMTIxLjEzNTY1IC0wLjYwNjY5LC02LjIxODMyIC0xLjc5MjM1LC0xMi4x\nNzk2NyAtMi42MzQ4MSwtMTMuMjQ3NDMgLTAuODQyNDYsLTEuMDY3NzggLTEuMDAzNDQsLTMuNjA1\nOTMgLTAuMzU3NzUsLTUuNjQwMzQgMS4wMTQxMSwtMy4xOTUxOCAwLjgzNjIxLC0zLjQxODU3IC0x\nLjMwNjM2LC0xLjY0MDQgLTEuMzY0MTksMS4xMzIxOSAtMTQuMDA2NTcsMTcuMzkxOTggLTI4LjA5\nNDE4LDM2LjEzMjkgLTE3LjIwMzY4LDIyLjg4NjMzIC0yNS44MDEzNSwzMy4xMjcwNSAtMjYuMTg0\nOTUsMzEuMTg5MDUgLTAuNzkwMDUsLTMuOTkxNDIgMi4zNDQ3NSwtMzMuNDIzIDcuMDQ4NDUsLTY2\nLjE3NTUxIDMuNTQ4MDQsLTI0LjcwNTQ4IDIwLjMzNjY1LC0xMjEuMjUyNTggMjIuNzcxODUsLTEz\nMC45NTUxNyAwLjUzOTYzLC0yLjE1MDA1IDAuMzU3MjQsLTMuODk3ODYgLTAuNDA1MzEsLTMuODg0\nMDMgLTAuNzYyNTQsMC4wMTM4IC0xMC4zNTE4Myw2LjYxNDM4IC0yMS4zMDk1MSwxNC42Njc4OSAt\nMTAuOTU3NjgsOC4wNTM1MiAtMjAuMzE4MTgsMTQuMjQ3NjQgLTIwLjgwMTEsMTMuNzY0NzEgLTAu\nNDgyOTMsLTAuNDgyOTMgMi4xMTQzNCwtMTEuNjI2MDcgNS43NzE2OSwtMjQuNzYyNTMgOC41NzM3\nLC0zMC43OTQ5OSAxMi43MzUxOSwtNDguNTkyMiAyNS40MTIyOSwtMTA4LjY3OTQ0IDUuNzE2MjYs\nLTI3LjA5NDAyIDEwLjc5NTM1LC01MC41MzM3NiAxMS4yODY4OCwtNTIuMDg4MzQgMC40OTE1NCwt\nMS41NTQ1NyAwLjM2MzQ3LC0yLjgyNjQ5IC0wLjI4NDYsLTIuODI2NDkgLTEuODE4NzEsMCAtMTQu\nNTM1NzcsNy42MzM3MSAtMzAuMjY2OTIsMTguMTY4NDMgLTcuODQ3Nyw1LjI1NTQgLTE0LjUwNzYy\nLDkuMzEzNjUgLTE0Ljc5OTgzLDkuMDE4MzMgLTAuMjkyMjIsLTAuMjk1MyA2LjEyODYzLC0xNC42\nMDA0MiAxNC4yNjg1NCwtMzEuNzg5MTQgbCAxNC43OTk4MywtMzEuMjUyMjMgNy4zNjEyLC0wLjU3\nMjY3IGMgNC4wNDg2NSwtMC4zMTQ5NiAxOS4zMTE3NCwtMy4xODY5NyAzMy45MTc5OCwtNi4zODIy\nMiAxNC42MDYyMywtMy4xOTUyNSAyOC43ODk1LC02LjIwOTk3IDMxLjUxODM5LC02LjY5OTM4IDQu\nODEyNzEsLTAuODYzMTMgNS4yMDAzOCwtMC40NjA4IDEyLjkxODg0LDEzLjQwNzMxIDE1LjUwMDQy\nLDI3Ljg1MDM4IDQzLjE1MjU3LDUwLjkwNzU2IDY5LjQ4NjE0LDU3LjkzOTYgMTIuNjYxMjYsMy4z\nODEwNCA1MC4xMDg2MywzLjM5NTg5IDY3LjY3MDExLDAuMDI2OSAyMS44Mjg4MSwtNC4xODc3IDUy\nLjA4MzQ5LC0xNS42ODc1MiA1MS40MDQ3LC0xOS41Mzg5NSAtMC4xNjMyMywtMC45MjYxNSAyLjc0\nNDAyLC0yLjU4Mzk2IDYuNDYwNTYsLTMuNjg0MDIgMTAuMTI0MjYsLTIuOTk2NzEgMjguNDA3NDEs\nLTE2LjIyMzI3IDM2Ljc5MDQzLC0yNi42MTUyNiA4Ljk3MDIyLC0xMS4xMTk5MyAxNS43NzUwOSwt\nMjQuNzI1MjkgMjEuNTUzMzYsLTQzLjA5MjkgNC44NzEyNSwtMTUuNDg0NDYgNy45MDgyNywtMjAu\nOTk2ODQgMTEuNTY4MSwtMjAuOTk2ODQgNC45NzI2NCwwIDU1LjE1MTk4LDEzLjE5OTA4IDczLjY2\nMTg3LDE5LjM3NTg5IDI1Ljg0NzEzLDguNjI1MjYgNDQuMzM3ODcsMTcuNzU1NyA1NC41NDY2NCwy\nNi45MzQyNyAxMy4zOTk0LDEyLjA0NzIxIDE1Ljg2MjA3LDIxLjcwMzU3IDkuNzIxMDEsMzguMTE3\nMDMgLTUuODEwNCwxNS41Mjk2OCAtNS43MzI2NywxNS41MDc4OCAtMTguNjAxODgsNS4yMTYzNyAt\nNi4yMDI0MiwtNC45NjAwNyAtMTYuMjgwNzcsLTExLjUzMjUgLTIyLjM5NjM1LC0xNC42MDUzOSAt\nMTAuMjU3OTYsLTUuMTU0MzEgLTEyLjI1OTYsLTUuNTg2MzcgLTI1Ljg0MjI3LC01LjU3ODA2IC0x\nOC44OTE0MywwLjAxMTUgLTI1LjUzMDE1LDEuOTUwNDggLTM0LjI3Mzg2LDEwLjAxMDE2IC03LjU5\nMDc2LDYuOTk2OTIgLTkuMDYzOTcsMTIuNTE4NSAtNC44NzAyNCwxOC4yNTM3NyAyLjA4ODg1LDIu\nODU2NjYgMy4xNDAwNCwyLjkyNjc1IDE2LjczODQzLDEuMTE2MjUgMTcuMTA1NDgsLTIuMjc3NDMg\nMjUuNjk2NzYsLTEuMDUyODkgNDIuODMzNzMsNi4xMDUyNSAyMy42MzIxMiw5Ljg3MTE4IDM1LjEw\nNzU2LDIyLjkyMjI2IDM1LjEwNzU2LDM5LjkyODA0IDAsMTAuNTg1ODIgLTQuMTAzNDEsMTkuMzA3\nODkgLTEyLjIzODM1LDI2LjAxMzM5IC04Ljc0Mzc5LDcuMjA3NCAtMTcuMzkxNzUsOS4wMjgxIC0z\nNy41MjM2Miw3LjkwMDExIC0xOS4yODI2OCwtMS4wODA0MSAtMzAuNDc5NzIsLTMuNjQxNjkgLTQ4\nLjIzNjgzLC0xMS4wMzM5OCAtMTcuODEyNzIsLTcuNDE1NDggLTI2LjQ1NDA2LC0xMy43ODM4OCAt\nMzEuNDc3MzUsLTIzLjE5NzkgLTcuODUzNDEsLTE0LjcxNzgzIC0xMC4xNDExMSwtMTUuMzE2NSAt\nMTkuODQxNzMsLTUuMTkyMzcgLTkuNTc2NTcsOS45OTQ2NiAtMTMuODk0ODEsMjguMzA4MjcgLTgu\nNDM1NDMsMzUuNzc0NDQgMi4wMzA3MSwyLjc3NzE2IDIuMzI0NjEsMi43MDcxMSA1LjQ4MTY3LC0x\nLjMwNjQ0IDEuODM0NzUsLTIuMzMyNTEgMy43ODIyNiwtNC4yNDA5MyA0LjMyNzc5LC00LjI0MDkz\nIDAuNTQ1NTUsMCA5LjIzMDIzLDQuOTYxMTcgMTkuMjk5MzEsMTEuMDI0ODEgMzIuNDM4NjksMTku\nNTM0NzMgNTQuNTc1MjQsMjUuMzIzNjUgOTIuMjg0LDI0LjEzMzE5IDE3LjE4ODU4LC0wLjU0MjYz\nIDI2LjAxODM0LC0xLjY0NDg0IDM2LjcxMjE2LC00LjU4MjcxIDE3LjUwNTcyLC00LjgwOTMgMTku\nNTYzMSwtMy43Mjk4MiAxNC4xNjA3NCw3LjQyOTk1IC02LjU0OTU2LDEzLjUyOTYgLTI2LjQyMjcz\nLDI1LjM2MjQ4IC01MS42NjA2MiwzMC43NTk3NyAtMjAuODc1OTgsNC40NjQ0NiAtMzYuMjYxOTMs\nMi41OTI0IC05MC40NDc5NSwtMTEuMDA1MTEgbCAtMzkuNTcwOTgsLTkuOTMgLTMzLjExMDQxLC0w\nLjA5MTYgYyAtNDQuNTk0NjUsLTAuMTIzMzggLTk1LjI4Njg2LDIuMzI1ODEgLTk1LjMxNTY1LDQu\nNjA1MTggLTAuMDEyMiwwLjk3MDA4IDcuMDc0Miw4LjQ1MzM0IDE1Ljc0NzY0LDE2LjYyOTQ4IDE1\nLjczMDkxLDE0LjgyODk0IDE1Ljc1NjU5LDE0Ljg2NTg4IDEwLjM4NjAxLDE0Ljk0MDA2IC0yLjk2\nMTE0LDAuMDQwOSAtMTEuMTgyMTgsLTEuNDA2MDcgLTE4LjI2ODk3LC0zLjIxNTQ5IC0yNy4wMzk3\nOCwtNi45MDM4NyAtMzcuMzgyNjksLTEyLjI1NTExIC01OS4zNzE4MSwtMzAuNzE3OTYgLTYuNDU3\nOTUsLTUuNDIyMzQgLTEyLjE4ODMxLC05LjQxMjIxIC0xMi43MzQxMywtOC44NjY0MSAtMC41NDU4\nMSwwLjU0NTgzIDEuMzk5OSw4LjI5MDk3IDQuMzIzODIsMTcuMjExNDEgMi45MjM5Miw4LjkyMDQ2\nIDQuOTA2NTMsMTYuNjI4NyA0LjQwNTgsMTcuMTI5NDMgLTEuNDkyOTQsMS40OTI5NSAtMTguNzM3\nNTEsLTEyLjE4NDI4IC0zMS4yMTAxMSwtMjQuNzUzNzQgLTIwLjEzMzM4LC0yMC4yODk3MyAtMzcu\nODU3NzIsLTQ2Ljc0MDU4IC00My45ODQ2NCwtNjUuNjQwMzIgLTQuNDc2NzMsLTEzLjgwOTM4IC03\nLjUwMzYsLTIuNDE5MTkgLTUuODIzNDksMjEuOTEzOTYgMS40MDAwNywyMC4yNzczMyA2LjQ1MDc0\nLDUwLjc1OTg4IDkuNjk4MjQsNTguNTMyMjQgMi45Mjg1MSw3LjAwODkyIDUuNzEzMjEsNS45MzY2\nOCA1LjczMjA5LC0yLjIwNzE0IDAuMDEyNywtNS40NjM3OCAwLjk2NzM4LC00LjIwODYyIDExLjQz\nNjIsMTUuMDM1MzkgMjMuMTY5NzIsNDIuNTkxMDQgNDMuOTkyNzEsNjkuMzI4NjYgODUuMTEwMTUs\nMTA5LjI4NTExIDMzLjAxMDE0LDMyLjA3ODA3IDU2Ljk2NTY1LDUxLjYzOTI3IDg0LjE3ODQsNjgu\nNzM2OTUgMTUuNDk5MjQsOS43MzgwNyAxNS44NDYxMyw5LjU5OTAyIC0xNS4xODY1Miw2LjA4ODcz\nIC0xMS41NDgyNywtMS4zMDYyOCAtMjMuNTQwNjksLTIuMzYxIC0yNi42NDk4NCwtMi4zNDM4MyBs\nIC01LjY1MywwLjAzMTUgNS4xMTgwNiw0LjY1ODEgYyAxMS43MjQwOSwxMC42NzA1NSA3NC4wNDU4\nOCw0My43NjcyMiA5NS44MjgzMSw1MC44OTA3MiAyNC44ODI4Myw4LjEzNzQzIDgwLjc1NzEsMTUu\nNjc4MyA4MC43NTcxLDEwLjg5OTE2IDAsLTAuNzExMyAtMy4zMTM4MiwtMi45MDMyNiAtNy4zNjQw\nNSwtNC44NzEwMyAtNC4wNTAyMiwtMS45Njc3OCAtMTYuNTc0MDgsLTkuNTYyNDIgLTI3LjgzMDgy\nLC0xNi44NzcwMiBsIC0yMC40NjY3NiwtMTMuMjk5MzggMzEuNDIwMjYsLTAuNDM5ODcgYyAxNy4y\nODExNSwtMC4yNDE5NSAzMi4wNjkyLC0xLjA4ODg1IDMyLjg2MjM3LC0xLjg4MjA1IDAuODQ2MDQs\nLTAuODQ2MDggLTAuNTg0NzgsLTQuMDAyMDggLTMuNDYxNzYsLTcuNjM1ODMgLTguODQxNDIsLTEx\nLjE2NjgyIC00Mi4zMDc1LC02OS40NzYzNiAtNDIuMzA3NSwtNzMuNzE0MjggMCwtMC41NTM2OSA1\nLjk0Mjg1LDEuODY3MiAxMy4yMDYzNSw1LjM3OTc1IDcuMjYzNDksMy41MTI1NCAxMy40OTY1Myw2\nLjA5NjI3IDEzLjg1MTE5LDUuNzQxNiAwLjM1NDY1LC0wLjM1NDY1IC0xLjkyNzU1LC03LjUxMjg4\nIC01LjA3MTU5LC0xNS45MDcxNSAtOS44NTQwNywtMjYuMzA5NTYgLTIwLjU0MTY2LC02OC44NTc3\nMSAtMjAuMjk4NTcsLTgwLjgxMDI1IDAuMDM5NywtMS45NTM2NCA0LjM0MzM0LDcuMTM4NTMgOS41\nNjM1NywyMC4yMDQ4NSAxOS4zMzQzMiw0OC4zOTQwNCAzOC42NjY2OSw4MC4yNzg2MiA2Mi43MTU0\nMSwxMDMuNDM1NDggMTMuMzMyMDIsMTIuODM3NiAxMy4yNzE1MSwxMi44Njg2NSAtMTQuOTc3OSw3\nLjY3ODUgLTkuNjUwNDYsLTEuNzczIC0xNy44MjI1NSwtMi45NDc0MiAtMTguMTYwMTgsLTIuNjA5\nODUgLTEuMTE4NjMsMS4xMTg3IDE4LjM3ODY1LDIyLjY1NDk1IDI4LjAyMjM1LDMwLjk1Mjg4IDE1\nLjQ1NzUsMTMuMzAwNSAyMC40NzM2MiwxNi42Mzg3NSA0MC43OTExLDI3LjE0NjYyIGwgMTkuMzQ4\nMDQsMTAuMDA2NTggLTE2Ljc2MjcxLC0wLjA0NSBjIC0yMC44ODI1NSwtMC4wNTU1IC0yMy40MTA1\nLDEuNjA0NTUgLTEzLjQ4MTA4LDguODU0MTIgOS45NDc2Niw3LjI2MjkzIDQwLjExOTgsMjEuNTM3\nODMgNTUuOTg4MTUsMjYuNDg4ODggNy45NDc3MSwyLjQ3OTcyIDIwLjc3NTU5LDQuODM1OTIgMzEu\nNDk1MjcsNS43ODQ4MiAyMy41MDM4MiwyLjA4MDY1IDgzLjA1OTQ4LDIuMTU1MzUgODAuOTk1ODUs\nMC4xMDE2MyAtMC44NDM5LC0wLjgzOTg1IC0xMi4yMTcyNywtNS4yMTUzNSAtMjUuMjc0MDUsLTku\nNzIzMyAtMTMuMDU2ODEsLTQuNTA3OTUgLTI4LjY4MzMsLTEwLjMzNjA1IC0zNC43MjU1NSwtMTIu\nOTUxMzggbCAtMTAuOTg1OSwtNC43NTUgMzUuNTMzMTEsLTAuMDU3IGMgNDcuMjk1MjcsLTAuMDc1\nOSAyMDcuMzI1MDksMi44OTc5MyAyMjAuMDAwNjksNC4wODgyNSBsIDEwLjAzMjIzLDAuOTQyMTUg\nLTAuMzQxNCw1LjkzNDkgLTAuMzQxMzMsNS45MzQ5IDkuNjkwODMsMS4wMzYxMyBjIDUuMzI5OTUs\nMC41Njk3NyAxNy40MjYzMiwxLjExNDg3IDI2Ljg4MDg3LDEuMjExMzIgMTMuNjc1OSwwLjEzOTQz\nIDE3LjM4LDAuNjcwNTggMTguMTE5NywyLjU5OCAwLjc4MzMsMi4wNDE1IDUuOTk5NywyLjQyMjcz\nIDMzLjE1MSwyLjQyMjczIDE3LjcyMTgsMCAzMy4zNTAzLDAuNDMzMiAzNC43MywwLjk2MjYyIDIu\nMjE0LDAuODQ5NiAyLjE0NDYsMS42NzYxIC0wLjU5MTEsNy4wMzg0NSBsIC0zLjA5OTYsNi4wNzU3\nNSAtMjMuOTA3OCwxLjAzNDE4IGMgLTEzLjE0OTIsMC41Njg4IC01Ni45Nzc3LDEuNzQ5MjIgLTk3\nLjM5NjYyLDIuNjIzMTIgLTQwLjQxODkzLDAuODczODMgLTg2LjU3MTYsMi4xMTYyOCAtMTAyLjU2\nMTUzLDIuNzYwOTggLTE1Ljk4OTkyLDAuNjQ0NjIgLTExMy4yOTY2NSwxLjIwMjkyIC0yMTYuMjM3\nMjIsMS4yNDA3MiBsIC0xODcuMTY0NjgsMC4wNjgzIC0zLjAwMjIsNS41OTc3OCBjIC0yLjMxNDA4\nLDQuMzE0NzUgLTIuNTM3NjEsNS44NTc4IC0wLjk3NTE2LDYuNzMyMTUgMS4xMTQ4OCwwLjYyMzky\nIDIzLjA5Mzc3LDEuOTE5MjUgNDguODQxOTYsMi44Nzg1NyAxMTcuODE4OTMsNC4zODk0NSAxMzku\nMDYwMzksNS40MTE3IDE0MS43MDQ0OSw2LjgxOTYgMS41NTQ1NywwLjgyNzc4IDIuODI2NSwyLjQ4\nNDk4IDIuODI2NSwzLjY4MjU4IDAsMi44OTYyNyA0LjkzMjA3LDQuMDY2ODcgMTguNTc0MTMsNC40\nMDgyNyA2LjIxODMsMC4xNTU2MyAtMTQuMzk2MDcsMC44MzgwNSAtNDUuODA5NjgsMS41MTY1OCAt\nMzYuNDkxNTgsMC43ODgxNyAtNTguNzMwNDYsMS45MDI1MiAtNjEuNTg3NjMsMy4wODYwMiAtMi40\nNTk1OSwxLjAxODggLTUuODEzNjcsNC4zNDM3IC03LjQ1MzUzLDcuMzg4NyBsIC0yLjk4MTUzLDUu\nNTM2NDMgaCAtMjQuMzkxMzMgLTI0LjM5MTMzIGwgLTMuNzgwMjUsOC4wNzA5NyBjIC0yLjA3OTE0\nLDQuNDM5MSAtMy40NzUzLDguMzY0ODMgLTMuMTAyNTksOC43MjM5MyAxLjA4ODg1LDEuMDQ5MDIg\nMTAwLjAwNTI0LDQuMDYyIDE3MS44ODI3Myw1LjIzNTQ1IGwgNjYuMjIwODIsMS4wODEwNSAtNC41\nMzMyMiw5LjQ0MTE1IC00LjUzMzIyLDkuNDQxMTUgSCA1ODYuMzEwMSBjIC04NS42ODk5NSwwIC0x\nMzkuNDY2NDUsMC41OTYzMiAtMTQyLjA0MDkyLDEuNTc1MTUgLTMuNzU3MjQsMS40Mjg0NSAtNC4x\nNDMsMi40MDYgLTQuMTQzLDEwLjQ5ODQyIDAsNC45MDc4IC0wLjU0NTExLDguOTE2OSAtMS4yMTEz\nNSw4LjkwOTIgLTEuOTE1NzksLTAuMDIzIC0yOC4wMDc5MSwtMjAuNzExOCAtNDEuMjI5NjUsLTMy\nLjY5MjYgLTYuNjE3MjgsLTUuOTk2MTcgLTEyLjQ0ODY5LC0xMC45MDIyMiAtMTIuOTU4NjUsLTEw\nLjkwMjIyIC0yLjk1NDYsMCA2Ljc4NjkyLDM5LjMyMTcyIDE0LjI5Njc3LDU3LjcwODkyIDEwLjAz\nNzg3LDI0LjU3NjkgOS44Nzk3NSwyNC43MDAzIC0xMC4xMjI5OSw3LjkwNjYgeiIKICAgICBpZD0i\ncGF0aDIyIiAvPgogIDxwYXRoCiAgICAgc3R5bGU9ImZpbGw6I2ZmZmZmZjtzdHJva2Utd2lkdGg6\nMS42MTUxNCIKICAgICBkPSJtIDk4OS42NzgyNSwxMDA4LjI5NTMgYyAtNS45NzI3LC0wLjc0MTIg\nLTUuOTUyNjgsLTAuNjkwMiAtMy4yMzAzMywtOC4yMzYyIGwgMi4wMTg5MywtNS41OTYxNSA3NS4x\nMDQwNSwxLjE1NjEyIGMgNDEuMzA3MywwLjYzNTkzIDc1LjU5NjgsMS42NDg5NSA3Ni4xOTksMi4y\nNTEyIDAuNjAyMSwwLjYwMjI2IDAuMDkyLDMuNDU3MjMgLTEuMTMzNCw2LjM0NDIzIGwgLTIuMjI4\nMiw1LjI0OTIgLTcwLjc0MDQsLTAuMjU4NCBjIC0zOC45MDcyLC0wLjE0MjIgLTczLjEwMjYsLTAu\nNTUxNyAtNzUuOTg5NjUsLTAuOTEgeiIKICAgICBpZD0icGF0aDIzIiAvPgogIDxwYXRoCiAgICAg\nc3R5bGU9ImZpbGw6I2ZmZmZmZjtzdHJva2Utd2lkdGg6MS42MTUxNCIKICAgICBkPSJtIDM4OC45\nMDAzLDEwMzMuMDg1NSBjIC0yMy42MjQyMiwtMTkuODM0MyAtNDguNzcxOTIsLTQ3LjA0ODM1IC02\nMi4yMzY0NywtNjcuMzUwMzMgLTExLjUxODU4LC0xNy4zNjc5IC0zMC40MzgzMywtNTMuMzc5MDcg\nLTQwLjg4MDY4LC03Ny44MTA4NSAtMi41NjI4MywtNS45OTYyNSAtNS4zMzc2NSwtMTAuOTAyMjIg\nLTYuMTY2MjgsLTEwLjkwMjIyIC0xLjY4MDQ5LDAgLTAuMzgzODMsMzYuMTk3MTcgMi4zODI2Niw2\nNi41MTMzNyAwLjk0OTczLDEwLjQwNzUzIDEuNDU5MzcsMjAuMjMyMTUgMS4xMzI1MywyMS44MzI0\nMyAtMS4yMTk5Miw1Ljk3MzE1IC0yNi4xODgwNSwtNDQuNjA0MzggLTM5LjE4MzgsLTc5LjM3Mzkz\nIC0xMC4zMzAzNCwtMjcuNjM4NCAtMjQuMzAyNTMsLTg3LjgwNjU1IC0yOC40NDc5NCwtMTIyLjUw\nNDc3IC0wLjk3NzE1LC04LjE3OTA1IC0yLjQ3NDQxLC0xNi4xODgyOSAtMy4zMjcyNCwtMTcuNzk4\nNDMgLTAuODUyODMsLTEuNjEwMTQgLTEuMDc0NzksLTQuNDI2NyAtMC40OTMyMiwtNi4yNTkwNCAx\nLjk5MzE2LC02LjI3OTg5IC0yLjI2MjI2LC0xLjM3Mjc5IC0yNS44NzQwNCwyOS44MzY0NCAtMTIu\nOTEzODUsMTcuMDY5MDMgLTI0Ljk4Nzk4LDMyLjg1MTczIC0yNi44MzEzOCwzNS4wNzI1NSBsIC0z\nLjM1MTY1LDQuMDM3ODUgMS4wMTg4OSwtMTQuNTM2MjcgYyAyLjE1Nzc4LC0zMC43ODQxMiAxMy43\nNDUyOCwtMTA1LjcwMDkzIDI2LjYyNzg1LC0xNzIuMTU3MzIgMS43NzA0MywtOS4xMzMwNCAyLjkw\nNDE1LC0xNi45MjAzMyAyLjUxOTM3LC0xNy4zMDUxMSAtMC4zODQ3OCwtMC4zODQ3OSAtOS44ODc4\nLDUuOTgwOTUgLTIxLjExNzg0LDE0LjE0NjA5IC0xMS4yMzAwMyw4LjE2NTEyIC0yMC44MDk4OSwx\nNC40NTQwNCAtMjEuMjg4NTYsMTMuOTc1MzcgLTAuNDc4NjcsLTAuNDc4NjggMi4wNTMyNiwtMTEu\nMjY3ODggNS42MjY1MiwtMjMuOTc1OTkgOC41MzM0NCwtMzAuMzQ4NzEgMzguMDY5MjQsLTE2Mi41\nMDM5MiAzNi42NzI2NSwtMTY0LjA4ODM3IC0wLjc2MDU4LC0wLjg2Mjg5IC02Ljg2NTgsMi42OTg2\nOCAtMjYuMzgxMjcsMTUuMzg5OTIgLTEwLjEwMDg3LDYuNTY4NzYgLTE4LjU3NzgzLDExLjcyODA2\nIC0xOC44Mzc2OSwxMS40NjUxIC0wLjI1OTg2LC0wLjI2Mjk2IDYuMjQ3OTIsLTE0LjY1MDk4IDE0\nLjQ2MTc0LC0zMS45NzMzOCAxMC42MjU3MSwtMjIuNDA4OSAxNS42NDUyOSwtMzEuMzczNDEgMTcu\nMzk4OTEsLTMxLjA3MjkgMS4zNTU1OCwwLjIzMjMgMTYuOTIxNiwtMi41OTE0OSAzNC41OTExNiwt\nNi4yNzUwOSAxNy42Njk1NywtMy42ODM1OCAzNC4wMDEzLC03LjAyNjkxIDM2LjI5MjczLC03LjQy\nOTYxIDMuNzg2MywtMC42NjU0IDQuODg4NDcsMC41NTQ2NiAxMi4wODU4NiwxMy4zNzg1MSAxNC4w\nMTAzOCwyNC45NjI4NCAzNS43Mjg1NSw0NC43MjQ1NiA2MC41NTg2Nyw1NS4xMDMzMiA5LjMyMDU3\nLDMuODk1OSAxMi44OTk2OSw0LjQyMTY1IDM0LjI4NzUsNS4wMzY1OSAyNy45MjU3NSwwLjgwMjkx\nIDQ0LjcxMTA4LC0xLjIzMTYgNjQuNTI3NTcsLTcuODIxMjUgMTUuNzU3OTgsLTUuMjQwMDcgMzEu\nMTQ5MDYsLTEyLjUzNzkzIDI5LjYzNTE0LC0xNC4wNTE4NyAtMC41NDQ5NywtMC41NDQ5NSAyLjA5\nMTE3LC0yLjAzMjY0IDUuODU4MDcsLTMuMzA1OTggMjYuMDY1MDgsLTguODEwODggNDcuODEwOTMs\nLTM0Ljc4NDg0IDU4LjQzNTE3LC02OS43OTY5IDQuNjYyNTYsLTE1LjM2NTQ2IDcuODkxNDksLTIw\nLjk4MjQ4IDEyLjA2MTcxLC0yMC45ODI0OCA1LjA4MzUzLDAgNTMuOTE0OTUsMTIuOTEzODYgNzMu\nMjQ0NjQsMTkuMzcwMTMgNDYuMDg4MzEsMTUuMzkzODUgNjcuNDk5MDUsMzEuNTAwMDYgNjcuNDk5\nMDUsNTAuNzc2MDggMCw1Ljg3MzIxIC00Ljk1OTczLDIwLjg2MjEgLTguNTA5NDksMjUuNzE2Njcg\nLTEuODY3OTEsMi41NTQ1MyAtMy4wMzE2NywyLjAxMTMgLTEzLjMyMzc5LC02LjIxOTMyIC02LjIw\nMjQyLC00Ljk2MDA3IC0xNi4yODA3NywtMTEuNTMyNDkgLTIyLjM5NjM1LC0xNC42MDUzOSAtMTAu\nMjE5NzMsLTUuMTM1MTEgLTEyLjI5NTA2LC01LjU4NzA3IC0yNS42NTQ4OCwtNS41ODcwNyAtMTgu\nNDY0MzksMCAtMjUuOTk3NiwyLjMwMTE2IC0zNC42MzkxLDEwLjU4MTE3IC03LjM1NzM4LDcuMDQ5\nNjEgLTguNzE1NTQsMTIuMTg5NzggLTQuNjc5MzIsMTcuNzA5NjMgMi4xMTAxOCwyLjg4NTg1IDMu\nMDgyNTgsMi45NDgxNSAxNi41NTUyMSwxLjA2MDY0IDE2Ljg4Mzk0LC0yLjM2NTQzIDI1LjYyMjc5\nLC0xLjExNzExIDQzLjAwMzg4LDYuMTQzIDIzLjYzMjEyLDkuODcxMTkgMzUuMTA3NTYsMjIuOTIy\nMjcgMzUuMTA3NTYsMzkuOTI4MDQgMCwxMC42NzAzNiAtNC4xMDkzNywxOS4zMDg5OSAtMTIuNDcw\nNDgsMjYuMjE1MDkgLTguNzA0MzcsNy4xODk2NyAtMTYuMTE4NTMsOC43MzQ4NyAtMzYuNzkxMzUs\nNy42Njc3OSAtMTkuNjc4NjQsLTEuMDE1NzYgLTMwLjc0OTg0LC0zLjUxNTMyIC00OC43MzY5Nywt\nMTEuMDAzMzYgLTE3Ljg5MTI3LC03LjQ0ODE2IC0yNi4zMTQ3NywtMTMuNzI5NTMgLTMxLjk4MDA2\nLC0yMy44NDczOCAtNi40Nzg0OSwtMTEuNTcwMTggLTkuMTI5ODcsLTEzLjE0MTA4IC0xNC45MjE5\nNCwtOC44NDA5MSAtNy43MjU2Nyw1LjczNTczIC0xMy45MjcwNCwxNy41MTU1IC0xNC42Mjc2MSwy\nNy43ODU4IC0wLjQyOTQyLDYuMjk1MTggMC4xMDM4NCwxMC4xMzQwNSAxLjcwNDIsMTIuMjY4NTIg\nMi4yNTc5LDMuMDExNDUgMi40MTU3NiwyLjk3NDM4IDUuMTU4MzUsLTEuMjExMzUgMS41NTYwNCwt\nMi4zNzQ4NSAzLjQ4MjMxLC00LjMxNzg4IDQuMjgwNTksLTQuMzE3ODggMC43OTgyOCwwIDkuNDcw\nMjUsNC44MTUxNiAxOS4yNzEwNywxMC43MDAzNiAyMS44MzY4OCwxMy4xMTI2NyAzNi45Nzk1LDE5\nLjEzNTk4IDU2LjY5MzE3LDIyLjU1MTAzIDIxLjAxNDk0LDMuNjQwNDYgNTEuOTI5NDgsMi41MTY1\nMyA3Mi4wMTg1NywtMi42MTgzMSAxOC4zNjU1NCwtNC42OTQzIDIwLjI5NDU0LC0zLjc0MDgzIDE0\nLjkxNDgyLDcuMzcyMTcgLTYuNTQ5NTcsMTMuNTI5NTkgLTI2LjQyMjc0LDI1LjM2MjQ4IC01MS42\nNjA2MywzMC43NTk3NiAtMjAuODA2NjksNC40NDk2NCAtMzkuNTcxODEsMi4xMjM2OSAtOTMuNjc4\nMjMsLTExLjYxMTUzIGwgLTM2LjM0MDY5LC05LjIyNTI3IC0yOS4wNzI1NSwtMC4xNDA3NiBjIC0z\nOC42ODYyMSwtMC4xODczMSAtOTcuNTc4MzYsMi4zNjc1NCAtOTguNzYzMTEsNC4yODQ1MiAtMC41\nMjA5OCwwLjg0Mjk2IDYuMjI0NSw4LjQxMDU3IDE0Ljk4OTk2LDE2LjgxNjkgbCAxNS45MzcxOCwx\nNS4yODQyNSAtOC44ODMyOCwtMC43ODcyOCBjIC0xMS4wNDg2OSwtMC45NzkxOSAtMzQuODU4Njcs\nLTcuODY1MDMgLTQ1Ljg0MTQ2LC0xMy4yNTczMyAtNC41NDYxOSwtMi4yMzIwNiAtMTUuNTI1NDUs\nLTkuODY0MzggLTI0LjM5ODM1LC0xNi45NjA3MiAtOC44NzI5MSwtNy4wOTYzMiAtMTYuMzgzLC0x\nMi4xNTEwOCAtMTYuNjg5MSwtMTEuMjMyNzkgLTAuMzA2MSwwLjkxODI5IDEuNzU5MzgsOC42MjEy\nIDQuNTg5OTYsMTcuMTE3NTkgMi44MzA1Nyw4LjQ5NjM4IDQuNzU4OTMsMTUuODM1NTMgNC4yODUy\nNSwxNi4zMDkyMSAtMy4yOTY3MywzLjI5NjczIC0zNy40MTI3NSwtMjguNzExNzMgLTUyLjc4MDA5\nLC00OS41MTk1MyAtOS4zNzkxNCwtMTIuNjk5NjEgLTIyLjYwNDY5LC0zNi44NjczNCAtMjIuNjA0\nNjksLTQxLjMwNjcgMCwtMS4wNDg2MyAtMS4wMzg3NSwtMi43Njg2OCAtMi4zMDgzMywtMy44MjIz\nNCAtMS45MjU2NSwtMS41OTgxNSAtMi40OTE4MiwtMC42OTIxNSAtMy40MTUxNSw1LjQ2NTEgLTEu\nMzM0NTYsOC44OTk0OCAxLjUzNjE1LDQzLjI0MjUxIDUuMDI3NSw2MC4xNDUyMiA0LjcxMTk0LDIy\nLjgxMTk5IDkuMDQ4OTYsMzAuNDAzNDYgMTAuMDkzMTQsMTcuNjY2OTkgMC41MDc3OSwtNi4xOTM3\nNSAwLjY0ODAxLC02LjAyNDI3IDExLjgxNDg1LDE0LjI3OTg5IDYuMjE1NTMsMTEuMzAxNCAxNC40\nMTk5NywyNS4zODc2OSAxOC4yMzIxMSwzMS4zMDI4NCAzMC43NDI3NCw0Ny43MDI0MSAxMDEuMjEz\nMjYsMTE1LjkxODM4IDE1Mi43MTYsMTQ3LjgzMDE4IDE0LjE3MzQxLDguNzgyMDUgMTQuMDI4NDks\nOC44MjMzOCAtMTcuNzI0NzksNS4wNTcxIC0xMS4xMDQxLC0xLjMxNyAtMjIuNjQwNDIsLTIuNDAw\nOSAtMjUuNjM2MjUsLTIuNDA4NjIgLTQuODkwNzIsLTAuMDEyNyAtNS4yMjA2LDAuMjYwMSAtMy4y\nMzAyOCwyLjY3MDMgMy41NDA3Niw0LjI4NzgyIDIyLjA0OTMxLDE1LjYyMDcgNDcuNzk3MzMsMjku\nMjY2NSAzMi41MTA1MywxNy4yMjk3NSA0OC45Mzc0NiwyNC4wMjU2NSA2OS4wNzA0OCwyOC41NzQ4\nNSAxOC4zMzU3NSw0LjE0MzE1IDUwLjMwNDU4LDcuOTg0MTIgNTguMTg3NTMsNi45OTExMiBsIDQu\nODI2OTYsLTAuNjA4MDIgLTUuNDUxNTYsLTMuMTcwNDggYyAtNy4yMjkxOCwtNC4yMDQzNSAtNDIu\nNDUxMzEsLTI2LjY1ODYgLTQ3LjI3NzE3LC0zMC4xMzk0MiAtMy42MjY1NiwtMi42MTU3OCAtMi4z\nMzk3NiwtMi43NDU2OCAyOC4wMDI0OSwtMi44MjY1MyAxNy40OTIwNSwtMC4wNDY1IDMyLjE4NTI2\nLC0wLjcwMiAzMi42NTE1NiwtMS40NTY1IDAuNDY2MzEsLTAuNzU0NSAtMS4yMjk1MiwtNC4yMDY5\nIC0zLjc2ODUzLC03LjY3MTk3IC0xMC4xNzM0NSwtMTMuODg0IC00Mi4xMTI2MywtNjkuOTMwODEg\nLTQyLjExMjYzLC03My44OTkxMiAwLC0wLjU5NjggNS4wNjk1NiwxLjQ2Nzg4IDExLjI2NTY5LDQu\nNTg4MjEgMTYuNjEwODgsOC4zNjUxMSAxNy4wMTA4MSw4LjA5MDU4IDExLjE0NzUxLC03LjY1MTYz\nIC05Ljc3ODQ3LC0yNi4yNTM4NyAtMjAuOTAwNDEsLTcwLjI5OTE1IC0yMC43NDkyMSwtODIuMTcx\nMzggMC4wMjY5LC0yLjEwOTIxIDQuOTYyMTgsOC4xNTc1MSAxMC45Njc0MSwyMi44MTQ5MiAxOS42\nMDI0Niw0Ny44NDUzMSAzNC4zNDg2MSw3Mi41MzYxMSA1Ny42MjcyOCw5Ni40OTA1NSAxNi43NjMy\nOCwxNy4yNDk5MiAxNy4yMTU2NSwxNi43NzQ5NSAtMTEuMzA1OTksMTEuODcwNTUgLTkuNzcxNjIs\nLTEuNjgwMjMgLTE3Ljk4NjUyLC0yLjg0NjkzIC0xOC4yNTUzMiwtMi41OTI2IC0wLjk3ODMxLDAu\nOTI1NTcgMjAuNjE4OSwyNC40NDUxMiAyOS4wMTM2NiwzMS41OTYyMiAxNC44MjIzMSwxMi42MjYy\nNSAyMC4wMTMzLDE2LjA0NTA1IDQwLjI2OTQsMjYuNTIxMiBsIDE5LjY4Njc2LDEwLjE4MTcgLTE3\nLjEwNTg2LC0wLjIxOTk3IGMgLTIwLjIxNTk3LC0wLjI1OTg4IC0yMy41NTY1OCwxLjM2MjYgLTE1\nLjM4NTY1LDcuNDcyNCA4LjE1MDI0LDYuMDk0NDIgMjguMDc4NCwxNi41MTMzNSA0Mi4zOTAwMywy\nMi4xNjI2NCAyNS4zMzE5Miw5Ljk5OTM4IDM0LjcwMjQ3LDExLjQwNzg4IDgyLjkyMDEsMTIuNDYz\nNzMgMjkuNDQ4MywwLjY0NDc3IDQ0LjAxMjYyLDAuNDE4OTUgNDQuMDEyNjIsLTAuNjgyNDMgMCwt\nMC45MDU0IC0xMC44MDY4MiwtNS4zNjQ1MiAtMjQuMDE1MTUsLTkuOTA5MjIgLTEzLjIwODM1LC00\nLjU0NDYzIC0yOS4wMTY1NSwtMTAuNDkyNSAtMzUuMTI5MzUsLTEzLjIxNzQ4IGwgLTExLjExNDE4\nLC00Ljk1NDU3IDg2LjQxMDA4LDAuOTA2ODMgYyA5Ny40MjQ0OCwxLjAyMjQ3IDE1NC4yMzYsMi4y\nMDA4IDE2OS4zMzg2OCwzLjUxMjMyIGwgMTAuMjQ3MjUsMC44ODk4IC0wLjk5ODE4LDUuMzIwNzMg\nYyAtMC43Nzg4Nyw0LjE1MTQ3IC0wLjM4Mzc3LDUuNTU2NDUgMS43OTc3NSw2LjM5MzYgMS41Mzc3\nMywwLjU5MDEgMTMuNzE5MjMsMS41MjEyOSAyNy4wNzAwNSwyLjA2OTMyIDE2Ljk1ODI4LDAuNjk2\nMTUgMjQuOTIzODgsMS42NDYxNyAyNi40Mjk5OCwzLjE1MjMyIDEuNjQ5MywxLjY0OTI2IDkuNSwy\nLjE1NTk2IDMzLjQwMzcsMi4xNTU5NiAxNy4xODY0LDAgMzIuMzc2NywwLjQzMzIgMzMuNzU2Myww\nLjk2MjYyIDMuOTg4NSwxLjUzMDUzIC0yLjY4NzQsMTMuNTcyNzUgLTcuNTI2MywxMy41NzYzNSAt\nMS45ODA3LDAgLTI1Ljc2OTEsMC43MDkzNSAtNTIuODYzMSwxLjU3Mjk3IC0xNTQuMTY5NjUsNC45\nMTQ0NSAtMjk1Ljc1NTQ3LDYuODkzNyAtNDMwLjg2NzYyLDYuMDIzMTggbCAtMTM5LjMzNDU1LC0w\nLjg5NzY4IC0zLjE3MjM4LDYuMjE4NCAtMy4xNzIzOCw2LjIxODMzIDQuNDEyMjgsMC44ODE3NyBj\nIDIuNDI2NzYsMC40ODQ5NSAyNC4wMzYyNywxLjYyMDQ1IDQ4LjAyMTEyLDIuNTIzMyAyMy45ODQ4\nNiwwLjkwMjg1IDY0LjY2ODYzLDIuNDQwMTMgOTAuNDA4MzYsMy40MTYxIDI1LjczOTc1LDAuOTc1\nOTggNDguMDg5MjgsMi40NjQ3MyA0OS42NjU2MiwzLjMwODQgMS41NzYzNSwwLjg0MzYgMi44NjYw\nOSwyLjU2NTYgMi44NjYwOSwzLjgyNjU4IDAsMi41OTExNyA1Ljc3NDY0LDMuODY3MzcgMjAuMTg5\nMjcsNC40NjIwNSA1LjMyOTk3LDAuMjE5ODIgLTE1LjkzMjM3LDAuOTA2NjcgLTQ3LjI0OTYzLDEu\nNTI2MTcgLTYxLjkyNTc5LDEuMjI1MTMgLTYzLjI4OTY3LDEuNDMzMTggLTY5LjAxMjcyLDEwLjUy\nOTU2IGwgLTMuMjU4MTUsNS4xNzg2IC0yNC40MzIwMywwLjA2MjIgLTI0LjQzMjAxLDAuMDYyMyAt\nMy41NDE1NCw4LjIzMjUyIGMgLTEuOTQ3ODYsNC41Mjc5IC0zLjMwOTI4LDguNDUxOSAtMy4wMjU0\nLDguNzIwMDMgMC44OTQ3NCwwLjg0NTEgMTA3LjY4ODY4LDMuOTYxNSAxNzQuMTk5MzcsNS4wODM0\nMiBsIDYzLjg1MzU4LDEuMDc3MDggLTMuNjM5MDMsNy40MjE0IGMgLTIuMDAxNDcsNC4wODE3MiAt\nMy42NjE3Niw4LjMwNyAtMy42ODk1NCw5LjM4OTQ3IC0wLjAzOTIsMS41Mjg1OCAtMzEuNjMyMTYs\nMi4wNjk3IC0xNDEuNDM0OCwyLjQyMjcyIC0xNTguMzI0MjUsMC41MDkwMyAtMTQ0LjQyNjksLTAu\nNzM4NzUgLTE0NC40NzExLDEyLjk3MTkzIC0wLjAxMzYsNC4yMTk2IC0wLjUwNTEzLDcuNjcyIC0x\nLjA5MjMsNy42NzIgLTIuMDE5OTcsMCAtMjUuNTAxOTEsLTE4LjM5NCAtMzkuNDY5MDcsLTMwLjkx\nNjk4IC03LjY5Mzk1LC02Ljg5ODUgLTE0LjMyODQ1LC0xMi4yMDMyNSAtMTQuNzQzMzIsLTExLjc4\nODQyIC0yLjUwMTk4LDIuNTAyIDYuNzE5MDksMzcuOTAwOSAxNS44MDQ1Miw2MC42NzIxIDMuMDU2\nNiw3LjY2MSA1LjU1NzQ2LDE0LjM4NCA1LjU1NzQ2LDE0Ljk0MDEgMCwyLjcyNTUgLTQuMzE2MjIs\nMC4wMSAtMTcuMzA3OSwtMTAuOTAwMyB6IgogICAgIGlkPSJwYXRoMjUiIC8+CiAgPHBhdGgKICAg\nICBzdHlsZT0iZmlsbDojZmZmZmZmO3N0cm9rZS13aWR0aDoxLjYxNTE0IgogICAgIGQ9Im0gMzg4\nLjkwMDMsMTAzMy4wODU1IGMgLTIzLjczMjE4LC0xOS45MjQ5IC00OC43NzI5NywtNDcuMDQzMDMg\nLTYyLjE5MTEsLTY3LjM1MDMzIC0xMi4xNTEsLTE4LjM4OTYyIC0yMC4yMzQ2OSwtMzMuMzQ5Mjcg\nLTM3LjkwMDgzLC03MC4xMzg5NSBsIC05LjMwNjk1LC0xOS4zODE3MiAtMC41NjMyNCw4LjA3NTcg\nYyAtMC4zMDk3OCw0LjQ0MTY1IDAuNjQxNzksMjQuNjcwNDIgMi4xMTQ1OSw0NC45NTI4MiAxLjQ3\nMjgsMjAuMjgyNCAyLjMxOTIsMzcuMjM1NyAxLjg4MDksMzcuNjc0IC0yLjE5MzExLDIuMTkzMDgg\nLTI4LjExNTcxLC01MS41NzMyMiAtMzkuMDU4NTIsLTgxLjAxMTYyIC02Ljc3MTUzLC0xOC4yMTY5\nIC0xNC42OTI1MSwtNDguMDQxNyAtMjEuMDE4NzksLTc5LjE0MjAzIC00LjE0NzUzLC0yMC4zODk0\nMiAtMTAuNDUzNjUsLTU3LjY1MTY2IC0xMS4wMDE5NiwtNjUuMDA5NDMgLTAuMTQ4OTQsLTEuOTk4\nNzQgLTAuNzUzODEsLTMuNjM0MDcgLTEuMzQ0MTQsLTMuNjM0MDcgLTAuNTkwMzMsMCAtMTEuODU1\nOTUsMTQuMjM1ODggLTI1LjAzNDcsMzEuNjM1MiAtMzMuMTEzNDQsNDMuNzE4MzMgLTI5LjUzNTUs\nMzkuNzMwMzUgLTI5LjU3ODQ1LDMyLjk2ODM1IC0wLjA2ODIsLTEwLjczNTUgNi4yNjAwNywtNjAu\nNjczMSAxMi4wNzU2LC05NS4yOTEyNSA2LjE4ODcsLTM2LjgzOTU4IDE2LjM1NzE3LC05My4xMzI1\nNyAxOC4wMTkwMSwtOTkuNzUzODQgMC45MTg3LC0zLjY2MDM5IDAuNzI0OTYsLTMuODE4NyAtMi41\nMzY4NiwtMi4wNzMwMiAtMS45NDIzNCwxLjAzOTUgLTExLjU1MTU2LDcuNzE4IC0yMS4zNTM4NCwx\nNC44NDExMiAtOS44MDIyNyw3LjEyMzEgLTE4LjIwMjI5LDEyLjU3MTEzIC0xOC42NjY3LDEyLjEw\nNjcxIC0wLjQ2NDQxLC0wLjQ2NDQxIDEuNzY4NTQsLTEwLjUxNzAyIDQuOTYyMTIsLTIyLjMzOTE0\nIDguNTEwNzgsLTMxLjUwNTYgMTUuNzk2NTcsLTYyLjU5OTUxIDI2Ljc5NzU3LC0xMTQuMzY1NDIg\nNS4zODAzMSwtMjUuMzE3MzUgMTAuMTg1MTcsLTQ3LjMwMzQ3IDEwLjY3NzQ4LC00OC44NTgwNSAw\nLjQ5MjMsLTEuNTU0NTcgMC4yMzYwMSwtMi44MjY0OSAtMC41Njk1MywtMi44MjY0OSAtMC44MDU1\nNCwwIC0xMS4xMTYyOCw2LjIxMjY4IC0yMi45MTI3NiwxMy44MDU5NyAtMTEuNzk2NDcsNy41OTMy\nOCAtMjEuNjYzOTYsMTMuNTg5NSAtMjEuOTI3NzUsMTMuMzI0OTIgLTAuMjYzNzgsLTAuMjY0NTgg\nNi4xODIyMSwtMTQuNTYyMDMgMTQuMzI0NDMsLTMxLjc3MjEyIGwgMTQuODA0MDIsLTMxLjI5MTA3\nIDYuODA3MzIsLTAuNTk5NzYgYyAzLjc0NDAzLC0wLjMyOTg3IDE5LjAwNzEyLC0zLjE4NzI5IDMz\nLjkxNzk4LC02LjM0OTgzIDE0LjkxMDg3LC0zLjE2MjU0IDI5LjM2NjcsLTYuMTUxMzcgMzIuMTI0\nMDgsLTYuNjQxODYgNC43OTU1MiwtMC44NTMwMiA1LjI4MDg5LC0wLjQxMDQxIDExLjE2NzA1LDEw\nLjE4MzQ5IDE2LjA4MDU3LDI4Ljk0MTczIDM4LjIyNDY4LDQ5LjEyODM5IDY1LjAwMTEyLDU5LjI1\nNTI3IDguMzQ2MjQsMy4xNTY1NSAxMi4zMTM1OSwzLjQ5OTM0IDM5Ljk1NDA2LDMuNDUyMTIgMjgu\nMjgxODcsLTAuMDQ4MyAzMS45MjY1MSwtMC4zOTQ2NSA0Ni40ODk0MywtNC40MTc3MiAxOC41MTk4\nMiwtNS4xMTYxOSA0MC42Mjk1LC0xNC44ODA2NiAzOS4xNDI0NSwtMTcuMjg2NzggLTAuNTY1MTYs\nLTAuOTE0NDUgMC4wMDgsLTEuNjYyNjMgMS4yNzM0NywtMS42NjI2MyAxLjI2NTU2LDAgNy4zNzA3\nLC0yLjUzNjI1IDEzLjU2Njk1LC01LjYzNjEyIDIwLjY3NTU4LC0xMC4zNDM1NiAzNy41MzU4OCwt\nMzEuMDU3MDggNDYuNTQ3NTgsLTU3LjE4NTQ0IDcuMzk3MTEsLTIxLjQ0NzAyIDkuNTgyMTMsLTI2\nLjQ0MDI4IDEyLjMyMzI0LC0yOC4xNjE0MiAzLjI3NjY2LC0yLjA1NzQgNDEuNTEzODEsNy4wNTM2\nNyA3My4zNDM1NywxNy40NzYxNyA0OC4yMjQ1NCwxNS43OTA4NyA3MC4xNTI0MywzMS45MTY1OSA3\nMC4xNTI0Myw1MS41ODk5MyAwLDUuODk1NTcgLTQuOTYzOTEsMjAuODY3ODIgLTguNTM5OTUsMjUu\nNzU4MzQgLTEuOTExOTIsMi42MTQ3MiAtMi45MDM2OCwyLjE3OTk1IC0xMi40Njg1NSwtNS40NjU5\nMiAtNS43MTUyOCwtNC41Njg2MyAtMTUuMDg5MTMsLTEwLjkzMzcxIC0yMC44MzA3OCwtMTQuMTQ0\nNjIgLTkuMTQ0OTgsLTUuMTE0MTcgLTEyLjEyOTIyLC01LjkyNjczIC0yNC4wNjgyMSwtNi41NTMz\nNiAtMTkuNTQ1ODksLTEuMDI1OSAtMjkuNTQyNDksMS41OTc1NiAtMzguMzg5OSwxMC4wNzQ4NiAt\nNy42ODc4LDcuMzY2MjEgLTkuMTk4NjUsMTMuMTk1ODggLTQuNjA5MzIsMTcuNzg1MiAyLjk5OTgz\nLDIuOTk5ODUgNS45NTczMiwzLjEyMjA4IDE5LjMwMzk5LDAuNzk3ODYgMTYuNDUxMjEsLTIuODY0\nODYgMzYuNTk2MzMsMi40NTA1NSA1NS40MzU3LDE0LjYyNzAyIDEzLjEwMTg3LDguNDY4MTQgMTgu\nNTAzNzEsMTYuODAzNDIgMTkuMzMzMzEsMjkuODMyMDYgMC43NzE0NiwxMi4xMTU0NiAtMi44NjQ1\nMywyMC41NDU4NSAtMTIuMTczMDUsMjguMjI0MzcgLTcuNzA2MzcsNi4zNTY5MiAtMTQuMjkzNzYs\nOC4xODE1MyAtMjkuNTIzMjEsOC4xNzc1MiAtMjMuNjc3MzcsLTAuMDA2IC00NC41NzY5NywtNS4x\nNzE3NiAtNjYuNDU5MzYsLTE2LjQyNjAyIC0xMy42OTQ2MywtNy4wNDMyNSAtMTYuNDM2OTksLTku\nNTM0NzEgLTIyLjM1NDQ0LC0yMC4zMDkxOSAtNS42MjIyNiwtMTAuMjM2OTggLTguMTAwNjQsLTEx\nLjQ0ODUyIC0xNC4zMzY0MiwtNy4wMDgyNiAtMTEuMTQyNiw3LjkzNDI0IC0xOC43Mjg2NSwzMS42\nMDE0IC0xMi42OTM5OCwzOS42MDMwNiAyLjI3MiwzLjAxMjU2IDIuNDI4NDIsMi45NzU5MyA1LjE3\nMjAzLC0xLjIxMTM1IDEuNTU2MDQsLTIuMzc0ODUgMy41OTMxMSwtNC4zMTc4OCA0LjUyNjc5LC00\nLjMxNzg4IDAuOTMzNjcsMCA2Ljg0NjI5LDMuMjA2NzUgMTMuMTM5MTYsNy4xMjYxMiAxOC4yMDU3\nNywxMS4zMzkwNiAzNi41Nzc0MiwxOS45MDM5MSA1MS4xOTUxLDIzLjg2NzEgMTEuNDkyNDIsMy4x\nMTU4NSAxNy41ODY1LDMuNjc3MTggNDAuMTk1OTgsMy43MDI1IDIyLjk3MDYzLDAuMDI1NyAyOC44\nMjQ0OCwtMC41MTU5MSA0Mi40MDE2MSwtMy45MjMxMyAxOS4yMjc5NiwtNC44MjUzNCAyMS4xMzI1\nNCwtMy45NDkwMiAxNS43MTk1OCw3LjIzMjY1IC02LjU0OTU3LDEzLjUyOTYgLTI2LjQyMjc0LDI1\nLjM2MjQ4IC01MS42NjA2MywzMC43NTk3NyAtMjAuNTMyNTQsNC4zOTEgLTM4Ljg3MzIyLDIuMTgz\nMDggLTkyLjg3MDY2LC0xMS4xODAxNiAtMTkuNTQzMjEsLTQuODM2NTMgLTQxLjE5Mzg4LC05LjI2\nNTc1IC00OC4xMTI1OSwtOS44NDI2OSAtMTMuNzM2NTksLTEuMTQ1NDkgLTEwNy44ODMsMS45MTA0\nNyAtMTEzLjg4MjY4LDMuNjk2NTkgLTMuNDI3MjcsMS4wMjAzMiAtMi41MDAwNCwyLjMyMTkzIDEy\nLjExMzU2LDE3LjAwNDQgbCAxNS44MjQ5MywxNS44OTk0OSAtOC44ODMyOCwtMC44NzIwNSBjIC0x\nMC41NTUyOSwtMS4wMzYxOSAtMzQuMTI0NzcsLTcuNzM2MzEgLTQ0LjY5ODI4LC0xMi43MDY0NCAt\nNC4xNDUxNiwtMS45NDg0NiAtMTUuMjI2MDcsLTkuNTM5NzQgLTI0LjYyNDI0LC0xNi44Njk1MSAt\nOS4zOTgxNiwtNy4zMjk3NiAtMTcuMjk3NTQsLTEyLjczOTUzIC0xNy41NTQxNiwtMTIuMDIxNyAt\nMC4yNTY2MywwLjcxNzgzIDEuNzk3MzUsOC4zNzI2OSA0LjU2NDM4LDE3LjAxMDgxIDIuNzY3MDQs\nOC42MzgxMiA0LjYyNTUsMTYuMTExMTUgNC4xMjk5MSwxNi42MDY3MyAtMS42MzA1MiwxLjYzMDUz\nIC0xNC4zMTA0NSwtOC4yMzAzMyAtMjguNTM0ODIsLTIyLjE5MDc5IC0xOC40NTk1MSwtMTguMTE3\nMDMgLTMyLjQ4ODU2LC0zNy4zNjAwMSAtNDIuMzkwNTYsLTU4LjE0NTEyIC03LjgwNzQ0LC0xNi4z\nODg0NSAtOC4xMjc0NiwtMTYuNzk1OTggLTkuNTEyNTEsLTEyLjExMzU2IC00LjAyNjY5LDEzLjYx\nMjkyIDYuNTcxMTYsODcuODA4OTcgMTIuMjc1MzEsODUuOTQwMTMgMC45ODQzNywtMC4zMjI1IDIu\nMDIzNSwtMi45NzI2NCAyLjMwOTE2LC01Ljg4OTIxIDAuNDc2MDYsLTQuODYwMzQgMS41ODQ3NCwt\nMy4zOTk4MSAxMy4yODY5NywxNy41MDM4NyAyNC4xMTkxOSw0My4wODQwNSAzNy42MjQ0Myw2MC41\nOTgwNyA3Ny4zODgxNCwxMDAuMzU5NDYgMzIuMzUxMzIsMzIuMzQ5NDIgNTcuMDcwOTEsNTMuMTQ2\nNTIgODQuNzM5NCw3MS4yOTI5MiAyMS45OTUwOSwxNC40MjU1NyAyMi40NTg2MiwxNC4wMDMxNyAt\nMTEuMTg5NDIsMTAuMTk3IC0xMS41NDgyNywtMS4zMDYyOCAtMjMuMTA4MjMsLTIuMzgyMzEgLTI1\nLjY4ODgyLC0yLjM5MTE2IC00LjU4MjksLTAuMDE1OCAtNC42MjQzMSwwLjA1ODUgLTEuNzgxNTQs\nMy4xOTk4IDguMTA4MzcsOC45NTk2NSA3OS41NTA4Miw0Ni43NzkxNSAxMDAuOTU5MzEsNTMuNDQ0\nOTMgMjEuNDk5NTIsNi42OTQwNSA3NS45MTE2OCwxMy43MTkzIDc1LjkxMTY4LDkuODAxMDcgMCwt\nMC45ODQ4MiAtMy4wODg5NiwtMy4zNjM3NSAtNi44NjQzNSwtNS4yODYzNyAtMy43NzU0LC0xLjky\nMjcgLTE1LjkzOCwtOS4zODczIC0yNy4wMjgsLTE2LjU4ODA1IGwgLTIwLjE2MzY2LC0xMy4wOTIz\nIDMxLjc0MzMyLC0wLjgwNzUzIGMgMTcuNDU4ODMsLTAuNDQ0MTUgMzIuMTI4OTQsLTEuMTk1MiAz\nMi42MDAyNSwtMS42Njg5NyAwLjQ3MTMyLC0wLjQ3Mzc4IC0yLjQxNzY4LC01LjU2MTQ4IC02LjQy\nMDAxLC0xMS4zMDYwMyAtMTAuODU3MzYsLTE1LjU4MzUgLTM5LjQwMDY4LC02Ni4zNTU2NSAtMzku\nNDAwNjgsLTcwLjA4NDkgMCwtMC40MjI1NiA1LjQ3ODU0LDEuODMzNDYgMTIuMTc0NTEsNS4wMTMz\nNyA2LjY5NTk3LDMuMTc5OSAxMi42NzMxNiw1LjI4Mjk2IDEzLjI4MjY1LDQuNjczNDcgMC42MDk1\nMSwtMC42MDk0OSAtMC45NDUwMiwtNi42OTk0MyAtMy40NTQ0NywtMTMuNTMzMTggLTkuNjE5Nzcs\nLTI2LjE5NjU2IC0yMC40NzA1LC02OS4zMjQ0NCAtMjAuMzM4NywtODAuODM5MjkgMC4wMjg2LC0y\nLjUwMDQ2IDQuNTkyNDEsNi43NjYzNSAxMS4wMjMyNSwyMi4zODI3NCAxOS44ODE5MSw0OC4yODAz\nOSAzNC43NTAyNyw3My4wNzg1NyA1OC4zNDY1OCw5Ny4zMTMyMiAxNS44ODQ1LDE2LjMxNDIyIDE2\nLjI5ODE3LDE1LjkxOTg3IC0xMS42NzczNSwxMS4xMzAyMiAtOS41NDk1MywtMS42MzQ5MiAtMTcu\nMzYyNzcsLTIuNDAxNjUgLTE3LjM2Mjc3LC0xLjcwMzc3IDAsMi4xMTY3MiAxNi4zNDI2NSwyMC4x\nMTE2MiAyNi42NDk4NCwyOS4zNDQxMiAxMi44OTQ4NywxMS41NTA0NSAyMi4zMzE5NCwxNy44NDAx\nIDQzLjYwODgzLDI5LjA2NDM4IGwgMTcuNzY2NTYsOS4zNzI0NSAtMTAuNDk4NDIsLTAuMjY3NiBj\nIC0yMS40NzQyOCwtMC41NDc0MyAtMjYuMTIxOTksLTAuMDM0NSAtMjUuNTU2OTEsMi44MjE5NSAx\nLjQ0Njc2LDcuMzEzMSA0OS44MDA4NCwzMC4xNDYxNyA3NC44MTg3NCwzNS4zMjk4NyAxNC45MTQ4\nMywzLjA5MDMgOTcuNzE2MDksNS42MjY3MyA5Ny43MTYwOSwyLjk5MzI1IDAsLTAuODM1MiAtOC4x\nNzY2NSwtNC4zMzA4NyAtMTguMTcwMzIsLTcuNzY4MTIgLTIwLjQxMzUyLC03LjAyMTA1IC01MC4w\nNTE1NSwtMTguNDgyMDMgLTUxLjI4MDc5LC0xOS44MzAxNSAtMS41NjU4MiwtMS43MTcyIDIzMS40\nNjQzMSwxLjc1NTk3IDI1NC45NDExOSwzLjc5OTggbCAxMC4yNDcyNSwwLjg5MjA1IC0wLjk5ODE4\nLDUuMzIwNzIgYyAtMC43Nzg4Nyw0LjE1MTQ4IC0wLjM4Mzc3LDUuNTU2NDUgMS43OTc3NSw2LjM5\nMzYgMS41Mzc3MywwLjU5MDEgMTMuNzI4OTgsMS41MTA1OCAyNy4wOTE2NSwyLjA0NTYzIDE0Ljg0\nNDI4LDAuNTk0MyAyNC43NDI5OCwxLjY2MzUgMjUuNDQ1NDgsMi43NDg1MiAwLjc1NDIsMS4xNjQ5\nIDExLjg2ODEsMS45MjA2IDMyLjMwMjksMi4xOTY0NSAxNy4xMzQyLDAuMjMxMyAzMi43OTUyLDAu\nNzkyIDM0LjgwMjMsMS4yNDU5OCBsIDMuNjQ5MywwLjgyNTMgLTMuMTcxLDYuMjE1NjIgLTMuMTcx\nLDYuMjE1NTUgLTIzLjg5NzgsMC45OTU3OCBjIC0xMy4xNDM4LDAuNTQ3NzIgLTM5LjE2MSwxLjM5\nNzU1IC01Ny44MTU5LDEuODg4NSAtMTguNjU0ODUsMC40OTEwMiAtNjIuNjI3MTMsMS43MjM1IC05\nNy43MTYwMywyLjczODg1IC0zNS4wODg5NywxLjAxNTM1IC0xNTAuMTk0OTIsMS45MjQ3MiAtMjU1\nLjc5MTA3LDIuMDIwOCBsIC0xOTEuOTkyOTQsMC4xNzQ3NSAtMy4wMzYzOSw1LjkxODU1IC0zLjAz\nNjQsNS45MTg1NSA1LjI1MDE1LDAuODgwMzUgYyA0LjIxNjIzLDAuNzA2OTUgMTY4LjU5MjM2LDcu\nMzQyMTIgMTgxLjg5MDE2LDcuMzQyMTIgMS45ODE0MywwIDUuNDUyMDEsMS45OTg3NSA3LjcxMjQz\nLDQuNDQxNjUgMy42NDUwMiwzLjkzOTMgNS42MDAwNyw0LjU0NzcgMTcuMjg1NjYsNS4zNzk2OCA4\nLjgxOTQyLDAuNjI3OTcgMS40MjczMSwxLjA2NTMgLTIyLjM1NzMzLDEuMzIyODUgLTE5LjU0MzIy\nLDAuMjExNTcgLTQ2LjI0NzA3LDAuOTI4NSAtNTkuMzQxOSwxLjU5MzE1IC0yMS44NjM3NCwxLjEw\nOTc3IC0yNC4xMzc1NywxLjUxNzQgLTI3LjgzMzQ0LDQuOTg5NDUgLTIuMjEzNTYsMi4wNzk1MiAt\nNC40NDk1Myw1LjExOTY1IC00Ljk2ODgzLDYuNzU1NzcgLTAuODYyOTcsMi43MTg5OCAtMy4wNzAy\nOSwyLjk3NDg4IC0yNS42NjQxMiwyLjk3NDg4IGggLTI0LjcxOTk2IGwgLTMuMzMzOCw3LjY3MTkg\nYyAtMS44MzM2MSw0LjIxOTU3IC0yLjgzNDc4LDguMTYwNzUgLTIuMjI0ODQsOC43NTgyIDAuNjA5\nOTQsMC41OTc0NSAyOS4wOTEzLDEuODY0ODcgNjMuMjkxOTMsMi44MTY1NSAzNC4yMDA2MywwLjk1\nMTYgNzQuNTM4ODEsMi4wOTIyNyA4OS42NDAzOSwyLjUzNDc3IDE1LjEwMTU3LDAuNDQyNSA0MC4y\nOTI3MywwLjgxMDM4IDU1Ljk4MDM1LDAuODE3NSBsIDI4LjUyMjk1LDAuMDEyNyAtMy44OTIwNCw4\nLjA2Nzc1IGMgLTIuMTQwNjEsNC40MzczIC0zLjg5MjAyLDguNzUxNDUgLTMuODkyMDIsOS41ODY5\nNSAwLDAuOTMzMyAtNTQuMTAxMzgsMS43MTUwMyAtMTQwLjI3MDE2LDIuMDI2ODggLTExNS45NjAz\nNywwLjQxOTcgLTE0MC43MzI4NSwwLjg5MTgyIC0xNDIuOTQwMDYsMi43MjQzNyAtMS44MTUyMSwx\nLjUwNzEzIC0yLjY2OTkxLDQuNzM4NTggLTIuNjY5OTEsMTAuMDk0NjggMCw0LjMzMjkgLTAuNDgw\nNCw3Ljg3OCAtMS4wNjc1Nyw3Ljg3OCAtMi4wMTk5NywwIC0yNS41MDE5MSwtMTguMzk0MDMgLTM5\nLjQ2OTA3LC0zMC45MTcgLTcuNjkzOTUsLTYuODk4NSAtMTQuMzQ3MywtMTIuMTg0NDMgLTE0Ljc4\nNTIsLTExLjc0NjUgLTIuNTI5NzgsMi41Mjk3NSA5LjMwOTQ1LDQ2LjM4MjQgMTcuNTY4MTksNjUu\nMDcyNyA2Ljc1OTgsMTUuMjk3OSA1LjE3MTkzLDE1LjI1MDUgLTEzLjQ3MjIzLC0wLjQwMjYgeiIK\nICAgICBpZD0icGF0aDI2IiAvPgogIDxwYXRoCiAgICAgc3R5bGU9ImZpbGw6I2ZmZmZmZjtzdHJv\na2Utd2lkdGg6MS42MTUxNCIKICAgICBkPSJtIDM4OC45MDY3NCwxMDMzLjEzNSBjIC03LjgwNjYs\nLTYuNTI0IC0yMi4yNTEwNCwtMjAuMjA1NyAtMzIuMDk4NzcsLTMwLjQwMzcgLTI2LjUyODQyLC0y\nNy40NzE4OCAtNDEuNDUyNjgsLTUxLjE1OTI3IC02OS41MzYwNiwtMTEwLjM2NTQgLTYuODE1Njgs\nLTE0LjM2ODk1IC03LjczMjc3LC0xNS42MTY2NSAtOC4zMTAyNCwtMTEuMzA1OTUgLTAuNTYzNjcs\nNC4yMDc4IDAuOTk3NCwzNC41NTIxMiAzLjg2Mzc0LDc1LjEwNDEgMC44MTAzMiwxMS40NjQwNSAw\nLjcxMTk4LDExLjk0MDM3IC0xLjgzNDAzLDguODgzMyAtNC4xNTI0NiwtNC45ODYwOCAtMjcuMDgw\nMSwtNTIuOTA3MjUgLTM0LjM4OTI2LC03MS44NzcyMyAtMTEuNzE3MDEsLTMwLjQxMDAyIC0yMi42\nMTIwMywtNzQuMzYyNjUgLTMwLjIyNjU2LC0xMjEuOTM5ODcgLTYuMTk2ODQsLTM4LjcxOTE3IC0y\nLjMxODU4LC0zOC45MjQzIC0zMy4xMDM3MiwxLjc1MTAzIC0yMy41ODk5MywzMS4xNjg1IC0yNi42\nNjMyOSwzNC42MzY0MiAtMjcuMTM0NzcsMzAuNjE4IC0xLjQwMTIsLTExLjk0MjMzIDEyLjQyNjcx\nLC0xMDYuMDkxNDYgMjUuNTAwMDksLTE3My42MjA1NCAyLjY1Mzk5LC0xMy43MDg4NSA0LjU3NjQx\nLC0yNS4xNzQyMiA0LjI3MjA2LC0yNS40Nzg1NiAtMC4zMDQzNSwtMC4zMDQzNiAtOS43OTU0NCw2\nLjA3MzMyIC0yMS4wOTEzMywxNC4xNzI2IC0xMS4yOTU4OCw4LjA5OTI5IC0yMC45MTEzOCwxNC4z\nNTI1NiAtMjEuMzY3NzgsMTMuODk2MTcgLTAuNDU2MzksLTAuNDU2NDIgMi41MDgyMSwtMTMuNDE2\nNTYgNi41ODgwMSwtMjguODAwMzMgOC44MjI2LC0zMy4yNjc1MSAxNS41NzM4MywtNjIuNTUxOTEg\nMjYuNzQxNjgsLTExNS45OTU3NSA0LjU0NzksLTIxLjc2NDA0IDguNjE0NywtNDAuNTYwNDIgOS4w\nMzczMiwtNDEuNzY5NzMgMS4xNjMwNCwtMy4zMjc5OSAtMi4wNzQwMSwtMS42NTEyNiAtMjQuNDQ1\nMzQsMTIuNjYyMTcgLTExLjEzNzQ2LDcuMTI1ODggLTIwLjUzMDU2LDEyLjk1NjE0IC0yMC44NzM1\nNSwxMi45NTYxNCAtMC45OTUyMywwIDMuMjEyMTMsLTkuNDU2NDYgMTYuOTQ4NDUsLTM4LjA5MzMg\nNy4zNTQ4MSwtMTUuMzMyOTYgMTMuNTQyNDcsLTI2LjMzNzM0IDE0LjQ4MjU2LC0yNS43NTYzNCAw\nLjkwMjc1LDAuNTU3OTMgMTYuMzcyMTksLTIuMDMwMjUgMzQuMzc2NTYsLTUuNzUxNSAxOC4wMDQz\nNiwtMy43MjEyNiAzNC41Nzk1NiwtNy4wODY3IDM2LjgzMzc3LC03LjQ3ODc2IDMuNjg3NjUsLTAu\nNjQxMzcgNC45NzgzMSwwLjc3MzQxIDEyLjg3MzU3LDE0LjExMTU3IDE5LjA5NzU1LDMyLjI2MzE3\nIDQ2LjIzMzc3LDUzLjIxNjE3IDc1LjUyNjcyLDU4LjMxNzMyIDE1LjgxNTM4LDIuNzU0MTMgNTIu\nNzk5MTUsMS4wMDMyMyA3MC42MzA0OSwtMy4zNDM4MiAxNS44NDE3NCwtMy44NjIgNDIuNDgxNzIs\nLTE0LjY4NzM4IDQxLjE4NjEyLC0xNi43MzYzMSAtMC40NDQxNywtMC43MDI0MyAzLjQzMzQsLTMu\nMDAwODEgOC42MTY4MiwtNS4xMDc1IDEyLjU1MzU3LC01LjEwMjE1IDIwLjMyMTc0LC0xMC42Nzg3\nMyAzMS40MTU2NSwtMjIuNTUyNTIgMTEuMTAzNDksLTExLjg4NDA0IDE3Ljk5OTI5LC0yNC4zOTc4\nOSAyNC42MjgwNywtNDQuNjkyNjQgNS42Njc4NiwtMTcuMzUyOCA4LjUxNjY5LC0yMi40MzY3IDEy\nLjU3MjY3LC0yMi40MzY3IDUuMjY2NTEsMCA1My4xNzIwNywxMi42MzAwNiA3Mi44MTA5NywxOS4x\nOTYyNSA0Ni42MzU5LDE1LjU5MjUyIDY3Ljk5OTk4LDMxLjU5OTg3IDY3Ljk5OTk4LDUwLjk0OTk2\nIDAsNS44NzU3MyAtNC45NjAyLDIwLjg2Mjc0IC04LjUxMjkxLDI1LjcyMTM3IC0xLjg3NTA3LDIu\nNTY0MjkgLTMuMDEyNzQsMi4wNTc4IC0xMy4zMjM4MSwtNS45MzE2OCAtNi4yMDA1MywtNC44MDQ0\nNiAtMTUuNjM0NTcsLTExLjE5NzMgLTIwLjk2NDU0LC0xNC4yMDYzMSAtOS40NTY5MiwtNS4zMzg4\nNSAtMTAuMTM5MjEsLTUuNDcwOTIgLTI4LjI2NDk5LC01LjQ3MDkyIC0xNy4zNTMwNiwwIC0xOS4w\nNzA3MiwwLjI5MTkyIC0yNi4xMjc5Miw0LjQ0MDUgLTEzLjM2NTQ2LDcuODU2ODggLTE3LjcyNjc4\nLDE2Ljk0NzY3IC0xMS4wMzU3MiwyMy4wMDMgMi45NjM2MywyLjY4MjA0IDQuMzI0NzYsMi43NTAz\nNyAxNy40MTQxLDAuODc0MjEgMTIuMzM3ODUsLTEuNzY4NDQgMTUuNTY4NjcsLTEuNjkxNDEgMjQu\nNjQ2MjksMC41ODc2MyAxMi41NzcwOCwzLjE1NzYzIDMwLjg2MTI5LDExLjkxMzgyIDM4Ljk3MDg1\nLDE4LjY2Mjg5IDE2Ljg2MjQxLDE0LjAzMzUgMTYuOTUzOSwzOS40MjgyOCAwLjE5MTg5LDUzLjI1\nNTEyIC03LjcwNjM3LDYuMzU2OTIgLTE0LjI5Mzc2LDguMTgxNTMgLTI5LjUyMzIsOC4xNzc1MyAt\nMjMuNjU2NjUsLTAuMDA2IC00NC41Nzc1NywtNS4xNzIzMiAtNjYuNDA0NjQsLTE2LjM5NzU3IC0x\nMy43MzEyMSwtNy4wNjE2OCAtMTcuMjQ1MzksLTEwLjI0NzU5IC0yMi43MDQzOSwtMjAuNTgzNSAt\nNS4yNTc0NCwtOS45NTQyNiAtNy44MjMyNCwtMTEuMTg5OTggLTE0LjA0MTIsLTYuNzYyNDEgLTku\nODc0NzgsNy4wMzE0NyAtMTYuOTI2ODQsMjUuMjAxMiAtMTQuMTIwNjMsMzYuMzgyMDIgMS4yNDMy\nNSw0Ljk1MzUxIDUuMDMyMzYsNS40MjMwNCA3LjQ0MTIsMC45MjIxIDAuOTUwODQsLTEuNzc2NjYg\nMi41MTA4MSwtMy4yMzAyOSAzLjQ2NjYsLTMuMjMwMjkgMC45NTU3NywwIDcuODIyODMsMy42NzU1\nNSAxNS4yNjAxMyw4LjE2Nzg5IDE4LjYyOTczLDExLjI1Mjg5IDM3Ljk1NDMyLDE5Ljk0MTc1IDUy\nLjMzOTUxLDIzLjUzMzI4IDE5LjA1NTc3LDQuNzU3NjQgNTguNjg4NDIsNC4yOTE4MiA3OS41NDk4\nOSwtMC45MzQ5OCAxOS41Mzk0NSwtNC44OTU1NyAyMS43NzMxOSwtMy44NDM2IDE1Ljc4OTM4LDcu\nNDM1OTkgLTcuMTYwNTYsMTMuNDk3NzcgLTI3LjE4MjEsMjUuNDg2MjggLTUwLjY2MzkyLDMwLjMz\nNjU4IC0yMi4yOTY3OCw0LjYwNTUzIC0zOC43MDk5MSwyLjY2Nzk0IC05NC43NDQ3NSwtMTEuMTg0\nNjkgbCAtMzYuMzQwNjksLTguOTgzOTYgLTI3LjQ1NzQxLC0wLjEzMDc2IGMgLTM3LjM5MzQ0LC0w\nLjE3ODA4IC05NS4zMTM1LDEuOTU3ODQgLTk3Ljk2OTY1LDMuNjEyODYgLTEuNjAxNTEsMC45OTc4\nNyAxLjgzNzgyLDUuMzc5NDQgMTMuMTQyOCwxNi43NDMzOCBsIDE1LjMxMTk0LDE1LjM5MTggaCAt\nNS4zODc5NiBjIC02LjUzNzI1LDAgLTIxLjAxODA3LC0zLjUyNDA3IC0zNy40NDg2NSwtOS4xMTM1\nNCAtMTEuNjc3NzIsLTMuOTcyNjEgLTIyLjU5NzczLC0xMC43MDU3NiAtNDIuNTYwMjQsLTI2LjI0\nMjE4IC01LjAxMDkzLC0zLjg5OTkxIC05LjQ0NzM3LC02Ljc1NDE0IC05Ljg1ODc3LC02LjM0Mjcz\nIC0wLjQxMTQsMC40MTE0IDEuNDczMjcsNy44NTQxOCA0LjE4ODE1LDE2LjUzOTUxIDIuNzE0ODgs\nOC42ODUzMyA0LjUzNzU0LDE2LjE5MDExIDQuMDUwMzQsMTYuNjc3MjkgLTEuNzM2ODEsMS43MzY4\nMyAtMTQuODk3NTMsLTguNzE1MDggLTMwLjAxMDI5LC0yMy44MzMzOSAtMTguNDI2NDgsLTE4LjQz\nMzI2IC0zMi43NTg2NywtMzguMjg2NzYgLTQxLjI1MDcyLC01Ny4xNDIxMSAtMy40NDYxLC03LjY1\nMTU3IC02Ljc2MDY2LC0xMy45MTE5NCAtNy4zNjU2OSwtMTMuOTExOTQgLTUuNzc1OCwwIDAuMjE4\nNTIsNjYuMTg0NDYgNy41OTI3OSw4My44MzM1NyAyLjU1MDksNi4xMDUxNCA0LjkwNDE2LDUuMzUx\nIDUuNzYzNDQsLTEuODQ2OTkgMC40NDI5NiwtMy43MTA2NiAyLjI3ODE4LC0xLjIxMTczIDExLjM4\nNzk4LDE1LjUwNjQ2IDUuOTcyOTUsMTAuOTYxNSAxNS4xMDkxNiwyNi4zOTgxNCAyMC4zMDI2OCwz\nNC4zMDM2NCAzMC4wMzgzOSw0NS43MjQwOSA5My44MzQ2LDEwNy43ODE1MyAxNDUuMTIxNTIsMTQx\nLjE2NjI1IDE5LjgzMjAyLDEyLjkwOTQ1IDIwLjU5MDc1LDEyLjQ3NTg3IC0xNS4xNTM2OSw4LjY1\nOTU3IC0xMy42MTI0MiwtMS40NTMyNyAtMjUuMjcyNywtMi4xMTUgLTI1LjkxMTc1LC0xLjQ3MDMg\nLTIuODEwNzYsMi44MzUzOCAyMy41MzE4NSwxOS4wNTg1NSA2Mi42MzYyMSwzOC41NzQ3NSAxNC42\nNTc0MSw3LjMxNTIgMzEuNzM3NTQsMTQuOTM0ODMgMzcuOTU1ODMsMTYuOTMyNDUgMjEuMzAwNjQs\nNi44NDI4NSA3Ni43MTkyNSwxNC4zMTAyMyA3Ni43MTkyNSwxMC4zMzc1NSAwLC0wLjkyMTM3IC00\nLjY3NzU4LC00LjI0NjM1IC0xMC4zOTQ2MiwtNy4zODg4NSAtNS43MTcwNCwtMy4xNDI1IC0xNy44\nOTExNywtMTAuNjU5IC0yNy4wNTM2MywtMTYuNzAzNDcgbCAtMTYuNjU5MDEsLTEwLjk4OTgzIDMy\nLjMwMjg0LC0wLjkyNzkgYyAxNy43NjY1NiwtMC41MTAzNyAzMi41MDY2OSwtMS4wNTU0NyAzMi43\nNTU4NCwtMS4yMTEzMiAwLjI0OTE2LC0wLjE1NTkzIC0zLjA4ODg5LC01LjU1Mjg1IC03LjQxNzkx\nLC0xMS45OTMyNSAtMTIuMTA0NDMsLTE4LjAwODEgLTM5LjA2NjYzLC02Ni4xMTg3NCAtMzkuMDY2\nNjMsLTY5LjcwOTQ3IDAsLTAuNDA4NTQgNS41MTg5LDEuODA3MSAxMi4yNjQyMiw0LjkyMzYzIDYu\nNzQ1MzQsMy4xMTY1MiAxMi42OTg0OCw1LjIzMjE4IDEzLjIyOTIxLDQuNzAxNDUgMC41MzA3Mywt\nMC41MzA3MyAtMC43MTk5NCwtNS40OTAwNiAtMi43NzkyNiwtMTEuMDIwNzMgLTkuNDc0NzQsLTI1\nLjQ0NjA2IC0yMS4yMDMzMSwtNzEuNzM2NzQgLTIxLjAyNzE4LC04Mi45OTA4NSAwLjA0NzksLTMu\nMDU1MTYgMy4wMjUwMSwyLjcwNDg3IDguODk2NzEsMTcuMjEyNjkgMTkuODgxOTgsNDkuMTI0NDMg\nMzcuMDMxNDYsNzguMTA2NDYgNjAuNDUwMTEsMTAyLjE1ODY1IDE1LjY2NzgsMTYuMDkxNjMgMTUu\nNzk0MDgsMTUuOTA3OCAtOC4wNDMyOCwxMS43MDg4NSAtMTguMzA3ODEsLTMuMjI0OTIgLTIxLjU4\nNTI3LC0zLjE4NzM1IC0xOS42NzU0MywwLjIyNTM4IDIuOTE4MDMsNS4yMTQyMiAzMC4zNTg2Mywz\nMS43NjM5MiAzOS44NjQ3LDM4LjU3MDQ3IDUuMzI5OTgsMy44MTYzOCAxOC4wNDkyMiwxMS4zNTQ0\nOCAyOC4yNjQ5OSwxNi43NTEyNSBsIDE4LjU3NDEzLDkuODEyNDggLTE4LjE3MDM0LDAuMDI3IGMg\nLTIwLjU0OTA4LDAuMDMwOCAtMjIuNjA4MDMsMS41MDYzIC0xMS45NDUxMyw4LjU2MjY3IDE5LjIx\nOTg5LDEyLjcxOTEgNTMuNzI4NTksMjYuOTg2OCA3Mi45MTY3NCwzMC4xNDc1MyAxNi4zNjI5Niwy\nLjY5NTQyIDk0LjkzNTQ2LDQuMjAyMDIgOTIuNjY2MDQsMS43NzY5IC0xLjAwMDg4LC0xLjA2OTU4\nIC0xMC44MTI5LC01LjA4Njk1IC0yMS44MDQ0NSwtOC45Mjc1NSAtMjAuNTgxNjEsLTcuMTkxNDUg\nLTQ1LjI4MTE0LC0xNi43MzU4IC00Ni41MzkwMSwtMTcuOTgzNSAtMS44MDg0NiwtMS43OTM4NSAy\nMzMuOTIxNywxLjQxMjYyIDI1NC42MDI4MSwzLjQ2MzIgbCAxMC4wMDQxNywwLjk5MTg3IDAuNDk0\nMjUsNS45Mzc5IDAuNDk0MTgsNS45Mzc5IDEwLjQ5ODQyLDEuMDIyNjMgYyA1Ljc3NDEsMC41NjI0\nMiAxNy41MjQyOCwxLjEwNzUyIDI2LjExMTQ1LDEuMjExMzIgMTAuOTE1MiwwLjEzMiAxNS44MTUs\nMC43OTYyOCAxNi4yODQzLDIuMjA3NyAwLjQ5OTQsMS41MDI0IDguNjk2LDIuMTMwNDUgMzIuMDMz\nNiwyLjQ1NDYgMTcuMjQ5MywwLjIzOTU1IDMzLjAwNDYsMC44MDAyNSAzNS4wMTE3LDEuMjQ1OSBs\nIDMuNjQ5MywwLjgxMDMgLTMuMTkwMyw2LjI1MzM1IC0zLjE5MDIsNi4yNTMzNSAtMjQuNjg2Miwx\nLjAyNjIzIGMgLTExMi4wMzk1NSw0LjY1NzI3IC0zMjMuNjIwOTYsNy44NjQ4NyAtNDY1LjM1MTI2\nLDcuMDU0NzIgLTExMi4zMjk3OCwtMC42NDIwNyAtMTM1Ljc3NTEsLTAuNDAyMjIgLTEzNy41ODUw\nNSwxLjQwNzc1IC0yLjcwNDY4LDIuNzA0NjUgLTQuNjQ4MSw4LjUyMTk1IC0zLjI5ODAyLDkuODcy\nMDMgMS4xNTM5MSwxLjE1Mzg3IDI5LjcxNTAxLDIuNzk2MDcgNzUuMjAxMiw0LjMyMzkgMTcuMzIy\nNCwwLjU4MTg1IDQ3LjEyMTc3LDEuNzM5NDcgNjYuMjIwODMsMi41NzI2NSAxOS4wOTkwNSwwLjgz\nMzEgMzcuNDg1ODEsMS41MjE2NyA0MC44NTk0NSwxLjUzMDA3IDQuNTg2NzUsMC4wMTEzIDcuMTU5\nNzUsMS4xMjIzIDEwLjIwMSw0LjQwMzg1IDMuNTc4OCwzLjg2MTYgNS42NTQwOCw0LjUxMDIgMTcu\nMjg1NjUsNS40MDI5MyA5LjUxODY4LDAuNzMwNSAzLjcyNDkxLDEuMTA1MjcgLTIwLjY5OTQyLDEu\nMzM4ODIgLTE4LjY1NDg5LDAuMTc4NDMgLTQ1LjM5NzE4LDAuOTIxIC01OS40MjczMiwxLjY1MDIz\nIC0yMy4zOTQxMSwxLjIxNTk3IC0yNS44MzYsMS42MzI3NSAtMjkuNDQ4NTksNS4wMjY1NyAtMi4x\nNjY1NywyLjAzNTQzIC00LjM2NDEsNS4wMzk0IC00Ljg4MzQsNi42NzU1MyAtMC44NjI5NCwyLjcx\nODkgLTMuMDcwMDMsMi45NzQ4NyAtMjUuNjU0NjksMi45NzQ4NyBoIC0yNC43MTA1MiBsIC0zLjM0\nMjksOC4wNDM4MyBjIC0xLjgzODU4LDQuNDI0MTcgLTIuODQ0LDguMzQzNjcgLTIuMjM0MjUsOC43\nMTAwNSAwLjYwOTcyLDAuMzY2MzcgMzAuOTA3OTgsMS40NzA5IDY3LjMyOTQzLDIuNDU0MzcgMzYu\nNDIxNDUsMC45ODM1NSA3Ni4zOTYyMiwyLjE0NjU4IDg4LjgzMjgxLDIuNTg0NTggMTIuNDM2NTks\nMC40Mzc5MiAzNS44MTA3MiwwLjgwMTQ1IDUxLjk0MjUsMC44MDc3NSBsIDI5LjMzMDUyLDAuMDEx\nMyAtMy44OTIwNCw4LjA2Nzc1IGMgLTIuMTQwNjEsNC40MzczIC0zLjg5MjAyLDguNzQ1IC0zLjg5\nMjAyLDkuNTcyNjIgMCwwLjkxNDAzIC01NC42OTMyNywxLjcwOTc4IC0xMzkuMzA1OTksMi4wMjY4\nOCAtMTA5Ljc0NzU4LDAuNDExMjIgLTE0MC4wNzcwOCwwLjk2MjQ3IC0xNDIuOTQwMDYsMi41OTc3\nNyAtMy4wMDE5OCwxLjcxNDY1IC0zLjYzNDA4LDMuNDcwNTUgLTMuNjM0MDgsMTAuMDk0NiAwLDQu\nNDEwNCAtMC41NDUxLDguMDExOSAtMS4yMTEzNSw4LjAwMzQgLTIuMDcyNTUsLTAuMDI2IC0yMi4y\nNzM1NywtMTUuNzQ2NzUgLTM3LjQ3MzUzLC0yOS4xNjE0NyAtNy45ODcyOSwtNy4wNDkxOCAtMTQu\nOTA2NTYsLTEyLjgxNjY4IC0xNS4zNzYxNCwtMTIuODE2NjggLTMuNTc4OTUsMCAzLjk1MDM5LDMy\nLjEwNjU1IDEzLjAyNjM2LDU1LjU0NzA1IDcuNTIxMzgsMTkuNDI1NSA3Ljc4NjU5LDIwLjM2NDUg\nNS43NTEyMywyMC4zNjQ1IC0wLjk1ODIyLDAgLTguMTI5NDIsLTUuMzM3OCAtMTUuOTM2MDEsLTEx\nLjg2MTggeiIKICAgICBpZD0icGF0aDI3IiAvPgogIDxwYXRoCiAgICAgc3R5bGU9ImZpbGw6I2Zm\nZmZmZjtzdHJva2Utd2lkdGg6MS42MTUxNCIKICAgICBkPSJtIDM5NC42NDM0NiwxMDM4LjMwNSBj\nIC00Ljc0MzUsLTMuNjgwNiAtMTkuMTg5MjQsLTE3LjMwODMgLTMyLjEwMTY2LC0zMC4yODM5IC0z\nMS43NTg5MiwtMzEuOTE0NCAtNDYuNzM4MzksLTU0LjQ4NDUzIC03MS4xMzA5LC0xMDcuMTc1NyAt\nNS42NTQ1MSwtMTIuMjE0NSAtMTAuODQ3NDgsLTIyLjIwODE4IC0xMS41Mzk5MywtMjIuMjA4MTgg\nLTEuNzk1ODYsMCAtMS41MDEyNSwxMi4zNjQ2NSAxLjE3Mzc1LDQ5LjI2MTggMS4yODgwNSwxNy43\nNjY2IDIuMzM0NzIsMzQuMTE5OSAyLjMyNTk0LDM2LjM0MDczIC0wLjAxNTcsMy45NjMzIC0wLjA2\nMjYsMy45NzgyMiAtMi41NDI3NiwwLjgwNzYgLTMuODkyMTEsLTQuOTc1OCAtMjYuOTkxOTUsLTUz\nLjA1OTY1IC0zMy43NTI3NiwtNzAuMjU4NzMgLTExLjI5MzA5LC0yOC43Mjg3NSAtMjMuMTU3MTIs\nLTc2LjE3OTMgLTI5Ljg4MzY1LC0xMTkuNTIwNDUgLTQuMTkyNDEsLTI3LjAxMzAyIC02LjA0NzQ1\nLC0zNC4zMDEwNyAtOC41MzAwMSwtMzMuNTEyNjEgLTEuMDU3ODIsMC4zMzU5NCAtMTMuMTg4OTMs\nMTUuNDAxNjkgLTI2Ljk1ODAxLDMzLjQ3OTM5IC0yMi41MzgwNCwyOS41OTA2NSAtMjUuMDg4ODIs\nMzIuNDA2NiAtMjUuNTc3NDIsMjguMjM2NDUgLTEuMzcxMDQsLTExLjcwMTk1IDEyLjQ1MTgsLTEw\nNS40NjcyNyAyNi4xODIzLC0xNzcuNjAzODkgMi4wMjkwMiwtMTAuNjU5OTQgMy4wNjk2NCwtMTku\nNjI5NTUgMi4zMTI1LC0xOS45MzI0OCAtMC43NTcxNCwtMC4zMDI5MyAtMTAuMTIzODUsNS43NzIy\nMiAtMjAuODE0OTEsMTMuNTAwMzIgLTEwLjY5MTA2LDcuNzI4MTEgLTE5LjgzOTk1LDEzLjY0OTQ1\nIC0yMC4zMzA4NywxMy4xNTg1NSAtMC40OTA5MiwtMC40OTA5MyAyLjA3NzgsLTEyLjAxNzE4IDUu\nNzA4MjcsLTI1LjYxMzkgOC43Njc4NywtMzIuODM3MjYgMTQuNDM3NTYsLTU3LjEwMTk3IDI0LjQ1\nNzM3LC0xMDQuNjcwODUgNC41ODQzNCwtMjEuNzY0MDQgOS4zMTMyMiwtNDMuODA4NCAxMC41MDg2\nMiwtNDguOTg3NDcgMi42NTM1NiwtMTEuNDk2NDkgNC4wMjg5NywtMTEuODIwNCAtMjIuNjcyMzQs\nNS4zMzkzNyAtMTEuMDkxOCw3LjEyODIyIC0yMC40ODk4OSwxMi45NjA0IC0yMC44ODQ2NSwxMi45\nNjA0IC0xLjA4OTA4LDAgMi43ODY5OCwtOC43Njc2MSAxNi42MDUsLTM3LjU2MDMzIGwgMTIuNTkx\nODYsLTI2LjIzNzc4IDUuOTg3NDQsLTAuNDA1MiBjIDMuMjkzMDgsLTAuMjIyODUgMTguNTExMzMs\nLTIuOTk1NDUgMzMuODE4MzMsLTYuMTYxMzIgMTUuMzA2OTksLTMuMTY1ODYgMzAuMDMzMTQsLTYu\nMTQxNjMgMzIuNzI0NzcsLTYuNjEyODEgNC43MTM2OSwtMC44MjUxNiA1LjIxOTE1LC0wLjMwMjU1\nIDEzLjcyODcxLDE0LjE5NDczIDEyLjk3NjQzLDIyLjEwNzI1IDMwLjU0Nzk0LDM5LjIxMzE4IDUw\nLjIzOTMzLDQ4LjkwODE3IDE3LjY4NDcxLDguNzA3MDIgMjYuNTg0ODcsMTAuNjQ0MjYgNDguOTAy\nNDgsMTAuNjQ0MjYgMzAuMDE0MTgsMCA0OS44NjU3MywtMy44NTQwOSA3Ni40NTY0MywtMTQuODQz\nNjYgNi40NDAzOCwtMi42NjE3MiAxMS43MDk3OCwtNS43NDY4NCAxMS43MDk3OCwtNi44NTU4MiAw\nLC0xLjEwODk5IDIuNzgxMzUsLTIuODQ5NjUgNi4xODA3NywtMy44NjgxNSAxNi4wMjIwNiwtNC44\nMDAzMiAzOC43MDQ4OSwtMjUuMjE4OSA0OC4zNzMwNSwtNDMuNTQ0MzUgMi42NzYwNywtNS4wNzIz\nMiA3LjEyNDA0LC0xNi4wNzUwNCA5Ljg4NDM4LC0yNC40NTA0NyA1LjgzNTUxLC0xNy43MDYxNSA4\nLjcyNTc1LC0yMi43Mjc3NyAxMy4wODEyMSwtMjIuNzI3NzcgNS4zNDY2LDAgNTIuNTc1MzYsMTIu\nNTI2ODEgNzMuMjU3MzgsMTkuNDMwNTcgMjcuMTMyLDkuMDU2NzggNDUuMTUwODgsMTguMDkxMDMg\nNTUuMzgyMTIsMjcuNzY3MzIgMTMuMzUwOTIsMTIuNjI2NzUgMTUuMDMyNzEsMjMuNjczNTcgNi40\nNTYxNCw0Mi40MDcyNSAtMy45MjYwOSw4LjU3NTc0IC00LjUwMTQ4LDkuMTM2NTcgLTcuMjgwMTMs\nNy4wOTYxNyAtMzUuNjU5MTcsLTI2LjE4NTA1IC0zOS41NzU4OSwtMjcuODg2OTIgLTYxLjgxOTUs\nLTI2Ljg2MTMxIC0xMy4xNzQ0OSwwLjYwNzQ1IC0xNi43MDY4NSwxLjM4MDg4IC0yMi45MTAyMiw1\nLjAxNjMgLTEyLjYyMjk4LDcuMzk3NTYgLTE3LjIyMzYsMTcuMDI0MzggLTEwLjg3OTU5LDIyLjc2\nNTYzIDIuODQ1MiwyLjU3NDg2IDQuNDExMywyLjY1MzYxIDE3LjQyMDQsMC44NzU4MyAxMi4zNTQ3\nLC0xLjY4ODM2IDE1LjcxMDc2LC0xLjU5MjU4IDI0LjczNDk0LDAuNzA1ODggMTMuNjUyNjQsMy40\nNzczMyAyOS41NjA0MSwxMC45NzU1IDM4LjA1ODMsMTcuOTM4ODkgMTcuNzYwNzIsMTQuNTUzNTcg\nMTguMjc0MTIsMzkuNzcxMTggMS4wOTgxNSw1My45Mzk0OSAtNy43MDYzNyw2LjM1NjkyIC0xNC4y\nOTM3Niw4LjE4MTUzIC0yOS41MjMyMSw4LjE3NzUyIC0yMi4zNDAwMSwtMC4wMDYgLTQzLjgyMTA5\nLC01LjAyOTE5IC02My43OTgxMSwtMTQuOTE5MDQgLTE0LjIwNjA5LC03LjAzMjkxIC0xOS43MTk3\nNiwtMTEuNzM0MTkgLTI1LjI0NjA2LC0yMS41MjYyMiAtMi43MTgzLC00LjgxNjU3IC01LjU3ODQ3\nLC05LjE1MDU0IC02LjM1NTkyLC05LjYzMTAzIC0yLjU5NTA2LC0xLjYwMzg0IC0xMC41NTM4LDMu\nODA2MzMgLTE0Ljk2NjUzLDEwLjE3MzkgLTcuNTYzNjgsMTAuOTE0NCAtOS45MTMxOSwzMi42OTMz\nMiAtMy41MjY5NCwzMi42OTMzMiAxLjI4NDMxLDAgMy4xMTMwNywtMS40NTM2MyA0LjA2MzkxLC0z\nLjIzMDI4IDAuOTUwODMsLTEuNzc2NjYgMi41MTA4LC0zLjIzMDI5IDMuNDY2NiwtMy4yMzAyOSAw\nLjk1NTc3LDAgNy43MzQ1NywzLjYyMjIzIDE1LjA2Mzk5LDguMDQ5NCAzNC45Mjk5LDIxLjA5ODY3\nIDUyLjI0NTQ5LDI2LjI2MjYgODguODc2MzUsMjYuNTA1MDggMjIuMDYxNDMsMC4xNDYwNCAyOC4y\nNzI0MywtMC4zOTk4MiA0Mi40NTI1MiwtMy43MzA5OCAyMC40MDc3MSwtNC43OTQxNiAyMi41MDcw\nNywtMy44NTc5NiAxNi41NDYwNSw3LjM3ODY4IC03LjE2MDU2LDEzLjQ5Nzc3IC0yNy4xODIxLDI1\nLjQ4NjI3IC01MC42NjM5MiwzMC4zMzY1OCAtMjIuNjcwOCw0LjY4Mjc4IC0zNy4yMTAxNiwyLjk2\nODQ1IC05NC42ODEyNSwtMTEuMTYzODQgbCAtMzYuMjc3MjMsLTguOTIwNjYgLTM5LjYzNDQ0LDAu\nMTIwODUgYyAtNDUuMjAzNTMsMC4xMzc4MiAtODQuODc4MzcsMS43ODM0IC04Ni42OTA2MSwzLjU5\nNTY0IC0wLjY3NzUyLDAuNjc3NTIgNS42MzU0OCw4LjE5OTc1IDE0LjAyODg3LDE2LjcxNjA5IGwg\nMTUuMjYwNywxNS40ODQyNCAtOS43OTA3MywtMC45NDI4OSBjIC01LjM4NDkyLC0wLjUxODU5IC0x\nNy43NDA3NSwtMy41NDI5MiAtMjcuNDU3NDIsLTYuNzIwNzIgLTE3Ljc3NDcsLTUuODEzMTQgLTI2\nLjQxMzc1LC0xMC44MzM4NCAtNDguMzEyNjMsLTI4LjA3NzUzIC00Ljk1Mzk1LC0zLjkwMDg2IC05\nLjMyMjU5LC02Ljc3NzA1IC05LjcwODA5LC02LjM5MTU1IC0wLjM4NTUsMC4zODU0OCAxLjUwNDM3\nLDcuODIzMDcgNC4xOTk3MSwxNi41Mjc5MyAyLjY5NTM0LDguNzA0ODYgNC40OTYwMywxNi4yMzE2\nMiA0LjAwMTUzLDE2LjcyNjEzIC0xLjc0OTM1LDEuNzQ5MzQgLTE0Ljg2Njk4LC04LjY1NzkzIC0z\nMC4wMjM2LC0yMy44MjAxIC0xOC40MjY0OCwtMTguNDMzMjYgLTMyLjc1ODY2LC0zOC4yODY3NiAt\nNDEuMjUwNzEsLTU3LjE0MjExIC0zLjQ0NjEsLTcuNjUxNTcgLTYuNzQ4NjUsLTEzLjkxMTk0IC03\nLjMzOTAyLC0xMy45MTE5NCAtNS42NDY5OSwwIDAuMjkxMTEsNjYuNDIyMDMgNy40NzUyNSw4My42\nMTYwOSAyLjY4Njg1LDYuNDMwNTMgNS41NTg4MSw1LjUzODgxIDUuNjEzNTMsLTEuNzQyOTcgMC4w\nMzUyLC00LjY5MjA1IDAuNjM0OCwtNC4wNjgzOSA2LjY5MDUsNi45NTk2OCAyMy4zMjczOSw0Mi40\nODE2NiAzNy4wNzUwMSw2MS45NzgxOSA2NS41OTQyMyw5My4wMjQwMSAzMi4wNTk2OSwzNC44OTk5\nOSA2OS4xMDcxNiw2Ny4yMTY4MSAxMDIuNzM3NTEsODkuNjE4NzEgMjIuMTA2NzksMTQuNzI1ODgg\nMjIuODM0MzksMTQuMTk3NjUgLTE0LjA1NDQ4LDEwLjIwMzk4IC0xMy4xNiwtMS40MjQ3OCAtMjQu\nNDI1NjEsLTIuMDg3NTUgLTI1LjAzNDcsLTEuNDcyODUgLTQuMTQ1NjIsNC4xODM3MiA3Mi40MDQ2\nOCw0Ni4xNzIyNSAxMDAuMTY2NCw1NC45NDIxNSAyMS45OCw2Ljk0MzUgNzcuMTk5MzUsMTQuODMw\nNjUgNzcuMTk5MzUsMTEuMDI2NjUgMCwtMC44MDQyMyAtNC45MDYsLTQuMjc0NzggLTEwLjkwMjIx\nLC03LjcxMjMzIC01Ljk5NjIxLC0zLjQzNzU1IC0xOC4xNzAzNSwtMTEuMDQyMjUgLTI3LjA1MzYz\nLC0xNi44OTkyMiBsIC0xNi4xNTE0MiwtMTAuNjQ5MSAzMi4zMDI4NCwtMC45NjA3NSBjIDE3Ljc2\nNjU2LC0wLjUyODM4IDMyLjUwNjY5LC0xLjA3MzU1IDMyLjc1NTg0LC0xLjIxMTMzIDAuMjQ5MTUs\nLTAuMTM3ODUgLTMuMDg3MDQsLTUuNTIgLTcuNDEzNzUsLTExLjk2MDQgLTEzLjI4MjE4LC0xOS43\nNzA3NSAtMzkuMDcwOCwtNjUuNzA5NTEgLTM5LjA3MDgsLTY5LjU5OTEzIDAsLTAuNDY5MjIgNS41\nMTg5MSwxLjY5Njc3IDEyLjI2NDIzLDQuODEzMjkgNi43NDUzMywzLjExNjUzIDEyLjYzMTUxLDUu\nMjk5MTcgMTMuMDgwMzcsNC44NTAyOSAwLjQ0ODg4LC0wLjQ0ODg4IC0yLjIxMTcyLC05Ljc0Mzg5\nIC01LjkxMjQ3LC0yMC42NTU1OCAtOC4wNzk2MSwtMjMuODIyOTIgLTE1LjY5ODUsLTU0LjI5OTkz\nIC0xNy4wMTU4OSwtNjguMDY2OCBsIC0wLjk1OTc4LC0xMC4wMjk3NiA5LjA0OTk1LDIxLjgwNDQy\nIGMgMjAuODc3OTgsNTAuMzAyMTggMzYuODA5NjUsNzcuMTg0NyA2MC4yNDg5OCwxMDEuNjYyIDE1\nLjk5MjUsMTYuNzAwNjIgMTYuMjY4NDMsMTYuMzE2NDcgLTguNjY3MSwxMi4wNjYwNyAtMjMuMTgy\nNzYsLTMuOTUxNjcgLTIzLjI1ODgyLC0zLjcxNjQ3IC00Ljg5MjMzLDE1LjEyNzIgMTkuMzg4NzMs\nMTkuODkyNDggMjkuMTE2MTcsMjcuMTcyNzMgNTMuODU0NzcsNDAuMzA2MjggbCAxOC45NjgyLDEw\nLjA3MDAyIC0xNy4yNjc0NSwwLjAyNDggYyAtMjYuMDc4NCwwLjAzNjcgLTI1LjMxMTQ0LDIuNzc3\nMTggNS4xNTM4OSwxOC40MTI4IDIwLjIwMzksMTAuMzY5MTMgMzYuOTQwODMsMTYuNTkyOTMgNTIu\nOTIxMzUsMTkuNjc5MjUgMTQuNzUzMzQsMi44NDkzMyA5NC4wNTY1OSw1LjIwMTQ4IDk0LjA1NjU5\nLDIuNzg5NyAwLC0wLjkwODQ3IC02LjcyMzA4LC00LjAyMjE3IC0xNC45NDAwOCwtNi45MTkzNSAt\nOC4yMTcwNCwtMi44OTcxNyAtMjMuNjYxODQsLTguNjI5NDIgLTM0LjMyMTc3LC0xMi43MzgzNyBs\nIC0xOS4zODE3MSwtNy40NzA3NSAyOS44ODAxMywtMC41MTgwMyBjIDM1LjE1ODQsLTAuNjA5NiAy\nMjAuOTQzNjgsMi42NDE2NSAyMjkuNzUzOTMsNC4wMjA2OCA1LjU4OTQ1LDAuODc0OTUgNi4wNTY3\nNywxLjM2MDk1IDYuMDU2NzcsNi4yOTkzMiAwLDIuOTQzMjMgMC41ODc5Myw1LjcxNDYzIDEuMzA2\nNSw2LjE1ODcgMC43MTg1OCwwLjQ0NDA4IDEyLjUyOTI4LDEuMzE5OTMgMjYuMjQ2MSwxLjk0NjI1\nIDE1LjIwMDk4LDAuNjk0MDUgMjUuMzg5MTgsMS44MzUwMyAyNi4wOTExOCwyLjkyMTkzIDAuNzUx\nMSwxLjE2MzAyIDEyLjkyMzEsMi4wNjQgMzUuMDAwMSwyLjU5MDcyIDE4LjYxNjcsMC40NDQxNSAz\nNC4xOTg5LDEuMTcwOTggMzQuNjI3MiwxLjYxNTEzIDAuNDI4MywwLjQ0NDE1IC0wLjQyNjksMy4z\nNTE0NSAtMS45MDA1LDYuNDYwNTcgbCAtMi42NzkxLDUuNjUyOTggLTE2LjE0MDcsMS4wNDc0NSBj\nIC0xNy44Njg2LDEuMTU5NTcgLTExOS42NzkxMywzLjg2NDMgLTIzNC45OTIzOCw2LjI0MjkyIC00\nMS4zMDczLDAuODUyMDggLTE0Mi44MzgyOSwxLjU1OTAzIC0yMjUuNjI0NTMsMS41NzEwMyBsIC0x\nNTAuNTIwMzksMC4wMjE3IC0yLjUxNDMsMy41ODk2NSBjIC0zLjk2MDE5LDUuNjUzOTUgLTMuMDM1\nODgsNy40NDgxIDQuMzUwMDYsOC40NDM3MiAzLjc3NTM5LDAuNTA4OTUgMzYuNjYzNzIsMS45OTky\nIDczLjA4NTE3LDMuMzExNyAxMTEuNjE2MzQsNC4wMjIxIDEwOC45NzYxNSwzLjgyMDY1IDExMy40\nNTk4Myw4LjY1ODYgMy4zMjY4MiwzLjU4OTczIDUuNjQ4OTUsNC4yOTMgMTcuMDY5MjQsNS4xNjk0\nNSA5LjgwMTY2LDAuNzUyMTggNS4wNzcyOSwxLjA4NTc4IC0xOC4yNzY3LDEuMjkwNTMgLTMzLjQ2\nMDYxLDAuMjkzNCAtNjQuODQwNDYsMS40MTY2NyAtNzguNjU0ODgsMi44MTU2NSAtOC44NzcyOCww\nLjg5OTAyIC0xNS43NDg0NSw0Ljk4MDgyIC0xNy40NTk0NSwxMC4zNzE2NyAtMC45NDkzMywyLjk5\nMTA4IC0yLjUzOTI0LDMuMTg4MTggLTI1LjcyMjM5LDMuMTg4MTggaCAtMjQuNzEwNTIgbCAtMy4z\nNTI5OSw4LjA2ODEyIC0zLjM1Mjk5LDguMDY4MiAzLjU0MTQzLDAuNzQ4OCBjIDQuMzgwOTQsMC45\nMjYyNSAxMDAuMjkyMTYsMy43MTM2MyAxNzcuMzY2MDUsNS4xNTQ2IGwgNTcuNTM0NDEsMS4wNzU2\nNSAtNC4yMzQ3Miw5LjM4NTczIC00LjIzNDcxLDkuMzg1OCAtMTEwLjc4MjA5LDAuMDUzMyBjIC0x\nMjguNzkwOTIsMC4wNjIyIC0xNjguNjQyNzEsMS4wOTY4NyAtMTcyLjAxMjUyLDQuNDY2NyAtMS4y\nNzQ2NywxLjI3NDcgLTIuMjc3OTUsNS40MjgyNyAtMi4yNzc5NSw5LjQzMDY1IDAsMy45MzQxIC0w\nLjU0NTExLDcuMTQ1OCAtMS4yMTEzNSw3LjEzNzMgLTIuMDcyNTUsLTAuMDI2IC0yMi4yNzM1Nywt\nMTUuNzQ2NyAtMzcuNDczNTMsLTI5LjE2MTQzIC03Ljk4NzMsLTcuMDQ5MTcgLTE0LjkwNjU2LC0x\nMi44MTY2NyAtMTUuMzc2MTUsLTEyLjgxNjY3IC0zLjU5NjE0LDAgNS4yNzA5NCwzNi40MjY4IDE0\nLjM0NDY1LDU4LjkyOTIgMy4xODkxMiw3LjkwODkgNS43OTg0LDE0Ljk2NTQgNS43OTg0LDE1LjY4\nMTEgMCwyLjYxNDQgLTMuMTI1NCwxLjE1NzYgLTExLjU2NDc0LC01LjM5MDYgeiIKICAgICBpZD0i\ncGF0aDI4IiAvPgogIDxwYXRoCiAgICAgc3R5bGU9ImZpbGw6I2ZmZmZmZjtzdHJva2Utd2lkdGg6\nMS42MTUxNCIKICAgICBkPSJtIDUwMy4xMTY3MiwxODcuMjcyMjcgYyAtMS43NzY2NiwtMC44MTQ0\nIC02Ljc4Mzc0LC0yLjk2MzE1IC0xMS4xMjY4NCwtNC43NzUgbCAtNy44OTY1NSwtMy4yOTQzIDE0\nLjM1NzEyLC05LjYwMTQ5IGMgMTkuNTQwMzQsLTEzLjA2NzgzIDI1LjU5MjI0LC0xOC4zNzQxMSAy\nNC40MjUwNSwtMjEuNDE1NzUgLTAuOTY2NTMsLTIuNTE4NzQgLTI0LjcyNjg2LC04Ljg5MTczIC00\nNi40MDg2MiwtMTIuNDQ3NyAtNS43NzQxMywtMC45NDcgLTEyLjkwNzQ1LC0yLjExNzgzIC0xNS44\nNTE4MSwtMi42MDE4MyBsIC01LjM1MzM4LC0wLjg4MDAyIDE5LjA4MjA5LC0xOS43NDUwNiBjIDI1\nLjY2NjEzLC0yNi41NTc4NSA0Ni4zNzIxNSwtNTAuODY5NDcxIDcyLjMyMzQ1LC04NC45MTczMDgg\nMjIuNjA5OTMsLTI5LjY2NDAwMDkgMzAuNjg1OSwtMzguNjk1Mjc5IDMyLjc0ODEsLTM2LjYyMTgz\nMjggMC42NzU1MSwwLjY3OTE5OTMgMS4xOTIyMywxNC43OTkwODI5IDEuMTQ4MjUsMzEuMzc3NTE5\nOCAtMC4xMTI5Myw0Mi41NzU0MTUgLTMuODgyOTYsNTkuMzU1ODc2IC0yMS42NDk3Nyw5Ni4zNjM0\nMzEgLTkuMjI1MDUsMTkuMjE1NDQgLTI1LjYzMDM4LDQ1LjQ1NTggLTM4LjYyNCw2MS43NzkxOCAt\nNi45MDExNyw4LjY2OTY4IC0xMC4yMDUyLDkuOTc0MTUgLTE3LjE3MzA5LDYuNzgwMTYgeiIKICAg\nICBpZD0icGF0aDI5IiAvPgogIDxwYXRoCiAgICAgc3R5bGU9ImZpbGw6I2ZmZmZmZjtzdHJva2Ut\nd2lkdGg6MS42MTUxNCIKICAgICBkPSJtIDUwMy4xMTY3MiwxODcuMjcyMjcgYyAtMS43NzY2Niwt\nMC44MTQ0IC02Ljc3NjQyLC0yLjk2MDAzIC0xMS4xMTA1OCwtNC43NjgwNCBsIC03Ljg4MDI5LC0z\nLjI4NzMxIDEzLjcwNTY3LC05LjI1NTE1IGMgMTkuMDY0NjMsLTEyLjg3MzkzIDI2LjA5NzYyLC0x\nOS4wMzAyOSAyNS4wMDE5NSwtMjEuODg1NTcgLTAuOTIxMjIsLTIuNDAwNjcgLTI1LjI1MzE2LC04\nLjg3NTQxIC00Ni4zNjY1OSwtMTIuMzM4MTcgLTUuNzc0MTMsLTAuOTQ3IC0xMi44OTc0NSwtMi4x\nMTU5NSAtMTUuODI5NiwtMi41OTc2NyBsIC01LjMzMTE3LC0wLjg3NTg0IDE5LjcyMzIsLTIwLjUw\nNDUgYyAyNC44Mzc4OSwtMjUuODIxODAzIDQ4Ljk1NjQzLC01NC4yNTQ0MjcgNzMuODAwOSwtODcu\nMDAxNjkgMjAuMzExNDYsLTI2Ljc3MjMzODcgMjguNTMwMDMsLTM1Ljg1MTU1NiAzMC41ODUxMiwt\nMzMuNzg3OTc2NyAyLjA5ODAxLDIuMTA2Njg0NiAxLjI1OTc4LDU5LjAyMjgwMjcgLTEuMDYwNjgs\nNzIuMDIwMTgyNyAtNi4xMjYxNywzNC4zMTQwMTQgLTI5LjUwMzU2LDgxLjYyMTEzNCAtNTguMDY0\nODQsMTE3LjUwMTU3NCAtNi45MDExNyw4LjY2OTY4IC0xMC4yMDUyLDkuOTc0MTUgLTE3LjE3MzA5\nLDYuNzgwMTYgeiIKICAgICBpZD0icGF0aDMwIiAvPgogIDxwYXRoCiAgICAgc3R5bGU9ImZpbGw6\nI2ZmZmZmZjtzdHJva2Utd2lkdGg6MS42MTUxNCIKICAgICBkPSJtIDIyOC4yNzkwNCwyODIuMTE0\nMzYgYyAtMS40NDgxNCwtMi44MTQ2MyAtMTEuMjI3MjgsLTE5LjA4NTM5IC0yMS43MzE0MiwtMzYu\nMTU3MjUgLTMwLjg4OTYzLC01MC4yMDM0MSAtMzUuMjkxMDMsLTYwLjgzMDE2IC0zOC42MzcyMSwt\nOTMuMjg1NyAtMy41ODgwNywtMzQuODAxNzUgMC4zNjQ0NCwtNTcuODAwNjQgMTkuMjE0MTEsLTEx\nMS44MDMxNTMgMTMuNTk0NDMsLTM4Ljk0Njc4MjMgMjkuMjUxMDMsLTc4LjAxNjUyMiAzMS4yNjM5\nMywtNzguMDE2NTIyIDguNTY5ODgsMCAyNS4xNTA2MiwyMC4yNjgzNjMgMzcuNjU2NTcsNDYuMDMx\nNTQ1NSAyMC44OTI1OCw0My4wNDAyNjQ1IDI2Ljk4NTgzLDg3LjU1MjA3MTUgMjEuMDMzNDUsMTUz\nLjY1MTQzOTUgLTEuNTA5MzgsMTYuNzYxMSAtMi41MDg2MiwzNC44MzU2MiAtMi4yMjA1NCw0MC4x\nNjU2IGwgMC41MjM3Nyw5LjY5MDg0IDEzLjgzMTUzLC0xOC41NzQxMyBjIDIwLjU0NDkxLC0yNy41\nODk0MiAzNi4wOTY1NiwtNDUuNTA4MjcgNTcuNDcyNTgsLTY2LjIyMDgxIDE4LjUyMzQ1LC0xNy45\nNDg1MSAyOS42NDIyNywtMjYuNDI4NzcgMjkuNjQyMjcsLTIyLjYwNzk5IDAsMC45OTA5OCAwLjcy\nNjgxLDEuMzUyNTggMS42MTUxNCwwLjgwMzU3IDAuODg4MzMsLTAuNTQ5MDIgMS42MTUxNCwtMC4y\nNDUxIDEuNjE1MTQsMC42NzUzNyAwLDMuNjY3NSAtOC42NzEyMywyNC40ODgzOCAtMTcuMDE4MDQs\nNDAuODYyNzUgLTQuODUzMzMsOS41MjEwNCAtOC44MjQyMywxNy42NTY0NyAtOC44MjQyMywxOC4w\nNzg3NCAwLDAuNDIyMjYgNS4yNjk0LC0xLjQyNzUxIDExLjcwOTc4LC00LjExMDYyIDE2Ljc1Mzg5\nLC02Ljk3OTc5IDMwLjA4NjUxLC05LjQyMjExIDUxLjI4MDc1LC05LjM5MzgxIDIzLjE3MTA0LDAu\nMDMxIDM5LjUyNjEsMi4wNjYyIDQyLjc4NDAzLDUuMzI0MTMgMi44MTEyNSwyLjgxMTI3IDIuNjQ4\nNzIsMi45MjQ5MSAtMjYuMzUwODgsMTguNDI1MjcgbCAtMTUuMDYyMTMsOC4wNTA3MiAxNS4wNjIx\nMywzLjY4MzEgYyAyMC4yNTU1OSw0Ljk1MyA0OS4zMjkyOCwxNS4wMzM5NSA2Mi40NzA1MSwyMS42\nNjA4NyAxMi41NjkzNiw2LjMzODUzIDI4LjMwMDg0LDE3LjY0MDc5IDQwLjE1NTEzLDI4Ljg0OTM5\nIDguMTc3NzIsNy43MzIzIDIxLjQ1ODU2LDIzLjc3NjQ2IDIxLjQ1ODU2LDI1LjkyMzQxIDAsMC41\nODQxOSAtMy43NzEyMiwxLjA2MjE2IC04LjM4MDUyLDEuMDYyMTYgLTYuNzc1OSwwIC0xMS45NTU3\nOSwtMS42NzkyOSAtMjcuMDUzNjIsLTguNzcwNjYgLTI1LjI0MjI0LC0xMS44NTYxMiAtNDMuMzYw\nODgsLTE4Ljk5MDQyIC00Ni42MzAxMiwtMTguMzYwODQgLTEuODkzNjIsMC4zNjQ2OSAwLjQzOTg3\nLDMuMTA0ODggNy43ODA2Niw5LjEzNjcgNS43NjcwMiw0LjczODY5IDEyLjgxMDU5LDExLjA4OTQ3\nIDE1LjY1MjM3LDE0LjExMjg1IGwgNS4xNjY4NSw1LjQ5NzA5IEggMzkzLjE5MTc5IDI4Mi42MjM5\nNiBsIC0yNS44NTU5NiwxMC4zNjY3MyAtMjUuODU1OTYsMTAuMzY2NzEgeiBtIDE1LjQ4MzgyLC00\nMy44NjEwNyBjIDcuMTk3NjksLTIzLjMwMzQzIDkuMDA2ODYsLTQzLjQyMTggOS4wMDY4NiwtMTAw\nLjE1ODY2IDAsLTQ2LjM4NDE4NyAtMC4zOTE5MywtNTQuOTc2MTU3IC0zLjAyMDY4LC02Ni4yMjA4\nMTMgLTUuNzQ2MDcsLTI0LjU3OTEyNSAtMTguNjAzNywtNTYuNTg5OTg0IC0yMi4yNDI5NSwtNTUu\nMzc2OTAxIC0zLjk4NTc0LDEuMzI4NTgxIC0yMy43MTYyNiw1Ni4yOTk2OTQgLTMxLjU2MjUyLDg3\nLjkzNjE1NCAtMy41NzIzNSwxNC40MDM5MSAtNC4zMTc3NSwyMC45NDg0IC00LjIwMTgzLDM2Ljg5\nMTg1IDAuMTY4OTgsMjMuMjQzNDkgMy41NTIxNywzNy43MzkxNSAxMy45MTI2Niw1OS42MTAzNyAx\nMS4xNTkwOSwyMy41NTcwNyAyNy43MDMxMiw0Ny43OTY1NyAzMi42MjIyNyw0Ny43OTY1NyAxLjM1\nOTA3LDAgMy41MzA5NywtNC4xNDgzMSA1LjQ4NjE5LC0xMC40Nzg1NyB6IgogICAgIGlkPSJwYXRo\nMzEiIC8+CiAgPHBhdGgKICAgICBzdHlsZT0iZmlsbDojZmZmZmZmO3N0cm9rZS13aWR0aDoxLjYx\nNTE0IgogICAgIGQ9Im0gMjI1Ljk1MTg1LDI3OC4yMjQ1IGMgLTIuODk4MDMsLTUuMTE2ODUgLTEy\nLjY5OTE1LC0yMS4zNTk3OCAtMjEuNzgwMjQsLTM2LjA5NTQyIC0yOC4xMzU4OSwtNDUuNjU1MyAt\nMzIuOTc2ODEsLTU3LjYwMTQ0IC0zNi4yNjQ3MywtODkuNDkxOTYgLTQuMTIwMzEsLTM5Ljk2NDA3\nIDEuMzgyNDcsLTY1LjYyNTQxMyAzMC44MjYzNSwtMTQzLjc1MzgzOTUgMTYuMTkwMDgsLTQyLjk1\nOTg3MDUgMTcuNjEyNjYsLTQ2LjAzMTU0NTUgMjEuMzE4NjMsLTQ2LjAzMTU0NTUgNC43NjA0MSww\nIDIwLjQyNjE0LDE3LjIwNzc2OCAyOC40NDA3NiwzMS4yNDAyOTU0IDE0LjExNjk5LDI0LjcxNjk5\nNjYgMjIuNzM0MDMsNDkuODI4NDUxNiAyOC41NjQ4MSw4My4yNDI1NjE2IDMuMDgxNDQsMTcuNjU4\nNTkzIDMuMDE5NTgsNTMuNzM1NTY4IC0wLjE0OTgzLDg3LjM4ODAxOCAtMS4zODE1OCwxNC42Njk0\nNSAtMi4yOTAxLDMxLjM5NiAtMi4wMTg5MywzNy4xNzAxNCBsIDAuNDkzMDMsMTAuNDk4NDEgMTMu\nODMwOCwtMTguNTc0MTMgYyAyMC4zNDcwMiwtMjcuMzI1MTIgMzUuOTY1MTMsLTQ1LjM2MTQ1IDU3\nLjQ4Njk0LC02Ni4zODc5MSAxOC4xMTI5NiwtMTcuNjk2MDUgMjkuNjI4NjQsLTI2LjM5ODcxIDI5\nLjYyODY0LC0yMi4zOTEwNSAwLDAuOTYzNTcgMC43MjY4MSwxLjMwMjc0IDEuNjE1MTQsMC43NTM3\nMyAwLjg4ODMzLC0wLjU0OTAyIDEuNjE1MTQsLTAuMTM2OTIgMS42MTUxNCwwLjkxNTc4IDAsMy42\nMjcwMyAtMTEuNzc2NTUsMzEuNDE2MjkgLTE4Ljk0ODA3LDQ0LjcxMTk3IC0zLjk1MzAxLDcuMzI4\nNzEgLTYuMzcyNjIsMTMuMzI0OTMgLTUuMzc2OTQsMTMuMzI0OTMgMC45OTU3LDAgNi4yODUxNCwt\nMS44ODM0MSAxMS43NTQzLC00LjE4NTM1IDE0Ljg2NTQ0LC02LjI1Njc3IDI4Ljk3OTksLTguNjgw\nMTkgNTAuNTI2NTQsLTguNjc1MjcgMjMuMDYxNiwwLjAwNSAzOC42MzIxNSwyLjAwMDIxIDQyLjAx\nNSw1LjM4MzA1IDIuNzc2OTgsMi43NzY5OSAxLjk4OTksMy4zMjY4OCAtMjYuMzc4NTksMTguNDI5\nNzcgbCAtMTUuMDUxMyw4LjAxMzA0IDE1LjA1MTMsMy42ODAzOSBjIDIwLjI0NTcyLDQuOTUwNTcg\nNDkuMzIwOTcsMTUuMDMyNTUgNjIuNDU5NjgsMjEuNjU4MjEgMTIuNTY5MzYsNi4zMzg1MyAyOC4z\nMDA4NCwxNy42NDA3OSA0MC4xNTUxMywyOC44NDkzOSA4LjE3NzcyLDcuNzMyMyAyMS40NTg1Niwy\nMy43NzY0NiAyMS40NTg1NiwyNS45MjM0MSAwLDAuNTg0MTkgLTMuNzcxMjIsMS4wNjIxNiAtOC4z\nODA1MiwxLjA2MjE2IC02Ljc3NTksMCAtMTEuOTU1NzksLTEuNjc5MjkgLTI3LjA1MzYyLC04Ljc3\nMDY2IC0yNS4wNDg3MiwtMTEuNzY1MjIgLTQzLjM1NDg0LC0xOC45OTE1OSAtNDYuNTUxMDEsLTE4\nLjM3NjA3IC0xLjc2MTk3LDAuMzM5MzMgMS41OTQ0Nyw0LjA5OTQzIDEwLjIwMzM3LDExLjQzMDQz\nIDIzLjA5OTI1LDE5LjY3MDQxIDM1LjQ4MzQ3LDE3LjMzMTQ0IC05MS43NjU1MiwxNy4zMzE0NCBI\nIDI4Mi44NjM4NSBsIC0yNC43Mzc5Miw5Ljg1NDEzIGMgLTEzLjYwNTg1LDUuNDE5NzUgLTI1LjIy\nNTQ5LDEwLjE1MTM4IC0yNS44MjE0MSwxMC41MTQ3MiAtMC41OTU5MywwLjM2MzMzIC0zLjQ1NDYy\nLC0zLjUyNTkyIC02LjM1MjY3LC04LjY0Mjc3IHogbSAxNC4zNjkxLC0zMS41MTE1NyBjIDIuMTgw\nODgsLTIuNjI3OTEgNy42OTgsLTI0LjU3MDQ0IDEwLjA1MTA5LC0zOS45NzQ3NiAxLjAxNzcyLC02\nLjY2MjQ2IDIuMjIwODksLTMzLjU1NDU4IDIuNjczNzEsLTU5Ljc2MDI1IDAuOTEyMzMsLTUyLjc5\nNzkzIC0wLjQ5MTk1LC02OC40MjM2NCAtOC40MTg4NywtOTMuNjc4MjM2IC01LjEwNDUzLC0xNi4y\nNjI2NzMgLTE0LjQzNDY3LC0zNy4xNDgyNjQgLTE2LjU5NTEsLTM3LjE0ODI2NCAtMy43ODAwMyww\nIC0yNC4wMzAyNyw1NS45MjI5NzMgLTMyLjE2NzIxLDg4LjgzMjgxIC01LjQ0ODgxLDIyLjAzNzY5\nIC01LjY4MjUyLDQ5LjA4Njc1IC0wLjU5MTA1LDY4LjQwODAxIDUuNTUxNzcsMjEuMDY4MSAyMC45\nNDM0Niw1MC45MTY2NiAzNS4zMDk5Miw2OC40NzUyNyA2LjA1MDk2LDcuMzk1NDQgNy4xNjIzNCw3\nLjk0ODQ4IDkuNzM3NTEsNC44NDU0MiB6IgogICAgIGlkPSJwYXRoMzIiIC8+CiAgPHBhdGgKICAg\nICBzdHlsZT0iZmlsbDojZmZmZmZmO3N0cm9rZS13aWR0aDoxLjYxNTE0IgogICAgIGQ9Im0gMjI1\nLjkxOTY2LDI3OC4yMjQ1IGMgLTIuOTE1NzYsLTUuMTE2ODUgLTEyLjY4NzAzLC0yMS4yOTU3OSAt\nMjEuNzEzOTYsLTM1Ljk1MzIxIC0yNy44MTg2OCwtNDUuMTcwNDEgLTMyLjg0MjI3LC01Ny40Mzk3\nMiAtMzYuMjgwMjYsLTg4LjYwODY2IC00LjMxMDY4LC0zOS4wODA4NCAwLjU3NzQ0LC02Mi45NTU5\nMzUgMjguMzE5MDEsLTEzOC4zMTg3ODEgMTUuNzY2MTIsLTQyLjgzMDMwMSAyMC4xMzEyOCwtNTIu\nNDkyMTE0IDIzLjcxNTcsLTUyLjQ5MjExNCAxLjY5NTc5LDAgNi42NDYzMywzLjU2MzA2OCAxMS4w\nMDExOSw3LjkxNzkyOSAyMC44MTkwOSwyMC44MTkwODgyIDM4LjM3MDY3LDYxLjE2ODg5NiA0Ni4w\nMDI5MywxMDUuNzU3MzU4IDMuMTcxMjMsMTguNTI2NjUgMy4xNDgxNSw1My4zNTI5MTggLTAuMDU4\nOCw4OC43ODc0MDggLTEuMzgyNzYsMTUuMjc4MzggLTIuMjkxMjgsMzIuMDcwNTcgLTIuMDE4OTMs\nMzcuMzE1OTYgbCAwLjQ5NTE4LDkuNTM3MDkgMTMuOTc4NzMsLTE4LjYyNzkzIGMgMjAuODc3NDgs\nLTI3LjgyMTEzIDM2LjAzMTU4LC00NS4yOTM0MSA1Ny4zMzkwMSwtNjYuMTEwNDMgMTguMTEyOTcs\nLTE3LjY5NjA1IDI5LjYyODY0LC0yNi4zOTg3MSAyOS42Mjg2NCwtMjIuMzkxMDUgMCwwLjk2MzU3\nIDAuNzI2ODEsMS4zMDI3NCAxLjYxNTE0LDAuNzUzNzMgMC44ODgzMywtMC41NDkwMiAxLjYxNTE0\nLC0wLjI0NTEgMS42MTUxNCwwLjY3NTM3IDAsMy41NzI3IC04LjU1Mzc3LDI0LjMxMzU1IC0xNi41\nMTkwOSw0MC4wNTQ3OSAtNC41OTI5Miw5LjA3NjY2IC03Ljk2NTA5LDE2Ljg4ODcgLTcuNDkzNzEs\nMTcuMzYwMSAwLjQ3MTM5LDAuNDcxMzggNS41MDMxMSwtMS4wMzA4IDExLjE4MTYxLC0zLjMzODE4\nIDE1LjUzMTUxLC02LjMxMTAyIDI5LjMzNjA5LC04LjY2NDkzIDUwLjc4NzAyLC04LjY2MDAyIDIz\nLjA2MTYsMC4wMDUgMzguNjMyMTUsMi4wMDAyMSA0Mi4wMTUsNS4zODMwNSAyLjc5ODgsMi43OTg3\nOSAxLjIyMTA4LDMuOTAzMzEgLTI2LjM3NzI2LDE4LjQ2NjExIGwgLTE1LjA0OTk1LDcuOTQxNDEg\nMTQuMjQyMzksMy41MjQ0NiBjIDQ4LjExNDUxLDExLjkwNjU5IDc2LjE0NzExLDI1LjQ5NTYyIDEw\nMi4wMzI4NSw0OS40NjEyNSA4LjkyMDg5LDguMjU5MTggMjIuODQ2NzYsMjQuODE0NzEgMjIuODQ2\nNzYsMjcuMTYwOTggMCwwLjU4NDE5IC0zLjcyNTgxLDEuMDYyMTYgLTguMjc5NTcsMS4wNjIxNiAt\nNi43ODY3NywwIC0xMi42ODM3NiwtMS45OTkzMiAtMzIuNzA2NjMsLTExLjA4ODg4IC0yOS42NTQ3\nNSwtMTMuNDYyMDcgLTM5LjQ3Mjk0LC0xNy4yMDc5OSAtNDEuMDE4MjUsLTE1LjY0OTY1IC0wLjY0\nNjQ1LDAuNjUxODkgMi40NTg3MSw0LjIwOTE5IDYuOTAwMzUsNy45MDUxIDQuNDQxNjQsMy42OTU5\nMyAxMS4zNDYzNyw5Ljc5MTk4IDE1LjM0Mzg0LDEzLjU0Njc4IGwgNy4yNjgxNCw2LjgyNjkxIC0x\nMTAuOTEyOTUsMC4wMzc0IC0xMTAuOTEyOTcsMC4wMzc0IC0yNC43NTg5Niw5Ljg3ODQgYyAtMTMu\nNjE3NDMsNS40MzMxMSAtMjUuMjQ2NTQsMTAuMTY0NzQgLTI1Ljg0MjQ2LDEwLjUxNDcyIC0wLjU5\nNTkzLDAuMzQ5OTcgLTMuNDY5MTEsLTMuNTUwMTkgLTYuMzg0ODYsLTguNjY3MDQgeiBtIDE1Ljgz\nODQxLC0zNC40NTczMyBjIDMuMjAzMTUsLTYuNTczNDMgOC41MTQ4MiwtMzUuNzE5OTggMTAuMTg2\nNSwtNTUuODk2MDYgMi4xMzA1MiwtMjUuNzE0MDUgMi4wNDA2NCwtODMuNTIxNzcgLTAuMTU3NzYs\nLTEwMS40NjEwMTUgLTAuOTc5NzUsLTcuOTk0OTU1IC0zLjQ4ODA1LC0yMC40Mjc4NTEgLTUuNTcz\nOTgsLTI3LjYyODY2MiAtNC42NDUzNiwtMTYuMDM2MTE1IC0xNS4zODg4MywtNDEuMzYyMjU1IC0x\nNy44OTI0NywtNDIuMTc4ODcxIC0zLjUxNjg5LC0xLjE0NzEwMiAtMjIuMDA1NDksNDguODAzODgz\nIC0zMS41MTc1LDg1LjE1MTM3OCAtMTEuMzA3ODcsNDMuMjA5ODggLTQuMzMxNzMsODIuMDYzMTUg\nMjIuMDg3MTgsMTIzLjAxMzM5IDEwLjQ5NTczLDE2LjI2ODc4IDE2Ljk2Mjg2LDI0LjIxNjggMTku\nMTMxMzIsMjMuNTEyMTcgMS4wMDQ5NywtMC4zMjY1NiAyLjY4NjQ5LC0yLjM1NzExIDMuNzM2NzEs\nLTQuNTEyMzMgeiIKICAgICBpZD0icGF0aDMzIiAvPgogIDxwYXRoCiAgICAgc3R5bGU9ImZpbGw6\nI2ZmZmZmZjtzdHJva2Utd2lkdGg6MS42MTUxNCIKICAgICBkPSJtIDIxMy45NDM0LDI1OC43MjEg\nYyAtNDEuMTE2NDEsLTY3LjM0OTczIC00NC44NTg5MiwtNzYuNzczNjggLTQ2Ljk0MTksLTExOC4y\nMDM2NSAtMS42NDQ5MiwtMzIuNzE3MTIgNC43MDYzNiwtNTkuNTgyNzY1IDMwLjM1NTgyLC0xMjgu\nNDAzNzg1IDE0Ljc2MDExLC0zOS42MDMzNjggMTkuMDUzMjEsLTQ5LjI2MTgzIDIxLjg5NjQ2LC00\nOS4yNjE4MyAxMi44OTc1MSwwIDM5Ljk5ODM4LDQzLjg4ODAyOTUgNTAuMzY2MjEsODEuNTY0NjY5\nIDEwLjQ0NTAxLDM3Ljk1NzA3OCAxMi4xMTkxNSw2NS44ODk4NDYgNy4yODI3NSwxMjEuNTExMjc2\nIC0xLjM4NDI2LDE1LjkxOTcgLTIuMjkyNzgsMzIuODM2OTcgLTIuMDE4OTQsMzcuNTkzOTUgbCAw\nLjQ5NzksOC42NDkwNiAxNC40MDM5NCwtMTkuMjcxNDYgYyAxOS43NDA1NywtMjYuNDExNTIgMzYu\nMDI2NTUsLTQ1LjEwODExIDU3Ljc0MiwtNjYuMjg4ODcgMTguOTg3MDksLTE4LjUxOTU3IDI2LjYw\nOTg4LC0yMy45NzgxOSAzMC4yOTk5OSwtMjEuNjk3NTYgMy4wMTU4OCwxLjg2MzkgLTEuMTE1MjIs\nMTMuODYyNzIgLTEzLjIzNzI5LDM4LjQ0Nzg4IC01LjM3MDExLDEwLjg5MTI3IC05LjQwOTIsMjAu\nMTU2OTQgLTguOTc1NzYsMjAuNTkwMzggMC40MzM0MiwwLjQzMzQ0IDUuNDM0MDksLTEuMDk5OCAx\nMS4xMTI1OSwtMy40MDcxOCAxNS41MzE1MSwtNi4zMTEwMiAyOS4zMzYwOSwtOC42NjQ5MyA1MC43\nODcwMiwtOC42NjAwMiAyMi45MDI1MywwLjAwNSAzOC42MjgzNywxLjk5NjQxIDQxLjk0MjY2LDUu\nMzEwNyAyLjkwOTA1LDIuOTA5MDUgMi42MjY3LDMuMTE2NTQgLTIyLjQ0MDU0LDE2LjQ5MDI2IC0y\nMC4yNTkxOCwxMC44MDg1NiAtMjEuMDg4NDcsOS4yOTY3MyA5LjU3MzU4LDE3LjQ1MzIxIDM1LjA3\nNzU5LDkuMzMxMDcgNTguNDI4NjgsMjAuNjY2MTMgODAuMjQ2NTgsMzguOTUzMjIgMTEuNDY2MDYs\nOS42MTA0OCAzMC4zODc1MSwzMC41NDAzMSAzMC4zODc1MSwzMy42MTI5MSAwLDAuOTA3MTggLTQu\nMjA3MTcsMS4yNTg3MyAtMTAuMDk0NjQsMC44NDM1MyAtNy44NTgyNiwtMC41NTQxOSAtMTMuODUx\nNzUsLTIuNTAzODMgLTI3LjA1MzYzLC04LjgwMDMzIC0yNC4xMDU0OSwtMTEuNDk2ODkgLTQzLjM0\nMjE5LC0xOS4xMjY1MiAtNDQuNzI4NTMsLTE3Ljc0MDE3IC0wLjY1MzczLDAuNjUzNzEgMi40MTk3\nNCw0LjIzNDYgNi44Mjk5MSw3Ljk1NzUgNC40MTAxNywzLjcyMjkgMTEuMjg5MTUsOS44NDkxNSAx\nNS4yODY2MywxMy42MTM4OCBsIDcuMjY4MTQsNi44NDQ5NyAtMTEwLjcwNzM0LDAuMDM3NCAtMTEw\nLjcwNzMzLDAuMDM3NCAtMTguNTA0MDMsNy40NDQzNyBjIC0xMC4xNzcyLDQuMDk0NDIgLTIxLjg2\nMjg0LDguNzcxMjIgLTI1Ljk2ODA3LDEwLjM5MjkyIGwgLTcuNDY0MDYsMi45NDg1NiB6IG0gMjcu\nNDAxMzksLTE0LjYwNDU5IGMgMS4zMTI3MSwtMi41Mzg1IDMuODY5MjMsLTExLjg5MDE1IDUuNjgx\nMTYsLTIwLjc4MTQ1IDcuNzIxMTMsLTM3Ljg4ODIyIDguNzY3MDIsLTEyMy43MDgxNDggMS44NzI1\nLC0xNTMuNjQ3MjM5IC00LjkzNTU2LC0yMS40MzI0MDIgLTE3LjU1NDU1LC01My41MzYzMDIgLTIx\nLjA0MzM3LC01My41MzYzMDIgLTMuNjc0MDUsMCAtMjcuOTI3OTMsNjkuMDc4MTkxIC0zMy4xOTM1\nNSw5NC41Mzk1NzEgLTUuOTEwMjIsMjguNTc4MjQgLTIuNjEyMjksNTguNjUzNjIgOS41MDUwNSw4\nNi42ODA4NiA4LjM4NTA4LDE5LjM5NDU2IDI5LjQ0NjA4LDUxLjM2MDAxIDMzLjgzOTQzLDUxLjM2\nMDAxIDAuNTIzNjIsMCAyLjAyNjA3LC0yLjA3Njk1IDMuMzM4NzgsLTQuNjE1NDUgeiIKICAgICBp\nZD0icGF0aDM0IiAvPgogIDxwYXRoCiAgICAgc3R5bGU9ImZpbGw6I2ZmZmZmZjtzdHJva2Utd2lk\ndGg6MS42MTUxNCIKICAgICBkPSJtIDIxOC40MDQ5OSwyNjYuMDM0MDcgYyAtNy4wMTE0MSwtMTEu\nNzM3MDMgLTE2Ljk5Mzk1LC0yOC4yNDQ3OSAtMjIuMTgzNDMsLTM2LjY4MzkxIC0xMy41MDU2OSwt\nMjEuOTYyOTQgLTIyLjc2NDYsLTQyLjQ4NTIgLTI1Ljc0Mjk2LC01Ny4wNTg5NiAtNC4wODc0Miwt\nMjAuMDAwNjMgLTQuOTAyODksLTQ5LjI2MTg1IC0xLjg4MzQyLC02Ny41ODI3NSAzLjYzMDA3LC0y\nMi4wMjU4NCAyNS4wNjQ4MiwtODYuNjQxNzk0IDQyLjkwNTE2LC0xMjkuMzM5MzY1IDUuOTY5Mzcs\nLTE0LjI4NjYwMiA3LjYwNzM4LC0xNS4wMjgwMDkgMTYuNjc5ODQsLTcuNTQ5NzI2IDIwLjI0MjU3\nLDE2LjY4NTYzMyA0MS41NzgwMiw2NC4yNDEyNTkgNDguODU3MDcsMTA4Ljg5OTg4MSAzLjA2OTE0\nLDE4LjgyOTg2IDMuMDE4ODUsNTEuOTgwMSAtMC4xMzYxOSw4OS43ODk2OCAtMS4zODUxOCwxNi41\nOTk3MSAtMi4yOTM3LDMzLjY2NTE2IC0yLjAxODkzLDM3LjkyMzI0IGwgMC40OTk1Nyw3Ljc0MTk2\nIDE0LjQwMzk0LC0xOS4yNzMxOCBjIDE5LjczNzI1LC0yNi40MDk0MSAzNi4wMjM3LC00NS4xMDcw\nNCA1Ny43NDIsLTY2LjI5MDU4IDE4Ljk4NzA5LC0xOC41MTk1NyAyNi42MDk4OCwtMjMuOTc4MTkg\nMzAuMjk5OTksLTIxLjY5NzU2IDIuOTQ3MTIsMS44MjE0MiAtMC45Nzg1MywxMy4zODA3OSAtMTMu\nMDA4MDcsMzguMzAzMTkgLTUuMjE4NTgsMTAuODExNyAtOS40ODgzMywyMC4wMTQ4NiAtOS40ODgz\nMywyMC40NTE0OCAwLDAuNDM2NiA3LjQ0OTg0LC0xLjgxMTE3IDE2LjU1NTIsLTQuOTk1MDkgMTUu\nMjU2NywtNS4zMzQ4OSAxOC4zMjg3OCwtNS44NDM1MiAzOS4xNjcxOSwtNi40ODQ4NCAyNC43MTgx\nNywtMC43NjA3IDQ1LjE5OTg5LDEuMzQyMjEgNDguODc1OTEsNS4wMTgyMyAyLjA1NDA0LDIuMDU0\nMDYgMC45NjEwNiwzLjE1NzE1IC04LjcyNzYzLDguODA4NDIgLTYuMDkxNjUsMy41NTMxNyAtMTUu\nMjU0OSw4LjUwNjUzIC0yMC4zNjI3OCwxMS4wMDc0NyAtNS4xMDc4OSwyLjUwMDk1IC05LjI4NzA3\nLDUuMDY2MiAtOS4yODcwNyw1LjcwMDU5IDAsMS4zNDI1MSA0LjU5MzgzLDIuODQ3OTggMjYuNTE3\nNTIsOC42OTAyMiA0MC4wNjQ0OCwxMC42NzY0MiA3MC43NjgyMSwyNy43ODIzNSA5My4wNTE5Myw1\nMS44NDE5MSA4LjUyMTQ4LDkuMjAwNTkgMTYuMTAyNDcsMTguOTczNDYgMTYuMTAyNDcsMjAuNzU4\nMTYgMCwwLjY4NTg3IC00LjU0MjU4LDAuOTIwNTMgLTEwLjA5NDY0LDAuNTIxNDUgLTguMTExNDMs\nLTAuNTgzMDYgLTE0LjM3MDcsLTIuNzI0MzEgLTMxLjg2MDE4LC0xMC44OTkxMyAtMjMuMzc0ODYs\nLTEwLjkyNTcyIC0zOC43NzIwNCwtMTYuNzc3NiAtNDAuMjUxNTcsLTE1LjI5ODA4IC0wLjUwNTU5\nLDAuNTA1NiAyLjMzOTIxLDMuNTgzOTYgNi4zMjE3OSw2Ljg0MDgxIDMuOTgyNTgsMy4yNTY4NiAx\nMC44NzUxNCw5LjM3MDcxIDE1LjMxNjc4LDEzLjU4NjM1IGwgOC4wNzU3MSw3LjY2NDgxIC0xMTAu\nNzA3MzQsMC4wMzQ4IC0xMTAuNzA3MzMsMC4wMzQ4IC0xOC41MDQwMyw3LjQ0NDM2IGMgLTEwLjE3\nNzIsNC4wOTQ0MiAtMjEuOTE0MTQsOC43OTE0NSAtMjYuMDgyMDksMTAuNDM3ODcgbCAtNy41Nzgw\nNiwyLjk5MzQ5IHogbSAyNS4zNzg5NSwtMjkuNDY0MTggYyA2LjYxMjcyLC0yMS40MDk1IDguNzQ5\nODIsLTQ1LjEzMTU3IDguODcxNTMsLTk4LjQ3NTI2IDAuMDk2LC00Mi4wNTcxMzggLTAuMzQxMTcs\nLTUyLjE3ODI0NSAtMi43MzE3MywtNjMuMjQ3ODIgLTMuNjg5ODMsLTE3LjA4NTkxMyAtOS40OTc4\nMywtMzQuNzU4Nzc4IC0xNS44MjE5OCwtNDguMTQzODY5IC00LjA2OTQsLTguNjEyODg0IC01LjQ4\nMzgzLC0xMC4zMzkzNjMgLTcuMjYxNzYsLTguODYzODEzIC00Ljg0MzMsNC4wMTk1ODcgLTI3LjQ0\nMDM0LDY5LjU2MDQ1NyAtMzIuMzY4NjQsOTMuODgyNjMyIC0zLjcxNzIsMTguMzQ1MTcgLTIuNDY5\nNDMsNTAuOTA0ODEgMi41NTgxMSw2Ni43NTE0MyA1LjE4MDk2LDE2LjMzMDE4IDE0LjcwNTc0LDM1\nLjU1NjE1IDI1LjkyMzEyLDUyLjMyNjMgOS4zNDk5LDEzLjk3ODI2IDEzLjc4MzM2LDE4LjU0OTQg\nMTYuNDEzODksMTYuOTIzNjUgMC42NjExMiwtMC40MDg2IDIuNjQ4OTcsLTUuNDI3NTYgNC40MTc0\nNiwtMTEuMTUzMjUgeiIKICAgICBpZD0icGF0aDM1IiAvPgogIDxwYXRoCiAgICAgc3R5bGU9ImZp\nbGw6I2ZmZmZmZjtzdHJva2Utd2lkdGg6MS42MTUxNCIKICAgICBkPSJtIDIyNi42MzA4NCwyNzku\nNzgyNCBjIC0yLjQzMjQ2LC00LjE5NzA1IC0xMi4wMTQxMiwtMTkuOTg2ODIgLTIxLjI5MjU4LC0z\nNS4wODgzOSAtMjEuMjM1NSwtMzQuNTYyOCAtMzEuNTE0NTcsLTU1Ljk0NjkzIC0zNC45MzcsLTcy\nLjY4MTM5IC00LjA3NTI3LC0xOS45MjY2NSAtNC44NTAwNiwtNDguODE4NTQgLTEuODA0ODgsLTY3\nLjMwNDE3IDMuNjI3ODEsLTIyLjAyMjQ0MiAyNS4wNjEwNiwtODYuNjM1NjUgNDIuOTAzOTYsLTEy\nOS4zMzkzNjUgNi41MTczNiwtMTUuNTk4MTE3IDguNDU2NywtMTUuODg3ODc1IDIwLjg0NDQsLTMu\nMTE0Mzg5IDIwLjUxNzU4LDIxLjE1NjUzNzUgMzcuNzM4MTMsNjEuNTYxNDk5IDQ0LjgxMzU4LDEw\nNS4xNDcwNjYgMi45NTU1NSwxOC4yMDY1MzUgMi44NTkzNSw1Mi40Mzc4OTggLTAuMjUxNzEsODku\nNTY4NDY4IC0xLjM4MjEyLDE2LjQ5NTYyIC0yLjI5MDY0LDMzLjQwNzgzIC0yLjAxODkyLDM3LjU4\nMjY3IGwgMC40OTQwMSw3LjU5MDYxIDEzLjcyODcxLC0xOC40NDc3MiBjIDI1Ljc0NDMzLC0zNC41\nOTM1NSA2OS4wODcyOCwtODEuMDM0MDcgODEuNzMxNjEsLTg3LjU3MjcgMTEuODc2MjIsLTYuMTQx\nNDM4IDEwLjQ0NzExLDIuNzU5MzEgLTYuMDEzNDIsMzcuNDUyNTMgLTUuMjIzNTYsMTEuMDA5NSAt\nOS40OTczNywyMC4xNzkgLTkuNDk3MzcsMjAuMzc2NjcgMCwwLjE5NzY3IDcuNDQ5ODQsLTIuMjY4\nNzggMTYuNTU1MiwtNS40ODA5OSBsIDE2LjU1NTIxLC01Ljg0MDM4IGggMzEuNDk1MjcgYyAyNC43\nODU2OSwwIDMyLjUxNzgzLDAuNTE2MTEgMzYuMjk1MjksMi40MjI3MSAyLjY0LDEuMzMyNDkgNC43\nNjI2NSwzLjE0OTUyIDQuNzE2OTksNC4wMzc4NSAtMC4wNDU3LDAuODg4MzMgLTYuOTI5OTYsNS4z\nODMxNiAtMTUuMjk4NDMsOS45ODg1MSAtOC4zNjg0OSw0LjYwNTMzIC0xNy4zMTI2MSw5LjUzNDAy\nIC0xOS44NzU4MywxMC45NTI2MyAtMi41NjMyMywxLjQxODYgLTQuMjkwNywyLjk0ODk5IC0zLjgz\nODgzLDMuNDAwODYgMC40NTE4NywwLjQ1MTg1IDEwLjkwNzQxLDMuNjU0ODYgMjMuMjM0NTQsNy4x\nMTc3NSA0NS41MjI4NCwxMi43ODgxOCA3MS45MDA1OCwyNy4xODc0MyA5NS4xNTMzMyw1MS45NDI5\nMiA4LjcyNjY5LDkuMjkwNjggMTYuOSwxOS42MDY1NiAxNi45LDIxLjMzMDIzIDAsMC41ODI0MSAt\nMy44MTU3NywxLjA1NTM4IC04LjQ3OTQ5LDEuMDUxMDggLTYuNjU1MywtMC4wMDYgLTExLjk1NDE0\nLC0xLjYwODk0IC0yNC42MzA5MSwtNy40NTA0MiAtMjEuNTkxODUsLTkuOTQ5NTcgLTQ3Ljc3MDc3\nLC0yMC40MTM0OCAtNDguNzEzMiwtMTkuNDcxMDYgLTAuNDE4MzYsMC40MTgzOCAyLjM1MzA4LDMu\nNTU5NjUgNi4xNTg3Niw2Ljk4MDY0IDMuODA1NjYsMy40MjA5OCAxMC41NzY0LDkuNjU2NjQgMTUu\nMDQ2MDYsMTMuODU3MDQgbCA4LjEyNjY3LDcuNjM3MDkgLTExMC43MDczNCwwLjAzNDggLTExMC43\nMDczMywwLjAzNDggLTE4LjUwNDAzLDcuNDQ0MzYgYyAtMTAuMTc3Miw0LjA5NDQyIC0yMS45MzY1\nNCw4LjgwMDI4IC0yNi4xMzE4NSwxMC40NTc0OCBsIC03LjYyNzg0LDMuMDEzMTEgeiBtIDE4Ljcx\nNDQxLC01MC4yMTIwOCBjIDUuNzk2MDQsLTI0LjgyMzg2IDcuMjEwNCwtNDIuOTM1NjEgNy4yNjk1\nMywtOTMuMDkwODMgMC4wMzk3LC0zMy42NTYzNyAtMC41ODcyOSwtNTEuNjYwNDYgLTIuMDUyOTUs\nLTU4Ljk1MjY3MyAtNC43MzUyMiwtMjMuNTU5NDQ3IC0xOS42MDIyLC02Mi41NjA3NSAtMjMuMDM4\nMTcsLTYwLjQzNzIgLTMuODU5NTksMi4zODUzNTggLTIxLjc2ODE3LDUxLjIwNTQyMiAtMzAuMTAz\nNzgsODIuMDY1MDI1IC0zLjg5MTM3LDE0LjQwNjQwOCAtNC40MDcyOCwxOS4xNzkyMjggLTQuMzgz\nNzgsNDAuNTU1MTM4IDAuMDI5NCwyNi43MDkwNyAxLjc4NDEyLDM1LjkyODMzIDEwLjcwODY4LDU2\nLjI2MjE0IDYuNjY5MzUsMTUuMTk1NSAyMS4xNDQ1MiwzOS4yNTUzIDI4LjM0Nzg0LDQ3LjExODE1\nIDUuMzQxNyw1LjgzMDggNS44Nzg5NCw2LjA1OTIxIDcuODY0NTUsMy4zNDM3IDEuMTcyMTYsLTEu\nNjAzMDEgMy41OTY3OSwtOS4xOTE1OCA1LjM4ODA4LC0xNi44NjM0NSB6IgogICAgIGlkPSJwYXRo\nMzYiIC8+CiAgPHBhdGgKICAgICBzdHlsZT0iZmlsbDojZmZmZmZmO3N0cm9rZS13aWR0aDoxLjYx\nNTE0IgogICAgIGQ9Im0gMjI1LjYyOTAzLDI3OC4xNjQyOCBjIC0yLjk5MTc1LC01LjA4Mzc0IC0x\nMi41NzksLTIwLjg3MjE3IC0yMS4zMDUwMSwtMzUuMDg1NDIgLTMxLjY1MjEyLC01MS41NTYxNSAt\nMzcuMDM5NTIsLTY3LjYzNzE1IC0zNy4wNjUyNSwtMTEwLjYzNzIyIC0wLjAxMzYsLTIyLjY5OTIx\nIDAuNTM4MTQsLTI4Ljc2NjcyIDMuNzIxNzYsLTQwLjkyOTY4NSA2LjY5MjE2LC0yNS41NjczNDgg\nMjYuODc1NjMsLTgzLjQwNjE5MDUgNDAuNTI5MjYsLTExNi4xNDI4NyA2LjUwNDU4LC0xNS41OTU3\nMTcgOC40NDQ4LC0xNS44ODY2OTkgMjAuODM0OTUsLTMuMTI0NjkgMjAuMzI4NTMsMjAuOTM4NjMz\nNSAzNi44NTczNyw1OS4zNjI3ODUgNDQuNjk1ODgsMTAzLjkwMzI3OCAzLjEwMDEzLDE3LjYxNTc4\nMiAzLjA0Mzc3LDUzLjgyNzkxNyAtMC4xNDMyMiw5Mi4wMjAyNTcgLTEuMzg3MiwxNi42MjM4MyAt\nMi4yOTU3MSwzMy4zMTg2NCAtMi4wMTg5MywzNy4wOTk1OSBsIDAuNTAzMjMsNi44NzQ0NSAxMy42\nNjc0OCwtMTguNDEzODkgYyAxOC41MTczOSwtMjQuOTQ4MDkgMzguMDg5OTIsLTQ3LjM4NzM0IDU5\nLjI1ODEsLTY3LjkzNzQ1IDE4LjQxODYxLC0xNy44ODA4MiAyNS44Njg4NiwtMjMuMTM0NTggMjku\nNTY2MjUsLTIwLjg0OTQ4IDIuOTc5NTMsMS44NDE0NiAtMC43NjI3MiwxMi45OTc0OCAtMTIuODgy\nMDYsMzguNDAyNyAtNS4xOTExNywxMC44ODIwMiAtOC43MDM0LDE5Ljc4NTQ5IC03LjgwNDk2LDE5\nLjc4NTQ5IDAuODk4NDUsMCA3LjkzNSwtMi4zNDUxNCAxNS42MzY3NywtNS4yMTE0IGwgMTQuMDAz\nMjEsLTUuMjExNDEgMzIuMzAyODUsLTAuMDM3OCBjIDI1LjU2MDk0LC0wLjAyOTkgMzMuMzA0NjQs\nMC40Njc4MyAzNy4xMDI4NiwyLjM4NDkxIDIuNjQsMS4zMzI0OSA0Ljc2MjY1LDMuMTQ5NTIgNC43\nMTY5OSw0LjAzNzg1IC0wLjA0NTcsMC44ODgzMyAtNi45Mjk5Niw1LjM4MzE2IC0xNS4yOTg0Myw5\nLjk4ODUxIC04LjM2ODQ5LDQuNjA1MzMgLTE3LjMxMjYxLDkuNTM0MDIgLTE5Ljg3NTgzLDEwLjk1\nMjYzIC0yLjU2MzIzLDEuNDE4NiAtNC4yOTk1LDIuOTQwMTggLTMuODU4NDEsMy4zODEyOCAwLjQ0\nMTExLDAuNDQxMSA5Ljg3MDczLDMuMjg3NzIgMjAuOTU0NzQsNi4zMjU4MiAzNi42MzA0OCwxMC4w\nNDAzNSA1OS42OTIwMiwyMC41NzU5OSA3OC45OTcwNSwzNi4wODk3NiAxNS41MjY2OCwxMi40Nzc0\nNiAzNS4zNTU2NiwzMy41NDQxNyAzNS4zNTU2NiwzNy41NjI1OCAwLDAuODIwMTcgLTMuODE1Nzcs\nMS40ODIzNSAtOC40Nzk0OSwxLjQ3MTUgLTYuMjMxODcsLTAuMDE0NSAtMTEuOTA0NDYsLTEuNTU5\nMjUgLTIxLjQwMDYzLC01LjgyNzgyIC0yMi45MjY1NCwtMTAuMzA1NTYgLTQ1LjExOTE5LC0xOS42\nNDM0NSAtNDkuMjYxODMsLTIwLjcyNzY4IC0yLjg1MTEsLTAuNzQ2MiAwLjQ3MTgsMy4yMjQxNiAx\nMS4zMDU5OSwxMy41MDg5NSBsIDE1LjM0Mzg1LDE0LjU2NTczIC0xMTAuNzA3MzQsMC4wNTc4IC0x\nMTAuNzA3MzMsMC4wNTc4IC0xOC41MDQwMyw3LjQ0NDM2IGMgLTEwLjE3NzIsNC4wOTQ0MyAtMjEu\nOTMzMTUsOC43OTg5NCAtMjYuMTI0MywxMC40NTQ1MiBsIC03LjYyMDMsMy4wMTAxNCB6IG0gMTku\nNzE2MjIsLTQ4LjU5Mzk2IGMgNS43MzQ5LC0yNC41NjIwMSA3LjEyOTQ4LC00Mi44NzIzNiA3LjE1\nMTY1LC05My44OTg0IDAuMDE3NywtNDAuODAzODI4IC0wLjQ1ODM3LC01MS40MzQzNTggLTIuNzcy\nODYsLTYxLjkxNTI3NyAtNS4zNjUyNywtMjQuMjk2MDUxIC0xOC41MzI1OCwtNTYuODU2NDEzIC0y\nMi40NzQ0NCwtNTUuNTc1MTU1IC00LjQzNywxLjQ0MjE5OSAtMjcuMTMyNDUsNjcuMjkyMzA3IC0z\nMi43NTM0NCw5NS4wMzMwMzIgLTMuMjYzOTIsMTYuMTA4MTIgLTIuNDE5MzYsNDYuNjQ1OTUgMS43\nMTU0Niw2Mi4wMjgzOCA1LjIwNjksMTkuMzcwNzUgMjUuMTEyMzQsNTYuMjc5OCAzNy4zNTUwNiw2\nOS4yNjQ0NCA0LjA3NTY0LDQuMzIyNjQgNC41NDE1Myw0LjQ1NTA1IDYuNDU3MTMsMS44MzUzIDEu\nMTM1NTEsLTEuNTUyODkgMy41MzAxNSwtOS4xMDA0NSA1LjMyMTQ0LC0xNi43NzIzMiB6IgogICAg\nIGlkPSJwYXRoMzciIC8+CiAgPHBhdGgKICAgICBzdHlsZT0iZmlsbDojZmZmZmZmO3N0cm9rZS13\naWR0aDoxLjYxNTE0IgogICAgIGQ9Im0gMjA5LjIwNjk5LDI1MS4yMDEyMyBjIC0zNy41NTU4Mywt\nNjEuODUwNjYgLTQxLjkyMTU2LC03NC4yMTA1IC00MS45NDgyMiwtMTE4Ljc1OTU5IC0wLjAxMzYs\nLTIyLjc0NzEzIDAuNTM3MSwtMjguNzc4NiAzLjc2MDUsLTQxLjE4NjExOCA2Ljg4NTYzLC0yNi41\nMDQxNDEgMjYuMDU3MjgsLTgxLjM5MTI5NjIgNDAuNDc4MjIsLTExNS44ODY0MzcgNi41OTU0Mywt\nMTUuNzc2MzY1IDguNzMzMzUsLTE2LjA4OTcxMyAyMS4wMzk5MiwtMy4wODM3NjEgMjAuNDE3MDgs\nMjEuNTc3MzY3NSAzNi43MTY4Miw1OS42MTc5ODcgNDQuNTAzMjEsMTAzLjg2MjM0OCAyLjY3OTI4\nLDE1LjIyNDQzOCAzLjExNjU5LDU2LjQzNjM4OCAwLjg1NDQxLDgwLjUyMTA5OCAtMi44NzIwOCwz\nMC41NzgyMSAtMy43ODY2Nyw1My43MjYxNSAtMi4wOTc5OCw1My4wOTkyOCAwLjg5OTYsLTAuMzMz\nOTUgNy4wNjcyMSwtNy45MjI5MiAxMy43MDU4LC0xNi44NjQ0IDE3LjA0OTkzLC0yMi45NjQ0NiAz\nOC4yNDMwMywtNDcuMTUxOTcgNTguODA0NDMsLTY3LjExMzAyIDE4LjQxODYyLC0xNy44ODA4MyAy\nNS44Njg4NiwtMjMuMTM0NTggMjkuNTY2MjUsLTIwLjg0OTQ4IDIuOTI2MDMsMS44MDg0IC0wLjY4\nNDU3LDEyLjcxNTY2IC0xMi40NTExNSwzNy42MTM1NSAtNC45Mzc2NiwxMC40NDc5OCAtOC41NzU2\nNCwxOS4zOTgyMyAtOC4wODQ0NSwxOS44ODk0NCAwLjQ5MTIyLDAuNDkxMiA3LjMyNzA4LC0xLjQz\nNjE4IDE1LjE5MDgzLC00LjI4MzA2IDEzLjA4NDE4LC00LjczNjgxIDE2LjM1NTc2LC01LjI0MTI1\nIDM4LjU0NDgzLC01Ljk0MzAyIDI1LjUwOTUsLTAuODA2NzkgNDUuMDM0MDgsMS4xNjU4NSA0OC43\nOTg1NCw0LjkzMDMyIDIuOTE3MDMsMi45MTcwMyAyLjIxNDczLDMuNDU2MzYgLTE5LjMyODk2LDE0\nLjg0MzIxIC05LjU0MjI4LDUuMDQzNTQgLTE3Ljc0MDg3LDkuODAzMTcgLTE4LjIxOTEsMTAuNTc2\nOTUgLTAuNDc4MjEsMC43NzM4IDIuMjQxNCwyLjE5MTE1IDYuMDQzNiwzLjE0OTY4IDYwLjc0NTg4\nLDE1LjMxNDIgOTIuODIwMDYsMzIuOTk5OTYgMTIxLjAyMTY3LDY2LjczMTU3IDQuMzA5MDYsNS4x\nNTQgNy44MzQ2MywxMC4wNTk5OSA3LjgzNDYzLDEwLjkwMjIxIDAsMC44NDIyIC0zLjgxNTc3LDEu\nNTIyNDEgLTguNDc5NDksMS41MTE1NiAtNi4xOTY0MSwtMC4wMTQ0IC0xMS45NTg0OCwtMS41Njk4\nNSAtMjEuNDAwNjMsLTUuNzc3MDIgLTMyLjYyODYyLC0xNC41Mzg0IC00Ni4wNDcwMiwtMjAuMDQx\nODUgLTQ4Ljc1NTQ2LC0xOS45OTY2NiAtMS45NDE4NiwwLjAzMjQgMS45NzAyNCw0LjYzNzg1IDEx\nLjYwNzE5LDEzLjY2NDI4IGwgMTQuNTM2MjgsMTMuNjE1MzcgLTExMC40Nzk3OCwwLjA2NDQgLTEx\nMC40Nzk3OCwwLjA2NDQgLTIwLjM0NjcyLDguMjgzNTcgYyAtMTEuMTkwNjksNC41NTU5NCAtMjMu\nMDM2Niw5LjIzNTQxIC0yNi4zMjQyMywxMC4zOTg4IGwgLTUuOTc3NTIsMi4xMTUyNSB6IG0gMzYu\nMTAwMjUsLTIxLjYzMDkxIGMgNS40MjQzOCwtMjMuNTA2NDQgNy4wMTIyNCwtNDQuMzAxODYgNy4x\nMDgwNiwtOTMuMDkwODMgMC4xMDIwNSwtNTEuOTU4ODcgLTEuMjEyNSwtNjMuMjU3OCAtMTAuMzg2\nNjksLTg5LjI3Njc3OSAtNS42NDgxNiwtMTYuMDE4NzkzIC0xMi4wNzcxOSwtMjkuNDM2MTQ5IC0x\nNC4xMDQ1NiwtMjkuNDM2MTQ5IC0zLjMzMzU3LDAgLTIyLjEwMDc4LDUwLjc2MDI4NSAtMzEuMTI3\nNDMsODQuMTkxMzc4IC00LjgzODUxLDE3LjkxOTkxIC01LjI1Mzk5LDU2LjE3MTQ4IC0wLjc4NzI0\nLDcyLjQ3NzM5IDUuNjczODIsMjAuNzEyMzQgMjQuNDE4NDksNTUuNTYyNDQgMzcuMTcyNTQsNjku\nMTExMTkgNC40OTA1NCw0Ljc3MDM2IDUuMjAwODksNS4wNDAzMyA2Ljk1Mzk1LDIuNjQyODggMS4w\nNzM4NywtMS40Njg2MiAzLjQwMDk5LC04Ljk0NzIxIDUuMTcxMzcsLTE2LjYxOTA4IHoiCiAgICAg\naWQ9InBhdGgzOCIgLz4KICA8cGF0aAogICAgIHN0eWxlPSJmaWxsOiNmZmZmZmY7c3Ryb2tlLXdp\nZHRoOjEuNjE1MTQiCiAgICAgZD0ibSAyMDkuMjA2OTksMjUxLjIwMTIzIGMgLTM3LjU3ODMzLC02\nMS44ODc3MiAtNDEuODQzMDEsLTczLjg3Mzk5IC00MS45NjcwNiwtMTE3Ljk1MjAyIC0wLjA1NTUs\nLTE5LjcxMTU0IDAuNjQxMDYsLTI4LjkwNzY0IDIuOTM2MDYsLTM4Ljc2MzQwOCA1LjI5NTI1LC0y\nMi43NDAxNzYgMjcuMjY4NjMsLTg2LjIxNzU0NDggNDAuOTUzOTksLTExOC4zMDkxNDYgNy4wOTA4\nNiwtMTYuNjI3NzU5IDkuMDIzNDgsLTE2Ljk3OTA2MSAyMS40MDc0MywtMy44OTEzMzMgMTcuMTg0\nNSwxOC4xNjEwODc3IDMxLjE5NjgsNDcuMjk4MzMgNDAuNTMwNSw4NC4yNzkyODUgNC40NTE2OSwx\nNy42Mzc5ODUgNS4xODY5NywyMy42ODM5OTcgNS44ODg1NCw0OC40MTk2MjIgMC41MjE5NCwxOC40\nMDI3NSAtMC4xMDI3NCwzOS44Njk2MyAtMS43OTAzMyw2MS41MjM3NCAtMi45MTc1NSwzNy40MzYx\nMyAtMy4xMDk4OSw0My40ODMyNCAtMS4zODA2Myw0My40MDcyNSAwLjY2NjI0LC0wLjAyOTMgNS41\nNzIyNCwtNi4wMjQ2MSAxMC45MDIyLC0xMy4zMjI5MyAxNi4zNjQ0NywtMjIuNDA3ODYgMzkuODgy\nNSwtNDkuNTE2NjIgNjAuMDAyMDYsLTY5LjE2MzE3IDE5LjgxNzg0LC0xOS4zNTE5MSAyNy40MDUw\nMiwtMjQuODIzMzYgMzEuMTgzNzgsLTIyLjQ4Nzk3IDIuOTI2MDMsMS44MDg0IC0wLjY4NDU3LDEy\nLjcxNTY2IC0xMi40NTExNSwzNy42MTM1NSAtNC45Mzc2NiwxMC40NDc5OCAtOC42MjYyNywxOS4z\nNDc2MSAtOC4xOTY5MywxOS43NzY5NSAwLjQyOTM0LDAuNDI5MzQgNy4yNjUyLC0xLjU0Njc0IDE1\nLjE5MDgzLC00LjM5MTI4IDE0LjM1OTYxLC01LjE1MzcyIDE0LjUyMzY3LC01LjE3MjE0IDQ2Ljcx\nMzA2LC01LjI0MDY3IDI1LjYwNzc3LC0wLjA1NDUgMzMuMjk3NjgsMC40MzMzNSAzNy4xMDI4Niwy\nLjM1MzkzIDIuNjQsMS4zMzI0OSA0LjgyMDQ0LDMuMTI3MDQgNC44NDU0MywzLjk4Nzg4IDAuMDQx\nMiwxLjQxOTcyIC02LjU1NDgsNS40MTkyNSAtMzEuNzkxMDksMTkuMjc2OCBsIC04LjU0MjA2LDQu\nNjkwNTUgNi40NjA1NywyLjAxNjU3IGMgMy41NTMzMSwxLjEwOTEgMTUuNTQ1NzMsNC42NzI5MiAy\nNi42NDk4NCw3LjkxOTYxIDIzLjI0MTA1LDYuNzk1MzIgNDkuOTkxNjEsMTkuMDAwNTEgNjIuOTI4\nOTIsMjguNzExODkgMTYuNTY4MjUsMTIuNDM2OTUgNDAuNDQwMTYsMzcuMDg1NzQgNDAuNDQwMTYs\nNDEuNzU2MjUgMCwwLjgwODU2IC0zLjgxNTc3LDEuNDYxMjMgLTguNDc5NDksMS40NTAzOCAtNi4y\nMTIxMSwtMC4wMTQ0IC0xMS45MzQ1NiwtMS41NjUxOSAtMjEuNDAwNjMsLTUuNzk5MzkgLTI5Ljcw\nMDQ4LC0xMy4yODUxMyAtNDcuNzU1MzIsLTIwLjQ1MjkyIC00OC44MjQ3NywtMTkuMzgzNDUgLTAu\nNjI2NjIsMC42MjY1OSA1LjAxNDkzLDYuODgxOTYgMTIuNTM2NzQsMTMuOTAwOCBsIDEzLjY3NjA0\nLDEyLjc2MTUxIC0xMTAuMjA3LDAuMDc3NyAtMTEwLjIwNjk5LDAuMDc3NyAtMjAuNjE5NSw4LjI1\nODUxIGMgLTExLjM0MDczLDQuNTQyMiAtMjMuMzA5MzksOS4yMjE2NiAtMjYuNTk3MDIsMTAuMzk4\nODIgbCAtNS45Nzc1MywyLjE0MDI5IHogbSAzMy42NTA5NiwtMTIuNTY0IGMgNi45OTgzNSwtMjQu\nNDEwNjEgNy45NzY3MywtMzQuNzAyMjIgOC43MTA0MSwtOTEuNjI1MDQgMC41MDIsLTM4Ljk0ODMg\nMC4xMzE1NiwtNTkuMzM5NzU1IC0xLjIzMjMyLC02Ny44MzU5NTggLTMuMzY0MzYsLTIwLjk1Nzk4\nIC0xOC4xNDM3NCwtNjEuNDA5NjcgLTIyLjQzNjU2LC02MS40MDk2NyAtMy40MDQyNSwwIC0yMi41\nNjM4Miw1Mi4zNTAyNzkgLTMxLjAzMzk1LDg0Ljc5NDk1OCAtNC45MTQ2MSwxOC44MjUzNCAtNS4z\nMjM4OSw1NS4yMjA0MyAtMC44MDgyNiw3MS44NzM4MSA2Ljg5NDgyLDI1LjQyNzYzIDM0LjQzNzU4\nLDcyLjY4MTM5IDQyLjM2Mzc0LDcyLjY4MTM5IDEuMTAzMjYsMCAzLjA5OTg4LC0zLjgxNTc4IDQu\nNDM2OTQsLTguNDc5NDkgeiIKICAgICBpZD0icGF0aDM5IiAvPgogIDxwYXRoCiAgICAgc3R5bGU9\nImZpbGw6I2ZmZmZmZjtzdHJva2Utd2lkdGg6MS42MTUxNCIKICAgICBkPSJtIDIyOC44ODEwNSwy\nODMuOTg3ODYgYyAtMjEuNDU4NzYsLTM0LjcxNDI4IC00Mi45NTY3NCwtNzEuNzY0NzggLTQ4Ljgw\nOTk1LC04NC4xMjEwNCAtOC45ODU1MSwtMTguOTY4NiAtMTEuNzk3MDcsLTMxLjMzMjE3IC0xMy4x\nNDYzOCwtNTcuODA5OTQgLTEuNzM1OTYsLTM0LjA2NTUgMy4xMjg1MSwtNTUuODQ2MDMgMjcuMjEx\nMzcsLTEyMS44MzgwNyAxNi44Mjc3MSwtNDYuMTExNDMzIDIxLjg2Njg3LC01Ny4zNjcwNzUgMjUu\nNjgzMjYsLTU3LjM2NzA3NSA3LjQ5NjQ2LDAgMjQuMjYxMTksMjAuNzk2MzM4IDM2LjAwODk4LDQ0\nLjY2ODQ3NDUgNy45MDgzNCwxNi4wNzAxNjE1IDExLjkwMTQzLDI3LjYxMjI4ODUgMTguMDcyODgs\nNTIuMjQwMDQyNSA1Ljc0MzIsMjIuOTE4NzEzIDYuOTA3NjIsNjEuNTgxNzY4IDMuMjYzNjEsMTA4\nLjM2Mjg1OCAtMi45MzYzNSwzNy42OTYzMiAtMy4wNzM5OSw0MS44NjY1OCAtMS4zNzkzMyw0MS43\nOTIxMSAwLjY2NjI0LC0wLjAyOTMgNS41NzIyNCwtNi4wMjQ2MSAxMC45MDIyLC0xMy4zMjI5MyAx\nNi4zNjQ0NywtMjIuNDA3ODYgMzkuODgyNSwtNDkuNTE2NjIgNjAuMDAyMDYsLTY5LjE2MzE3IDE5\nLjgxNzg0LC0xOS4zNTE5MSAyNy40MDUwMiwtMjQuODIzMzYgMzEuMTgzNzgsLTIyLjQ4Nzk3IDIu\nOTE3NTYsMS44MDMxNSAtMC42MzM2OSwxMi42MjMxOCAtMTIuMzY3MjYsMzcuNjgwODcgLTQuOTA5\nNzMsMTAuNDg1MDIgLTguNjEwNTIsMTkuMzc5OTMgLTguMjIzOTgsMTkuNzY2NDYgMC4zODY1NCww\nLjM4NjU2IDcuMTkyMTEsLTEuNjUwNzcgMTUuMTIzNTEsLTQuNTI3MzggbCAxNC40MjA2OSwtNS4y\nMzAxOCBoIDMyLjMwMjg1IGMgMjUuNTAzNTIsMCAzMy4zMTMxNywwLjUwOTk0IDM3LjEwMjg2LDIu\nNDIyNzEgMi42NCwxLjMzMjQ5IDQuNzYwMDgsMy4xNDk1MiA0LjcxMTI5LDQuMDM3ODUgLTAuMDg4\nMywxLjYwNzMzIC03LjM3ODM0LDYuMTEzNTkgLTI4Ljg1MzA2LDE3LjgzNTIxIGwgLTExLjI2NjAz\nLDYuMTQ5MzcgNi40MjA2MSwxLjk4Mjk3IGMgMy41MzEzMywxLjA5MDYyIDE1LjUwNTc3LDQuNjM5\nMzIgMjYuNjA5ODgsNy44ODYwMSAyNC4xNzMzMSw3LjA2NzkxIDQ5Ljc2MTU0LDE4LjkyMzY0IDY0\nLjQwNTY1LDI5Ljg0MDk1IDE1LjIwODU2LDExLjMzODA5IDM4Ljk2MzQzLDM2LjI2NjM5IDM4Ljk2\nMzQzLDQwLjg4ODExIDAsMC45NDQ2OSAtNC4wOCwxLjI5NTM1IC0xMC4wOTQ2NCwwLjg2NzY0IC03\nLjc5MzQ3LC0wLjU1NDIgLTE0LjMyODc0LC0yLjY2MTMzIC0yOC42Njg3NiwtOS4yNDM0NyAtMjMu\nMTUxMTMsLTEwLjYyNjQ4IC0zOC44ODI4NywtMTYuNjc2MTggLTQwLjEyNzc1LC0xNS40MzEyOSAt\nMC41MjQxNiwwLjUyNDE2IDUuMjAxMTksNi42OTU3IDEyLjcyMywxMy43MTQ1NCBsIDEzLjY3NjA0\nLDEyLjc2MTUxIC0xMTAuMjExMTIsMC4wNzc3IC0xMTAuMjExMTIsMC4wNzc3IC0yNC42NTMyNCw5\nLjg2OTk3IGMgLTEzLjU1OTI3LDUuNDI4NDggLTI1LjUwNzY0LDEwLjIwOTc3IC0yNi41NTE5Mywx\nMC42MjUwOSAtMS4wNDQyOCwwLjQxNTMxIC0yLjk0NDgxLC0wLjkzNzIxIC00LjIyMzQsLTMuMDA1\nNjIgeiBtIDEyLjgxMDkyLC00Mi44MjEzIGMgMS4zNjczOCwtMy4yNzI1OCA0LjAxNjUsLTEzLjI2\nNjI3IDUuODg2OTQsLTIyLjIwODE5IDMuMTA2MTIsLTE0Ljg0OTI2IDMuNDA4MzgsLTIxLjY0NjMz\nIDMuNDg4MjMsLTc4LjQ0MTAyIGwgMC4wODc0LC02Mi4xODI5NjIgLTQuMjk5MTQsLTE1LjM1NjE2\nIEMgMjQxLjk0MzAyLDQ1LjQzMTQzNyAyMzEuMDQ3MTQsMTkuMTA3MDYzIDIyOC4zMywxOC4yMjA5\nMiBjIC0yLjQ3MjM1LC0wLjgwNjMxNiAtMTEuNTEwNjMsMjEuMjQ3MTYyIC0yMi4xNDcwNyw1NC4w\nMzkwNTggLTExLjY2MTk2LDM1Ljk1MzUxMiAtMTIuOTc1ODcsNDIuNzU2NDYyIC0xMy4wMjcyMSw2\nNy40NDk4MDIgLTAuMDUwNiwyNC4zMzc2NSAxLjk1NjcsMzQuOTk1MTggMTAuMTkwODksNTQuMTA3\nMjUgOS45MjQ0NCwyMy4wMzUyNCAyOS4zOTM0Niw1My4yOTk2OSAzNC4yODc1Myw1My4yOTk2OSAw\nLjg2NDQzLDAgMi42OTA0NiwtMi42Nzc1NyA0LjA1NzgzLC01Ljk1MDE2IHoiCiAgICAgaWQ9InBh\ndGg0MCIgLz4KICA8cGF0aAogICAgIHN0eWxlPSJmaWxsOiNmZmZmZmY7c3Ryb2tlLXdpZHRoOjEu\nNjE1MTQiCiAgICAgZD0ibSAzNTcuNzUzOTQsNDI0LjcwMjEyIGMgLTI5LjI1MDQ1LC0zLjI1MTQg\nLTQ1LjkwNzEzLC0xNy4xMjQzMyAtNTUuMDEzMTIsLTQ1LjgxOTA0IC0yLjIzMjEzLC03LjAzMzg2\nIC01LjkwOTE3LC0yMy4zNzI3MSAtOC4xNzEyLC0zNi4zMDg1NCAtMi4yNjIwMywtMTIuOTM1ODMg\nLTQuNDU4NjMsLTI0LjA3OTI3IC00Ljg4MTMyLC0yNC43NjMyIC0xLjU1Njc0LC0yLjUxODg3IC05\nLjc4NTM0LC0xLjA4NDk4IC0zMS4xNjc1NSw1LjQzMTIgLTI0LjE3NDE0LDcuMzY3MDEgLTUwLjEw\nMTcsMTYuMzU0MDUgLTg1Ljc4NTg3LDI5LjczNTIgLTI0LjMyNTI4LDkuMTIxNyAtMjcuNjYwMjgs\nMTAuMDcxMzIgLTI0LjU5MTA2LDcuMDAyMSAwLjk5NjU3LC0wLjk5NjU3IDEuNDY4NzksLTIuNzA2\nMjIgMS4wNDkzNywtMy43OTkyMiAtMS4yOTQ0MiwtMy4zNzMxOCA5Ljk5MjUxLC0xMS43MjY4MiAy\nNi40NjY2NSwtMTkuNTg4MzggOC42NTM5NywtNC4xMjk3NCAzOC4yNjU3MSwtMTYuOTQ0NzQgNjUu\nODAzODgsLTI4LjQ3NzggbCA1MC4wNjk0LC0yMC45NjkxOSAxODguMjQyMzEsMC44OTg3OSBjIDEy\nMS40MDcwNCwwLjU3OTY4IDE4OS43NzU1LDEuNDgxNzEgMTkyLjU2MDU3LDIuNTQwNTkgNS45NzE1\nNywyLjI3MDQgNi44NjE5OSw2LjgzNjcgNi42NDE0NiwzNC4wNTg5IC0wLjE1NDAxLDE5LjAwOTA5\nIC0wLjk4NjY0LDI2Ljg5ODQ5IC00LjQyMTM0LDQxLjg5MjY0IC0yLjMyNzM4LDEwLjE2MDE5IC00\nLjc4NTg2LDE5LjAwOTQxIC01LjQ2MzI2LDE5LjY2NDkzIC0wLjY3NzQxLDAuNjU1NTIgLTMuMzcx\nNjcsLTAuNTI5MTcgLTUuOTg3MjUsLTIuNjMyNjYgbCAtNC43NTU1OCwtMy44MjQ1MiA1LjM2OTc4\nLC0yNy4zNTU2MiBjIDUuNzM4ODMsLTI5LjIzNTY3IDUuOTAxNDQsLTQyLjk4NDg1IDAuNTYwNzgs\nLTQ3LjQxNzIzIC0yLjA3Njc3LC0xLjcyMzU4IC0zMC41OTc0OCwtMi4yMjA2NyAtMTQ4Ljg5NDc0\nLC0yLjU5NTExIC04MC40NzkyNSwtMC4yNTQ3NSAtMTYwLjg2MjE5LDAuMTA4NjYgLTE3OC42Mjg3\nNSwwLjgwNzU3IGwgLTMyLjMwMjg0LDEuMjcwNzMgLTAuNDY4MDMsNC4wMzc4NSBjIC0wLjUzODA3\nLDQuNjQyMDUgOS44Mzg2Myw2Mi44Nzc4NSAxMy40MzUxOCw3NS40MDA0OCAyLjg5NTk1LDEwLjA4\nMzI4IDguNTU5NTEsMTcuMTg5NDggMTcuNTE5OTMsMjEuOTgyNjUgNi40MzY3MSwzLjQ0MzE3IDgu\nMjY2NzcsMy41NjMyNCA1NC4zMDc4NywzLjU2MzI0IDUzLjk1OTUsMCA1OC43ODI4OCwtMC43MzQ0\nNiA3MC4wNjk3NCwtMTAuNjY5NTMgOC4wMzc5MSwtNy4wNzUyNiAxMC43MTkzMSwtMTIuOTY2MzYg\nMjAuNDYyODUsLTQ0Ljk1NzQ1IDMuNjM2NTUsLTExLjkzOTk1IDcuNjY3NywtMjMuNzUwNjggOC45\nNTgxMSwtMjYuMjQ2MDYgbCAyLjM0NjIsLTQuNTM3MDUgMTguOTM2NDQsMC4wMjM4IGMgMjEuMzIw\nOTYsMC4wMjY4IDI3LjAxMjE1LDEuNzMzMzIgMjcuMDEyMTUsOC4wOTk2IDAsMy45Mzg4NiAtMC4x\nNTg2NSwzLjk4Mzc0IC0xMi4zNDA3NSwzLjQ5MDkxIC0xNC42MjIzMywtMC41OTE1NCAtMjIuNjkw\nODUsMi4xNDI0OCAtMjYuMTIyMDcsOC44NTE1IC0xLjI4NTE3LDIuNTEyODkgLTUuMDQ5MDIsMTMu\nMjkwNjUgLTguMzY0MTIsMjMuOTUwNTggLTE1Ljk2NzIsNTEuMzQzNzYgLTI5LjAwMjIxLDU5LjIz\nOTA1IC05Ni45MjA3LDU4LjcwNDY5IC0xMy4zMjQ5MiwtMC4xMDQ4NCAtMjkuMzE0ODIsLTAuNzU2\nMTUgLTM1LjUzMzExLC0xLjQ0NzM1IHoiCiAgICAgaWQ9InBhdGg0MSIgLz4KICA8cGF0aAogICAg\nIHN0eWxlPSJmaWxsOiNmZmZmZmY7c3Ryb2tlLXdpZHRoOjEuNjE1MTQiCiAgICAgZD0ibSA0MDIu\nNDgzNDcsMTA0NS44MTggYyAtMi45MzY5NCwtMi4wMjY2IC0xMy45MDIyNywtMTEuMzUxOCAtMjQu\nMzY3NDIsLTIwLjcyMjYgLTM3LjU3OTc4LC0zMy42NDk5MyAtNTguMDcxODEsLTYyLjg3NzQzIC04\nNS45Mjk3LC0xMjIuNTYwNDggLTUuNjgyMjEsLTEyLjE3MzYyIC0xMC45MDg0NywtMjEuNTU2NjUg\nLTExLjYxMzkyLC0yMC44NTEyIC0xLjM0Mjg2LDEuMzQyODggMS4xMjQ2MSw0OS4yNDUzIDMuNzUz\nMyw3Mi44NjUyIDAuODQwMzQsNy41NTA3OCAxLjUzMzg2LDE0LjgwNTkgMS41NDExNCwxNi4xMjI1\nMyAwLjAzOTksNy4yMDc4NyAtMjUuMzE5MDcsLTQyLjM0MDM1IC0zOC4wMTA0OCwtNzQuMjY3NyAt\nMTEuNTg0MjIsLTI5LjE0MiAtMjMuOTU1OTEsLTc4LjY3ODkgLTMyLjI3MDEyLC0xMjkuMjExMzUg\nLTMuNTU3NjMsLTIxLjYyMjYyIC00LjIyMjgzLC0yNC40Mjk0MSAtNS42NjI2NSwtMjMuODkzMzgg\nLTAuNzAwMDMsMC4yNjA2MiAtMTIuNjgwMjQsMTUuNzM2OSAtMjYuNjIyNzEsMzQuMzkxOCAtMTMu\nOTQyNDYsMTguNjU0OSAtMjUuNzM1NDcsMzQuMjgxNDUgLTI2LjIwNjY4LDM0LjcyNTYgLTEuOTY5\nODMsMS44NTY3IDEuMjg2MDIsLTQwLjAyNzIgNS4xNDc2OSwtNjYuMjIwODQgNC44OTIxNSwtMzMu\nMTgzMzUgMTMuNjUwNTgsLTg1LjU0NjI3IDE5LjQyNzIzLC0xMTYuMTQ3MTcgMi4yNDkwMSwtMTEu\nOTEzNzMgMy44OTU3NCwtMjEuODU0NyAzLjY1OTQyLC0yMi4wOTEwMyAtMC4yMzYzMywtMC4yMzYz\nMyAtOS41MDcwMiw2LjM4NDIgLTIwLjYwMTUzLDE0LjcxMjI4IC0xOC45NzkxLDE0LjI0NjYxIC0y\nMC4yMDIwNSwxNC45MDgyOSAtMjAuNjgyNzUsMTEuMTkwMzQgLTAuMjgxMDEsLTIuMTczMzYgNC4y\nNjE2OSwtMjMuMDMxMTMgMTAuMDk0ODksLTQ2LjM1MDU4IDEwLjQ5ODQ2LC00MS45Njk4NCAzMS44\nNDAzNCwtMTM4LjgwMDE1IDMwLjgxNzY0LC0xMzkuODIyODQgLTAuMjkxNjEsLTAuMjkxNjIgLTku\nODQyNDcsNS42ODkzIC0yMS4yMjQxMywxMy4yOTA5MyAtMTYuMTQ2MjMsMTAuNzgzOCAtMjAuOTc2\nMTEsMTMuMzE2OTEgLTIxLjk3Nzk0LDExLjUyNjczIC0wLjgzMjA1LC0xLjQ4Njc5IDMuNzM0NCwt\nMTIuOTg4OTYgMTIuOTcyOSwtMzIuNjc2NzUgMTEuNzMxNzcsLTI1LjAwMTA5IDE0Ljk1NDE5LC0z\nMC41Njc4MyAxOC4xOTM2NiwtMzEuNDI5NTggOS43NTY1MSwtMi41OTUzOSA3Mi45NzkzOSwtMTQu\nMjQ3MjUgNzMuOTczMSwtMTMuNjMzMDkgMC42MTA5NywwLjM3NzYgNS40NTM1Miw3LjcyOTkyIDEw\nLjc2MTIyLDE2LjMzODUgMTguNDI4NTMsMjkuODg5MyA0Ni42NDY3OSw1MS4xMjAzMiA3NC4xODk4\nNSw1NS44MTk0MiAxNy4zMTc3LDIuOTU0NTUgNTMuMDE0MDUsMS4wMTUwNCA3Mi4xMzIwMSwtMy45\nMTkyIDE1Ljg0MDExLC00LjA4ODI0IDQwLjE4NjU1LC0xNC4yNjY0MSA0MC4xODY1NSwtMTYuODAw\nMjEgMCwtMC43NzA2NCAzLjQ1MjM2LC0yLjY0NjkyIDcuNjcxOTIsLTQuMTY5NSAxNi4xODgwOCwt\nNS44NDEzMSAzOC4wMDY1MiwtMjYuNDkxMDIgNDcuMTk1MzMsLTQ0LjY2NzI2IDEuNTgzMzgsLTMu\nMTMyMDYgNS41ODM5NCwtMTMuMzI2MTkgOC44OTAxMiwtMjIuNjUzNjMgOC45OTI3NywtMjUuMzcw\nNDcgNy4wOTc4NSwtMjQuNDg2NjkgMzYuNzQ2NjEsLTE3LjEzODQzIDY3LjI5NzE3LDE2LjY3OTE2\nIDEwNC43Nzk1NCwzNC40NTc3NiAxMTUuMzcyMzQsNTQuNzIzMjYgNC42NjI0OSw4LjkyMDAxIDIu\nMjQ1MDksMjYuNTM1NDYgLTUuMjAxMDcsMzcuODk5NzMgbCAtMi40MDA1LDMuNjYzNjMgLTEwLjg2\nMjkzLC04Ljk2OTY3IGMgLTUuOTc0NjEsLTQuOTMzMzIgLTE1Ljg2NjU3LC0xMS40NzYwNSAtMjEu\nOTgyMTUsLTE0LjUzOTQgLTEwLjU3MDYzLC01LjI5NDk0IC0xMS45NTk4NywtNS41Njk3MiAtMjgu\nMTU4ODIsLTUuNTY5NzIgLTEzLjkyNTE2LDAgLTE4LjMwMDcsMC42MTA0OCAtMjMuOTM5MTUsMy4z\nNCAtNy44NTc2LDMuODAzOCAtMTYuNzMyMzMsMTMuMDkzNzkgLTE2LjczMjMzLDE3LjUxNTI4IDAs\nNi4wMjM5NCAzLjgwMjgsNy4wODY3NCAxOC45NjAwMyw1LjI5ODg5IDE5LjU4Mjg5LC0yLjMwOTg4\nIDMxLjg0Nzc4LDAuMzI2NzggNTIuMzQ4OCwxMS4yNTM3MSAzMC4zMDM5MywxNi4xNTE4MiAzNS43\nNDMyMyw1MC4xOTU1NiAxMC42MjI0Niw2Ni40ODQxNyAtOC4xNjMyMSw1LjI5MzExIC0xNy4yNTQw\nNyw2LjY1Njk1IC0zNS43NzUxOCw1LjM2NzEyIC0yMC40NDQzLC0xLjQyMzc5IC0zMy4wODE4Mywt\nNC43OTM1NSAtNTEuODA5MTEsLTEzLjgxNDc1IC0xNy4wNjg5LC04LjIyMjMzIC0yMi4yMjgwMiwt\nMTIuNTk3MjIgLTI4Ljc0ODE4LC0yNC4zNzgxOCAtNC44Nzg1NywtOC44MTQ4NSAtOC42MjYyNywt\nOS43MTU0MSAtMTUuNjkxMzIsLTMuNzcwNTcgLTguNTI1NTYsNy4xNzM3OSAtMTUuNTA4MzgsMjgu\nMjI5NTcgLTExLjQxMzUyLDM0LjQxNTk1IDEuMzM2MTYsMi4wMTg2MSAyLjE4ODk2LDEuODA0MTgg\nNS42MTEyNSwtMS40MTA4OSBsIDQuMDM1NjEsLTMuNzkxMjcgMTcuMDM4NCw5Ljk1ODk4IGMgMzUu\nNTM5MDYsMjAuNzcyNjcgNTAuMjA4OTYsMjQuOTE2NTIgODguMTIwNDQsMjQuODkxNzEgMjEuNjgx\nNTEsLTAuMDE0MiAyOS40Njk0OCwtMC42NzYxNSA0MS43NzQxNiwtMy41NTA2OCAxMS41NTQ5NSwt\nMi42OTk0IDE1LjY1MjYsLTMuMDk0ODIgMTcuMzYyNzcsLTEuNjc1NDggNy44MDk1NCw2LjQ4MTM0\nIC01LjQ1MzA3LDIzLjg4Mjk0IC0yNi4wOTU1LDM0LjIzOTM2IC0xNS41NDQ3NCw3Ljc5ODg4IC0y\nNS44NDk1NCw5Ljk1NTk4IC00Ni43NzAxNCw5Ljc5MDM3IC0yMC41MDgyMiwtMC4xNjIzMyAtMzIu\nNzg2MzEsLTIuMzM1MDIgLTcyLjAxNjA4LC0xMi43NDM3MiAtMzguMzk1MTUsLTEwLjE4NzI1IC00\nNS4wMjk3LC0xMC45Mzk5NCAtODUuNDYwMjYsLTkuNjk1MzkgLTE5Ljk4NzM4LDAuNjE1MjUgLTQ2\nLjE1MjY4LDEuNjA3NDcgLTU4LjE0NTEsMi4yMDQ5MSBsIC0yMS44MDQ0MiwxLjA4NjI1IDE2Ljgx\nNjkyLDE3LjM3NDc1IGMgMTYuMTMyNzMsMTYuNjY3ODUgMTYuNTkyNzMsMTcuMzM2MjUgMTEuMzA1\nOTksMTYuNDI4OTcgLTM4LjU1NzkxLC02LjYxNzA1IC01Ni4yMzUwMiwtMTQuNTMxMDUgLTgyLjIz\nMDE3LC0zNi44MTQyNCAtNS43NzQxMywtNC45NDk2MSAtMTAuNjc1NzMsLTguNDc3NTMgLTEwLjg5\nMjQzLC03LjgzOTgxIC0wLjIxNjcxLDAuNjM3NzEgMi4xMTE3Nyw5LjEyNzUyIDUuMTc0NDEsMTgu\nODY2MjUgMy4wNjI2Myw5LjczODcyIDUuMzk1OTgsMTcuODc5MjIgNS4xODUyMSwxOC4wODk5NyAt\nMC4yMTA3NiwwLjIxMDc2IC01LjE2OTAzLC0zLjIwMDg1IC0xMS4wMTgzNiwtNy41ODEzOCAtMjMu\nOTk5NDIsLTE3Ljk3Mjk4IC01MC44NzkxMiwtNTEuMzEzMyAtNjIuNzg3OTQsLTc3Ljg3OTIyIC0z\nLjA4NTczLC02Ljg4MzU3IC02LjI1NzMzLC0xMi41MTYzNyAtNy4wNDgwMSwtMTIuNTE3MzQgLTIu\nNTc3MzMsLTAuMDAzIDAuMjU4MTEsNTEuMzM0NzIgMy43NDAyMyw2Ny43MTk1OCAzLjI3MDM5LDE1\nLjM4ODY3IDguMTk1NzksMjQuOTQzOTYgOC4xOTU3OSwxNS44OTk4OCAwLC0yLjM1ODc1IDAuNTg0\nMTksLTQuNjQ5NjkgMS4yOTgyLC01LjA5MDk2IDAuNzE0MDIsLTAuNDQxMjkgNS45MTgwNiw3LjY0\nNTkgMTEuNTY0NTQsMTcuOTcxNTIgMjIuNzkzNjgsNDEuNjgyNDQgNDMuMDkzMDksNjcuNjAyMzkg\nODUuMDY3NTgsMTA4LjYyMTI0IDMwLjAwNzIsMjkuMzI0MDMgNDguNzIzMzcsNDUuMDA0MSA3NS44\nNjM3OCw2My41NTcxNSA5Ljg2MzExLDYuNzQyNDMgMTguODU1NTcsMTMuMzcwNjMgMTkuOTgzMjYs\nMTQuNzI5NCAyLjU5NTYzLDMuMTI3NTggMy4yMDU3LDMuMTI4NzggLTI1LjY5MDY3LC0wLjA1MTgg\nLTMxLjk4NTcyLC0zLjUyMDggLTMyLjc1OTM2LC0zLjIwMTQ1IC0xNy4wNzA5Miw3LjA0NjQ4IDE0\nLjk5MDI4LDkuNzkxODUgNjUuNTQ3MjYsMzUuODQ1MiA4MC4zMzEsNDEuMzk2NjIgMTcuMDk2OTEs\nNi40MjAxNSA2OC4xOTY4OSwxNS4xODQ1OCA3OC44OTUzLDEzLjUzMTggMy41MTIzNSwtMC41NDI2\nMiAzLjE4MDE1LC0wLjk4MzEgLTMuMzE4NywtNC40MDA2MiAtMTMuNDMzMzMsLTcuMDY0MDMgLTQy\nLjExNjg2LC0yNi4zMzUwNSAtNDMuNzU1NzIsLTI5LjM5NzMgLTEuNTY0MTcsLTIuOTIyNzUgLTAu\nNTI4NzQsLTMuMDQ4OSAyNi4yODk3MiwtMy4yMDM1NSAxNS4zNTQ5MSwtMC4wODg1IDI5LjU0OTMz\nLC0wLjYzMzY4IDMxLjU0MzE0LC0xLjIxMTMzIGwgMy42MjUxMiwtMS4wNTAzNyAtNC45MjExNCwt\nNy4yNjgxOCBjIC0xNC4zNTMzOSwtMjEuMTk4ODIgLTQxLjYxNDIzLC03MC4yNzUzIC0zOS45NTY5\nLC03MS45MzI2NCAwLjQ0Nzc2LC0wLjQ0Nzc3IDUuODI2NTUsMS4zMzE3NiAxMS45NTI4MiwzLjk1\nNDUgOC40ODAwNSwzLjYzMDQyIDExLjI1NzY0LDQuMjQ2MDkgMTEuNjM3MDQsMi41Nzk0NCAwLjI3\nNDA3LC0xLjIwNDA0IC0yLjA2Mzk2LC05LjY5NDgyIC01LjE5NTY1LC0xOC44Njg0MSAtOC4xNDcx\nNSwtMjMuODY1MzEgLTE1LjQ3ODUsLTUzLjYwODExIC0xNi40NTMsLTY2Ljc0ODYzIGwgLTAuODM4\nNDYsLTExLjMwNiA2LjM0MDExLDE2LjE1MTQyIGMgMjEuMTM0OCw1My44NDA5NSAzNS45NTU4OSw3\nOS4xNjA4NSA2My44MTE5NywxMDkuMDE0MzUgMTYuNzk4ODEsMTguMDAzNDUgMTYuNzk4OTUsMTgu\nMDAzMyAtMTAuMzc5NzgsMTMuMDM4OSAtOS45ODYxLC0xLjgyNCAtMTguNDQ5NSwtMy4wMjM0OCAt\nMTguODA3NTcsLTIuNjY1MzUgLTEuMTM4OTgsMS4xMzg5NSAyOS4xMTk5NywzMC44NzA2NyAzOC4z\nNjE1OCwzNy42OTMxMiA0Ljg4NTgxLDMuNjA2OSAxNy42MDUwNSwxMS4zMzgzNSAyOC4yNjQ5OSwx\nNy4xODEgMTAuNjU5OTQsNS44NDI3MyAxOC42NTQ4OCwxMC42NDUyIDE3Ljc2NjU2LDEwLjY3MjEz\nIC0wLjg4ODMzLDAuMDI3IC0wLjU3OTEsMC43MDQ3NyAwLjY4NzIxLDEuNTA2MTUgMS40MjA1NSww\nLjg5OTEgLTIuNjAwMjksMS4xMzA4NSAtMTAuNDk4NDIsMC42MDUxIC03LjA0MDQ0LC0wLjQ2ODYg\nLTE1LjM0NDYzLC0wLjE2ODUzIC0xOC40NTM3OCwwLjY2NjkgbCAtNS42NTI5OSwxLjUxODgyIDku\nNjkwODUsNi4yNjMyNSBjIDEyLjU3MjA5LDguMTI1NDMgNDAuNzQ0NTUsMjAuNzAyMDMgNTUuOTAy\nMTYsMjQuOTU1NjUgMTMuODY5ODEsMy44OTIyIDU1LjkzNTExLDYuODkwNCA4Ni4yMzAzMyw2LjE0\nNjAzIGwgMTkuMzgxNzMsLTAuNDc2MTggLTE2LjE1MTQ4LC01LjQ2NjM3IGMgLTI1LjcwNzU1LC04\nLjcwMDYgLTQ5LjI2NTE5LC0xOC4yNTYyIC00OC40MTE3OCwtMTkuNjM3MDMgMC40MzE4OSwtMC42\nOTg3NyAtMC4xMjc3OSwtMS42OTc5MiAtMS4yNDM3NCwtMi4yMjAzIC0yLjUyNTI1LC0xLjE4MjE1\nIDE2OC4wNDg2NSwwLjQ3MDMzIDIyMS40MTA0NywyLjE0NDkzIDQyLjM4MDQsMS4zMjk5IDQyLjE3\nNjc4LDEuMjgwNTUgNDIuMjI2NjUsMTAuMjM4NyAwLjAyMzIsNC4xMjE5MiAwLjUxODg1LDQuNDUy\nMDcgNi44ODkxMyw0LjU4NjI1IDE1LjI1NjcyLDAuMzIxMyA0NC40NTI2LDIuODY1NTIgNTEuMjgw\nNyw0LjQ2ODggMy45OTc1LDAuOTM4NTUgMTkuMzkwNCwxLjczODEyIDM0LjIwNjQsMS43NzY4MiAy\nMi4yNzAzLDAuMDU4NSAyNy4zNzgxLDAuNTEwMTUgMjkuNDc2MywyLjYwODQzIDMuMTAxMiwzLjEw\nMTE3IDMuMTA1OCwyLjYyMjQ1IC0wLjA5LDkuMzIzMDIgbCAtMi42Mjc2LDUuNTEwMTggLTI2Ljg0\nODcsMS4wNjcxIGMgLTEwNi45MzEzLDQuMjQ5ODcgLTI1MS40MDc5Myw2LjUxMzQ1IC00MTguMjU5\nMjgsNi41NTMyIGwgLTE4MS40NDIwOSwwLjA0MjcgLTMuMjA1NzcsMy45NTg1NyBjIC0zLjA4OTAz\nLDMuODE0NDMgLTMuMDk2OTEsMy45OTg5MyAtMC4yMTY0NCw1LjA2NDQ1IDEuNjQ0MTEsMC42MDgx\nOCAzNC45NDg5LDIuMzI0NCA3NC4wMTA2MywzLjgxMzgzIDEwNS44NTA1NCw0LjAzNjA1IDExMS40\nMzg2Myw0LjQ1MTkyIDExNS44ODA3Miw4LjYyNTA3IDIuMDM2MDEsMS45MTI3MyA2LjU4NTAzLDQu\nMTUyOSAxMC4xMDg5MSw0Ljk3ODEzIGwgNi40MDcwOCwxLjUwMDQ1IC01LjY1MywwLjQ1ODQgYyAt\nMy4xMDkxNSwwLjI1MjE1IDAuMTYxNTEsMC44NzAzIDcuMjY4MTQsMS4zNzM2MiA3LjQ5NDQ4LDAu\nNTMwODUgLTExLjM3MzY3LDEuMzc1MDUgLTQ0LjkyNjEzLDIuMDEwMjMgLTYzLjE3MjM1LDEuMTk1\nOCAtNjIuNTI3MDcsMS4wOTIxNSAtNjguMzY4OTYsMTAuOTgxNjUgbCAtMi44ODQwNCw0Ljg4MjI3\nIC0yNC4yODI2OCwwLjAxNjUgLTI0LjI4MjY3LDAuMDE2NSAtMi44MjMwMSw2LjcxNjg1IGMgLTEu\nNTUyNjUsMy42OTQyOCAtMi42NDI4Nyw2Ljg3MzQ1IC0yLjQyMjcxLDcuMDY0OTMgMC43MTIxMyww\nLjYxOTM1IDExNi42Mzg4NiwzLjkyMzQ3IDE3Ni40MzAyNSw1LjAyODYgNDUuMTY2NDgsMC44MzQ4\nMiA1OC42MDUsMS41NTQ2NyA2MC4yNzk0MSwzLjIyOTEyIDEuMTg1MTQsMS4xODUxNSAxLjQ0NTU3\nLDIuMTU0ODMgMC41Nzg3MiwyLjE1NDgzIC0wLjg2Njg0LDAgLTMuMjMyOTUsMy45OTc0MiAtNS4y\nNTc5OSw4Ljg4MzIyIGwgLTMuNjgxOTMsOC44ODMzIEggNTg0LjM4NDE4IDQ0Mi41NDg4OSBsIC0w\nLjE5MTA4LDYuMDU2NzggYyAtMC4xMDUxMSwzLjMzMTMgLTAuNjUwMjIsOC4xMTI4IC0xLjIxMTM2\nLDEwLjYyNTggbCAtMS4wMjAyNyw0LjU2OSAtMTMuMjQyNTIsLTkuODY2NyBjIC03LjI4MzM3LC01\nLjQyNjggLTE5LjAxMzgzLC0xNS4yOTYwNSAtMjYuMDY3NjksLTIxLjkzMTgzIC03LjA1Mzg0LC02\nLjYzNTc3IC0xMy4zNjI0OSwtMTIuMDY1MDIgLTE0LjAxOTE5LC0xMi4wNjUwMiAtMy42MzkyOSww\nIDQuNTI3NTYsMzMuMjQ1MDUgMTQuNjMzODgsNTkuNTcwNTUgMy45MjAzLDEwLjIxMTkgNi45NjI0\nMiwxOC43MTY2IDYuNzYwMjYsMTguODk5NSAtMC4yMDIxNywwLjE4MjggLTIuNzcwNTIsLTEuMzI1\nNyAtNS43MDc0NSwtMy4zNTI0IHoiCiAgICAgaWQ9InBhdGg0MiIgLz4KPC9zdmc+Cg==\n",
-				LogoFormat:       "svg+xml",
+				LogoFrom:         &catalogv1alpha1.LogoSource{Bundled: "local-ai"},
+			},
+			DefaultNamespace: &catalogv1alpha1.AppNamespaceSpec{
+				Name: "local-ai",
 			},
 			ChartVersions: []catalogv1alpha1.ChartVersion{
 				{ChartVersion: "3.4.2", AppVersion: "2.23"},
@@ -334,8 +432,10 @@ persistence:
 				Description:      "Trivy-Operator is a Kubernetes-native security toolkit.",
 				DocumentationURL: "https://aquasecurity.github.io/trivy-operator/",
 				SourceURL:        "https://github.com/aquasecurity/trivy-operator",
-				Logo:             "iVBORw0KGgoAAAANSUhEUgAAAH0AAABGCAYAAADhPZtLAAABA2lDQ1BJQ0MgUHJvZmlsZQAAKM9j\nYGA8wQAELAYMDLl5JUVB7k4KEZFRCgxIIDG5uIABN2BkYPh2DUQyMFzWZSAdcKakFicD6Q9ArFIE\ntBxopAiQLZIOYWuA2EkQtg2IXV5SUAJkB4DYRSFBzkB2CpCtkY7ETkJiJxcUgdT3ANk2uTmlyQh3\nM/Ck5oUGA2kOIJZhKGYIYnBncEL4P38RA4PFVwYG5gkIsaSZDAzbWxkYJG4hxFQWMDDwtzAwbDuP\nEEOESUFiUSJYiAWImdLSGBg+LWdg4I1kYBC+wMDAFQ0LCBxuUwC7zZ0hHwjTGXIYUoEingx5DMkM\nekCWEYMBgyGDGQAefz5WhPghuAAAAAlwSFlzAAAWJQAAFiUBSVIk8AAAFyhJREFUeF7tXQmUVcWZ\nrqq7vnvf0t2vm95puhsaaFRAZGtaFgUDggpR3ILGaISJUcfESTRzMoPGLB6NOiQuExc0RjN4WIwS\nUcIOaUBWUWj2XuiN3t6+3bXmr8eDo56sMwd5dL/vUH3vreWvuvX9/19/3XffA2WQQQYZZJBBBhlk\nkMEFQ+z490vDJ+66NHWZQV/Gqe2nHNrBqd8wDpXsNY8XtsTrxz3R+9kTpaniDPoawgdvuso8MnSV\ndTDLoocxpYcQpUcUah4p26MdvvruYzvXuFNVM/gScOp40SCx71vVVNy3UOA7FnDE50UREyH4lwSB\n5ETItj0WJfkfGVbFc47qDzcijOmZChkwXDSkh479LE+Mr75L4Ju+TWR/FbI0hAwo4D5/C3BO7TN3\nJWFkRbPDNi1appEJS1wjXj10pk4GaU86pVSI118zV8SHH+IkXw0SYuDbeWTpaieWlMO25WilOh+n\nGFEwdJ5IlptSfznWE8NJtq4ggSArnN1s26UvB/Dc1wdU/7gjJbrfIq1Jjx2+oUagRx7kcMc8nBUR\naS9vUDNnk8EP/sjSBpziBQdnCzQL42AWoQkRYSlq2qqP6laEt0yBCO2jCT55A8kODUWWgMxI3i7b\nrnpBkNa9Q6oIuIr+ibQkPX7wvsE83fkdwjUtIGpgALI4ZEVyN1no0tconxMT0MGJNuqZRPTIMIxM\nNzhyHsGyTSn8sTmd8lI3Etx7LL10q86NOSzYWybxYsN9xB3NsXtcNiJFq3Wueolj+KpNqS77FdKK\n9LZNi3PzCzcvQFbDdzi+uwqRBKK6M2GZlU8jsXYj4TZ+HdnttxAcHYAMiN70VEMWwLE7YeGaBYmD\n5IBkS8gmnr2mPeYFU8vqkaT1izlH9xhkcBDsZftMXPi27r/yN67xL/ar9T4tSKcU7LRx+gIbnfgO\np/dMhAgsmWlzrqBuXPZ9G+X6ZKXuJ4QELkVhIDsBw5aAYRnYpiIQSDVEbA08goPwtoAsiPDiUM6U\nwAmLPXZYBq74dTA8fVWO852fcNzpqclymSmFtwHZZctIYtQv8WUv+c+MqG+D2cQFBsWqOjW/Ztza\naVhqmY6NmBtZNhCuRnS75nuEw6Yo73iB2IFBqDcVmbs44NPVbeHCD8xE+QoTj1hmiqNW21bBettw\nHEQ8BHbILCUOMOkwNLEMwqmBCSLXjuORG54VcPMYWP7zEQW3wBsK5pWWo73zD2762h8C3csfZ76i\nT+OCWzqz8uEVex4Nh0u9Lz79q/XX3fj8TCDqdqt30G8Nq3i3JP35FYJDbhSAym5mvHLcIMVvmOa4\nDyWOOrF16DLDDpdiJKgYm2Eq5DaadNgBSjpU2Tz4L7BM1KBwikcvBHNa6bOaefkBh/znZ7EV3hOO\nj/j1qO7VAwWijRoRPPTYuzfO6vPRfVq49/L8Q8+Fegc/FDPjfy4q7n5z2dKnmq+o7e6l3Tvfwvzp\nYagNKrkwc8WnNLvmP0wkRFS0615MeydjoimweEMF5svhdigPV45ukxYvj+k3L3fw/3OXxDd8M/kQ\nxwNViAcM/NLbQIMa57c9UrAyMfoG4kbfyLEje29pPHTdC9+c0Qu1+jRYCHTBgTkKm+8AkrFRG+h0\nPT/ha0+57/nPB3qidtlLlCg7UCGs28TdaRhz7idmNFcl614nfOtMWAoUFAbCQ0A4uPHkMWogYoTy\nRPnYfW751Sdsa/6rFi1YjkpkRG1HMzWUJ9b4px0ndX/0fWBW/ZzzGN+CkEBEhh5x8TLTnj6PtCAd\naGcmCq4euEcRa8Llh8VNb1X/3FuxfsS//+rpx5Ar73HMjf8p5o9NlOS6Z4BUN2UhlwbNZCA6C0KT\nbB68AdwO811x+NNrIeJoq5WkN55CZMYKalX+Yl9w8iK+qaF9gX/hf03O2jlaRvGglYgirMOWnRIs\nKU7mLvo80oT0M1xhxCMiGh1IsPx6WL/cSfiFLz512xJ3QfOp5sHvvCWU9jipxEUQxyEsAD8eCOiU\n7JOms/BlUyp6kioFf6KSw0YKlKkgMaEgzJtRYjbsI3s/WzYp9Pa/EiX4QkKgtYIkYQemJzBhU0CS\naoey2Ej6PtKDdJt5VTbrQDq1Ar4eKpkmVantQzx1DFPF7ltvGPHJhKpRq/a3+q68k+ZKq6nXbVJH\n8cro/usXxe58sC52+30d/sfuedZ2Vj1M1Zww5VxHKSq894amtUtGnVo6vIQ7dD11CrPMhEaortlI\nVDxOausE+gYfk5wIORphg+jzSA/Sk6OAMB5CMODAsg2Rp0iABMEXDSEBW52yQAb7mr0vlI1eOW/m\nvcuWYnHY7f43rnuNeybrIRdqfMWtNC1xN/peDHx7NjQYOn9V7L77ybFDWR+qQ54P8/gOL8adKAQ+\n3zbYh24EUY7jKbERhs7Zgzxqo2AwOZo+jzSx9DMHZnEcRySniiAaYx+jATdMGQhVtTjpItjEOZje\nseP9mpdw4c6QZ29knMNrz8EYAjEzjnjVrsg2u+4cveKPrTdFf/Qw7wk/bROtLG5qQc2iFMwY/sEt\n27bF2VYoRBBvM9tmJWkyFV8F0uROzw4DXK3F5+bmm0gUrF6cfHZkI8OMDRFlGuI4o9WmYSQisYDz\nBq8lLsuHjASQBlrDQjBIhEabIqODNYKtzTTjYYQjUeRApEOjlodyQnLxJpRGLS0U1BDnZc9nkrqA\nbZQJ5L5yMGsD324J2QlNUgTJgt05I11Dti4PcqsKL8v6ATZkG6J8RzRRuyRcfYCK8U8R70BIkBAy\n7SBV0MpmSZ5gs0exkDjLtAcJ1gmseAZZBCJ8uGNBEJpDpmUmDHMoskzmSaCfjKV/xTjj39kabtuS\no71RrhQd0QOEY86dWbrsbGoUaz25sfc53oA8HYkGvvzRbbdOR/nhH1PRaqUYh2io8weFP3rSy+l0\nvhWH7Z+oIgVZx11aoN1P7Sst00SEl5CC0W4HwEBWOTI12CrCNDDmM9H7hQC4d5tD8bhYU1AW2Ud4\nPcy2cRSCr0jIfZO3oOuEpMTWE6zAMpBASlj6Qe7qJaP2857vxvOku0ueeY4PWORpQzNcyDYRL/Eo\nH2tvNnPZlVHEXcbetuEtw87RI5tbkDTBlFWIBViwaIPLt3Emer9g0JCWkCdGowYRVWMT+4yUoAgy\nE2plQ33xreWVrU/yciQAThph05CR3/mTye8uHDz0zSd7AlR6VrPsXJs9cMnxouxYeP34SM/6FsTf\nb2IOY1FCKkUHLiOo0Ye42ZbFPAwLBM74mkz0foFAgXTLcGT52/OuyS/2vUNEU7cRe4QaQdFwzr09\nfqW4oKjrQcEhRGxQCGoQsObE0JLjLWW6psvItBDvzUVZicTOseGuxRuU4oVBWb3E1qJI4Dg00NaX\nHrD5sTFRqQbtgh77hXF/AWlHOturUzuBQgHXjbISDSjO2HJMstjKDgEdkX2ni38piDhUVNK6UFbM\nFsJlnzFWbFtEdUIErtr5RvTtqYHm7+335N3eJUr3WNEgwu4c5DES6y41W3a3iY6FoCvQFXuzsv8h\nDUlnQ0ogU1dzTx0pXFg++LPfCI7QSQxRFkUB2KEJea1NRa9oOu+pHN58u+JuXyk6zYQjIvDZtrGl\nSvMvGpM4/caOrKJHe0T1u0YMAjrZBW5d7xwab/rZVrnw5qioDkORyOdsnG3ZcGbLdqHA4nX2hAzB\nfjwe81zfdmrQ5LLy9n8TZTNEUTaU+pEWR3ndHd7nmxvyFmaVHH5tZK3vVxPag5tr/KeejFFcspEr\neLNbUG8wQrD08yJE67ZZmeh+NKTmDexEzgfMGBAOwVsyak92yvqDlInev0JQJFEqJE+SKUlGAtZ2\nAwd6ix+Napy7eFDTw4KMohjlgF2GwNUbXCLoueP08ZG/3b/Ffc2uarlss6vo16dE9+IIIoWWvxcs\nXEUOUTAGarEfFif0042c90kNIkDOSIBhsz5SgRyG/TsmkubvSYM3ic4/0oJ0yYE6BAmISL7lAAQk\nyWCLbgyIN53drYXPccQRL61o+K6kmO0YD0iu8Zbth12YnEcT8hUJQ6qIYGGwEYMQPAEu3Z2NVILD\n5Xr44VLTf2SnI++lCKVFKBJGNhBusz4IuHVFRZIsIBfVuwaT6NlXLfs00oL06ZNaXs4b0LlI9YQP\ncTwj3pW0ebbmspfcjDjJaTo54HnNFNXK0Yfvkp2RLRwEdxS5k5/Bg2eOUkvXUDzMnqUiLsuLsiz9\nk5HRrjtUI+HbpRS86uelQTTsT94xZa4c3Abn8YKake6ywOlfjkXtj3x77lz2KkafR1rtV2Zcuba8\n8WTJvVG/625NU/NtOwocxWCQGFlIRZyATdUTfGVg2em3e9oLJvsDWXdbxoDBbuvI0sJjwpa9vXm/\nJbFYd7YdemOq3rlqj1Q6/RSSfhintgtHw0A2MC5wiKgupJiGlmMb75ea+rN111bvTA2hXyCtSD+L\niaPqarraCh6IRBxf1xO8SGkQyDfBshVEONiWOWL7cgo6XvB6extaTg2fI+u+npIPXEcaTMe0ku62\nNbqoOE+KygN+QZmix2HZgD06c+VU8SCJwyjbin9cbCSWPLKzfuXNj9/cL1z655GWpDMsXrxY/mDF\n7Lmdbd4H4zF1IntEgxCQl7R7N1h9AtZiY0N2Qc97eR7fn0Y+o4T2G+6rD9uuuWEiztKIqNiwP0fU\nTAYNIiS3lWjIo9Zvao22370ya0q//U5b2pJ+FvPmrR1wZG/ZXUGfvEiLOyssKw657F06How3C2ER\no1zU+IviI/KuXS05v48h0WFHfAjpYMCigIgT9uiJWLgAm2+PjPS+uGJezWdJwf0YaU/6WUwa+/GI\n9kbP/fGIepuuSR728SqGCJ5t4XJQ+8v5R7ntu9rUN4wo+16ijbCqIIdpUS+mH+abkef2zLps/RlJ\nGaRF9P6PoG73+EMN3cPuKxjUOt/jDXwkSUxf2dMUdsQJbNk6Nk0bORUkgXXn2daBYYa+aHG0/dYM\n4X0Azz+/2Dmycu/CUnfjp3miQQeh+lfHHa2/U97ZSz0bWjqGrTv209s31JWlqmfwJVw07v0v4cpx\na8tbmwsekQwjWLzGdag5IczKj4WX1F07sl9twfod2Hfhbr3uvfyHV34yoHHTJjmVnUEGGWTQz5HW\na/rICVcV64pQEbMSu5unTtUvXV83TiO4c9y4y7rCZo+g2e54nm076492mYlE9yVhbDWc2rat3/+Q\n0N9D2pJePX36QCOs32FQ5JQk/qggCTEtEr/EojTm9rgNged2Kx613orqtwcCIT6ux7MJzydcWFq6\nb9vaDPF/A2m7T+ctazDibKdE0O8VUbwEW+h6jsNrsjxZB+F8XDgYLW9p7BgajsWGEo6Uyg5lBbF5\npGN6SUpEBn8FafvSQEFZmd+00CBJlqdgXggRSutEQoe4nS6Z8uhYIpFwE56MNylaztvmaYrpLJ4j\nOQLHbT3deKIzJSaDv4C0XtMnTJjgMNy5ZaIq+Ha8+27X7NrZ2S2KNU+QxZAgih/YkYh310cftbK6\nl0+bWSm4JWugKLYsX768z/9uTL/C+Nmzp0675ZZrUpcZ/B9w0Tx7P4uRxcV1Q666ql/+6F9aY968\neWNnz549MnXZpzB1au2kadMmjUhdXpQ4L5YeDocvicfjFanLLwNfe+21w6dMmXJRvnDs9wdGd3UF\nylOXFyXOC+mWZbHvC9EHHnhAmj9//rkdwuLFi/mZM2e6oHxIQUGBN5V9DpT9uCul/9SOAuQ7FyxY\noKYuvwBWNmPGjL9aBuMRU5dfwOflwXjdbNypS2SaNCGKHN60aRMP+efmD+R5/pI8dk9z5sxRUpdp\ngfMSvdfW1t4GNzvV6XTuHjBgwJ98Pp9b1/UhkMo8Hs8OqMLeS8vCGNP33ntvM2vDlAM8xHye551w\nXLZly5YIyx8zZoxQXFw8BU7r33///XaWx3DTTTc5QO5sjuPyQY4NsttlWf4IoIEXcYqiOIsQ4jVN\nExuG0Zqfn78Wonp97ty53mg0OgPauGCMBBSwfuPGjduA6HK/318JabjL5ToGbT6BsmtApmLbNmLX\nmzdv/ri6ethCRXGOzs3NrausrPxw3759nCAIM6GeCvUxjOPgtm3btt544/Wjw+FEGWwtBzocjqNr\nAamhX3Ccl3360KFDL4EJ8MOkbhw4cGDH/v37F8H1dCBoGUxKQygUmgATqQEmVldXHzt+/HgELH84\n1JkFk+SCpeFgW1tbiMmqqqoSIX9OLBbraG5u7oEsDAoidHd33wmE5oDMNZIknYQ2Y0He4IqKiqNQ\n5xvQxgmkr4VjA6RxwWCwDEhqAvm3QTv2/dT1QGQXnE+GNvT06dPDQMb3IH81kwdlt0BZJ5xvhvMQ\nyLga6hnRaNgFOtALRK8D5cFQ507oh41rPcj2QdmM4cOHWZ2dnWPi8cQ90O4PUHdvY2Mje88rLXBe\nSIfJuRQsrW3Dhg17du3aZeXl5Y2H7E1wvgGIM8rKyq4A7d8Dk8ks0dvU1HS0tLT0Opi8drDAGOQ1\nAunsh0HRuHHjCEzmEJh8DuQMgbaVkF0Fk5kHdZeCm+0EpfGD0hwBLzESJriI57Ha2yu8tWPHui6Q\n7S8qKjoOslnZEEhydnb2VkgYPFEClI8RNxGUkUKdwzt37nwHZI1hbrmuru6dEydOhEBGByhfL4xh\nbCQSFaD+0T179nxWWFg4mXkLsOy3gdRwS0vL6YqK8g4Y1xjTtHIoNbfv3r13ZToRznBe1nSYQAHS\n2f84h1MUpRsISz5EAdI4NqHgRtnSsh+IHz527NgCmKgicJEHoYyHZLK6DOAJbChj1t4LpH4K6TNo\nA1Vo6OwSwAAKFQJFawJCKuLxWO+nn65jr84mAUT6oF0bpEIgVmWkgOeYAmNkS1AukHkEXHpvTk5O\n8qVJULJCWGK+8FSPtQfZOtRVRNFxxlgsq0CLRr/wnB+WkWbwPhrUoxjzR1LZaYXzQjqQwtzoqJqa\nGhdcWjDJjGj2ZbWzwDCJLOg5AmUakH0XWFw3pMMwsTxcn/tvtmAdrw4EAtPB9frBolqA6FaocxDI\nK5k2bdq5V6Kgr0rotyoY9HVC3TkQwA1IFaGrr766CmQPg3afsDUFCN387rvvvr1q1arfw1JzFPpr\ngHLmYZIBHBDW6Ha7q1nckBQA6OrqurKnp2cUeIpmp8d5OYs1BFn+TFSUEXB+LlADDzUJlMkFsnyg\nqOfapxPOi3uHCetQVbUciJ4BQZgJxwJw590NDQ0tWVlZvNfrhRkTWiAw6igpKckHsu4H6/hvCKha\n4FqDCZtbXl5eCF6hGsRNh7pgkM51J0+eTLp8cLfBpUuXOmFSZ4PrdkMfw0AJroHr3batd0iSYyzE\nVHmFhfm54IKHg2JNh7R76tSpG8HVDoT+agcNGpQDfY2G84nQXz0QnQP9EFgqjo8YMaKTKQkoRw3E\nJFmQRoEXmQhjiEKd5RalVTwh02Aox6BPD8i+EgI7F8gcC8p8OchaA0pfBGPSQQmOszGnE86LpdfX\n1+tA4utw09vBioDnrJ0wWSdZGazRBkzQViA+6RZBIVg0/xgoBXOFePv27Z9A3ddg0iVIMijQByBj\nLxBzbqwwyXTr1q1roN4foJ88SDmQtxLc+BZRVCGA49dKEr8OCMiDlA3jWA5eYuPjjz9uQ7sVIGI7\n5LEyG45vQFxwCEg9CqTWM/lsBwCEvwlyd0MqgDINdh0vwrr+5qJFiwK+7u7XIO9TqOp86KGHfgck\n74ZUDrIwLGWvr1+//jAc90FeUl66Id0+cMGzZs2aDVYSgqDoY5hoGZTh6zDxbJ/8O3DtbP//NzF+\n/BWzILYqhDV+aSorgy/hvFj6/wMU3C3bLo2CaP6bYHl3ggXbcM327X+XcAaOEwOyzLMtVAZ/BWn5\n0Sp7UAN74BKw8MTq1av/qc/GZ86cKYGykBUrVqTVNimDDDLIIIMMzisQ+l9qEBz93WV2ZwAAAABJ\nRU5ErkJggg==\n",
-				LogoFormat:       "png",
+				LogoFrom:         &catalogv1alpha1.LogoSource{Bundled: "trivy-operator"},
+			},
+			DefaultNamespace: &catalogv1alpha1.AppNamespaceSpec{
+				Name: "trivy-system",
 			},
 			ChartVersions: []catalogv1alpha1.ChartVersion{
 				{ChartVersion: "0.28.0", AppVersion: "0.26.0"},
@@ -353,43 +453,45 @@ persistence:
 			Metadata: &catalogv1alpha1.ChartMetadata{
 				AppName:          "kubernetes-mcp-server",
 				DocumentationURL: "https://github.com/Flux159/mcp-server-kubernetes/blob/main/ADVANCED_README.md",
-				Logo:             "iVBORw0KGgoAAAANSUhEUgAAADIAAAAyCAYAAAAeP4ixAAAAAXNSR0IArs4c6QAAAARnQU1BAACx\njwv8YQUAAAAJcEhZcwAADsMAAA7DAcdvqGQAAAxKSURBVGhDvZp7XFXVtse/a+8NWwQEeQhKKg+v\n+EIUMRDSEF+YKeeDHCzJRyc9lVYerdtNrQ5Wp3OvFmVZnU6Zml4fR9N8XFLUUkDkpYIoKgKiIMpD\nlPdT1v1jrb3Ye5nt3Qn6fdibNeeYa87xm3OMMcecINTW1or8rhCArh9So67ofnQ9CX4PIkUlFRTc\nKFdXdzm6lUj2pes8tuBvjF/4NzJyi9RiFQR1xa9CtxIpLKmgQxTp6BC5VlqpFqvw20yuW4kM7Oci\nPQgC3o+4qsVdim4l4ukhKS8AQ3081OIuRbcSKS6rVAzm6vXbKmnXQrBsHzE4ogVNgfzi2xw8cY7t\nP6Rxs+IuAP1cHZk3M5SoSYEM7OesfuU3wyyRDduPseVACi/EhPNc1AS1WEFb230Oncxm8/5k0i+Y\nRihRngBBnpBgPx9inwwhcuJorK10Jm2N8fHWI2zen8zSpyazODpMLTaBWSL+0W9RXl2LRhDYtXYJ\n48cMNpGXV9Ww6ftk/jfhNJV360xkxhBVAVYEXBzsmD8zlGf/MJ4+zr2MpJCQlMOf/roRAG8PV1K3\nvWUiV8MskY17k3j78710dIj07mXLng9fYpiPB0UlFcRvPcyBE+doa79vZHSGJyO1RdUQgmBSZ6XT\nEhk2muXzI/Dp34eM3CJiV/6D+sYWrHRaPn59LlGTA026UMMsEYBzl66z4oMdXLp2C0GAgCEDyS24\nSVv7fUUhw4wP7OtMROhIAod5ciLrMtsSTgMi82eEEh40lLTcIg6nnKf41p3OAeQ+rHRaBg90J6+o\nDIAxwzyJf+1pBnu6d7Z9CCwiAtDU3Mq4ee9x+06NNNeC6U48IWAwr86P4FE/b6Vu1frdfLM/BQFY\nPPtx3lkaJdMVScspZO2mBE7nFhpNhjQdhglJ+fZNdFqtRUHG4vBr08Oa0FGDpIJMQgDcnHqxMe5P\n7Fq3xIQEwM2KeyCrUX2vQa6VlAr292Hvxy/z1dsLce0t+YchGAA8OsIbnVZjEQl+DZH6xhaOZ1xC\nMJAQpK9x/oOYMm64ujmAEnpBpLy6ViWVMDXEjyA/b6lD6QeAY+l5NLe0qVo/HBYT2XU4nZr6JsN4\nijPvP5lN7BtfUlPXZNL+Xm0j18qq5JJAYWnFz7Z5+r++4FByjtLOgLt1DexOzFTK5mAxkZ2H0+XV\nENDrrfnPBdOV1TmVU8CMlz4iI7eIvMIyFv31G0bMfpPGphaQ1SurvMfI6Dd5fs1m8grLSDmbT8SS\nD0nNKQBAb6Vj3fI56PVWclSDTfuTTXT4JVjk7JeKypj053VKecW8aby2IIKPtibywdYjAIiGcCqK\nnc+ID5q4oHxJRXkyvlg1j8jwAD7aeoS1m39Q5AkbljN66ECl/DBYtCL/OtK5xE4OdrwYEw7A8nlT\nmT1pDIIgSB8kvzGUJaNXfYwM00Di+egwIsMDAHghJhzX3vbKeDsPpyvPEjonwRhmibS3d/Dd8SxF\nkZipY7G1sWbroVSGRK7iUFJO56QbOauhDKDRaOjv5oSdjb6TpCAgID1/9V0S016MJ/PCNWz0VjwV\nEaR0sf/EOVpb2zv7fGCJJZgl8mPGJaqU0AlTgqUIVXWvnqcigsjd/Q7/8/JsSSjCF6vnU5IYz+pF\nTyIgEDTCm7RvV5Ox/W3y9r3PG88+AcBbf57FzWMfUZoYT96+94ieHMiCtzdyt6aBaSEjZLJQU9/E\n0bSLyvgPg1ki+348o8y0Tqth9JABAGgEDVY6LbY99cwKk5I/vbUVk4KGohUEtBoNeisdX8c9y52a\nBqJf/Yxt/5fKK7FTCPEfBCLUNTQx5/XP2bw/hcVREwgc5sWR0xfxHzwAvbVO2Vf2Hj+j0upBmKSe\niacuUFZ1Dy8PVwa4O5GVV8yx9DzFXHrorVm//RhNza1kXLhGyKhBtLa2Y6O3YmLgENra2+nZQ09N\nfSOCIDBycH9cHO1ZtnY7qTkFnLt8g+zLN7hVVUOHKHL/fgensgvJKyzjldgp+A50Y/OBFC4WlNLD\n2oqW1nYEAX7KvMzuxExGDu5PcWklN8qr8fZwZVLwMEV3hUhi6gUWxn0jpQuyEyr2Lpcbmlr4ZMcx\nQy3j/H1oaW0n/WIRkWGjqG9s5sLVUrRaDYIg0Ed22htyXtXU2sZueXZFUURvrSNqUgAhI31AhIKS\ncnKvlnI+v0QZAwSaW9tYtna78p4hSOz98CWC/X3A2LRcHO3RaTotzdHORnlBgVHGaiw5dDKHqeOG\nMzVkBIeSc+TBOkOycuYQRZM+9NZWrH7uSYJH+vDpjmMcTct7wJVFOZxrBAE7G71UJ/fp7GintFNW\nJGDYQFI2r6SiuhZPD1dcHO24cu02z6z+J2VVNYCIva0Na16IpLahiR8zLiuKHjl9kbXLY3BxtOdQ\nUg4zJ/gjCAJXb1SAACH+g7h07RZuTr04/s/XWfOP7wGRuvomAuauQZCVW/BkCAPcnXB2tOPv3yRQ\nfqcGQRCw0VuRsGEFvl7uVFbXUVxWxQB3J9xcHBQiJs4+oK8zgcO9cJGZ+nq5M2O8v6SwCPWNzUSE\n+rF49uOE+A+S6gWoa2wm+Ww+eYU3Kb51B1EEjSBwtbSCtPOFrF48k/Wvz2X3B0uw7aknM69YGtAo\nXE8bN5y/L4vmxTnhRE4MoLqmXtErInQkvl5SKu/qZM/YEV4mJFAT+TnMChulPHeIIhnyMVajgbyi\nMmXD+nLPCeK3JSIAh0/lcvbSdQAWxW1ixw9peHu4kn+9nOhXP6P41h1yrpTw3dEsAOxte/Du0ihl\nnDN5xdJZR8Yfp/7yoQosTFGCn3mXkvK7iEDs9GDWrYghPbeIPUezqG9q4WCS5BcGGEzF2B8kuQCC\n/NvIzzbGPUtEqJ/S9o2Pd/PtoVSQjwlZO+PQalT+qoLZFQGInBggm5fIgZPZ1DY0E+TnzboVMVhb\n6UxIoOy90jFJFEXl8qFzVxZNgkVqtpQ4AtTWN5nsG9GTA82SwFIi0VMClRSlvrGZz3f9CMDm/Sns\nOdqZh+m0Gtyc5EsEY24mPAVcHOzQ6bRKzcbvk/l6bxIAG3Yep75ZyppB4KnpnenKL0G7cuXKOHWl\nGs4OdiSduUJZ5T0QRXLyS7HRWxH35QHZ4QUe6dObHf/9PCufm0E/FwdKy+9Sea/TYQEG9e/D0jmT\n2LDyGaaH+vFT5mXqGpoBOHnmCjZ6az7dcZz7HVKfY4d7smSOlKCag0U+AvCvxEz+Im9Khg0S2c5H\nDu7Pt+8tMslaAR6NfZfS8mqQr3RStqwykZffqWXhW19z/mqpSb1BoXV/+SOxM8aZyB4Gi0wLIDJs\nNK6O9hIJ2ScEYOLYoez5YOkDJAA8+zpLfoKI58/cLro592Jv/EtMCxlh6kWiiLtTL6LNXAEZw2Ii\nemsdjwX8h2kkAq7eKCdTdbNoQF9XB8mpRSlz6ETnip7PL5U2TmNXEgTCxg6RTosWwmIildV1nMy6\noq6mpLya2FVfMX/112RfvmEic3PqpUQ0ZwdbI4lIXmEZz8VtIuq1zygqrTCJYogiiakXuFvbaFz7\ni7DIRxKScnjjkz1U3a3D3taGCWN8STtfSHVt5znFAF9Pd6aH+vHoCC9Sswv4dOdxRFFkaUw4U8YN\nJ/NiMUfT8sjKuya/oew6ONjZ8PgYX46l59HQ1MIjfXrz/ivRTDbKch8Gs0TitxxWzuXuzg4c/GQZ\nHm69qalrYuO+JLYcTKXKkE6Ihis20w3ROGM1yazl5162PZgbEcSLMeG49Laj5HY1kcvWc6tSuhdb\ntWgmL8+dLL3/EJglEjT3HUoqqtFpteyNf5nA4Z4m8pbWdg6cOMeWg6mcuyylJUqHqo0SJOUNJIZ7\n9+OZJ4KZPTkQ255SZmtA1sViopZ/Qlv7ffq6OnJ21xoTuRpmiew8nM6WA6d4PjqMP8gXBA9Dbn4p\n2xJOc+BENrUNpndYBvSys2HW46N4enowo3z7q8Um2Hf8DF/u/on5sx5j7hPBarEJzBL5d9Dadp/k\ns/ms336UM3KmG+TnzdI54YwP8MXaqnNX7yp0CxEDrpfdIXTh+4hA+tY3ecStt7pJl8Hi8PvvwNXJ\nHuQrBEf7nmqxGZhPFI3RrUSuFN9G0EjJ5sWCm2qxGfw6Q+lWIiW3pDxLEASKbpr7h4Hfhm4l4uvl\njpVOi1ajYYhnX7W4S9Gtzo78N5KODpH+7k5qUZei24n8XuhW0/o98f+4NsFvT60e4gAAAABJRU5E\nrkJggg==\n",
-				LogoFormat:       "png",
+				LogoFrom:         &catalogv1alpha1.LogoSource{Bundled: "kubernetes-mcp-server"},
 				SourceURL:        "https://github.com/Flux159/mcp-server-kubernetes",
 				DisplayName:      "Kubernetes MCP Server",
 				Description:      "MCP Server that can connect to a Kubernetes cluster and manage it.",
 			},
+			DefaultNamespace: &catalogv1alpha1.AppNamespaceSpec{
+				Name: "mcp-server-kubernetes",
+			},
 			ChartVersions: []catalogv1alpha1.ChartVersion{
 				{ChartVersion: "2.9.9", AppVersion: "2.9.9"},
 			},
 			DefaultValuesBlock: `transport:
 service:
-	# To Expose the Kubernetes MCP Server externally without ingress, set service type as "LoadBalancer". Default value is "ClusterIP".
-	type: "ClusterIP"
-	port: 3000
-	targetPort: 3001
+  # To Expose the Kubernetes MCP Server externally without ingress, set service type as "LoadBalancer". Default value is "ClusterIP".
+  type: "ClusterIP"
+  port: 3000
+  targetPort: 3001
 rbac:
-	rules:
-	- apiGroups: [""] # Core API group
-		resources: ["pods", "services", "nodes", "namespaces"]
-		verbs: ["get", "list", "watch"]
-	- apiGroups: ["apps"]
-		resources: ["deployments", "statefulsets", "daemonsets"]
-		verbs: ["get", "list", "watch"]
-	- apiGroups: ["batch"]
-		resources: ["jobs", "cronjobs"]
-		verbs: ["get", "list", "watch"]
+  rules:
+  - apiGroups: [""] # Core API group
+    resources: ["pods", "services", "nodes", "namespaces"]
+    verbs: ["get", "list", "watch"]
+  - apiGroups: ["apps"]
+    resources: ["deployments", "statefulsets", "daemonsets"]
+    verbs: ["get", "list", "watch"]
+  - apiGroups: ["batch"]
+    resources: ["jobs", "cronjobs"]
+    verbs: ["get", "list", "watch"]
 security:
-	# Tool filtering
-	allowOnlyNonDestructive: false
-	# When enabled, these destructive tools are DISABLED:
-	# kubectl_delete, uninstall_helm_chart, cleanup, kubectl_generic
-	allowOnlyReadonly: false
+  # Tool filtering
+  allowOnlyNonDestructive: false
+  # When enabled, these destructive tools are DISABLED:
+  # kubectl_delete, uninstall_helm_chart, cleanup, kubectl_generic
+  allowOnlyReadonly: false
 env:
-	# 5 MB for large clusters, default is 1 MB
-	SPAWN_MAX_BUFFER: "5242880"
-	ENABLE_UNSAFE_STREAMABLE_HTTP_TRANSPORT: "1"
-	HOST: "0.0.0.0"
+  # 5 MB for large clusters, default is 1 MB
+  SPAWN_MAX_BUFFER: "5242880"
+  ENABLE_UNSAFE_STREAMABLE_HTTP_TRANSPORT: "1"
+  HOST: "0.0.0.0"
 `,
 		},
 		{
@@ -400,8 +502,10 @@ env:
 				Description:      "Envoy Gateway is a cloud-native ingress gateway and API gateway built on Envoy Proxy and Kubernetes Gateway API",
 				DocumentationURL: "https://gateway.envoyproxy.io/",
 				SourceURL:        "https://github.com/envoyproxy/gateway",
-				Logo:             "PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiPz4KPHN2ZyB4bWxucz0iaHR0cDovL3d3dy53My5vcmcvMjAwMC9zdmciIHZlcnNpb249IjEuMSIgdmlld0JveD0iMCAwIDEyMDAgNDAwIj4KICA8ZGVmcz4KICAgIDxzdHlsZT4KICAgICAgLmNscy0xIHsKICAgICAgICBmaWxsOiAjZDA0YzliOwogICAgICB9CgogICAgICAuY2xzLTIgewogICAgICAgIGZpbGw6ICM0YTIzNjU7CiAgICAgIH0KCiAgICAgIC5jbHMtMyB7CiAgICAgICAgZmlsbDogI2I5NmFhYjsKICAgICAgfQoKICAgICAgLmNscy00IHsKICAgICAgICBpc29sYXRpb246IGlzb2xhdGU7CiAgICAgIH0KCiAgICAgIC5jbHMtNSB7CiAgICAgICAgZmlsbDogI2E1Mzk5NTsKICAgICAgfQogICAgPC9zdHlsZT4KICA8L2RlZnM+CiAgPCEtLSBHZW5lcmF0b3I6IEFkb2JlIElsbHVzdHJhdG9yIDI4LjcuMSwgU1ZHIEV4cG9ydCBQbHVnLUluIC4gU1ZHIFZlcnNpb246IDEuMi4wIEJ1aWxkIDE0MikgIC0tPgogIDxnPgogICAgPGcgaWQ9IkxheWVyXzEiPgogICAgICA8Zz4KICAgICAgICA8cG9seWdvbiBpZD0icG9seWdvbjIwIiBjbGFzcz0iY2xzLTUiIHBvaW50cz0iMTkxLjIgMjQyLjI5IDE5MC41NSAyMTMgMTU5Ljc2IDE5My44MyAxNjAuNCAyMjMuMTIgMTkxLjIgMjQyLjI5Ii8+CiAgICAgICAgPHBhdGggaWQ9InBhdGgyMiIgY2xhc3M9ImNscy01IiBkPSJNMjM0LjkyLDMxNS41MmwtLjY1LTI4LjY1LTI2LjkyLTE2LjhjLS40My0uMjEtLjg2LS42NC0xLjA4LS44NmwuNjUsMjguODYsMjgsMTcuNDVoMFoiLz4KICAgICAgICA8cGF0aCBpZD0icGF0aDI0IiBjbGFzcz0iY2xzLTUiIGQ9Ik0xMzguNjUsMzU0LjA3bC03MC40Mi00My43Mi0xLjczLTczLjIzLDM0LjQ2LTE0Ljg2LS42NS0yOS4yOS01NS4xNCwyMy42OWMtNC4zLDEuOTQtNi44OSw1LjgxLTYuNjcsMTAuMzNsMi4xNSw4Ny44N2MwLDQuNTMsMi44LDkuMDYsNy4xMSwxMS42NGw4NC40Myw1Mi4zM2MzLjg4LDIuMzcsOC42MSwzLjAyLDEyLjcxLDEuOTQuNDMtLjIxLjg2LS4yMSwxLjI5LS40M2w1MS45LTIyLjM5LTI4LjIxLTE3LjQ1LTMxLjIzLDEzLjU3aDBaIi8+CiAgICAgICAgPHBhdGggaWQ9InBhdGgyNiIgY2xhc3M9ImNscy0zIiBkPSJNMzY2LjI5LDE5Mi4xMWMtLjIxLTUuMTctMy4yMy0xMC41NS04LjM5LTEzLjU3bC0xMDIuNTItNjMuNTMtMy4yMywxLjI5LjY0LDMwLjgsODEuMTksNTAuNCwxLjk0LDgyLjI3LDMxLjAxLDE5LjE3LDEuNzItLjY0LTIuMzctMTA2LjE4aDBaIi8+CiAgICAgICAgPHBhdGggaWQ9InBhdGgyOCIgY2xhc3M9ImNscy0zIiBkPSJNMjQzLjUzLDMzNi42MmwtOTUuNDEtNTkuMDEtMi4zNy05OS4wNyw0My41MS0xOC43NC0uODYtMzQuMjQtNjcuNDEsMjkuMDdjLTQuOTUsMi4xNi03Ljk3LDYuNjgtNy43NSwxMi4wNmwyLjgsMTE2LjNjMCw1LjM4LDMuMjMsMTAuNTYsOC40LDEzLjU3bDExMS43OCw2OS4zNWM0LjUyLDIuNzksMTAuMTIsMy42NiwxNC44NiwyLjE1LjQzLS4yMi44Ni0uNDMsMS4yOS0uNDNsNjUuOS0yOC40NC0zMi43My0yMC4yNS00MiwxNy42N2gwWiIvPgogICAgICAgIDxwYXRoIGlkPSJwYXRoMzAiIGNsYXNzPSJjbHMtMSIgZD0iTTUxMS42NywxMTAuNjlMMzY4LjQ1LDIxLjk2Yy01LjM4LTMuMjMtMTEuNjItNC4xLTE3LTIuMzctLjQ0LjIxLTEuMDguNDMtMS41MS42NGwtMTM5Ljc4LDYwLjMxYy01LjYsMi4zNy05LjA0LDcuNTQtOC44MywxMy43OGwzLjQ0LDE0OS4wNGMuMjIsNi4wMywzLjg4LDEyLjA2LDkuNywxNS41MWwxNDMuMjIsODguNzNjNS4xNywzLjIzLDExLjYzLDQuMDksMTcuMDEsMi4zOC40My0uMjIsMS4wOC0uNDQsMS41MS0uNjVsMTM5Ljc4LTYwLjNjNS41OS0yLjM3LDkuMDUtNy43NSw4Ljg0LTEzLjc5bC0zLjQ1LTE0OS4wNGMtLjIxLTYuMDMtMy44OC0xMS44NS05LjY5LTE1LjUxTTM2Ni4wOCwzMTQuMjJsLTEyNC40OC03Ny4xMS0zLjAyLTEyOS40NCwxMjEuNDctNTIuMzMsMTI0LjQ5LDc3LjEsMy4wMiwxMjkuNDUtMTIxLjQ3LDUyLjMzaDBaIi8+CiAgICAgIDwvZz4KICAgICAgPHBhdGggaWQ9InBhdGgxMCIgY2xhc3M9ImNscy0yIiBkPSJNNjA3LjQ2LDE4Mi4xNGMwLDQuNjIsMS4wOCw5LjA0LDMuMjMsMTIuODMsMi4xNiwzLjc4LDQuOTUsNy4xNSw4LjE4LDkuODgsMy40NCwyLjc0LDcuMzMsNC44NCwxMS44NCw2LjMyLDQuNTIsMS40Nyw5LjI2LDIuMzEsMTQsMi4zMSw2LjQ2LDAsMTIuMjctMS40OCwxNy4wMS00LjQxLDQuNzMtMi45Niw5LjI2LTYuOTYsMTMuMzUtMTEuNzlsMTUuOTMsMTEuNzljLTExLjYzLDE0LjcxLTI3Ljk5LDIyLjA4LTQ4Ljg4LDIyLjA4LTguNjEsMC0xNi41OC0xLjQ3LTIzLjY5LTQuNDEtNy4xMS0yLjk2LTEzLjEzLTYuOTUtMTcuODgtMTItNC45NS01LjA1LTguNjEtMTEuMTUtMTEuMTktMTcuODgtMi41OC02Ljk0LTMuODgtMTQuMzEtMy44OC0yMi41MXMxLjUxLTE1LjU3LDQuMzEtMjIuNTFjMi44LTYuOTQsNi44OS0xMi44MywxMS44NC0xNy44OHMxMC45OC05LjA0LDE4LjA5LTEyYzYuOS0yLjk0LDE0LjY1LTQuNDIsMjIuODMtNC40Miw5LjY5LDAsMTguMDksMS42OSwyNC43Nyw1LjA2LDYuNjcsMy4zNiwxMi4yNyw3Ljc3LDE2LjU4LDEzLjAzLDQuMzEsNS40OCw3LjU0LDExLjM3LDkuNDgsMTguMzEsMS45NCw2LjczLDMuMDEsMTMuNjcsMy4wMSwyMC42MnY3LjM3aC04OC45M3YuMjFoMFpNNjczLjc4LDE2NS45NWMtLjIyLTQuNjMtLjg2LTguNjQtMi4xNS0xMi40Mi0xLjI5LTMuNzktMy4yMy03LjE1LTYuMDMtOS44OS0yLjU5LTIuNzQtNi4wMi01LjA1LTkuOTEtNi41My0zLjg4LTEuNjktOC42MS0yLjMxLTEzLjc4LTIuMzFzLTkuOSwxLjA1LTEzLjk5LDIuOTRjLTQuMzEsMS44OC03Ljk3LDQuNDItMTAuNzcsNy4zNi0zLjAxLDIuOTQtNS4xNyw2LjMxLTYuODksMTAuMS0xLjcyLDMuNzktMi4zNyw3LjM3LTIuMzcsMTAuOTVoNjUuODl2LS4yMWgwWiIvPgogICAgICA8cGF0aCBpZD0icGF0aDEyIiBjbGFzcz0iY2xzLTIiIGQ9Ik03MTQuMjYsMTIwLjcxaDIwLjg5djE2LjYxaC40M2MyLjU4LTUuNjgsNy4zMi0xMC41MiwxMy43OC0xMy44OCw2LjQ2LTMuNTgsMTQtNS4yNiwyMi42MS01LjI2LDUuMzksMCwxMC4zNC44NCwxNS4yOSwyLjMxLDQuOTUsMS42OCw5LjI2LDQuMDEsMTIuNzEsNy4zNiwzLjY2LDMuMzcsNi40Niw3LjU3LDguODMsMTIuODQsMi4xNiw1LjI2LDMuMjMsMTEuMzYsMy4yMywxOC41MXY2OS40NGgtMjAuODl2LTYzLjc2YzAtNS4wNS0uNjUtOS4yNS0yLjE1LTEyLjgzLTEuMy0zLjU4LTMuMjMtNi41My01LjYtOC42NC0yLjM3LTIuMS00Ljk1LTMuNzktNy45Ny00LjgzLTMuMDEtMS4wNi02LjI0LTEuNDgtOS40Ny0xLjQ4LTQuMywwLTguNC42My0xMi4wNiwyLjEtMy42NiwxLjQ4LTYuODksMy41OC05LjY4LDYuNTMtMi44LDIuOTQtNC45Niw2LjczLTYuNDcsMTEuMzUtMS41LDQuNjMtMi4zNyw5Ljg5LTIuMzcsMTYuMjF2NTUuNTVoLTIwLjg4di0xMDguMTVoLS4yMnYuMDJaIi8+CiAgICAgIDxwb2x5Z29uIGlkPSJwb2x5Z29uMTQiIGNsYXNzPSJjbHMtMiIgcG9pbnRzPSI4NDMuMDMgMTIwLjcxIDg3NS45OCAyMDMuNCA5MDcuNjMgMTIwLjcxIDkzMC4wMyAxMjAuNzEgODg2Ljc0IDIyOC42NCA4NjMuNzEgMjI4LjY0IDgxOC43IDEyMC43MSA4NDMuMDMgMTIwLjcxIi8+CiAgICAgIDxwYXRoIGlkPSJwYXRoMTYiIGNsYXNzPSJjbHMtMiIgZD0iTTkzMS41MywxNzQuNzdjMC03Ljc4LDEuNTEtMTUuMTUsNC41My0yMi4wOSwzLjAxLTYuNzMsNy4xLTEyLjgzLDEyLjQ5LTE3Ljg4LDUuMzgtNS4wNiwxMS40MS05LjI2LDE4LjczLTEyLjIsNy4xMi0yLjk1LDE0Ljg2LTQuNDIsMjMuMDQtNC40MnMxNS45MywxLjQ3LDIzLjA0LDQuNDJjNy4xLDIuOTQsMTMuMzYsNi45MywxOC43MywxMi4yLDUuMTcsNS4yNSw5LjQ4LDExLjE1LDEyLjUsMTcuODgsMy4wMSw2LjczLDQuNTMsMTQuMSw0LjUzLDIyLjA5cy0xLjUyLDE1LjM2LTQuNTMsMjIuMWMtMy4wMiw2Ljk0LTcuMTEsMTIuODMtMTIuNSwxNy44OC01LjM3LDUuMDUtMTEuNCw5LjA0LTE4LjczLDEyLTcuMTEsMi45NC0xNC44NSw0LjQxLTIzLjA0LDQuNDFzLTE1LjkzLTEuNDctMjMuMDQtNC40MWMtNy4xLTIuOTYtMTMuMzUtNi45NS0xOC43My0xMi01LjM5LTUuMDUtOS40OC0xMS4xNS0xMi40OS0xNy44OC0zLjAxLTYuOTQtNC41My0xNC4zMS00LjUzLTIyLjFNOTUzLjcxLDE3NC43N2MwLDUuNDcuODYsMTAuNTIsMi41OSwxNS4xNSwxLjcxLDQuNjIsNC4wOSw4LjYyLDcuMzEsMTEuNzksMy4wMiwzLjM3LDYuOSw1Ljg5LDExLjQyLDcuNzgsNC41MiwxLjg5LDkuNDgsMi45NCwxNS4wOCwyLjk0czEwLjU1LS44NCwxNS4wNy0yLjk0YzQuNTEtMS44OSw4LjQtNC40MSwxMS40Mi03Ljc4LDMtMy4xNiw1LjU5LTcuMTYsNy4zMS0xMS43OSwxLjczLTQuNjMsMi41OS05LjY4LDIuNTktMTUuMTVzLS44Ni0xMC41Mi0yLjU5LTE1LjE1Yy0xLjcxLTQuNjMtNC4wOS04LjYzLTcuMzEtMTEuNzgtMy4wMi0zLjE1LTYuOS01Ljg5LTExLjQyLTcuNzgtNC41Mi0xLjg5LTkuNDgtMi45NC0xNS4wNy0yLjk0cy0xMC41NiwxLjA1LTE1LjA4LDIuOTRjLTQuNTIsMS45LTguMzksNC40Mi0xMS40Miw3Ljc4LTMsMy4zNy01LjU5LDcuMTUtNy4zMSwxMS43OC0xLjczLDQuNjMtMi41OSw5LjY4LTIuNTksMTUuMTUiLz4KICAgICAgPHBhdGggaWQ9InBhdGgxOCIgY2xhc3M9ImNscy0yIiBkPSJNMTA1MC4zOSwxMjAuNzFoMjQuMTJsMzIuNzQsODQuMTVoLjQybDMxLjQ0LTg0LjE1aDIyLjRsLTUyLjMyLDEzMS4wN2MtMS45NCw0LjYzLTMuODgsOS4wNS01LjgyLDEyLjg0cy00LjMxLDcuMTUtNy4xMSw5Ljg4Yy0yLjc5LDIuNzMtNi4yNSw0Ljg0LTEwLjEyLDYuMzEtMy44OCwxLjQ4LTguODIsMi4zMS0xNC40MywyLjMxLTMuMDIsMC02LjI0LS4yMS05LjQ4LS42Mi0zLjIyLS40MS02LjI1LTEuMjYtOS4yNS0yLjMxbDIuNTgtMTguNzRjNC4wOSwxLjY5LDguNCwyLjUzLDEyLjQ5LDIuNTMsMy4yNCwwLDYuMDMtLjQyLDguMTgtMS4yNiwyLjE2LS44NCw0LjA5LTIuMSw1LjgxLTMuNTgsMS43My0xLjY5LDMuMDMtMy4zNiw0LjA5LTUuNDYsMS4wOC0yLjEyLDIuMTUtNC42MywzLjIzLTcuMzdsNi44OC0xNy4wNC00NS44Ni0xMDguNTZoMFoiLz4KICAgICAgPGcgY2xhc3M9ImNscy00Ij4KICAgICAgICA8cGF0aCBjbGFzcz0iY2xzLTUiIGQ9Ik02MzYuODYsMzI4LjIzYzAsOC40LTIuMTUsMTQuNzctNi40NCwxOS4xNC00LjMsNC4zNi0xMC41MSw2LjU0LTE4LjY1LDYuNTQtNC41MywwLTguNjEtLjY2LTEyLjI1LTEuOTctMy42NC0xLjMxLTcuMS0zLjU4LTEwLjM4LTYuNzlsNS41MS02LjNjMi40MywyLjYyLDUsNC42MSw3LjcyLDUuOTUsMi43MiwxLjM0LDUuODUsMi4wMiw5LjQsMi4wMnM2LjI1LS41MSw4LjUxLTEuNTJjMi4yNi0xLjAyLDQuMDUtMi40LDUuMzYtNC4xMywxLjMxLTEuNzQsMi4yMy0zLjcyLDIuNzUtNS45NS41Mi0yLjIzLjc5LTQuNTYuNzktNi45OXYtNS45aC0uMmMtMS44NCwyLjk1LTQuMjMsNS4xMi03LjE4LDYuNDktMi45NSwxLjM4LTUuOTcsMi4wNy05LjA1LDIuMDctMy42MSwwLTYuOTItLjU5LTkuOTQtMS43Ny0zLjAyLTEuMTgtNS42MS0yLjgyLTcuNzctNC45Mi0yLjE2LTIuMS0zLjg0LTQuNTktNS4wMi03LjQ4LTEuMTgtMi44OS0xLjc3LTYuMDMtMS43Ny05LjQ1LDAtMy43NC41OS03LjEyLDEuNzctMTAuMTMsMS4xOC0zLjAyLDIuODQtNS41Niw0Ljk3LTcuNjIsMi4xMy0yLjA3LDQuNzEtMy42Niw3LjcyLTQuNzcsMy4wMi0xLjExLDYuMzYtMS42NywxMC4wNC0xLjY3LDEuNTcsMCwzLjE1LjE4LDQuNzIuNTQsMS41Ny4zNiwzLjEuOTIsNC41OCwxLjY3LDEuNDguNzUsMi44LDEuNzEsMy45OCwyLjg1LDEuMTgsMS4xNSwyLjE3LDIuNDgsMi45NSwzLjk4aC4ydi03Ljg3aDcuNjd2NDMuOThaTTU5Ni41MiwzMDcuMjhjMCwyLjM2LjQzLDQuNTQsMS4yOCw2LjU0Ljg1LDIsMi4wMiwzLjc0LDMuNDksNS4yMSwxLjQ4LDEuNDgsMy4yLDIuNjQsNS4xNywzLjQ5LDEuOTcuODUsNC4wNywxLjI4LDYuMywxLjI4LDIuNjIsMCw0Ljk1LS40Niw2Ljk5LTEuMzgsMi4wMy0uOTIsMy43NS0yLjE1LDUuMTctMy42OSwxLjQxLTEuNTQsMi40OC0zLjMxLDMuMi01LjMxLjcyLTIsMS4wOC00LjEyLDEuMDgtNi4zNSwwLTIuNDktLjM5LTQuNzctMS4xOC02Ljg0LS43OS0yLjA3LTEuOS0zLjg1LTMuMzQtNS4zNi0xLjQ0LTEuNTEtMy4xOC0yLjY3LTUuMjEtMy40OS0yLjAzLS44Mi00LjI2LTEuMjMtNi42OS0xLjIzcy00LjY0LjQ0LTYuNjQsMS4zM2MtMiwuODgtMy43MSwyLjEtNS4xMiwzLjY0LTEuNDEsMS41NC0yLjUxLDMuMzUtMy4zLDUuNDEtLjc5LDIuMDctMS4xOCw0LjMxLTEuMTgsNi43NFoiLz4KICAgICAgICA8cGF0aCBjbGFzcz0iY2xzLTUiIGQ9Ik02NTAuNDQsMjkwLjE2YzIuMzYtMi40Myw1LjE3LTQuMjEsOC40MS01LjM2LDMuMjUtMS4xNSw2LjU0LTEuNzIsOS44OS0xLjcyLDYuODIsMCwxMS43NCwxLjYxLDE0Ljc2LDQuODIsMy4wMiwzLjIxLDQuNTMsOC4xLDQuNTMsMTQuNjZ2MTkuNzhjMCwxLjMxLjA2LDIuNjcuMiw0LjA4LjEzLDEuNDEuMywyLjcxLjQ5LDMuODloLTcuMzhjLS4yNi0xLjA1LS40MS0yLjIxLS40NC0zLjQ5LS4wMy0xLjI4LS4wNS0yLjQxLS4wNS0zLjM5aC0uMmMtMS41MSwyLjM2LTMuNTMsNC4zLTYuMDUsNS44MS0yLjUzLDEuNTEtNS41NiwyLjI2LTkuMSwyLjI2LTIuMzYsMC00LjU4LS4yOS02LjY0LS44OS0yLjA3LS41OS0zLjg3LTEuNDYtNS40MS0yLjYxLTEuNTQtMS4xNS0yLjc3LTIuNTctMy42OS00LjI4LS45Mi0xLjcxLTEuMzgtMy42Ny0xLjM4LTUuOSwwLTMuOC45OC02Ljc5LDIuOTUtOC45NSwxLjk3LTIuMTYsNC40MS0zLjc3LDcuMzMtNC44MiwyLjkyLTEuMDUsNi4wNy0xLjcsOS40NS0xLjk3LDMuMzgtLjI2LDYuNDgtLjM5LDkuMy0uMzloMi45NXYtMS4zOGMwLTMuMzUtMS01Ljg3LTMtNy41OC0yLTEuNzEtNC44MS0yLjU2LTguNDEtMi41Ni0yLjQ5LDAtNC45NC40MS03LjMzLDEuMjMtMi40LjgyLTQuNTEsMi4wOC02LjM1LDMuNzlsLTQuODItNS4wMlpNNjcyLjY3LDMwOC4xNmMtNC45MiwwLTguODIuNjktMTEuNzEsMi4wNy0yLjg5LDEuMzgtNC4zMywzLjY0LTQuMzMsNi43OSwwLDIuODkuOTcsNC45NCwyLjksNi4xNSwxLjkzLDEuMjEsNC4zNCwxLjgyLDcuMjMsMS44MiwyLjIzLDAsNC4xOC0uMzgsNS44NS0xLjEzLDEuNjctLjc1LDMuMDctMS43NSw0LjE4LTMsMS4xMi0xLjI1LDEuOTctMi43MSwyLjU2LTQuMzguNTktMS42Ny45Mi0zLjQ2Ljk4LTUuMzZ2LTIuOTVoLTcuNjdaIi8+CiAgICAgICAgPHBhdGggY2xhc3M9ImNscy01IiBkPSJNNzI3LjI4LDI5MC43NWgtMTMuNTh2MjMuMDJjMCwxLjUxLjAzLDIuOS4xLDQuMTguMDYsMS4yOC4zMywyLjM5Ljc5LDMuMzQuNDYuOTUsMS4xNSwxLjcxLDIuMDcsMi4yNi45Mi41NiwyLjIzLjg0LDMuOTQuODQsMS4xMSwwLDIuMjYtLjEzLDMuNDQtLjM5LDEuMTgtLjI2LDIuMy0uNjYsMy4zNS0xLjE4bC4yOSw2Ljk5Yy0xLjMxLjU5LTIuNzcsMS4wMi00LjM4LDEuMjgtMS42MS4yNi0zLjEzLjM5LTQuNTguMzktMi43NSwwLTQuOTUtLjM2LTYuNTktMS4wOC0xLjY0LS43Mi0yLjkyLTEuNzQtMy44NC0zLjA1LS45Mi0xLjMxLTEuNTItMi45My0xLjgyLTQuODctLjI5LTEuOTMtLjQ0LTQuMDgtLjQ0LTYuNDR2LTI1LjI5aC0xMC4wNHYtNi40OWgxMC4wNHYtMTMuMDloNy42N3YxMy4wOWgxMy41OHY2LjQ5WiIvPgogICAgICAgIDxwYXRoIGNsYXNzPSJjbHMtNSIgZD0iTTc3Ny44NSwzMjEuOTRjLTIuNzUsMy41NC01Ljc3LDYuMDItOS4wNSw3LjQzLTMuMjgsMS40MS03LjA4LDIuMTItMTEuNDEsMi4xMi0zLjYxLDAtNi44NS0uNjQtOS43NC0xLjkyLTIuODktMS4yOC01LjMzLTMtNy4zMy01LjE3LTItMi4xNy0zLjU0LTQuNzItNC42Mi03LjY3LTEuMDgtMi45NS0xLjYyLTYuMS0xLjYyLTkuNDUsMC0zLjU0LjU5LTYuOCwxLjc3LTkuNzksMS4xOC0yLjk4LDIuODItNS41NCw0LjkyLTcuNjcsMi4xLTIuMTMsNC41OS0zLjc5LDcuNDgtNC45NywyLjg5LTEuMTgsNi4wMy0xLjc3LDkuNDUtMS43NywzLjIxLDAsNi4xNy41NCw4Ljg2LDEuNjIsMi42OSwxLjA4LDUsMi42Niw2Ljk0LDQuNzIsMS45MywyLjA3LDMuNDMsNC41OSw0LjQ4LDcuNTgsMS4wNSwyLjk5LDEuNTcsNi4zOCwxLjU3LDEwLjE4djIuNDZoLTM3LjE5Yy4xMywxLjk3LjYxLDMuODUsMS40Myw1LjY2LjgyLDEuODEsMS44OCwzLjM4LDMuMiw0LjcyLDEuMzEsMS4zNSwyLjg1LDIuNDEsNC42MiwzLjIsMS43Ny43OSwzLjcxLDEuMTgsNS44LDEuMTgsMy4zNSwwLDYuMTctLjU5LDguNDYtMS43NywyLjI5LTEuMTgsNC4zNi0yLjkyLDYuMi01LjIxbDUuOCw0LjUzWk03NzEuMjYsMzAzLjE0Yy0uMTMtMy45NC0xLjQxLTcuMDgtMy44NC05LjQ1LTIuNDMtMi4zNi01Ljc3LTMuNTQtMTAuMDQtMy41NHMtNy43MSwxLjE4LTEwLjMzLDMuNTRjLTIuNjIsMi4zNi00LjIsNS41MS00LjcyLDkuNDVoMjguOTNaIi8+CiAgICAgICAgPHBhdGggY2xhc3M9ImNscy01IiBkPSJNODQwLjgyLDMzMC4zaC03LjU4bC0xMy4wOS0zNS40MmgtLjJsLTExLjYxLDM1LjQyaC03Ljg3bC0xNC45Ni00Ni4wNWg4LjQ2bDEwLjUzLDM1LjQyaC4ybDExLjkxLTM1LjQyaDguMDdsMTIuMSwzNS40MmguMmwxMC40My0zNS40Mmg4LjI2bC0xNC44Niw0Ni4wNVoiLz4KICAgICAgICA8cGF0aCBjbGFzcz0iY2xzLTUiIGQ9Ik04NjMuNzQsMjkwLjE2YzIuMzYtMi40Myw1LjE3LTQuMjEsOC40MS01LjM2LDMuMjUtMS4xNSw2LjU0LTEuNzIsOS44OS0xLjcyLDYuODIsMCwxMS43NCwxLjYxLDE0Ljc2LDQuODIsMy4wMiwzLjIxLDQuNTMsOC4xLDQuNTMsMTQuNjZ2MTkuNzhjMCwxLjMxLjA2LDIuNjcuMiw0LjA4LjEzLDEuNDEuMjksMi43MS40OSwzLjg5aC03LjM4Yy0uMjYtMS4wNS0uNDEtMi4yMS0uNDQtMy40OS0uMDQtMS4yOC0uMDUtMi40MS0uMDUtMy4zOWgtLjJjLTEuNTEsMi4zNi0zLjUzLDQuMy02LjA1LDUuODEtMi41MywxLjUxLTUuNTYsMi4yNi05LjEsMi4yNi0yLjM2LDAtNC41OC0uMjktNi42NC0uODktMi4wNy0uNTktMy44Ny0xLjQ2LTUuNDEtMi42MS0xLjU0LTEuMTUtMi43Ny0yLjU3LTMuNjktNC4yOC0uOTItMS43MS0xLjM4LTMuNjctMS4zOC01LjksMC0zLjguOTgtNi43OSwyLjk1LTguOTUsMS45Ny0yLjE2LDQuNDEtMy43Nyw3LjMzLTQuODIsMi45Mi0xLjA1LDYuMDctMS43LDkuNDUtMS45NywzLjM4LS4yNiw2LjQ4LS4zOSw5LjMtLjM5aDIuOTV2LTEuMzhjMC0zLjM1LTEtNS44Ny0zLTcuNTgtMi0xLjcxLTQuOC0yLjU2LTguNDEtMi41Ni0yLjQ5LDAtNC45NC40MS03LjMzLDEuMjMtMi40LjgyLTQuNTEsMi4wOC02LjM1LDMuNzlsLTQuODItNS4wMlpNODg1Ljk4LDMwOC4xNmMtNC45MiwwLTguODIuNjktMTEuNzEsMi4wNy0yLjg5LDEuMzgtNC4zMywzLjY0LTQuMzMsNi43OSwwLDIuODkuOTcsNC45NCwyLjksNi4xNSwxLjkzLDEuMjEsNC4zNCwxLjgyLDcuMjMsMS44MiwyLjIzLDAsNC4xOC0uMzgsNS44NS0xLjEzLDEuNjctLjc1LDMuMDctMS43NSw0LjE4LTMsMS4xMi0xLjI1LDEuOTctMi43MSwyLjU2LTQuMzguNTktMS42Ny45Mi0zLjQ2Ljk4LTUuMzZ2LTIuOTVoLTcuNjhaIi8+CiAgICAgICAgPHBhdGggY2xhc3M9ImNscy01IiBkPSJNOTMyLjMyLDM0MC44M2MtLjc5LDEuOTctMS41OSwzLjc1LTIuNDEsNS4zNi0uODIsMS42MS0xLjgsMi45OC0yLjk1LDQuMTMtMS4xNSwxLjE1LTIuNTMsMi4wMy00LjEzLDIuNjYtMS42MS42Mi0zLjU2LjkzLTUuODUuOTMtMS4xMiwwLTIuMjgtLjA3LTMuNDktLjItMS4yMS0uMTMtMi4zNS0uNDYtMy4zOS0uOThsLjk4LTYuNjljLjc5LjMzLDEuNjEuNTQsMi40Ni42NC44NS4xLDEuODQuMTUsMi45NS4xNSwyLjQ5LDAsNC4zMy0uNjksNS41MS0yLjA3LDEuMTgtMS4zOCwyLjItMy4yMSwzLjA1LTUuNTFsMy4xNS04LjY2LTE5LjA5LTQ2LjM0aDguOTVsMTQuMjcsMzYuMTFoLjJsMTMuNjgtMzYuMTFoOC4zNmwtMjIuMjQsNTYuNTdaIi8+CiAgICAgIDwvZz4KICAgIDwvZz4KICA8L2c+Cjwvc3ZnPg==",
-				LogoFormat:       "svg+xml",
+				LogoFrom:         &catalogv1alpha1.LogoSource{Bundled: "envoy-gateway"},
+			},
+			DefaultNamespace: &catalogv1alpha1.AppNamespaceSpec{
+				Name: "envoy-gateway-system",
 			},
 			ChartVersions: []catalogv1alpha1.ChartVersion{
 				{ChartVersion: "v1.7.0", AppVersion: "v1.7.0"},
@@ -431,7 +535,9 @@ func parseIncludeList(annotation string) []string {
 	return parts
 }
 
-func mergeCharts(userCharts, defaults []catalogv1alpha1.ChartConfig) []catalogv1alpha1.ChartConfig {
+// MergeCharts merges two chart lists by chartName. Charts in userCharts replace the charts
+// with the same name in defaults. The result is sorted by chartName and chart version.
+func MergeCharts(userCharts, defaults []catalogv1alpha1.ChartConfig) []catalogv1alpha1.ChartConfig {
 	result := make(map[string]catalogv1alpha1.ChartConfig)
 
 	for _, chart := range defaults {
@@ -483,6 +589,21 @@ func sortCharts(charts []catalogv1alpha1.ChartConfig) {
 	})
 }
 
+// IsDefaultChart returns true if the chart is one of the default charts, unchanged from how
+// DefaultApplicationCatalog merges it into a catalog.
+func IsDefaultChart(chart *catalogv1alpha1.ChartConfig) bool {
+	charts := GetDefaultCharts()
+	sortCharts(charts)
+
+	for i := range charts {
+		if charts[i].ChartName == chart.ChartName {
+			return equality.Semantic.DeepEqual(&charts[i], chart)
+		}
+	}
+
+	return false
+}
+
 // GetDefaultChartNames returns the valid default chart names.
 // Derived from GetDefaultCharts() - single source of truth.
 func GetDefaultChartNames() []string {
@@ -588,3 +588,32 @@ func TestGetDefaultChartNames(t *testing.T) {
 		}
 	}
 }
+
+func TestIsDefaultChart(t *testing.T) {
+	catalog := &catalogv1alpha1.ApplicationCatalog{
+		Spec: catalogv1alpha1.ApplicationCatalogSpec{
+			Helm: &catalogv1alpha1.HelmSpec{
+				IncludeDefaults: true,
+				Charts: []catalogv1alpha1.ChartConfig{
+					{ChartName: "argo-cd", ChartVersions: []catalogv1alpha1.ChartVersion{{ChartVersion: "8.0.0", AppVersion: "v3.0.0"}}},
+					{ChartName: "redis", ChartVersions: []catalogv1alpha1.ChartVersion{{ChartVersion: "1.0.0", AppVersion: "v1.0.0"}}},
+				},
+			},
+		},
+	}
+
+	DefaultApplicationCatalog(catalog)
+
+	expected := map[string]bool{
+		"argo-cd":      false,
+		"redis":        false,
+		"cert-manager": true,
+		"metallb":      true,
+	}
+
+	for _, chart := range catalog.Spec.Helm.Charts {
+		if isDefault, ok := expected[chart.ChartName]; ok && IsDefaultChart(&chart) != isDefault {
+			t.Errorf("expected IsDefaultChart(%q) to be %v", chart.ChartName, isDefault)
+		}
+	}
+}
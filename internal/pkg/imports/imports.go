@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package imports resolves the spec.imports of ApplicationCatalogs, which include the
+// charts of other ApplicationCatalogs.
+package imports
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8c.io/application-catalog-manager/internal/pkg/defaulting"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CycleError is returned if the imports of an ApplicationCatalog form a cycle.
+type CycleError struct {
+	// Path lists the catalog names of the cycle, starting and ending with the same catalog.
+	Path []string
+}
+
+func (e *CycleError) Error() string {
+	return fmt.Sprintf("import cycle detected: %s", strings.Join(e.Path, " -> "))
+}
+
+// Resolver resolves the imports of ApplicationCatalogs.
+type Resolver struct {
+	client ctrlruntimeclient.Reader
+}
+
+// NewResolver creates a new Resolver reading the imported ApplicationCatalogs with the given client.
+func NewResolver(client ctrlruntimeclient.Reader) *Resolver {
+	return &Resolver{client: client}
+}
+
+// ResolveCharts returns the charts of the catalog merged with the charts of all imported
+// catalogs. Later imports take precedence over earlier ones and the charts of the catalog
+// itself take precedence over all imports.
+// The charts of catalogs without imports are returned unchanged.
+// Errors of missing imported catalogs wrap the NotFound API error.
+func (r *Resolver) ResolveCharts(ctx context.Context, catalog *catalogv1alpha1.ApplicationCatalog) ([]catalogv1alpha1.ChartConfig, error) {
+	charts, _, err := r.ResolveChartsWithOrigins(ctx, catalog)
+	return charts, err
+}
+
+// ResolveChartsWithOrigins works like ResolveCharts, and additionally returns the name of the
+// catalog each imported chart is taken from, keyed by chart name. The charts of the catalog
+// itself are not part of the map.
+func (r *Resolver) ResolveChartsWithOrigins(ctx context.Context, catalog *catalogv1alpha1.ApplicationCatalog) ([]catalogv1alpha1.ChartConfig, map[string]string, error) {
+	if len(catalog.Spec.Imports) == 0 {
+		return catalog.GetHelmCharts(), nil, nil
+	}
+
+	charts, origins, err := r.resolveImports(ctx, catalog, []string{catalog.Name})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, chart := range catalog.GetHelmCharts() {
+		delete(origins, chart.ChartName)
+	}
+
+	return defaulting.MergeCharts(catalog.DeepCopy().GetHelmCharts(), charts), origins, nil
+}
+
+// resolveImports returns the merged charts of all catalogs imported by the catalog and the
+// names of the catalogs they are taken from. The path lists the names of the catalogs that
+// led to this catalog and is used to detect cycles.
+func (r *Resolver) resolveImports(ctx context.Context, catalog *catalogv1alpha1.ApplicationCatalog, path []string) ([]catalogv1alpha1.ChartConfig, map[string]string, error) {
+	var charts []catalogv1alpha1.ChartConfig
+	origins := map[string]string{}
+
+	for _, imp := range catalog.Spec.Imports {
+		imported, err := r.getImport(ctx, imp.Name, path)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		transitive, transitiveOrigins, err := r.resolveImports(ctx, imported, appendPath(path, imported.Name))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for name, origin := range transitiveOrigins {
+			origins[name] = origin
+		}
+		for _, chart := range imported.GetHelmCharts() {
+			origins[chart.ChartName] = imported.Name
+		}
+
+		importedCharts := defaulting.MergeCharts(materialize(imported), transitive)
+		charts = defaulting.MergeCharts(importedCharts, charts)
+	}
+
+	return charts, origins, nil
+}
+
+// ImportedCatalogs returns the names of all catalogs imported by the catalog, directly or transitively.
+func (r *Resolver) ImportedCatalogs(ctx context.Context, catalog *catalogv1alpha1.ApplicationCatalog) (sets.Set[string], error) {
+	imported := sets.New[string]()
+	if err := r.collectImports(ctx, catalog, []string{catalog.Name}, imported); err != nil {
+		return nil, err
+	}
+
+	return imported, nil
+}
+
+func (r *Resolver) collectImports(ctx context.Context, catalog *catalogv1alpha1.ApplicationCatalog, path []string, imported sets.Set[string]) error {
+	for _, imp := range catalog.Spec.Imports {
+		next, err := r.getImport(ctx, imp.Name, path)
+		if err != nil {
+			return err
+		}
+
+		// Imports reached on multiple paths only need to be collected once.
+		if imported.Has(next.Name) {
+			continue
+		}
+		imported.Insert(next.Name)
+
+		if err := r.collectImports(ctx, next, appendPath(path, next.Name), imported); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Imports returns true if the catalog with the given name imports the target catalog, directly or
+// transitively. Catalogs that do not exist or whose imports cannot be resolved import nothing.
+func (r *Resolver) Imports(ctx context.Context, name, target string) bool {
+	catalog := &catalogv1alpha1.ApplicationCatalog{}
+	if err := r.client.Get(ctx, ctrlruntimeclient.ObjectKey{Name: name}, catalog); err != nil {
+		return false
+	}
+
+	imported, err := r.ImportedCatalogs(ctx, catalog)
+	if err != nil {
+		return false
+	}
+
+	return imported.Has(target)
+}
+
+// getImport returns the imported catalog with the given name. The path lists the names of
+// the catalogs that led to this import and is used to detect cycles.
+func (r *Resolver) getImport(ctx context.Context, name string, path []string) (*catalogv1alpha1.ApplicationCatalog, error) {
+	for _, p := range path {
+		if p == name {
+			return nil, &CycleError{Path: appendPath(path, name)}
+		}
+	}
+
+	imported := &catalogv1alpha1.ApplicationCatalog{}
+	if err := r.client.Get(ctx, ctrlruntimeclient.ObjectKey{Name: name}, imported); err != nil {
+		return nil, fmt.Errorf("failed to get imported ApplicationCatalog %q: %w", name, err)
+	}
+
+	return imported, nil
+}
+
+// appendPath returns a copy of the path with the name appended.
+func appendPath(path []string, name string) []string {
+	return append(append(make([]string, 0, len(path)+1), path...), name)
+}
+
+// materialize returns the charts of an imported catalog with its catalog-level settings applied,
+// so that they generate the same ApplicationDefinitions in the importing catalog:
+//   - the global repository settings are copied to charts without their own baseURL
+//   - the resolved appName is set explicitly if it differs from the chart name, which happens if
+//     spec.helm.sanitizeAppNames is enabled
+func materialize(catalog *catalogv1alpha1.ApplicationCatalog) []catalogv1alpha1.ChartConfig {
+	charts := catalog.GetHelmCharts()
+	global := catalog.GetGlobalRepositorySettings()
+
+	result := make([]catalogv1alpha1.ChartConfig, 0, len(charts))
+	for i := range charts {
+		chart := charts[i].DeepCopy()
+
+		if global != nil && global.BaseURL != "" && (chart.RepositorySettings == nil || chart.RepositorySettings.BaseURL == "") {
+			chart.RepositorySettings = global.DeepCopy()
+		}
+
+		if appName := catalog.ResolveAppName(chart); appName != chart.GetAppName() {
+			if chart.Metadata == nil {
+				chart.Metadata = &catalogv1alpha1.ChartMetadata{}
+			}
+			chart.Metadata.AppName = appName
+		}
+
+		result = append(result, *chart)
+	}
+
+	return result
+}
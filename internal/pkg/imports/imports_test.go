@@ -0,0 +1,312 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imports
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newResolver(t *testing.T, catalogs ...*catalogv1alpha1.ApplicationCatalog) *Resolver {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := catalogv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add catalogv1alpha1 to scheme: %v", err)
+	}
+
+	objects := make([]ctrlruntimeclient.Object, 0, len(catalogs))
+	for _, catalog := range catalogs {
+		objects = append(objects, catalog)
+	}
+
+	return NewResolver(ctrlruntimefakeclient.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		Build())
+}
+
+func newCatalog(name string, imports []string, charts ...catalogv1alpha1.ChartConfig) *catalogv1alpha1.ApplicationCatalog {
+	catalog := &catalogv1alpha1.ApplicationCatalog{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: catalogv1alpha1.ApplicationCatalogSpec{
+			Helm: &catalogv1alpha1.HelmSpec{Charts: charts},
+		},
+	}
+
+	for _, imp := range imports {
+		catalog.Spec.Imports = append(catalog.Spec.Imports, catalogv1alpha1.CatalogImport{Name: imp})
+	}
+
+	return catalog
+}
+
+func newChart(name, description string) catalogv1alpha1.ChartConfig {
+	return catalogv1alpha1.ChartConfig{
+		ChartName:     name,
+		Metadata:      &catalogv1alpha1.ChartMetadata{Description: description},
+		ChartVersions: []catalogv1alpha1.ChartVersion{{ChartVersion: "1.0.0", AppVersion: "v1.0.0"}},
+	}
+}
+
+// chartDescriptions maps the chart names to their descriptions, which tell where a chart comes from.
+func chartDescriptions(charts []catalogv1alpha1.ChartConfig) map[string]string {
+	result := make(map[string]string, len(charts))
+	for _, chart := range charts {
+		result[chart.ChartName] = chart.Metadata.Description
+	}
+
+	return result
+}
+
+func TestResolveCharts(t *testing.T) {
+	platform := newCatalog("platform", nil,
+		newChart("cert-manager", "platform"),
+		newChart("ingress-nginx", "platform"),
+	)
+	security := newCatalog("security", []string{"base"},
+		newChart("falco", "security"),
+		newChart("ingress-nginx", "security"),
+	)
+	base := newCatalog("base", nil,
+		newChart("falco", "base"),
+		newChart("trivy", "base"),
+	)
+
+	resolver := newResolver(t, platform, security, base)
+
+	prod := newCatalog("prod", []string{"platform", "security"},
+		newChart("cert-manager", "prod"),
+		newChart("redis", "prod"),
+	)
+
+	charts, origins, err := resolver.ResolveChartsWithOrigins(context.Background(), prod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]string{
+		"cert-manager":  "prod",
+		"falco":         "security",
+		"ingress-nginx": "security",
+		"redis":         "prod",
+		"trivy":         "base",
+	}
+	if result := chartDescriptions(charts); !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected charts %v, got %v", expected, result)
+	}
+
+	expectedOrigins := map[string]string{
+		"falco":         "security",
+		"ingress-nginx": "security",
+		"trivy":         "base",
+	}
+	if !reflect.DeepEqual(origins, expectedOrigins) {
+		t.Errorf("expected chart origins %v, got %v", expectedOrigins, origins)
+	}
+
+	imported, err := resolver.ImportedCatalogs(context.Background(), prod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := sets.New("platform", "security", "base"); !imported.Equal(expected) {
+		t.Errorf("expected imported catalogs %v, got %v", sets.List(expected), sets.List(imported))
+	}
+}
+
+func TestResolveChartsWithoutImports(t *testing.T) {
+	catalog := newCatalog("prod", nil, newChart("redis", "prod"))
+
+	charts, err := newResolver(t).ResolveCharts(context.Background(), catalog)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(charts, catalog.Spec.Helm.Charts) {
+		t.Errorf("expected charts to be unchanged, got %v", charts)
+	}
+}
+
+func TestResolveChartsMaterializesImportedSettings(t *testing.T) {
+	base := newCatalog("base", nil, newChart("trivy", "base"))
+
+	platform := newCatalog("platform", []string{"base"},
+		newChart("nvidia/gpu-operator", "platform"),
+		newChart("falco", "platform"),
+	)
+	platform.Spec.Helm.SanitizeAppNames = true
+	platform.Spec.Helm.RepositorySettings = &catalogv1alpha1.RepositorySettings{BaseURL: "oci://registry.example.com/platform"}
+	platform.Spec.Helm.Charts[1].RepositorySettings = &catalogv1alpha1.RepositorySettings{BaseURL: "https://falcosecurity.github.io/charts"}
+
+	prod := newCatalog("prod", []string{"platform"})
+
+	charts, err := newResolver(t, base, platform).ResolveCharts(context.Background(), prod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	byName := make(map[string]catalogv1alpha1.ChartConfig, len(charts))
+	for _, chart := range charts {
+		byName[chart.ChartName] = chart
+	}
+
+	gpuOperator := byName["nvidia/gpu-operator"]
+	if appName := prod.ResolveAppName(&gpuOperator); appName != "nvidia-gpu-operator" {
+		t.Errorf("expected the sanitized appName of the imported catalog, got %q", appName)
+	}
+	if url := prod.ResolveChartURL(&gpuOperator, &gpuOperator.ChartVersions[0]); url != "oci://registry.example.com/platform" {
+		t.Errorf("expected the global repository of the imported catalog, got %q", url)
+	}
+
+	falco := byName["falco"]
+	if url := prod.ResolveChartURL(&falco, &falco.ChartVersions[0]); url != "https://falcosecurity.github.io/charts" {
+		t.Errorf("expected the chart repository to be kept, got %q", url)
+	}
+
+	trivy := byName["trivy"]
+	if url := prod.ResolveChartURL(&trivy, &trivy.ChartVersions[0]); url != catalogv1alpha1.DefaultHelmRepository {
+		t.Errorf("expected transitively imported chart to keep the default repository, got %q", url)
+	}
+
+	if platform.Spec.Helm.Charts[0].Metadata.AppName != "" {
+		t.Error("expected the imported catalog not to be modified")
+	}
+}
+
+func TestResolveChartsErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		catalogs      []*catalogv1alpha1.ApplicationCatalog
+		catalog       *catalogv1alpha1.ApplicationCatalog
+		expectedCycle []string
+		notFound      bool
+	}{
+		{
+			name:          "self import",
+			catalog:       newCatalog("prod", []string{"prod"}),
+			expectedCycle: []string{"prod", "prod"},
+		},
+		{
+			name: "cycle through imported catalogs",
+			catalogs: []*catalogv1alpha1.ApplicationCatalog{
+				newCatalog("platform", []string{"security"}),
+				newCatalog("security", []string{"prod"}),
+			},
+			catalog:       newCatalog("prod", []string{"platform"}),
+			expectedCycle: []string{"prod", "platform", "security", "prod"},
+		},
+		{
+			name: "cycle between imported catalogs",
+			catalogs: []*catalogv1alpha1.ApplicationCatalog{
+				newCatalog("platform", []string{"security"}),
+				newCatalog("security", []string{"platform"}),
+			},
+			catalog:       newCatalog("prod", []string{"platform"}),
+			expectedCycle: []string{"prod", "platform", "security", "platform"},
+		},
+		{
+			name:     "missing import",
+			catalog:  newCatalog("prod", []string{"platform"}),
+			notFound: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resolver := newResolver(t, tc.catalogs...)
+
+			_, err := resolver.ResolveCharts(context.Background(), tc.catalog)
+			_, importErr := resolver.ImportedCatalogs(context.Background(), tc.catalog)
+
+			for _, err := range []error{err, importErr} {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+
+				if tc.notFound && !apierrors.IsNotFound(err) {
+					t.Errorf("expected NotFound error, got %v", err)
+				}
+
+				if tc.expectedCycle != nil {
+					var cycleErr *CycleError
+					if !errors.As(err, &cycleErr) {
+						t.Fatalf("expected cycle error, got %v", err)
+					}
+					if !reflect.DeepEqual(cycleErr.Path, tc.expectedCycle) {
+						t.Errorf("expected cycle %v, got %v", tc.expectedCycle, cycleErr.Path)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestResolveChartsDiamond(t *testing.T) {
+	resolver := newResolver(t,
+		newCatalog("platform", []string{"base"}),
+		newCatalog("security", []string{"base"}),
+		newCatalog("base", nil, newChart("trivy", "base")),
+	)
+
+	charts, err := resolver.ResolveCharts(context.Background(), newCatalog("prod", []string{"platform", "security"}))
+	if err != nil {
+		t.Fatalf("expected imports reached on multiple paths not to be a cycle, got: %v", err)
+	}
+
+	if expected := map[string]string{"trivy": "base"}; !reflect.DeepEqual(chartDescriptions(charts), expected) {
+		t.Errorf("expected charts %v, got %v", expected, chartDescriptions(charts))
+	}
+}
+
+func TestImports(t *testing.T) {
+	resolver := newResolver(t,
+		newCatalog("prod", []string{"platform"}),
+		newCatalog("platform", []string{"base"}),
+		newCatalog("base", nil),
+		newCatalog("broken", []string{"missing"}),
+	)
+
+	tests := []struct {
+		name     string
+		target   string
+		expected bool
+	}{
+		{name: "prod", target: "platform", expected: true},
+		{name: "prod", target: "base", expected: true},
+		{name: "platform", target: "prod", expected: false},
+		{name: "base", target: "base", expected: false},
+		{name: "broken", target: "missing", expected: false},
+		{name: "missing", target: "base", expected: false},
+	}
+
+	for _, tc := range tests {
+		if result := resolver.Imports(context.Background(), tc.name, tc.target); result != tc.expected {
+			t.Errorf("expected Imports(%q, %q) to be %v, got %v", tc.name, tc.target, tc.expected, result)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2025 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+)
+
+// PreserveClusterFields copies the fields of the existing ApplicationDefinition which an admin
+// owns in the cluster to the desired one, so that they survive replacing the spec:
+//   - Enforced: if true in cluster, preserve it
+//   - Default: if true in cluster, preserve it
+//   - Selector.Datacenters: if set in cluster, preserve it
+//   - DefaultVersion: if set in cluster, preserve it
+//
+// This follows KKP's pattern from pkg/ee/default-application-catalog/application_catalog.go.
+func PreserveClusterFields(existing, desired *appskubermaticv1.ApplicationDefinition) {
+	if existing.Spec.Enforced {
+		desired.Spec.Enforced = true
+	}
+	if existing.Spec.Default {
+		desired.Spec.Default = true
+	}
+	if existing.Spec.Selector.Datacenters != nil {
+		desired.Spec.Selector.Datacenters = existing.Spec.Selector.Datacenters
+	}
+	if existing.Spec.DefaultVersion != "" {
+		desired.Spec.DefaultVersion = existing.Spec.DefaultVersion
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package logos provides the application logos bundled with the application catalog
+// manager and helpers to detect the format of logo images.
+package logos
+
+import (
+	"bytes"
+	"embed"
+	"path"
+	"sort"
+	"strings"
+)
+
+const (
+	// FormatPNG is the logo format of PNG images.
+	FormatPNG = "png"
+	// FormatSVG is the logo format of SVG images.
+	FormatSVG = "svg+xml"
+
+	// MaxSize is the maximum size of a decoded logo in bytes. Logos are inlined into the
+	// ApplicationDefinitions, so they must stay well below the object size limit.
+	MaxSize = 256 * 1024
+)
+
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+//go:embed bundled
+var bundled embed.FS
+
+// Get returns the bundled logo with the given ID and its format.
+// The ID is the file name of the logo without extension, e.g. "cert-manager".
+func Get(id string) ([]byte, string, bool) {
+	for _, ext := range []string{".png", ".svg"} {
+		data, err := bundled.ReadFile(path.Join("bundled", id+ext))
+		if err == nil {
+			return data, DetectFormat(data), true
+		}
+	}
+
+	return nil, "", false
+}
+
+// IDs returns the sorted IDs of all bundled logos.
+func IDs() []string {
+	entries, err := bundled.ReadDir("bundled")
+	if err != nil {
+		return nil
+	}
+
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, strings.TrimSuffix(entry.Name(), path.Ext(entry.Name())))
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+// DetectFormat returns the logo format matching the given image data,
+// or an empty string if the format is not supported.
+func DetectFormat(data []byte) string {
+	if bytes.HasPrefix(data, pngSignature) {
+		return FormatPNG
+	}
+
+	if bytes.Contains(bytes.ToLower(data), []byte("<svg")) {
+		return FormatSVG
+	}
+
+	return ""
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logos
+
+import (
+	"testing"
+)
+
+func TestBundledLogos(t *testing.T) {
+	ids := IDs()
+	if len(ids) == 0 {
+		t.Fatal("expected bundled logos")
+	}
+
+	for _, id := range ids {
+		data, format, ok := Get(id)
+		if !ok {
+			t.Errorf("expected bundled logo %q to exist", id)
+			continue
+		}
+
+		if format == "" {
+			t.Errorf("bundled logo %q has an unsupported format", id)
+		}
+
+		if len(data) > MaxSize {
+			t.Errorf("bundled logo %q exceeds the maximum size: %d > %d", id, len(data), MaxSize)
+		}
+	}
+
+	if _, _, ok := Get("does-not-exist"); ok {
+		t.Error("expected unknown logo not to exist")
+	}
+}
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		expected string
+	}{
+		{
+			name:     "png",
+			data:     []byte("\x89PNG\r\n\x1a\n\x00\x00"),
+			expected: FormatPNG,
+		},
+		{
+			name:     "svg",
+			data:     []byte(`<?xml version="1.0"?><SVG xmlns="http://www.w3.org/2000/svg"></SVG>`),
+			expected: FormatSVG,
+		},
+		{
+			name:     "unsupported",
+			data:     []byte("GIF89a"),
+			expected: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if format := DetectFormat(tc.data); format != tc.expected {
+				t.Errorf("expected format %q, got %q", tc.expected, format)
+			}
+		})
+	}
+}
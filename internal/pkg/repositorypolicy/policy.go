@@ -0,0 +1,248 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package repositorypolicy implements a cluster-wide policy that restricts which Helm
+// repositories ApplicationCatalogs may reference. The policy is loaded from a YAML file
+// and enforced by the ApplicationCatalog validating webhook.
+package repositorypolicy
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+)
+
+// Policy restricts the repository URLs that can be used in ApplicationCatalogs.
+//
+// Example:
+//
+//	allowedURLs:
+//	- oci://quay.io/kubermatic-mirror/helm-charts
+//	- https://*.charts.example.com
+//	forbidPlainHTTP: true
+//	forbidInsecureSkipTLSVerify: true
+//	requireCredentials:
+//	- registry.internal.example.com
+type Policy struct {
+	// AllowedURLs is a list of URL patterns that repositories must match. A pattern
+	// matches a URL if the schemes are equal, the hosts are equal (a leading "*." in
+	// the pattern matches any subdomain) and the pattern path is a prefix of the URL
+	// path on a path segment boundary. URLs whose path contains dot segments, empty
+	// segments or encoded slashes are rejected. If empty, all URLs are allowed.
+	AllowedURLs []string `json:"allowedURLs,omitempty"`
+
+	// ForbidPlainHTTP rejects repositories using the http scheme and oci
+	// repositories with plainHTTP enabled.
+	ForbidPlainHTTP bool `json:"forbidPlainHTTP,omitempty"`
+
+	// ForbidInsecureSkipTLSVerify rejects repositories that disable certificate validation.
+	ForbidInsecureSkipTLSVerify bool `json:"forbidInsecureSkipTLSVerify,omitempty"`
+
+	// RequireCredentials is a list of hosts for which credentials must be configured.
+	// A leading "*." matches any subdomain.
+	RequireCredentials []string `json:"requireCredentials,omitempty"`
+
+	allowedURLs []*url.URL
+}
+
+// Load reads and parses a policy file.
+func Load(filename string) (*Policy, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file: %w", err)
+	}
+
+	return Parse(content)
+}
+
+// Parse parses and validates a YAML encoded policy.
+func Parse(content []byte) (*Policy, error) {
+	policy := &Policy{}
+	if err := yaml.UnmarshalStrict(content, policy); err != nil {
+		return nil, fmt.Errorf("failed to parse policy: %w", err)
+	}
+
+	for _, pattern := range policy.AllowedURLs {
+		u, err := url.Parse(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed URL pattern %q: %w", pattern, err)
+		}
+
+		if u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalid allowed URL pattern %q: must contain a scheme and a host", pattern)
+		}
+
+		policy.allowedURLs = append(policy.allowedURLs, u)
+	}
+
+	for _, host := range policy.RequireCredentials {
+		if host == "" || strings.Contains(host, "/") {
+			return nil, fmt.Errorf("invalid host %q in requireCredentials: must be a hostname", host)
+		}
+	}
+
+	return policy, nil
+}
+
+// ValidateApplicationCatalog checks every repository URL the catalog resolves to against
+// the policy. This includes the default repository for charts without any repositorySettings.
+// Errors are reported on the field that configured the URL, so each violation is only
+// reported once even if it affects multiple chart versions.
+func (p *Policy) ValidateApplicationCatalog(catalog *catalogv1alpha1.ApplicationCatalog) field.ErrorList {
+	if p == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	seen := sets.New[string]()
+
+	helmPath := field.NewPath("spec", "helm")
+	charts := catalog.GetHelmCharts()
+	for i := range charts {
+		chart := &charts[i]
+		chartPath := helmPath.Child("charts").Index(i)
+
+		for j := range chart.ChartVersions {
+			version := &chart.ChartVersions[j]
+
+			var fldPath *field.Path
+			switch {
+			case version.RepositorySettings != nil && version.RepositorySettings.BaseURL != "":
+				fldPath = chartPath.Child("chartVersions").Index(j).Child("repositorySettings")
+			case chart.RepositorySettings != nil && chart.RepositorySettings.BaseURL != "":
+				fldPath = chartPath.Child("repositorySettings")
+			default:
+				// Covers both the global repository and the built-in default repository.
+				fldPath = helmPath.Child("repositorySettings")
+			}
+
+			settings := catalog.ResolveChartRepositorySettings(chart, version)
+			if settings == nil {
+				settings = &catalogv1alpha1.RepositorySettings{BaseURL: catalog.ResolveChartURL(chart, version)}
+			}
+
+			for _, err := range p.validateRepository(settings, fldPath) {
+				if key := err.Error(); !seen.Has(key) {
+					seen.Insert(key)
+					allErrs = append(allErrs, err)
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func (p *Policy) validateRepository(settings *catalogv1alpha1.RepositorySettings, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	urlPath := fldPath.Child("baseURL")
+	u, err := url.Parse(settings.BaseURL)
+	if err != nil {
+		// Malformed URLs are already reported by the regular catalog validation.
+		return nil
+	}
+
+	if p.ForbidPlainHTTP {
+		if u.Scheme == "http" {
+			allErrs = append(allErrs, field.Forbidden(urlPath, fmt.Sprintf("repository %q uses plain http, which is forbidden by the repository policy", settings.BaseURL)))
+		} else if settings.PlainHTTP {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("plainHTTP"), fmt.Sprintf("repository %q uses plain http, which is forbidden by the repository policy", settings.BaseURL)))
+		}
+	}
+
+	if p.ForbidInsecureSkipTLSVerify && settings.InsecureSkipTLSVerify {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("insecureSkipTLSVerify"), "disabling certificate validation is forbidden by the repository policy"))
+	}
+
+	if len(p.allowedURLs) > 0 {
+		if !isCleanPath(u) {
+			allErrs = append(allErrs, field.Forbidden(urlPath, fmt.Sprintf("repository %q contains dot segments, empty segments or encoded slashes in its path, which are not allowed by the repository policy", settings.BaseURL)))
+		} else if !p.isAllowed(u) {
+			allErrs = append(allErrs, field.Forbidden(urlPath, fmt.Sprintf("repository %q is not allowed by the repository policy, allowed are: %s", settings.BaseURL, strings.Join(p.AllowedURLs, ", "))))
+		}
+	}
+
+	if settings.Credentials == nil && p.requiresCredentials(u.Hostname()) {
+		allErrs = append(allErrs, field.Required(fldPath.Child("credentials"), fmt.Sprintf("the repository policy requires credentials for host %q", u.Hostname())))
+	}
+
+	return allErrs
+}
+
+func (p *Policy) isAllowed(u *url.URL) bool {
+	for _, pattern := range p.allowedURLs {
+		if pattern.Scheme == u.Scheme && matchHost(pattern.Host, u.Host) && matchPath(pattern.Path, u.Path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *Policy) requiresCredentials(host string) bool {
+	for _, pattern := range p.RequireCredentials {
+		if matchHost(pattern, host) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchHost matches a host against a pattern, a leading "*." in the pattern
+// matches any (possibly nested) subdomain, but not the domain itself.
+func matchHost(pattern, host string) bool {
+	pattern = strings.ToLower(pattern)
+	host = strings.ToLower(host)
+
+	if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+		return strings.HasSuffix(host, "."+suffix)
+	}
+
+	return pattern == host
+}
+
+// isCleanPath returns true if the path of the URL is already in its shortest form and contains
+// no encoded slashes. Otherwise, the server could resolve it to a path outside of the allowed
+// prefix, e.g. "/stable/../evil" or "/stable%2F..%2Fevil". Dot segments are checked after
+// decoding, so that "%2e%2e" is covered as well.
+func isCleanPath(u *url.URL) bool {
+	escaped := strings.ToLower(u.EscapedPath())
+	if strings.Contains(escaped, "%2f") || strings.Contains(escaped, "%5c") {
+		return false
+	}
+
+	p := strings.TrimSuffix(u.Path, "/")
+
+	return p == "" || path.Clean(p) == p
+}
+
+// matchPath returns true if prefix is a prefix of path on a segment boundary.
+func matchPath(prefix, path string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	path = strings.TrimSuffix(path, "/")
+
+	return prefix == "" || path == prefix || strings.HasPrefix(path, prefix+"/")
+}
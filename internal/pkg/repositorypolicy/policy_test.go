@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositorypolicy
+
+import (
+	"testing"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const testPolicy = `
+allowedURLs:
+- oci://quay.io/kubermatic-mirror/helm-charts
+- https://*.charts.example.com/stable
+- http://legacy.example.com
+forbidPlainHTTP: true
+requireCredentials:
+- "*.charts.example.com"
+`
+
+func newCatalog(global *catalogv1alpha1.RepositorySettings, charts ...catalogv1alpha1.ChartConfig) *catalogv1alpha1.ApplicationCatalog {
+	return &catalogv1alpha1.ApplicationCatalog{
+		Spec: catalogv1alpha1.ApplicationCatalogSpec{
+			Helm: &catalogv1alpha1.HelmSpec{
+				RepositorySettings: global,
+				Charts:             charts,
+			},
+		},
+	}
+}
+
+func newChart(name string, settings *catalogv1alpha1.RepositorySettings, versions ...string) catalogv1alpha1.ChartConfig {
+	chart := catalogv1alpha1.ChartConfig{ChartName: name, RepositorySettings: settings}
+	for _, v := range versions {
+		chart.ChartVersions = append(chart.ChartVersions, catalogv1alpha1.ChartVersion{ChartVersion: v, AppVersion: "v" + v})
+	}
+
+	return chart
+}
+
+func newSettings(baseURL string, withCredentials bool) *catalogv1alpha1.RepositorySettings {
+	settings := &catalogv1alpha1.RepositorySettings{BaseURL: baseURL}
+	if withCredentials {
+		settings.Credentials = &catalogv1alpha1.RepositoryCredentials{
+			Password: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "creds"},
+				Key:                  "password",
+			},
+		}
+	}
+
+	return settings
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{
+			name:    "valid policy",
+			content: testPolicy,
+		},
+		{
+			name:    "empty policy",
+			content: "",
+		},
+		{
+			name:    "pattern without scheme",
+			content: "allowedURLs: [quay.io/charts]",
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			content: "allowedRepositories: [oci://quay.io]",
+			wantErr: true,
+		},
+		{
+			name:    "credentials host with path",
+			content: "requireCredentials: [quay.io/charts]",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse([]byte(tc.content))
+			if (err != nil) != tc.wantErr {
+				t.Errorf("expected error=%v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestValidateApplicationCatalog(t *testing.T) {
+	policy, err := Parse([]byte(testPolicy))
+	if err != nil {
+		t.Fatalf("failed to parse policy: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		catalog        *catalogv1alpha1.ApplicationCatalog
+		expectedFields []string
+	}{
+		{
+			name:    "default repository is allowed",
+			catalog: newCatalog(nil, newChart("nginx", nil, "1.0.0", "1.1.0")),
+		},
+		{
+			name: "allowed subdomain and path with credentials",
+			catalog: newCatalog(nil,
+				newChart("nginx", newSettings("https://eu.charts.example.com/stable/nginx", true), "1.0.0"),
+			),
+		},
+		{
+			name:           "global repository not in allowlist is reported once",
+			catalog:        newCatalog(newSettings("oci://ghcr.io/charts", false), newChart("nginx", nil, "1.0.0", "1.1.0"), newChart("redis", nil, "2.0.0")),
+			expectedFields: []string{"spec.helm.repositorySettings.baseURL"},
+		},
+		{
+			name:           "path prefix must match on segment boundary",
+			catalog:        newCatalog(nil, newChart("nginx", newSettings("oci://quay.io/kubermatic-mirror/helm-charts-evil", false), "1.0.0")),
+			expectedFields: []string{"spec.helm.charts[0].repositorySettings.baseURL"},
+		},
+		{
+			name:           "dot segments cannot leave the allowed path",
+			catalog:        newCatalog(nil, newChart("nginx", newSettings("https://eu.charts.example.com/stable/../../evil", true), "1.0.0")),
+			expectedFields: []string{"spec.helm.charts[0].repositorySettings.baseURL"},
+		},
+		{
+			name:           "encoded dot segments cannot leave the allowed path",
+			catalog:        newCatalog(nil, newChart("nginx", newSettings("https://eu.charts.example.com/stable/%2e%2e/evil", true), "1.0.0")),
+			expectedFields: []string{"spec.helm.charts[0].repositorySettings.baseURL"},
+		},
+		{
+			name:           "encoded slashes are rejected",
+			catalog:        newCatalog(nil, newChart("nginx", newSettings("https://eu.charts.example.com/stable%2F..%2Fevil", true), "1.0.0")),
+			expectedFields: []string{"spec.helm.charts[0].repositorySettings.baseURL"},
+		},
+		{
+			name:           "empty segments are rejected",
+			catalog:        newCatalog(nil, newChart("nginx", newSettings("https://eu.charts.example.com/stable//evil", true), "1.0.0")),
+			expectedFields: []string{"spec.helm.charts[0].repositorySettings.baseURL"},
+		},
+		{
+			name:    "trailing slash is allowed",
+			catalog: newCatalog(nil, newChart("nginx", newSettings("https://eu.charts.example.com/stable/", true), "1.0.0")),
+		},
+		{
+			name:           "plain http is forbidden even if allowlisted",
+			catalog:        newCatalog(nil, newChart("nginx", newSettings("http://legacy.example.com/charts", false), "1.0.0")),
+			expectedFields: []string{"spec.helm.charts[0].repositorySettings.baseURL"},
+		},
+		{
+			name: "missing credentials for host requiring them",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newChart("nginx", newSettings("https://eu.charts.example.com/stable", true), "1.0.0", "1.1.0")
+				chart.ChartVersions[1].RepositorySettings = newSettings("https://us.charts.example.com/stable", false)
+				return newCatalog(nil, chart)
+			}(),
+			expectedFields: []string{"spec.helm.charts[0].chartVersions[1].repositorySettings.credentials"},
+		},
+		{
+			name:           "apex domain does not match wildcard",
+			catalog:        newCatalog(nil, newChart("nginx", newSettings("https://charts.example.com/stable", true), "1.0.0")),
+			expectedFields: []string{"spec.helm.charts[0].repositorySettings.baseURL"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := policy.ValidateApplicationCatalog(tc.catalog)
+
+			if len(errs) != len(tc.expectedFields) {
+				t.Fatalf("expected %d errors, got %d: %v", len(tc.expectedFields), len(errs), errs)
+			}
+
+			for i, err := range errs {
+				if err.Field != tc.expectedFields[i] {
+					t.Errorf("expected error %d on field %q, got %q", i, tc.expectedFields[i], err.Field)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateApplicationCatalogDefaultRepository(t *testing.T) {
+	policy, err := Parse([]byte("allowedURLs: [https://charts.example.com]"))
+	if err != nil {
+		t.Fatalf("failed to parse policy: %v", err)
+	}
+
+	errs := policy.ValidateApplicationCatalog(newCatalog(nil, newChart("nginx", nil, "1.0.0")))
+	if len(errs) != 1 || errs[0].Field != "spec.helm.repositorySettings.baseURL" {
+		t.Errorf("expected the default repository to be rejected, got: %v", errs)
+	}
+}
+
+func TestNilPolicyAllowsEverything(t *testing.T) {
+	var policy *Policy
+
+	if errs := policy.ValidateApplicationCatalog(newCatalog(newSettings("http://example.com", false), newChart("nginx", nil, "1.0.0"))); len(errs) != 0 {
+		t.Errorf("expected no errors, got: %v", errs)
+	}
+}
+
+func TestValidateApplicationCatalogTransportSettings(t *testing.T) {
+	policy, err := Parse([]byte("forbidPlainHTTP: true\nforbidInsecureSkipTLSVerify: true"))
+	if err != nil {
+		t.Fatalf("failed to parse policy: %v", err)
+	}
+
+	chart := newChart("nginx", &catalogv1alpha1.RepositorySettings{
+		BaseURL:               "oci://legacy.example.com/charts",
+		PlainHTTP:             true,
+		InsecureSkipTLSVerify: true,
+	}, "1.0.0")
+
+	errs := policy.ValidateApplicationCatalog(newCatalog(nil, chart))
+
+	expectedFields := []string{
+		"spec.helm.charts[0].repositorySettings.plainHTTP",
+		"spec.helm.charts[0].repositorySettings.insecureSkipTLSVerify",
+	}
+	if len(errs) != len(expectedFields) {
+		t.Fatalf("expected %d errors, got %d: %v", len(expectedFields), len(errs), errs)
+	}
+
+	for i, err := range errs {
+		if err.Field != expectedFields[i] {
+			t.Errorf("expected error %d on field %q, got %q", i, expectedFields[i], err.Field)
+		}
+	}
+}
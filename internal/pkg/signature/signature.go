@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package signature verifies detached signatures of catalog bundles.
+//
+// Public keys are PEM-encoded PKIX keys. Ed25519 keys verify the signature over the raw
+// content, ECDSA keys verify an ASN.1 signature over the SHA-256 digest of the content,
+// which is the format produced by "cosign sign-blob". Signatures are base64-encoded.
+package signature
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrUnsigned is returned if content without signature is verified.
+var ErrUnsigned = errors.New("content is not signed")
+
+// Verifier verifies signatures with a set of named public keys.
+type Verifier struct {
+	keys map[string]any
+}
+
+// NewVerifier parses the PEM-encoded public keys. The map key is the name of the signer,
+// e.g. the key in the Secret holding the public key.
+func NewVerifier(keys map[string][]byte) (*Verifier, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("no public keys configured")
+	}
+
+	v := &Verifier{keys: make(map[string]any, len(keys))}
+	for name, data := range keys {
+		key, err := parsePublicKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %q: %w", name, err)
+		}
+		v.keys[name] = key
+	}
+
+	return v, nil
+}
+
+// Verify verifies the base64-encoded signature of the content and returns the name of the
+// key that produced it. Keys are tried in lexical order of their names.
+func (v *Verifier) Verify(content, signature []byte) (string, error) {
+	if len(signature) == 0 {
+		return "", ErrUnsigned
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+	if err != nil {
+		return "", fmt.Errorf("failed to decode signature: %w", err)
+	}
+
+	names := make([]string, 0, len(v.keys))
+	for name := range v.keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if verify(v.keys[name], content, sig) {
+			return name, nil
+		}
+	}
+
+	return "", errors.New("signature does not match any of the public keys")
+}
+
+func verify(key any, content, sig []byte) bool {
+	switch key := key.(type) {
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, content, sig)
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(content)
+		return ecdsa.VerifyASN1(key, digest[:], sig)
+	default:
+		return false
+	}
+}
+
+func parsePublicKey(data []byte) (any, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	switch key.(type) {
+	case ed25519.PublicKey, *ecdsa.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T, only ed25519 and ECDSA keys are supported", key)
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"testing"
+)
+
+func encodePublicKey(t *testing.T, key crypto.PublicKey) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal public key: %v", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func TestVerify(t *testing.T) {
+	content := []byte("apiVersion: applicationcatalog.k8c.io/v1alpha1\nkind: ApplicationCatalog\n")
+
+	edPublic, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	ecPrivate, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	_, otherPrivate, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	digest := sha256.Sum256(content)
+	ecSignature, err := ecdsa.SignASN1(rand.Reader, ecPrivate, digest[:])
+	if err != nil {
+		t.Fatalf("failed to sign: %v", err)
+	}
+
+	encode := func(sig []byte) []byte {
+		return []byte(base64.StdEncoding.EncodeToString(sig) + "\n")
+	}
+
+	verifier, err := NewVerifier(map[string][]byte{
+		"release.pub": encodePublicKey(t, edPublic),
+		"cosign.pub":  encodePublicKey(t, &ecPrivate.PublicKey),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		content        []byte
+		signature      []byte
+		expectedSigner string
+		expectedErr    error
+	}{
+		{
+			name:           "ed25519 signature",
+			content:        content,
+			signature:      encode(ed25519.Sign(edPrivate, content)),
+			expectedSigner: "release.pub",
+		},
+		{
+			name:           "cosign signature",
+			content:        content,
+			signature:      encode(ecSignature),
+			expectedSigner: "cosign.pub",
+		},
+		{
+			name:        "tampered content",
+			content:     append([]byte("# tampered\n"), content...),
+			signature:   encode(ed25519.Sign(edPrivate, content)),
+			expectedErr: errors.New("signature does not match any of the public keys"),
+		},
+		{
+			name:        "unknown key",
+			content:     content,
+			signature:   encode(ed25519.Sign(otherPrivate, content)),
+			expectedErr: errors.New("signature does not match any of the public keys"),
+		},
+		{
+			name:        "unsigned",
+			content:     content,
+			expectedErr: ErrUnsigned,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			signer, err := verifier.Verify(tc.content, tc.signature)
+			if tc.expectedErr != nil {
+				if err == nil || err.Error() != tc.expectedErr.Error() {
+					t.Fatalf("expected error %q, got %v", tc.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if signer != tc.expectedSigner {
+				t.Errorf("expected signer %q, got %q", tc.expectedSigner, signer)
+			}
+		})
+	}
+}
+
+func TestNewVerifier(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		keys map[string][]byte
+	}{
+		{
+			name: "no keys",
+			keys: nil,
+		},
+		{
+			name: "not PEM",
+			keys: map[string][]byte{"key": []byte("not a key")},
+		},
+		{
+			name: "unsupported key type",
+			keys: map[string][]byte{"key": encodePublicKey(t, &rsaKey.PublicKey)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := NewVerifier(tc.keys); err == nil {
+				t.Error("expected an error, got none")
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+// MaxRepositorySize is the maximum size of the objects of a cloned Git repository.
+const MaxRepositorySize = 256 * 1024 * 1024
+
+// GitFetcher fetches YAML files from a Git repository. The repository is cloned in memory.
+type GitFetcher struct {
+	URL string
+
+	// Only one of Branch, Tag and Commit should be set. If none is set, the default
+	// branch of the repository is used.
+	Branch string
+	Tag    string
+	Commit string
+
+	// Path is the path of a YAML file, or of a directory whose *.yaml and *.yml files
+	// are read in lexical order. Subdirectories are ignored. The detached signature
+	// of a file is read from the file with the additional ".sig" extension, if it exists.
+	Path string
+
+	InsecureSkipTLSVerify bool
+	Credentials           *Credentials
+
+	// MaxSize limits the size of the objects of the cloned repository. Defaults to
+	// MaxRepositorySize.
+	MaxSize int64
+}
+
+var _ Fetcher = &GitFetcher{}
+
+// Fetch clones the repository and reads the files. The revision is the checked out commit.
+func (f *GitFetcher) Fetch(ctx context.Context) (*Artifact, error) {
+	var (
+		repo *git.Repository
+		fs   billy.Filesystem
+		err  error
+	)
+
+	if f.Commit != "" {
+		repo, fs, err = f.fetchCommit(ctx)
+	} else {
+		repo, fs, err = f.clone(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get HEAD: %w", err)
+	}
+
+	files, signatures, err := readYAMLFiles(fs, f.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Artifact{Revision: head.Hash().String(), Files: files, Signatures: signatures}, nil
+}
+
+// clone clones the branch, the tag or the default branch of the repository shallowly.
+func (f *GitFetcher) clone(ctx context.Context) (*git.Repository, billy.Filesystem, error) {
+	opts := &git.CloneOptions{
+		URL:             f.URL,
+		Auth:            f.auth(),
+		InsecureSkipTLS: f.InsecureSkipTLSVerify,
+		Tags:            git.NoTags,
+		SingleBranch:    true,
+		Depth:           1,
+	}
+
+	switch {
+	case f.Branch != "":
+		opts.ReferenceName = plumbing.NewBranchReferenceName(f.Branch)
+	case f.Tag != "":
+		opts.ReferenceName = plumbing.NewTagReferenceName(f.Tag)
+	}
+
+	fs := memfs.New()
+	repo, err := git.CloneContext(ctx, f.newStorage(), fs, opts)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to clone %s: %w", f.URL, err)
+	}
+
+	return repo, fs, nil
+}
+
+// fetchCommit fetches only the commit, if the server allows fetching commits by their hash.
+// Otherwise, the whole repository is cloned, within the size limit of the storage.
+func (f *GitFetcher) fetchCommit(ctx context.Context) (*git.Repository, billy.Filesystem, error) {
+	fs := memfs.New()
+	repo, err := git.Init(f.newStorage(), fs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to init repository: %w", err)
+	}
+
+	remote, err := repo.CreateRemote(&config.RemoteConfig{Name: git.DefaultRemoteName, URLs: []string{f.URL}})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create remote: %w", err)
+	}
+
+	err = remote.FetchContext(ctx, &git.FetchOptions{
+		RefSpecs:        []config.RefSpec{config.RefSpec(f.Commit + ":refs/heads/pinned")},
+		Depth:           1,
+		Auth:            f.auth(),
+		InsecureSkipTLS: f.InsecureSkipTLSVerify,
+		Tags:            git.NoTags,
+	})
+	if errors.Is(err, git.ErrExactSHA1NotSupported) {
+		fs = memfs.New()
+		repo, err = git.CloneContext(ctx, f.newStorage(), fs, &git.CloneOptions{
+			URL:             f.URL,
+			Auth:            f.auth(),
+			InsecureSkipTLS: f.InsecureSkipTLSVerify,
+			Tags:            git.NoTags,
+			NoCheckout:      true,
+		})
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch commit %s of %s: %w", f.Commit, f.URL, err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get worktree: %w", err)
+	}
+
+	if err := worktree.Checkout(&git.CheckoutOptions{Hash: plumbing.NewHash(f.Commit), Force: true}); err != nil {
+		return nil, nil, fmt.Errorf("failed to check out commit %s: %w", f.Commit, err)
+	}
+
+	return repo, fs, nil
+}
+
+func (f *GitFetcher) newStorage() *limitedStorage {
+	limit := f.MaxSize
+	if limit <= 0 {
+		limit = MaxRepositorySize
+	}
+
+	return &limitedStorage{Storage: memory.NewStorage(), limit: limit}
+}
+
+// limitedStorage is an in-memory storage that fails once its objects exceed the limit.
+type limitedStorage struct {
+	*memory.Storage
+
+	limit int64
+	size  int64
+}
+
+func (s *limitedStorage) SetEncodedObject(obj plumbing.EncodedObject) (plumbing.Hash, error) {
+	s.size += obj.Size()
+	if s.size > s.limit {
+		return plumbing.ZeroHash, fmt.Errorf("repository exceeds the maximum size of %d bytes", s.limit)
+	}
+
+	return s.Storage.SetEncodedObject(obj)
+}
+
+func (f *GitFetcher) auth() transport.AuthMethod {
+	if f.Credentials == nil || (f.Credentials.Username == "" && f.Credentials.Password == "") {
+		return nil
+	}
+
+	return &githttp.BasicAuth{Username: f.Credentials.Username, Password: f.Credentials.Password}
+}
+
+// readYAMLFiles reads the file at p, or all YAML files in the directory at p, and
+// their signatures.
+func readYAMLFiles(fs billy.Filesystem, p string) ([][]byte, [][]byte, error) {
+	p = path.Clean("/" + p)
+
+	info, err := fs.Stat(p)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read path %q: %w", p, err)
+	}
+
+	var names []string
+	if info.IsDir() {
+		entries, err := fs.ReadDir(p)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read directory %q: %w", p, err)
+		}
+
+		for _, entry := range entries {
+			if !entry.IsDir() && (strings.HasSuffix(entry.Name(), ".yaml") || strings.HasSuffix(entry.Name(), ".yml")) {
+				names = append(names, path.Join(p, entry.Name()))
+			}
+		}
+		sort.Strings(names)
+	} else {
+		names = []string{p}
+	}
+
+	var (
+		files      [][]byte
+		signatures [][]byte
+		total      int64
+	)
+
+	for _, name := range names {
+		data, err := readFile(fs, name, MaxArtifactSize-total)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		total += int64(len(data))
+		files = append(files, data)
+
+		var signature []byte
+		if _, err := fs.Stat(name + ".sig"); err == nil {
+			if signature, err = readFile(fs, name+".sig", maxSignatureSize); err != nil {
+				return nil, nil, err
+			}
+		}
+		signatures = append(signatures, signature)
+	}
+
+	return files, signatures, nil
+}
+
+func readFile(fs billy.Filesystem, name string, limit int64) ([]byte, error) {
+	file, err := fs.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %q: %w", name, err)
+	}
+	defer file.Close()
+
+	data, err := ReadLimited(file, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %q: %w", name, err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// newGitRepository creates a local repository with two commits on the "main" branch.
+// The first commit is tagged "v1". It returns the path of the repository and the
+// hashes of both commits.
+func newGitRepository(t *testing.T) (string, plumbing.Hash, plumbing.Hash) {
+	t.Helper()
+
+	dir := t.TempDir()
+	repo, err := git.PlainInitWithOptions(dir, &git.PlainInitOptions{
+		InitOptions: git.InitOptions{DefaultBranch: plumbing.NewBranchReferenceName("main")},
+	})
+	if err != nil {
+		t.Fatalf("failed to init repository: %v", err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatalf("failed to get worktree: %v", err)
+	}
+
+	commit := func(files map[string]string) plumbing.Hash {
+		for name, content := range files {
+			path := filepath.Join(dir, name)
+			if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+				t.Fatalf("failed to create directory: %v", err)
+			}
+			if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+				t.Fatalf("failed to write file: %v", err)
+			}
+		}
+
+		if err := worktree.AddGlob("."); err != nil {
+			t.Fatalf("failed to add files: %v", err)
+		}
+
+		hash, err := worktree.Commit("update", &git.CommitOptions{
+			Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+		})
+		if err != nil {
+			t.Fatalf("failed to commit: %v", err)
+		}
+
+		return hash
+	}
+
+	first := commit(map[string]string{
+		"catalogs/a.yaml": "a: 1\n",
+		"README.md":       "catalogs\n",
+	})
+
+	if _, err := repo.CreateTag("v1", first, nil); err != nil {
+		t.Fatalf("failed to create tag: %v", err)
+	}
+
+	second := commit(map[string]string{
+		"catalogs/a.yaml":        "a: 2\n",
+		"catalogs/b.yml":         "b: 2\n",
+		"catalogs/b.yml.sig":     "signature\n",
+		"catalogs/notes.txt":     "ignored\n",
+		"catalogs/nested/c.yaml": "ignored: true\n",
+	})
+
+	return dir, first, second
+}
+
+func TestGitFetcher(t *testing.T) {
+	dir, first, second := newGitRepository(t)
+
+	tests := []struct {
+		name               string
+		fetcher            *GitFetcher
+		expectedRevision   plumbing.Hash
+		expectedFiles      []string
+		expectedSignatures []string
+		expectedErr        bool
+	}{
+		{
+			name:               "default branch",
+			fetcher:            &GitFetcher{URL: dir, Path: "catalogs"},
+			expectedRevision:   second,
+			expectedFiles:      []string{"a: 2\n", "b: 2\n"},
+			expectedSignatures: []string{"", "signature\n"},
+		},
+		{
+			name:               "branch and single file",
+			fetcher:            &GitFetcher{URL: dir, Branch: "main", Path: "catalogs/b.yml"},
+			expectedRevision:   second,
+			expectedFiles:      []string{"b: 2\n"},
+			expectedSignatures: []string{"signature\n"},
+		},
+		{
+			name:               "tag",
+			fetcher:            &GitFetcher{URL: dir, Tag: "v1", Path: "/catalogs/"},
+			expectedRevision:   first,
+			expectedFiles:      []string{"a: 1\n"},
+			expectedSignatures: []string{""},
+		},
+		{
+			name:               "commit",
+			fetcher:            &GitFetcher{URL: dir, Commit: first.String(), Path: "catalogs"},
+			expectedRevision:   first,
+			expectedFiles:      []string{"a: 1\n"},
+			expectedSignatures: []string{""},
+		},
+		{
+			name:        "missing path",
+			fetcher:     &GitFetcher{URL: dir, Path: "missing"},
+			expectedErr: true,
+		},
+		{
+			name:        "repository exceeding the size limit",
+			fetcher:     &GitFetcher{URL: dir, MaxSize: 64},
+			expectedErr: true,
+		},
+		{
+			name:        "commit exceeding the size limit",
+			fetcher:     &GitFetcher{URL: dir, Commit: first.String(), MaxSize: 64},
+			expectedErr: true,
+		},
+		{
+			name:        "missing branch",
+			fetcher:     &GitFetcher{URL: dir, Branch: "missing"},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			artifact, err := tc.fetcher.Fetch(context.Background())
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if artifact.Revision != tc.expectedRevision.String() {
+				t.Errorf("expected revision %s, got %s", tc.expectedRevision, artifact.Revision)
+			}
+
+			files := make([]string, 0, len(artifact.Files))
+			for _, file := range artifact.Files {
+				files = append(files, string(file))
+			}
+
+			if !reflect.DeepEqual(files, tc.expectedFiles) {
+				t.Errorf("expected files %q, got %q", tc.expectedFiles, files)
+			}
+
+			signatures := make([]string, 0, len(artifact.Signatures))
+			for _, signature := range artifact.Signatures {
+				signatures = append(signatures, string(signature))
+			}
+
+			if !reflect.DeepEqual(signatures, tc.expectedSignatures) {
+				t.Errorf("expected signatures %q, got %q", tc.expectedSignatures, signatures)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+)
+
+// HTTPFetcher fetches a single YAML file over HTTP(S).
+type HTTPFetcher struct {
+	URL string
+
+	// SignatureURL is the URL of the detached signature of the file. If empty, no
+	// signature is fetched. A missing signature is not an error.
+	SignatureURL string
+
+	InsecureSkipTLSVerify bool
+	Credentials           *Credentials
+
+	// Client is the HTTP client used to fetch the file. If nil, a client honoring
+	// InsecureSkipTLSVerify is created.
+	Client *http.Client
+}
+
+var _ Fetcher = &HTTPFetcher{}
+
+// Fetch downloads the file and its signature. The revision is the ETag of the response,
+// or the digest of the content if the server does not return one.
+func (f *HTTPFetcher) Fetch(ctx context.Context) (*Artifact, error) {
+	data, etag, err := f.get(ctx, f.URL, MaxArtifactSize)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, fmt.Errorf("failed to fetch %s: not found", f.URL)
+	}
+
+	artifact := &Artifact{Files: [][]byte{data}, Signatures: [][]byte{nil}}
+	artifact.Revision = etag
+	if artifact.Revision == "" {
+		artifact.Revision = artifact.Digest()
+	}
+
+	if f.SignatureURL != "" {
+		if artifact.Signatures[0], _, err = f.get(ctx, f.SignatureURL, maxSignatureSize); err != nil {
+			return nil, err
+		}
+	}
+
+	return artifact, nil
+}
+
+// get downloads the given URL and returns its content and ETag. Missing files return nil.
+func (f *HTTPFetcher) get(ctx context.Context, url string, limit int64) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	if f.Credentials != nil && (f.Credentials.Username != "" || f.Credentials.Password != "") {
+		req.SetBasicAuth(f.Credentials.Username, f.Credentials.Password)
+	}
+
+	resp, err := f.client().Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to fetch %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, "", nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("failed to fetch %s: unexpected status %s", url, resp.Status)
+	}
+
+	data, err := ReadLimited(resp.Body, limit)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read %s: %w", url, err)
+	}
+
+	return data, resp.Header.Get("ETag"), nil
+}
+
+func (f *HTTPFetcher) client() *http.Client {
+	if f.Client != nil {
+		return f.Client
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if f.InsecureSkipTLSVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	return &http.Client{Transport: transport}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHTTPFetcher(t *testing.T) {
+	const catalog = "apiVersion: applicationcatalog.k8c.io/v1alpha1\nkind: ApplicationCatalog\nmetadata:\n  name: example\n"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/catalog.yaml":
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write([]byte(catalog))
+		case "/no-etag.yaml":
+			_, _ = w.Write([]byte(catalog))
+		case "/catalog.yaml.sig":
+			_, _ = w.Write([]byte("signature"))
+		case "/private.yaml":
+			if username, password, ok := r.BasicAuth(); !ok || username != "user" || password != "pass" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(catalog))
+		case "/large.yaml":
+			_, _ = w.Write([]byte(strings.Repeat("a", MaxArtifactSize+1)))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name              string
+		fetcher           *HTTPFetcher
+		expectedRevision  string
+		expectedSignature string
+		expectedErr       bool
+	}{
+		{
+			name:             "etag is used as revision",
+			fetcher:          &HTTPFetcher{URL: server.URL + "/catalog.yaml"},
+			expectedRevision: `"v1"`,
+		},
+		{
+			name:              "signature",
+			fetcher:           &HTTPFetcher{URL: server.URL + "/catalog.yaml", SignatureURL: server.URL + "/catalog.yaml.sig"},
+			expectedRevision:  `"v1"`,
+			expectedSignature: "signature",
+		},
+		{
+			name:             "missing signature",
+			fetcher:          &HTTPFetcher{URL: server.URL + "/no-etag.yaml", SignatureURL: server.URL + "/no-etag.yaml.sig"},
+			expectedRevision: (&Artifact{Files: [][]byte{[]byte(catalog)}}).Digest(),
+		},
+		{
+			name:             "digest is used as revision without etag",
+			fetcher:          &HTTPFetcher{URL: server.URL + "/no-etag.yaml"},
+			expectedRevision: (&Artifact{Files: [][]byte{[]byte(catalog)}}).Digest(),
+		},
+		{
+			name: "basic auth",
+			fetcher: &HTTPFetcher{
+				URL:         server.URL + "/private.yaml",
+				Credentials: &Credentials{Username: "user", Password: "pass"},
+			},
+			expectedRevision: (&Artifact{Files: [][]byte{[]byte(catalog)}}).Digest(),
+		},
+		{
+			name:        "missing credentials",
+			fetcher:     &HTTPFetcher{URL: server.URL + "/private.yaml"},
+			expectedErr: true,
+		},
+		{
+			name:        "not found",
+			fetcher:     &HTTPFetcher{URL: server.URL + "/missing.yaml"},
+			expectedErr: true,
+		},
+		{
+			name:        "too large",
+			fetcher:     &HTTPFetcher{URL: server.URL + "/large.yaml"},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			artifact, err := tc.fetcher.Fetch(context.Background())
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if artifact.Revision != tc.expectedRevision {
+				t.Errorf("expected revision %q, got %q", tc.expectedRevision, artifact.Revision)
+			}
+
+			if len(artifact.Files) != 1 || string(artifact.Files[0]) != catalog {
+				t.Errorf("unexpected files %q", artifact.Files)
+			}
+
+			if len(artifact.Signatures) != 1 || string(artifact.Signatures[0]) != tc.expectedSignature {
+				t.Errorf("expected signature %q, got %q", tc.expectedSignature, artifact.Signatures)
+			}
+		})
+	}
+}
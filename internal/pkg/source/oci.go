@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+)
+
+// MaxManifestSize is the maximum size of a fetched OCI manifest.
+const MaxManifestSize = 4 * 1024 * 1024
+
+// OCIFetcher fetches YAML files from an OCI artifact. Every layer with the media type
+// catalogv1alpha1.CatalogSourceLayerMediaType is read as a file, other layers are ignored.
+// The detached signature of a layer is read from its
+// catalogv1alpha1.AnnotationCatalogSourceSignature annotation.
+type OCIFetcher struct {
+	// URL is the URL of the repository with the "oci://" prefix.
+	URL string
+
+	// Only one of Tag and Digest should be set. If none is set, "latest" is used.
+	Tag    string
+	Digest string
+
+	PlainHTTP             bool
+	InsecureSkipTLSVerify bool
+	Credentials           *Credentials
+
+	// target replaces the remote repository, it is used in tests.
+	target oras.ReadOnlyTarget
+}
+
+var _ Fetcher = &OCIFetcher{}
+
+// Fetch fetches the manifest and the catalog layers. The revision is the manifest digest.
+func (f *OCIFetcher) Fetch(ctx context.Context) (*Artifact, error) {
+	target := f.target
+	if target == nil {
+		repo, err := f.repository()
+		if err != nil {
+			return nil, err
+		}
+		target = repo
+	}
+
+	reference := f.reference()
+
+	desc, err := target.Resolve(ctx, reference)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s: %w", reference, err)
+	}
+
+	if desc.MediaType != ocispec.MediaTypeImageManifest {
+		return nil, fmt.Errorf("unsupported manifest media type %q", desc.MediaType)
+	}
+
+	if desc.Size > MaxManifestSize {
+		return nil, fmt.Errorf("manifest exceeds the maximum size of %d bytes", MaxManifestSize)
+	}
+
+	data, err := content.FetchAll(ctx, target, desc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch manifest: %w", err)
+	}
+
+	manifest := ocispec.Manifest{}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	var (
+		files      [][]byte
+		signatures [][]byte
+		total      int64
+	)
+
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != catalogv1alpha1.CatalogSourceLayerMediaType {
+			continue
+		}
+
+		total += layer.Size
+		if total > MaxArtifactSize {
+			return nil, fmt.Errorf("content exceeds the maximum size of %d bytes", MaxArtifactSize)
+		}
+
+		data, err := content.FetchAll(ctx, target, layer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch layer %s: %w", layer.Digest, err)
+		}
+		files = append(files, data)
+
+		var signature []byte
+		if sig, ok := layer.Annotations[catalogv1alpha1.AnnotationCatalogSourceSignature]; ok {
+			signature = []byte(sig)
+		}
+		signatures = append(signatures, signature)
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("artifact contains no layers of media type %q", catalogv1alpha1.CatalogSourceLayerMediaType)
+	}
+
+	return &Artifact{Revision: desc.Digest.String(), Files: files, Signatures: signatures}, nil
+}
+
+// reference returns the tag or digest to resolve.
+func (f *OCIFetcher) reference() string {
+	switch {
+	case f.Digest != "":
+		return f.Digest
+	case f.Tag != "":
+		return f.Tag
+	default:
+		return "latest"
+	}
+}
+
+func (f *OCIFetcher) repository() (*remote.Repository, error) {
+	return NewRepository(f.URL, f.PlainHTTP, f.InsecureSkipTLSVerify, f.Credentials)
+}
+
+// NewRepository returns a client for the OCI repository with the given URL. The "oci://"
+// prefix of the URL is optional.
+func NewRepository(url string, plainHTTP, insecureSkipTLSVerify bool, credentials *Credentials) (*remote.Repository, error) {
+	repo, err := remote.NewRepository(strings.TrimPrefix(url, "oci://"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository %q: %w", url, err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if insecureSkipTLSVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	client := &auth.Client{
+		Client: &http.Client{Transport: transport},
+		Cache:  auth.NewCache(),
+	}
+
+	if credentials != nil {
+		host := repo.Reference.Host()
+
+		cred, err := credentials.registryCredential(host)
+		if err != nil {
+			return nil, err
+		}
+		client.Credential = auth.StaticCredential(host, cred)
+	}
+
+	repo.Client = client
+	repo.PlainHTTP = plainHTTP
+
+	return repo, nil
+}
+
+// dockerConfig is the subset of a Docker config.json needed to authenticate.
+type dockerConfig struct {
+	Auths map[string]struct {
+		Auth     string `json:"auth"`
+		Username string `json:"username"`
+		Password string `json:"password"`
+	} `json:"auths"`
+}
+
+// registryCredential returns the credential for the given registry host. The username
+// and password take precedence over the registry config.
+func (c *Credentials) registryCredential(host string) (auth.Credential, error) {
+	if c.Username != "" || c.Password != "" {
+		return auth.Credential{Username: c.Username, Password: c.Password}, nil
+	}
+
+	if len(c.RegistryConfig) == 0 {
+		return auth.EmptyCredential, nil
+	}
+
+	config := dockerConfig{}
+	if err := json.Unmarshal(c.RegistryConfig, &config); err != nil {
+		return auth.EmptyCredential, fmt.Errorf("failed to parse registry config: %w", err)
+	}
+
+	for registry, entry := range config.Auths {
+		registry = strings.TrimPrefix(strings.TrimPrefix(registry, "https://"), "http://")
+		if strings.TrimSuffix(registry, "/") != host {
+			continue
+		}
+
+		if entry.Auth == "" {
+			return auth.Credential{Username: entry.Username, Password: entry.Password}, nil
+		}
+
+		decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+		if err != nil {
+			return auth.EmptyCredential, fmt.Errorf("failed to decode auth of registry %q: %w", registry, err)
+		}
+
+		username, password, ok := strings.Cut(string(decoded), ":")
+		if !ok {
+			return auth.EmptyCredential, fmt.Errorf("invalid auth of registry %q", registry)
+		}
+
+		return auth.Credential{Username: username, Password: password}, nil
+	}
+
+	return auth.EmptyCredential, nil
+}
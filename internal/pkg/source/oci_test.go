@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package source
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"testing"
+
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/memory"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+)
+
+func TestOCIFetcher(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+
+	push := func(mediaType string, data []byte) ocispec.Descriptor {
+		desc := content.NewDescriptorFromBytes(mediaType, data)
+		if err := store.Push(ctx, desc, bytes.NewReader(data)); err != nil {
+			t.Fatalf("failed to push blob: %v", err)
+		}
+		return desc
+	}
+
+	layers := []ocispec.Descriptor{
+		push(catalogv1alpha1.CatalogSourceLayerMediaType, []byte("a: 1\n")),
+		push("text/plain", []byte("ignored\n")),
+		push(catalogv1alpha1.CatalogSourceLayerMediaType, []byte("b: 1\n")),
+	}
+	layers[2].Annotations = map[string]string{catalogv1alpha1.AnnotationCatalogSourceSignature: "signature"}
+
+	manifest, err := oras.PackManifest(ctx, store, oras.PackManifestVersion1_1, "application/vnd.k8c.applicationcatalog.v1", oras.PackManifestOptions{Layers: layers})
+	if err != nil {
+		t.Fatalf("failed to pack manifest: %v", err)
+	}
+	// Unlike registries, the memory store only resolves tags, so the digest is tagged as well.
+	for _, reference := range []string{"v1", manifest.Digest.String()} {
+		if err := store.Tag(ctx, manifest, reference); err != nil {
+			t.Fatalf("failed to tag manifest: %v", err)
+		}
+	}
+
+	empty, err := oras.PackManifest(ctx, store, oras.PackManifestVersion1_1, "application/vnd.k8c.applicationcatalog.v1", oras.PackManifestOptions{Layers: layers[1:2]})
+	if err != nil {
+		t.Fatalf("failed to pack manifest: %v", err)
+	}
+	if err := store.Tag(ctx, empty, "empty"); err != nil {
+		t.Fatalf("failed to tag manifest: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		fetcher     *OCIFetcher
+		expectedErr bool
+	}{
+		{
+			name:    "tag",
+			fetcher: &OCIFetcher{Tag: "v1", target: store},
+		},
+		{
+			name:    "digest",
+			fetcher: &OCIFetcher{Digest: manifest.Digest.String(), target: store},
+		},
+		{
+			name:        "missing tag",
+			fetcher:     &OCIFetcher{target: store},
+			expectedErr: true,
+		},
+		{
+			name:        "no catalog layers",
+			fetcher:     &OCIFetcher{Tag: "empty", target: store},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			artifact, err := tc.fetcher.Fetch(ctx)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if artifact.Revision != manifest.Digest.String() {
+				t.Errorf("expected revision %s, got %s", manifest.Digest, artifact.Revision)
+			}
+
+			if len(artifact.Files) != 2 || string(artifact.Files[0]) != "a: 1\n" || string(artifact.Files[1]) != "b: 1\n" {
+				t.Errorf("unexpected files %q", artifact.Files)
+			}
+
+			if len(artifact.Signatures) != 2 || artifact.Signatures[0] != nil || string(artifact.Signatures[1]) != "signature" {
+				t.Errorf("unexpected signatures %q", artifact.Signatures)
+			}
+		})
+	}
+}
+
+func TestRegistryCredential(t *testing.T) {
+	config := []byte(`{"auths":{"https://registry.example.com":{"auth":"` + base64.StdEncoding.EncodeToString([]byte("user:pass")) + `"},"other.example.com":{"username":"other","password":"secret"}}}`)
+
+	tests := []struct {
+		name             string
+		credentials      *Credentials
+		host             string
+		expectedUsername string
+		expectedPassword string
+	}{
+		{
+			name:             "username and password",
+			credentials:      &Credentials{Username: "a", Password: "b", RegistryConfig: config},
+			host:             "registry.example.com",
+			expectedUsername: "a",
+			expectedPassword: "b",
+		},
+		{
+			name:             "encoded auth",
+			credentials:      &Credentials{RegistryConfig: config},
+			host:             "registry.example.com",
+			expectedUsername: "user",
+			expectedPassword: "pass",
+		},
+		{
+			name:             "plain username and password",
+			credentials:      &Credentials{RegistryConfig: config},
+			host:             "other.example.com",
+			expectedUsername: "other",
+			expectedPassword: "secret",
+		},
+		{
+			name:        "unknown registry",
+			credentials: &Credentials{RegistryConfig: config},
+			host:        "unknown.example.com",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cred, err := tc.credentials.registryCredential(tc.host)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if cred.Username != tc.expectedUsername || cred.Password != tc.expectedPassword {
+				t.Errorf("expected %s:%s, got %s:%s", tc.expectedUsername, tc.expectedPassword, cred.Username, cred.Password)
+			}
+		})
+	}
+}
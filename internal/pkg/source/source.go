@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package source fetches ApplicationCatalog manifests from HTTP URLs, Git repositories
+// and OCI artifacts.
+package source
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"k8c.io/application-catalog-manager/internal/pkg/kubernetes"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// MaxArtifactSize is the maximum total size of the files of an artifact.
+	MaxArtifactSize = 10 * 1024 * 1024
+
+	// maxSignatureSize is the maximum size of a detached signature.
+	maxSignatureSize = 64 * 1024
+)
+
+// Artifact is the content fetched from a source.
+type Artifact struct {
+	// Revision identifies the fetched version of the source, e.g. a Git commit.
+	Revision string
+
+	// Files holds the content of the fetched YAML files in a stable order.
+	Files [][]byte
+
+	// Signatures holds the detached signatures of the files in the same order.
+	// Files without signature have a nil entry.
+	Signatures [][]byte
+}
+
+// Digest returns the SHA-256 digest of the content of all files of the artifact.
+func (a *Artifact) Digest() string {
+	h := sha256.New()
+	for _, file := range a.Files {
+		h.Write(file)
+		// Separate the files so that moving content between them changes the digest.
+		h.Write([]byte{0})
+	}
+
+	return "sha256:" + hex.EncodeToString(h.Sum(nil))
+}
+
+// Credentials are the resolved credentials used to authenticate against a source.
+type Credentials struct {
+	Username string
+	Password string
+
+	// RegistryConfig is the content of a Docker config.json, used for OCI registries.
+	RegistryConfig []byte
+}
+
+// ResolveCredentials reads the referenced credentials from the Secrets in the given namespace.
+// Returns nil if no credentials are given.
+func ResolveCredentials(ctx context.Context, client ctrlruntimeclient.Reader, namespace string, creds *catalogv1alpha1.RepositoryCredentials) (*Credentials, error) {
+	if creds == nil {
+		return nil, nil
+	}
+
+	get := func(selector *corev1.SecretKeySelector) (string, error) {
+		if selector == nil {
+			return "", nil
+		}
+		return kubernetes.GetCredentialFromSecret(ctx, client, namespace, selector.Name, selector.Key)
+	}
+
+	result := &Credentials{}
+
+	var err error
+	if result.Username, err = get(creds.Username); err != nil {
+		return nil, err
+	}
+	if result.Password, err = get(creds.Password); err != nil {
+		return nil, err
+	}
+
+	registryConfig, err := get(creds.RegistryConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	result.RegistryConfig = []byte(registryConfig)
+
+	return result, nil
+}
+
+// Fetcher fetches the artifact of a source.
+type Fetcher interface {
+	Fetch(ctx context.Context) (*Artifact, error)
+}
+
+// ReadLimited reads r and fails if it contains more than limit bytes.
+func ReadLimited(r io.Reader, limit int64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("content exceeds the maximum size of %d bytes", limit)
+	}
+
+	return data, nil
+}
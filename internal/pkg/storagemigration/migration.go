@@ -0,0 +1,165 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package storagemigration migrates the stored ApplicationCatalogs to the storage version of
+// their CustomResourceDefinition, so that older versions can be removed from the CRD.
+package storagemigration
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CRDName is the name of the ApplicationCatalog CustomResourceDefinition.
+var CRDName = catalogv1alpha1.ApplicationCatalogResourceName + "." + catalogv1alpha1.GroupName
+
+// Migrator rewrites all ApplicationCatalogs, which makes the API server store them in the
+// current storage version, and then removes the other versions from the stored versions of
+// the CRD. It is a Runnable of the manager, which retries until the migration succeeded.
+type Migrator struct {
+	client ctrlruntimeclient.Client
+	reader ctrlruntimeclient.Reader
+	log    *zap.SugaredLogger
+
+	backoff wait.Backoff
+}
+
+// New returns a Migrator. Objects are read with the reader, which should not be cached, so
+// that the cache does not have to watch CustomResourceDefinitions.
+func New(client ctrlruntimeclient.Client, reader ctrlruntimeclient.Reader, log *zap.SugaredLogger) *Migrator {
+	return &Migrator{
+		client: client,
+		reader: reader,
+		log:    log,
+		backoff: wait.Backoff{
+			Duration: 5 * time.Second,
+			Factor:   2,
+			Cap:      5 * time.Minute,
+			Steps:    100,
+		},
+	}
+}
+
+// NeedLeaderElection makes the migration run only in the leader.
+func (m *Migrator) NeedLeaderElection() bool {
+	return true
+}
+
+// Start migrates the ApplicationCatalogs. A failed migration does not stop the manager,
+// since the objects can still be served in all versions.
+func (m *Migrator) Start(ctx context.Context) error {
+	err := wait.ExponentialBackoffWithContext(ctx, m.backoff, func(ctx context.Context) (bool, error) {
+		if err := m.Migrate(ctx); err != nil {
+			m.log.Warnw("Failed to migrate the storage version of ApplicationCatalogs, retrying", "error", err)
+			return false, nil
+		}
+
+		return true, nil
+	})
+	if err != nil && ctx.Err() == nil {
+		m.log.Errorw("Giving up migrating the storage version of ApplicationCatalogs", "error", err)
+	}
+
+	return nil
+}
+
+// Migrate rewrites the ApplicationCatalogs if the CRD has stored versions other than the
+// storage version.
+func (m *Migrator) Migrate(ctx context.Context) error {
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := m.reader.Get(ctx, ctrlruntimeclient.ObjectKey{Name: CRDName}, crd); err != nil {
+		return fmt.Errorf("failed to get CustomResourceDefinition: %w", err)
+	}
+
+	storageVersion := ""
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			storageVersion = version.Name
+		}
+	}
+
+	if slices.Equal(crd.Status.StoredVersions, []string{storageVersion}) {
+		return nil
+	}
+
+	// Without the conversion webhook, the API server would store the objects as they are
+	// with the apiVersion of the storage version.
+	if len(crd.Spec.Versions) > 1 && (crd.Spec.Conversion == nil || crd.Spec.Conversion.Strategy != apiextensionsv1.WebhookConverter) {
+		return fmt.Errorf("the conversion webhook of the CustomResourceDefinition is not configured yet")
+	}
+
+	log := m.log.With("storageVersion", storageVersion, "storedVersions", crd.Status.StoredVersions)
+	log.Info("Migrating ApplicationCatalogs to the storage version")
+
+	catalogs := &catalogv1alpha1.ApplicationCatalogList{}
+	if err := m.reader.List(ctx, catalogs); err != nil {
+		return fmt.Errorf("failed to list ApplicationCatalogs: %w", err)
+	}
+
+	var (
+		errs   []error
+		failed []string
+	)
+	for i := range catalogs.Items {
+		catalog := &catalogs.Items[i]
+		if err := m.rewrite(ctx, catalog); err != nil {
+			log.Errorw("Failed to migrate ApplicationCatalog", "catalog", catalog.Name, "error", err)
+			errs = append(errs, fmt.Errorf("failed to migrate ApplicationCatalog %q: %w", catalog.Name, err))
+			failed = append(failed, catalog.Name)
+		}
+	}
+
+	// The stored versions are kept until every ApplicationCatalog was migrated, the failed ones
+	// have to be fixed by an admin, e.g. if they are not valid anymore.
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to migrate ApplicationCatalogs %v: %w", failed, kerrors.NewAggregate(errs))
+	}
+
+	oldCRD := crd.DeepCopy()
+	crd.Status.StoredVersions = []string{storageVersion}
+	if err := m.client.Status().Patch(ctx, crd, ctrlruntimeclient.MergeFrom(oldCRD)); err != nil {
+		return fmt.Errorf("failed to update the stored versions of the CustomResourceDefinition: %w", err)
+	}
+
+	log.Infow("Migrated ApplicationCatalogs to the storage version", "catalogs", len(catalogs.Items))
+
+	return nil
+}
+
+// rewrite writes the ApplicationCatalog with an empty patch, which stores it in the storage
+// version without changing it. Unlike an update, the patch does not send back the object as
+// read, so it cannot revert changes made in the meantime.
+func (m *Migrator) rewrite(ctx context.Context, catalog *catalogv1alpha1.ApplicationCatalog) error {
+	err := m.client.Patch(ctx, catalog, ctrlruntimeclient.RawPatch(types.MergePatchType, []byte("{}")))
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package storagemigration
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func newTestCRD(strategy apiextensionsv1.ConversionStrategyType) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: CRDName},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha1", Served: true},
+				{Name: "v1beta1", Served: true, Storage: true},
+			},
+			Conversion: &apiextensionsv1.CustomResourceConversion{Strategy: strategy},
+		},
+		Status: apiextensionsv1.CustomResourceDefinitionStatus{
+			StoredVersions: []string{"v1alpha1", "v1beta1"},
+		},
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		crd                    *apiextensionsv1.CustomResourceDefinition
+		expectedError          string
+		expectedStoredVersions []string
+		expectedRewrite        bool
+	}{
+		{
+			name:                   "migrates with webhook conversion",
+			crd:                    newTestCRD(apiextensionsv1.WebhookConverter),
+			expectedStoredVersions: []string{"v1beta1"},
+			expectedRewrite:        true,
+		},
+		{
+			name:                   "fails without webhook conversion",
+			crd:                    newTestCRD(apiextensionsv1.NoneConverter),
+			expectedError:          "conversion webhook of the CustomResourceDefinition is not configured",
+			expectedStoredVersions: []string{"v1alpha1", "v1beta1"},
+		},
+		{
+			name: "skips migrated CustomResourceDefinition",
+			crd: func() *apiextensionsv1.CustomResourceDefinition {
+				crd := newTestCRD(apiextensionsv1.NoneConverter)
+				crd.Status.StoredVersions = []string{"v1beta1"}
+				return crd
+			}(),
+			expectedStoredVersions: []string{"v1beta1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			scheme := runtime.NewScheme()
+			if err := catalogv1alpha1.AddToScheme(scheme); err != nil {
+				t.Fatalf("failed to add catalogv1alpha1 to scheme: %v", err)
+			}
+			if err := apiextensionsv1.AddToScheme(scheme); err != nil {
+				t.Fatalf("failed to add apiextensionsv1 to scheme: %v", err)
+			}
+
+			catalog := &catalogv1alpha1.ApplicationCatalog{ObjectMeta: metav1.ObjectMeta{Name: "platform"}}
+
+			client := ctrlruntimefakeclient.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.crd, catalog).
+				WithStatusSubresource(tc.crd).
+				Build()
+
+			if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(catalog), catalog); err != nil {
+				t.Fatalf("failed to get ApplicationCatalog: %v", err)
+			}
+			resourceVersion := catalog.ResourceVersion
+
+			err := New(client, client, zap.NewNop().Sugar()).Migrate(ctx)
+			if tc.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+					t.Fatalf("expected error containing %q, got %v", tc.expectedError, err)
+				}
+			} else if err != nil {
+				t.Fatalf("migration failed: %v", err)
+			}
+
+			crd := &apiextensionsv1.CustomResourceDefinition{}
+			if err := client.Get(ctx, ctrlruntimeclient.ObjectKey{Name: CRDName}, crd); err != nil {
+				t.Fatalf("failed to get CustomResourceDefinition: %v", err)
+			}
+			if !reflect.DeepEqual(crd.Status.StoredVersions, tc.expectedStoredVersions) {
+				t.Errorf("expected stored versions %v, got %v", tc.expectedStoredVersions, crd.Status.StoredVersions)
+			}
+
+			if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(catalog), catalog); err != nil {
+				t.Fatalf("failed to get ApplicationCatalog: %v", err)
+			}
+			if rewritten := catalog.ResourceVersion != resourceVersion; rewritten != tc.expectedRewrite {
+				t.Errorf("expected ApplicationCatalog to be rewritten: %v", tc.expectedRewrite)
+			}
+		})
+	}
+}
+
+func TestMigrateReportsFailedCatalogs(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	if err := catalogv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add catalogv1alpha1 to scheme: %v", err)
+	}
+	if err := apiextensionsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add apiextensionsv1 to scheme: %v", err)
+	}
+
+	crd := newTestCRD(apiextensionsv1.WebhookConverter)
+	valid := &catalogv1alpha1.ApplicationCatalog{ObjectMeta: metav1.ObjectMeta{Name: "platform"}}
+	invalid := &catalogv1alpha1.ApplicationCatalog{ObjectMeta: metav1.ObjectMeta{Name: "invalid"}}
+
+	client := ctrlruntimefakeclient.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(crd, valid, invalid).
+		WithStatusSubresource(crd).
+		WithInterceptorFuncs(interceptor.Funcs{
+			// The API server rejects writes of stored objects which are not valid anymore.
+			Patch: func(ctx context.Context, client ctrlruntimeclient.WithWatch, obj ctrlruntimeclient.Object, patch ctrlruntimeclient.Patch, opts ...ctrlruntimeclient.PatchOption) error {
+				if obj.GetName() == invalid.Name {
+					return apierrors.NewInvalid(catalogv1alpha1.SchemeGroupVersion.WithKind("ApplicationCatalog").GroupKind(), obj.GetName(), field.ErrorList{
+						field.Required(field.NewPath("spec", "helm"), "helm is required"),
+					})
+				}
+				return client.Patch(ctx, obj, patch, opts...)
+			},
+		}).
+		Build()
+
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(valid), valid); err != nil {
+		t.Fatalf("failed to get ApplicationCatalog: %v", err)
+	}
+	resourceVersion := valid.ResourceVersion
+
+	err := New(client, client, zap.NewNop().Sugar()).Migrate(ctx)
+	if err == nil || !strings.Contains(err.Error(), `failed to migrate ApplicationCatalogs [invalid]`) {
+		t.Fatalf("expected the invalid ApplicationCatalog to be reported, got %v", err)
+	}
+
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(valid), valid); err != nil {
+		t.Fatalf("failed to get ApplicationCatalog: %v", err)
+	}
+	if valid.ResourceVersion == resourceVersion {
+		t.Error("expected the valid ApplicationCatalog to be migrated nonetheless")
+	}
+
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKey{Name: CRDName}, crd); err != nil {
+		t.Fatalf("failed to get CustomResourceDefinition: %v", err)
+	}
+	if expected := []string{"v1alpha1", "v1beta1"}; !reflect.DeepEqual(crd.Status.StoredVersions, expected) {
+		t.Errorf("expected stored versions %v to be kept, got %v", expected, crd.Status.StoredVersions)
+	}
+}
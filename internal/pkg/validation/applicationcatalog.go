@@ -0,0 +1,614 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+
+	"k8c.io/application-catalog-manager/internal/pkg/logos"
+	"k8c.io/application-catalog-manager/internal/pkg/values"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+)
+
+// MaxVersionDefaultValuesSize is the maximum combined size of the annotations that hold the
+// per-version default values of a chart on its ApplicationDefinition. It leaves room for the
+// other annotations within the total annotation size limit of Kubernetes.
+const MaxVersionDefaultValuesSize = apivalidation.TotalAnnotationSizeLimitB - 32*(1<<10)
+
+var (
+	supportedURLSchemes = []string{"http", "https", "oci"}
+)
+
+// ValidateApplicationCatalog validates the spec of an ApplicationCatalog.
+// It returns field errors for invalid configurations and warnings for configurations
+// that are accepted but most likely not what the user intended.
+func ValidateApplicationCatalog(catalog *catalogv1alpha1.ApplicationCatalog) (field.ErrorList, []string) {
+	var (
+		allErrs  field.ErrorList
+		warnings []string
+	)
+
+	helmPath := field.NewPath("spec", "helm")
+	if global := catalog.GetGlobalRepositorySettings(); global != nil {
+		errs, warns := validateRepositorySettings(global, helmPath.Child("repositorySettings"))
+		allErrs = append(allErrs, errs...)
+		warnings = append(warnings, warns...)
+	}
+
+	if catalog.Spec.Helm != nil {
+		allErrs = append(allErrs, validateImageRegistryRewrite(catalog.Spec.Helm.ImageRegistryRewrite, helmPath.Child("imageRegistryRewrite"))...)
+	}
+
+	charts := catalog.GetHelmCharts()
+	for i := range charts {
+		errs, warns := validateChart(catalog, &charts[i], helmPath.Child("charts").Index(i))
+		allErrs = append(allErrs, errs...)
+		warnings = append(warnings, warns...)
+	}
+
+	allErrs = append(allErrs, validateImports(catalog, field.NewPath("spec", "imports"))...)
+
+	errs, warns := validateVars(catalog, field.NewPath("spec"))
+	allErrs = append(allErrs, errs...)
+	warnings = append(warnings, warns...)
+
+	if value, ok := catalog.Annotations[catalogv1alpha1.AnnotationRollbackTo]; ok {
+		allErrs = append(allErrs, validateRollbackTo(value, field.NewPath("metadata", "annotations").Key(catalogv1alpha1.AnnotationRollbackTo))...)
+	}
+
+	return allErrs, warnings
+}
+
+// validateRollbackTo validates the revision in the rollback annotation. Whether the revision
+// exists is checked by the controller, which reports it in the RolledBack condition.
+func validateRollbackTo(value string, fldPath *field.Path) field.ErrorList {
+	if revision, err := strconv.ParseInt(value, 10, 64); err != nil || revision < 1 {
+		return field.ErrorList{field.Invalid(fldPath, value, "must be the number of a revision of the catalog")}
+	}
+
+	return nil
+}
+
+// validateVars validates the variable names and the referenced ConfigMaps. Variables used in
+// the default values of the charts but not defined in spec.vars only produce a warning if no
+// ConfigMaps are referenced, since those are read by the controller.
+func validateVars(catalog *catalogv1alpha1.ApplicationCatalog, fldPath *field.Path) (field.ErrorList, []string) {
+	var (
+		allErrs  field.ErrorList
+		warnings []string
+	)
+
+	for _, name := range sets.List(sets.KeySet(catalog.Spec.Vars)) {
+		if !values.IsValidVariableName(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("vars").Key(name), name, "must start with a letter or underscore and consist of letters, digits, '_' or '-'"))
+		}
+	}
+
+	seen := sets.New[string]()
+	for i, ref := range catalog.Spec.VarsFrom {
+		namePath := fldPath.Child("varsFrom").Index(i).Child("name")
+
+		for _, msg := range validation.IsDNS1123Subdomain(ref.Name) {
+			allErrs = append(allErrs, field.Invalid(namePath, ref.Name, msg))
+		}
+
+		if seen.Has(ref.Name) {
+			allErrs = append(allErrs, field.Duplicate(namePath, ref.Name))
+		}
+		seen.Insert(ref.Name)
+	}
+
+	if len(catalog.Spec.VarsFrom) == 0 {
+		used := sets.New[string]()
+		for _, chart := range catalog.GetHelmCharts() {
+			used.Insert(values.Variables(chart.DefaultValuesBlock)...)
+			for _, version := range chart.ChartVersions {
+				used.Insert(values.Variables(version.DefaultValuesBlock)...)
+				used.Insert(values.Variables(version.DefaultValuesPatch)...)
+			}
+		}
+
+		for _, name := range sets.List(used.Difference(sets.KeySet(catalog.Spec.Vars))) {
+			warnings = append(warnings, fmt.Sprintf("variable %q is used in default values but not defined in spec.vars, the reference is kept as it is", name))
+		}
+	}
+
+	return allErrs, warnings
+}
+
+// validateImports only validates the references to the imported catalogs. Import cycles
+// can only be detected with access to the other catalogs, so they are checked by the webhook.
+func validateImports(catalog *catalogv1alpha1.ApplicationCatalog, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	seen := make(map[string]struct{}, len(catalog.Spec.Imports))
+	for i, imp := range catalog.Spec.Imports {
+		namePath := fldPath.Index(i).Child("name")
+
+		for _, msg := range validation.IsDNS1123Subdomain(imp.Name) {
+			allErrs = append(allErrs, field.Invalid(namePath, imp.Name, msg))
+		}
+
+		if imp.Name == catalog.Name {
+			allErrs = append(allErrs, field.Invalid(namePath, imp.Name, "a catalog cannot import itself"))
+		}
+
+		if _, exists := seen[imp.Name]; exists {
+			allErrs = append(allErrs, field.Duplicate(namePath, imp.Name))
+		}
+		seen[imp.Name] = struct{}{}
+	}
+
+	return allErrs
+}
+
+func validateChart(catalog *catalogv1alpha1.ApplicationCatalog, chart *catalogv1alpha1.ChartConfig, fldPath *field.Path) (field.ErrorList, []string) {
+	var (
+		allErrs  field.ErrorList
+		warnings []string
+	)
+
+	allErrs = append(allErrs, validateAppName(catalog, chart, fldPath)...)
+
+	if chart.RepositorySettings != nil {
+		errs, warns := validateRepositorySettings(chart.RepositorySettings, fldPath.Child("repositorySettings"))
+		allErrs = append(allErrs, errs...)
+		warnings = append(warnings, warns...)
+	}
+
+	if chart.Metadata != nil {
+		allErrs = append(allErrs, validateLogo(chart.Metadata, fldPath.Child("metadata"))...)
+	}
+
+	allErrs = append(allErrs, validateValuesBlock(chart.DefaultValuesBlock, fldPath.Child("defaultValuesBlock"))...)
+	allErrs = append(allErrs, validateValuesReferences(chart.DefaultValuesFrom, fldPath.Child("defaultValuesFrom"))...)
+
+	allErrs = append(allErrs, validateImageRegistryKeys(chart.ImageRegistryKeys, fldPath.Child("imageRegistryKeys"))...)
+
+	errs, warns := validateValuesSchemas(catalog, chart, fldPath)
+	allErrs = append(allErrs, errs...)
+	warnings = append(warnings, warns...)
+
+	if chart.DefaultDeployOptions != nil {
+		allErrs = append(allErrs, validateDeployOptions(chart.DefaultDeployOptions, fldPath.Child("defaultDeployOptions"))...)
+	}
+
+	if chart.DefaultNamespace != nil {
+		allErrs = append(allErrs, validateNamespace(chart.DefaultNamespace, fldPath.Child("defaultNamespace"))...)
+	}
+
+	chartVersions := make(map[string]struct{}, len(chart.ChartVersions))
+	appVersions := make(map[string]struct{}, len(chart.ChartVersions))
+	for i := range chart.ChartVersions {
+		version := &chart.ChartVersions[i]
+		versionPath := fldPath.Child("chartVersions").Index(i)
+
+		if _, err := semver.NewVersion(version.ChartVersion); err != nil {
+			allErrs = append(allErrs, field.Invalid(versionPath.Child("chartVersion"), version.ChartVersion, fmt.Sprintf("must be a valid semantic version: %v", err)))
+		}
+
+		if _, err := semver.NewVersion(version.AppVersion); err != nil {
+			warnings = append(warnings, fmt.Sprintf("%s: %q is not a valid semantic version, KKP may not be able to order it correctly", versionPath.Child("appVersion"), version.AppVersion))
+		}
+
+		if _, exists := chartVersions[version.ChartVersion]; exists {
+			allErrs = append(allErrs, field.Duplicate(versionPath.Child("chartVersion"), version.ChartVersion))
+		}
+		chartVersions[version.ChartVersion] = struct{}{}
+
+		// appVersion is used as the version key of the ApplicationDefinition, so
+		// duplicates would silently overwrite each other.
+		if _, exists := appVersions[version.AppVersion]; exists {
+			allErrs = append(allErrs, field.Duplicate(versionPath.Child("appVersion"), version.AppVersion))
+		}
+		appVersions[version.AppVersion] = struct{}{}
+
+		if version.RepositorySettings != nil {
+			errs, warns := validateRepositorySettings(version.RepositorySettings, versionPath.Child("repositorySettings"))
+			allErrs = append(allErrs, errs...)
+			warnings = append(warnings, warns...)
+		}
+
+		allErrs = append(allErrs, validateVersionDefaultValues(version, versionPath)...)
+	}
+
+	allErrs = append(allErrs, validateVersionDefaultValuesSize(chart, fldPath.Child("chartVersions"))...)
+
+	return allErrs, warnings
+}
+
+// validateVersionDefaultValuesSize ensures that the annotations holding the per-version default
+// values fit into the ApplicationDefinition. Variables and values from ConfigMaps are resolved
+// by the controller, which reports ApplicationDefinitions exceeding the limit in the catalog status.
+func validateVersionDefaultValuesSize(chart *catalogv1alpha1.ChartConfig, fldPath *field.Path) field.ErrorList {
+	size := 0
+	for i := range chart.ChartVersions {
+		version := &chart.ChartVersions[i]
+
+		block := version.DefaultValuesBlock
+		if block == "" && version.DefaultValuesPatch != "" {
+			// Invalid values are reported for the version itself.
+			merged, err := values.Merge(chart.DefaultValuesBlock, version.DefaultValuesPatch)
+			if err != nil {
+				continue
+			}
+			block = merged
+		}
+
+		if block != "" {
+			size += len(version.DefaultValuesAnnotation()) + len(block)
+		}
+	}
+
+	if size > MaxVersionDefaultValuesSize {
+		return field.ErrorList{field.Forbidden(fldPath, fmt.Sprintf("the per-version default values take %d bytes, but may not exceed %d bytes combined, as they are stored in annotations of the ApplicationDefinition", size, MaxVersionDefaultValuesSize))}
+	}
+
+	return nil
+}
+
+// validateImageRegistryRewrite ensures that both the source and the target registries are
+// plain registry hosts, optionally followed by a path, as used in image references.
+func validateImageRegistryRewrite(rewrite map[string]string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, from := range sets.List(sets.KeySet(rewrite)) {
+		if msg := validateRegistry(from); msg != "" {
+			allErrs = append(allErrs, field.Invalid(fldPath, from, msg))
+		}
+
+		if msg := validateRegistry(rewrite[from]); msg != "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(from), rewrite[from], msg))
+		}
+	}
+
+	return allErrs
+}
+
+// validateImageRegistryKeys ensures that the keys can be set in the chart values without
+// conflicting with each other.
+func validateImageRegistryKeys(keys []catalogv1alpha1.ImageRegistryKey, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	paths := sets.New[string]()
+	for i := range keys {
+		key := &keys[i]
+		keyPath := fldPath.Index(i)
+
+		switch {
+		case slices.Contains(strings.Split(key.Path, "."), ""):
+			allErrs = append(allErrs, field.Invalid(keyPath.Child("path"), key.Path, "must be a dot-separated path without empty segments"))
+		case paths.Has(key.Path):
+			allErrs = append(allErrs, field.Duplicate(keyPath.Child("path"), key.Path))
+		}
+
+		for _, other := range sets.List(paths) {
+			if strings.HasPrefix(key.Path, other+".") || strings.HasPrefix(other, key.Path+".") {
+				allErrs = append(allErrs, field.Invalid(keyPath.Child("path"), key.Path, fmt.Sprintf("conflicts with %q", other)))
+			}
+		}
+		paths.Insert(key.Path)
+
+		if msg := validateRegistry(key.Registry); msg != "" {
+			allErrs = append(allErrs, field.Invalid(keyPath.Child("registry"), key.Registry, msg))
+		}
+
+		if strings.HasPrefix(key.Repository, "/") || strings.HasSuffix(key.Repository, "/") {
+			allErrs = append(allErrs, field.Invalid(keyPath.Child("repository"), key.Repository, "must not start or end with '/'"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateRegistry returns an error message if the given value is not a registry as used in
+// image references, e.g. "registry.k8s.io" or "mirror.example.com:5000/k8s".
+func validateRegistry(registry string) string {
+	switch {
+	case registry == "":
+		return "must not be empty"
+	case strings.Contains(registry, "://"):
+		return "must not contain a scheme"
+	case strings.ContainsAny(registry, " \t\n@"):
+		return "must not contain whitespace or '@'"
+	case strings.HasPrefix(registry, "/") || strings.HasSuffix(registry, "/"):
+		return "must not start or end with '/'"
+	}
+
+	return ""
+}
+
+// validateAppName ensures that the chart resolves to a valid ApplicationDefinition name.
+// Without this check, charts like "nvidia/gpu-operator" without a metadata.appName would be
+// accepted and only fail later when the controller creates the ApplicationDefinition.
+func validateAppName(catalog *catalogv1alpha1.ApplicationCatalog, chart *catalogv1alpha1.ChartConfig, fldPath *field.Path) field.ErrorList {
+	appName := catalog.ResolveAppName(chart)
+
+	msgs := catalogv1alpha1.ValidateAppName(appName)
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	namePath := fldPath.Child("chartName")
+	if chart.Metadata != nil && chart.Metadata.AppName != "" {
+		namePath = fldPath.Child("metadata", "appName")
+	}
+
+	return field.ErrorList{field.Invalid(namePath, appName, fmt.Sprintf(
+		"%s; set metadata.appName to a valid name or enable spec.helm.sanitizeAppNames",
+		strings.Join(msgs, "; "),
+	))}
+}
+
+// validateValuesReferences only validates the references themselves. The referenced objects
+// may be created after the catalog, so their existence is checked by the controller.
+func validateValuesReferences(refs []catalogv1alpha1.ValuesReference, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	seen := make(map[catalogv1alpha1.ValuesReference]struct{}, len(refs))
+	for i, ref := range refs {
+		refPath := fldPath.Index(i)
+
+		switch ref.Kind {
+		case catalogv1alpha1.ValuesReferenceKindConfigMap, catalogv1alpha1.ValuesReferenceKindSecret:
+		default:
+			allErrs = append(allErrs, field.NotSupported(refPath.Child("kind"), ref.Kind, []catalogv1alpha1.ValuesReferenceKind{
+				catalogv1alpha1.ValuesReferenceKindConfigMap,
+				catalogv1alpha1.ValuesReferenceKindSecret,
+			}))
+		}
+
+		for _, msg := range validation.IsDNS1123Subdomain(ref.Name) {
+			allErrs = append(allErrs, field.Invalid(refPath.Child("name"), ref.Name, msg))
+		}
+
+		for _, msg := range validation.IsConfigMapKey(ref.Key) {
+			allErrs = append(allErrs, field.Invalid(refPath.Child("key"), ref.Key, msg))
+		}
+
+		key := ref
+		key.Optional = false
+		if _, exists := seen[key]; exists {
+			allErrs = append(allErrs, field.Duplicate(refPath, fmt.Sprintf("%s %s/%s", ref.Kind, ref.Name, ref.Key)))
+		}
+		seen[key] = struct{}{}
+	}
+
+	return allErrs
+}
+
+func validateVersionDefaultValues(version *catalogv1alpha1.ChartVersion, fldPath *field.Path) field.ErrorList {
+	if !version.HasDefaultValuesOverride() {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	if version.DefaultValuesBlock != "" && version.DefaultValuesPatch != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("defaultValuesPatch"), "defaultValuesBlock and defaultValuesPatch are mutually exclusive"))
+	}
+
+	allErrs = append(allErrs, validateValuesBlock(version.DefaultValuesBlock, fldPath.Child("defaultValuesBlock"))...)
+	allErrs = append(allErrs, validateValuesBlock(version.DefaultValuesPatch, fldPath.Child("defaultValuesPatch"))...)
+
+	// The values are published in an annotation named after the appVersion.
+	for _, msg := range validation.IsQualifiedName(version.DefaultValuesAnnotation()) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("appVersion"), version.AppVersion, fmt.Sprintf("cannot be used in an annotation name, which is required for per-version default values: %s", msg)))
+	}
+
+	return allErrs
+}
+
+// validateDeployOptions mirrors the rules KKP applies to ApplicationInstallations, so that
+// defaults from the catalog never produce installations that KKP rejects.
+func validateDeployOptions(opts *catalogv1alpha1.DeployOptions, fldPath *field.Path) field.ErrorList {
+	if opts.Helm == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	helmPath := fldPath.Child("helm")
+
+	if opts.Helm.Timeout != nil {
+		if opts.Helm.Timeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(helmPath.Child("timeout"), opts.Helm.Timeout.Duration.String(), "must be greater than 0"))
+		}
+
+		if !opts.Helm.Wait {
+			allErrs = append(allErrs, field.Forbidden(helmPath.Child("timeout"), "timeout requires wait to be enabled"))
+		}
+	}
+
+	if opts.Helm.Atomic && !opts.Helm.Wait {
+		allErrs = append(allErrs, field.Forbidden(helmPath.Child("atomic"), "atomic requires wait to be enabled"))
+	}
+
+	return allErrs
+}
+
+func validateNamespace(ns *catalogv1alpha1.AppNamespaceSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, msg := range validation.IsDNS1123Label(ns.Name) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), ns.Name, msg))
+	}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabels(ns.Labels, fldPath.Child("labels"))...)
+	allErrs = append(allErrs, apivalidation.ValidateAnnotations(ns.Annotations, fldPath.Child("annotations"))...)
+
+	return allErrs
+}
+
+func validateRepositorySettings(settings *catalogv1alpha1.RepositorySettings, fldPath *field.Path) (field.ErrorList, []string) {
+	var (
+		allErrs  field.ErrorList
+		warnings []string
+	)
+
+	if settings.BaseURL == "" {
+		if settings.Credentials != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("credentials"), "credentials are only used together with a baseURL at the same level"))
+		}
+
+		if settings.InsecureSkipTLSVerify {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("insecureSkipTLSVerify"), "insecureSkipTLSVerify is only used together with a baseURL at the same level"))
+		}
+
+		if settings.PlainHTTP {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("plainHTTP"), "plainHTTP is only used together with a baseURL at the same level"))
+		}
+
+		return allErrs, warnings
+	}
+
+	urlPath := fldPath.Child("baseURL")
+	u, err := url.Parse(settings.BaseURL)
+	if err != nil {
+		return append(allErrs, field.Invalid(urlPath, settings.BaseURL, fmt.Sprintf("must be a valid URL: %v", err))), warnings
+	}
+
+	switch u.Scheme {
+	case "https", "oci":
+	case "http":
+		warnings = append(warnings, fmt.Sprintf("%s: %q uses plain http, credentials and charts are transferred unencrypted", urlPath, settings.BaseURL))
+	default:
+		allErrs = append(allErrs, field.Invalid(urlPath, settings.BaseURL, fmt.Sprintf("unsupported scheme %q, must be one of: %s", u.Scheme, strings.Join(supportedURLSchemes, ", "))))
+	}
+
+	if u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(urlPath, settings.BaseURL, "must contain a host"))
+	}
+
+	if settings.PlainHTTP {
+		if u.Scheme == "oci" {
+			warnings = append(warnings, fmt.Sprintf("%s: %q uses plain http, credentials and charts are transferred unencrypted", fldPath.Child("plainHTTP"), settings.BaseURL))
+		} else {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("plainHTTP"), "plainHTTP is only supported for oci:// URLs, use an http:// URL instead"))
+		}
+	}
+
+	if settings.InsecureSkipTLSVerify {
+		if u.Scheme == "http" || settings.PlainHTTP {
+			warnings = append(warnings, fmt.Sprintf("%s: has no effect for plain http connections", fldPath.Child("insecureSkipTLSVerify")))
+		} else {
+			warnings = append(warnings, fmt.Sprintf("%s: certificate validation for %q is disabled, repositories using a private CA can be trusted through spec.caBundle of the KubermaticConfiguration instead", fldPath.Child("insecureSkipTLSVerify"), settings.BaseURL))
+		}
+	}
+
+	return allErrs, warnings
+}
+
+func validateValuesBlock(valuesBlock string, fldPath *field.Path) field.ErrorList {
+	if valuesBlock == "" {
+		return nil
+	}
+
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(valuesBlock), &values); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, field.OmitValueType{}, fmt.Sprintf("must be a valid YAML object: %v", err))}
+	}
+
+	return nil
+}
+
+func validateLogo(metadata *catalogv1alpha1.ChartMetadata, fldPath *field.Path) field.ErrorList {
+	if metadata.LogoFrom != nil {
+		if metadata.Logo != "" {
+			return field.ErrorList{field.Forbidden(fldPath.Child("logoFrom"), "logo and logoFrom are mutually exclusive")}
+		}
+
+		return validateLogoSource(metadata.LogoFrom, metadata.LogoFormat, fldPath)
+	}
+
+	if metadata.Logo == "" {
+		return nil
+	}
+
+	logo, err := base64.StdEncoding.DecodeString(metadata.Logo)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath.Child("logo"), field.OmitValueType{}, fmt.Sprintf("must be base64-encoded: %v", err))}
+	}
+
+	if len(logo) > logos.MaxSize {
+		return field.ErrorList{field.Invalid(fldPath.Child("logo"), field.OmitValueType{}, fmt.Sprintf("must not exceed %d bytes, use logoFrom for large logos", logos.MaxSize))}
+	}
+
+	return validateLogoFormat(logos.DetectFormat(logo), metadata.LogoFormat, fldPath)
+}
+
+func validateLogoSource(source *catalogv1alpha1.LogoSource, logoFormat string, fldPath *field.Path) field.ErrorList {
+	sourcePath := fldPath.Child("logoFrom")
+
+	switch {
+	case source.Bundled != "" && source.ConfigMapKeyRef != nil:
+		return field.ErrorList{field.Forbidden(sourcePath, "exactly one of configMapKeyRef and bundled must be set")}
+
+	case source.Bundled != "":
+		logo, format, ok := logos.Get(source.Bundled)
+		if !ok || len(logo) == 0 {
+			return field.ErrorList{field.NotSupported(sourcePath.Child("bundled"), source.Bundled, logos.IDs())}
+		}
+
+		return validateLogoFormat(format, logoFormat, fldPath)
+
+	case source.ConfigMapKeyRef != nil:
+		// The ConfigMap may be created after the catalog, its content is checked by the controller.
+		var allErrs field.ErrorList
+		refPath := sourcePath.Child("configMapKeyRef")
+
+		for _, msg := range validation.IsDNS1123Subdomain(source.ConfigMapKeyRef.Name) {
+			allErrs = append(allErrs, field.Invalid(refPath.Child("name"), source.ConfigMapKeyRef.Name, msg))
+		}
+
+		for _, msg := range validation.IsConfigMapKey(source.ConfigMapKeyRef.Key) {
+			allErrs = append(allErrs, field.Invalid(refPath.Child("key"), source.ConfigMapKeyRef.Key, msg))
+		}
+
+		return allErrs
+
+	default:
+		return field.ErrorList{field.Required(sourcePath, "exactly one of configMapKeyRef and bundled must be set")}
+	}
+}
+
+// validateLogoFormat checks the detected format of a logo against the configured logoFormat.
+// If no logoFormat is configured, the detected format is used by the controller.
+func validateLogoFormat(detected, logoFormat string, fldPath *field.Path) field.ErrorList {
+	if detected == "" {
+		return field.ErrorList{field.Invalid(fldPath.Child("logo"), field.OmitValueType{}, "unsupported image format, only PNG and SVG images are supported")}
+	}
+
+	if logoFormat != "" && logoFormat != detected {
+		return field.ErrorList{field.Invalid(fldPath.Child("logoFormat"), logoFormat, fmt.Sprintf("does not match the format of the logo (detected: %s)", detected))}
+	}
+
+	return nil
+}
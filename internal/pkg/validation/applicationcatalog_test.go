@@ -0,0 +1,864 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"k8c.io/application-catalog-manager/internal/pkg/defaulting"
+	"k8c.io/application-catalog-manager/internal/pkg/logos"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	testPNGLogo = "iVBORw0KGgoAAAAAAAAAAA=="
+	testSVGLogo = "PHN2ZyB4bWxucz0iaHR0cDovL3d3dy53My5vcmcvMjAwMC9zdmciPjwvc3ZnPg=="
+)
+
+func newTestCatalog(global *catalogv1alpha1.RepositorySettings, charts ...catalogv1alpha1.ChartConfig) *catalogv1alpha1.ApplicationCatalog {
+	return &catalogv1alpha1.ApplicationCatalog{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-catalog"},
+		Spec: catalogv1alpha1.ApplicationCatalogSpec{
+			Helm: &catalogv1alpha1.HelmSpec{
+				RepositorySettings: global,
+				Charts:             charts,
+			},
+		},
+	}
+}
+
+func newTestChart(name string, versions ...string) catalogv1alpha1.ChartConfig {
+	chart := catalogv1alpha1.ChartConfig{ChartName: name}
+	for _, v := range versions {
+		chart.ChartVersions = append(chart.ChartVersions, catalogv1alpha1.ChartVersion{ChartVersion: v, AppVersion: "v" + v})
+	}
+
+	return chart
+}
+
+func testCredentials() *catalogv1alpha1.RepositoryCredentials {
+	return &catalogv1alpha1.RepositoryCredentials{
+		Username: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "creds"},
+			Key:                  "username",
+		},
+	}
+}
+
+func TestValidateApplicationCatalog(t *testing.T) {
+	tests := []struct {
+		name             string
+		catalog          func() *catalogv1alpha1.ApplicationCatalog
+		expectedErrPaths []string
+		expectedWarnings int
+	}{
+		{
+			name: "empty catalog is valid",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				return &catalogv1alpha1.ApplicationCatalog{}
+			},
+		},
+		{
+			name: "valid catalog",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0", "1.1.0")
+				chart.RepositorySettings = &catalogv1alpha1.RepositorySettings{
+					BaseURL:     "https://charts.example.com",
+					Credentials: testCredentials(),
+				}
+				chart.DefaultValuesBlock = "# comment\nreplicas: 2\n"
+				chart.Metadata = &catalogv1alpha1.ChartMetadata{DisplayName: "NGINX", Logo: testPNGLogo, LogoFormat: "png"}
+				return newTestCatalog(&catalogv1alpha1.RepositorySettings{BaseURL: "oci://registry.example.com/charts"}, chart)
+			},
+		},
+		{
+			name: "invalid chart name without appName",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				return newTestCatalog(nil, newTestChart("nvidia/gpu-operator", "1.0.0"))
+			},
+			expectedErrPaths: []string{"spec.helm.charts[0].chartName"},
+		},
+		{
+			name: "invalid appName",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("redis", "1.0.0")
+				chart.Metadata = &catalogv1alpha1.ChartMetadata{AppName: "My_Redis"}
+				return newTestCatalog(nil, newTestChart("nginx", "1.0.0"), chart)
+			},
+			expectedErrPaths: []string{"spec.helm.charts[1].metadata.appName"},
+		},
+		{
+			name: "invalid names are accepted when sanitization is enabled",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				catalog := newTestCatalog(nil, newTestChart("nvidia/gpu-operator", "1.0.0"))
+				catalog.Spec.Helm.SanitizeAppNames = true
+				return catalog
+			},
+		},
+		{
+			name: "unsupported URL schemes on all levels",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.RepositorySettings = &catalogv1alpha1.RepositorySettings{BaseURL: "ftp://charts.example.com"}
+				chart.ChartVersions[0].RepositorySettings = &catalogv1alpha1.RepositorySettings{BaseURL: "git://charts.example.com"}
+				return newTestCatalog(&catalogv1alpha1.RepositorySettings{BaseURL: "s3://bucket"}, chart)
+			},
+			expectedErrPaths: []string{
+				"spec.helm.repositorySettings.baseURL",
+				"spec.helm.charts[0].repositorySettings.baseURL",
+				"spec.helm.charts[0].chartVersions[0].repositorySettings.baseURL",
+			},
+		},
+		{
+			name: "URL without host",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				return newTestCatalog(&catalogv1alpha1.RepositorySettings{BaseURL: "oci://"})
+			},
+			expectedErrPaths: []string{"spec.helm.repositorySettings.baseURL"},
+		},
+		{
+			name: "plain http URL produces a warning",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				return newTestCatalog(&catalogv1alpha1.RepositorySettings{BaseURL: "http://charts.example.com"})
+			},
+			expectedWarnings: 1,
+		},
+		{
+			name: "credentials without baseURL",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.RepositorySettings = &catalogv1alpha1.RepositorySettings{Credentials: testCredentials()}
+				chart.ChartVersions[0].RepositorySettings = &catalogv1alpha1.RepositorySettings{Credentials: testCredentials()}
+				return newTestCatalog(&catalogv1alpha1.RepositorySettings{Credentials: testCredentials()}, chart)
+			},
+			expectedErrPaths: []string{
+				"spec.helm.repositorySettings.credentials",
+				"spec.helm.charts[0].repositorySettings.credentials",
+				"spec.helm.charts[0].chartVersions[0].repositorySettings.credentials",
+			},
+		},
+		{
+			name: "invalid chart version",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				return newTestCatalog(nil, newTestChart("nginx", "1.0.0", "latest"))
+			},
+			expectedErrPaths: []string{"spec.helm.charts[0].chartVersions[1].chartVersion"},
+			expectedWarnings: 1,
+		},
+		{
+			name: "duplicate chart and app versions",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				return newTestCatalog(nil, newTestChart("nginx", "1.0.0", "1.1.0", "1.0.0"))
+			},
+			expectedErrPaths: []string{
+				"spec.helm.charts[0].chartVersions[2].chartVersion",
+				"spec.helm.charts[0].chartVersions[2].appVersion",
+			},
+		},
+		{
+			name: "duplicate app version with distinct chart versions",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0", "1.0.1")
+				chart.ChartVersions[1].AppVersion = chart.ChartVersions[0].AppVersion
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{"spec.helm.charts[0].chartVersions[1].appVersion"},
+		},
+		{
+			name: "invalid default values block",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.DefaultValuesBlock = "service:\n\ttype: ClusterIP\n"
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{"spec.helm.charts[0].defaultValuesBlock"},
+		},
+		{
+			name: "default values block must be an object",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.DefaultValuesBlock = "- a\n- b\n"
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{"spec.helm.charts[0].defaultValuesBlock"},
+		},
+		{
+			name: "logo is not base64",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.Metadata = &catalogv1alpha1.ChartMetadata{DisplayName: "NGINX", Logo: "not base64!", LogoFormat: "png"}
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{"spec.helm.charts[0].metadata.logo"},
+		},
+		{
+			name: "logo format does not match",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.Metadata = &catalogv1alpha1.ChartMetadata{DisplayName: "NGINX", Logo: testSVGLogo, LogoFormat: "png"}
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{"spec.helm.charts[0].metadata.logoFormat"},
+		},
+		{
+			name: "logo format is detected",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.Metadata = &catalogv1alpha1.ChartMetadata{DisplayName: "NGINX", Logo: testSVGLogo}
+				return newTestCatalog(nil, chart)
+			},
+		},
+		{
+			name: "logo format is not supported",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.Metadata = &catalogv1alpha1.ChartMetadata{DisplayName: "NGINX", Logo: base64.StdEncoding.EncodeToString([]byte("GIF89a"))}
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{"spec.helm.charts[0].metadata.logo"},
+		},
+		{
+			name: "logo exceeds the maximum size",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				logo := append([]byte("<svg>"), make([]byte, logos.MaxSize)...)
+				chart.Metadata = &catalogv1alpha1.ChartMetadata{DisplayName: "NGINX", Logo: base64.StdEncoding.EncodeToString(logo)}
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{"spec.helm.charts[0].metadata.logo"},
+		},
+		{
+			name: "bundled logo",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.Metadata = &catalogv1alpha1.ChartMetadata{
+					DisplayName: "NGINX",
+					LogoFrom:    &catalogv1alpha1.LogoSource{Bundled: "nginx"},
+					LogoFormat:  "png",
+				}
+				return newTestCatalog(nil, chart)
+			},
+		},
+		{
+			name: "bundled logo does not exist",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.Metadata = &catalogv1alpha1.ChartMetadata{DisplayName: "NGINX", LogoFrom: &catalogv1alpha1.LogoSource{Bundled: "does-not-exist"}}
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{"spec.helm.charts[0].metadata.logoFrom.bundled"},
+		},
+		{
+			name: "bundled logo format does not match",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.Metadata = &catalogv1alpha1.ChartMetadata{
+					DisplayName: "NGINX",
+					LogoFrom:    &catalogv1alpha1.LogoSource{Bundled: "kueue"},
+					LogoFormat:  "png",
+				}
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{"spec.helm.charts[0].metadata.logoFormat"},
+		},
+		{
+			name: "logo from ConfigMap",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.Metadata = &catalogv1alpha1.ChartMetadata{
+					DisplayName: "NGINX",
+					LogoFrom: &catalogv1alpha1.LogoSource{
+						ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "logos"},
+							Key:                  "nginx.png",
+						},
+					},
+				}
+				return newTestCatalog(nil, chart)
+			},
+		},
+		{
+			name: "invalid logo sources",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				invalidRef := newTestChart("nginx", "1.0.0")
+				invalidRef.Metadata = &catalogv1alpha1.ChartMetadata{
+					LogoFrom: &catalogv1alpha1.LogoSource{
+						ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "Logos"},
+							Key:                  "nginx/png",
+						},
+					},
+				}
+
+				both := newTestChart("redis", "1.0.0")
+				both.Metadata = &catalogv1alpha1.ChartMetadata{Logo: testPNGLogo, LogoFrom: &catalogv1alpha1.LogoSource{Bundled: "nginx"}}
+
+				empty := newTestChart("falco", "1.0.0")
+				empty.Metadata = &catalogv1alpha1.ChartMetadata{LogoFrom: &catalogv1alpha1.LogoSource{}}
+
+				return newTestCatalog(nil, invalidRef, both, empty)
+			},
+			expectedErrPaths: []string{
+				"spec.helm.charts[0].metadata.logoFrom.configMapKeyRef.name",
+				"spec.helm.charts[0].metadata.logoFrom.configMapKeyRef.key",
+				"spec.helm.charts[1].metadata.logoFrom",
+				"spec.helm.charts[2].metadata.logoFrom",
+			},
+		},
+		{
+			name: "plain http oci repository produces a warning",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				return newTestCatalog(&catalogv1alpha1.RepositorySettings{BaseURL: "oci://legacy.example.com/charts", PlainHTTP: true}, newTestChart("nginx", "1.0.0"))
+			},
+			expectedWarnings: 1,
+		},
+		{
+			name: "plainHTTP requires an oci URL",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				return newTestCatalog(&catalogv1alpha1.RepositorySettings{BaseURL: "https://charts.example.com", PlainHTTP: true}, newTestChart("nginx", "1.0.0"))
+			},
+			expectedErrPaths: []string{"spec.helm.repositorySettings.plainHTTP"},
+		},
+		{
+			name: "transport options without baseURL",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.RepositorySettings = &catalogv1alpha1.RepositorySettings{InsecureSkipTLSVerify: true, PlainHTTP: true}
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{
+				"spec.helm.charts[0].repositorySettings.insecureSkipTLSVerify",
+				"spec.helm.charts[0].repositorySettings.plainHTTP",
+			},
+		},
+		{
+			name: "insecureSkipTLSVerify produces a warning",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				return newTestCatalog(&catalogv1alpha1.RepositorySettings{BaseURL: "https://harbor.internal", InsecureSkipTLSVerify: true}, newTestChart("nginx", "1.0.0"))
+			},
+			expectedWarnings: 1,
+		},
+		{
+			name: "valid default namespace",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.DefaultNamespace = &catalogv1alpha1.AppNamespaceSpec{
+					Name:        "ingress-nginx",
+					Labels:      map[string]string{"pod-security.kubernetes.io/enforce": "baseline"},
+					Annotations: map[string]string{"example.com/owner": "platform team"},
+				}
+				return newTestCatalog(nil, chart)
+			},
+		},
+		{
+			name: "invalid default namespace",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.DefaultNamespace = &catalogv1alpha1.AppNamespaceSpec{
+					Name:   "Ingress.Nginx",
+					Labels: map[string]string{"team": "platform team"},
+				}
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{
+				"spec.helm.charts[0].defaultNamespace.name",
+				"spec.helm.charts[0].defaultNamespace.labels",
+			},
+		},
+		{
+			name: "per-version default values",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("argo-cd", "5.0.0", "7.0.0")
+				chart.DefaultValuesBlock = "server:\n  replicas: 1\n"
+				chart.ChartVersions[0].DefaultValuesBlock = "server:\n  replicaCount: 1\n"
+				chart.ChartVersions[1].DefaultValuesPatch = "server:\n  replicas: 2\n"
+				return newTestCatalog(nil, chart)
+			},
+		},
+		{
+			name: "per-version default values block and patch are mutually exclusive",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("argo-cd", "5.0.0")
+				chart.ChartVersions[0].DefaultValuesBlock = "a: 1\n"
+				chart.ChartVersions[0].DefaultValuesPatch = "- b\n"
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{
+				"spec.helm.charts[0].chartVersions[0].defaultValuesPatch",
+				"spec.helm.charts[0].chartVersions[0].defaultValuesPatch",
+			},
+		},
+		{
+			name: "per-version default values require an annotation compatible appVersion",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("argo-cd", "5.0.0")
+				chart.ChartVersions[0].AppVersion = "v5.0.0+build.1"
+				chart.ChartVersions[0].DefaultValuesPatch = "a: 1\n"
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{"spec.helm.charts[0].chartVersions[0].appVersion"},
+		},
+		{
+			name: "per-version default values exceeding the annotation size limit",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("argo-cd", "5.0.0", "6.0.0")
+				chart.ChartVersions[0].DefaultValuesBlock = "a: " + strings.Repeat("x", 120*1024) + "\n"
+				chart.ChartVersions[1].DefaultValuesBlock = "b: " + strings.Repeat("x", 120*1024) + "\n"
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{"spec.helm.charts[0].chartVersions"},
+		},
+		{
+			name: "per-version default values patches exceeding the annotation size limit with the chart-level values",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("argo-cd", "5.0.0", "6.0.0", "7.0.0")
+				chart.DefaultValuesBlock = "a: " + strings.Repeat("x", 80*1024) + "\n"
+				chart.ChartVersions[0].DefaultValuesPatch = "b: 1\n"
+				chart.ChartVersions[1].DefaultValuesPatch = "b: 2\n"
+				chart.ChartVersions[2].DefaultValuesPatch = "b: 3\n"
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{"spec.helm.charts[0].chartVersions"},
+		},
+		{
+			name: "per-version default values of different charts are limited separately",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				first := newTestChart("argo-cd", "5.0.0")
+				first.ChartVersions[0].DefaultValuesBlock = "a: " + strings.Repeat("x", 150*1024) + "\n"
+				second := newTestChart("nginx", "1.0.0")
+				second.ChartVersions[0].DefaultValuesBlock = "a: " + strings.Repeat("x", 150*1024) + "\n"
+				return newTestCatalog(nil, first, second)
+			},
+		},
+		{
+			name: "default values references",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.DefaultValuesFrom = []catalogv1alpha1.ValuesReference{
+					{Kind: catalogv1alpha1.ValuesReferenceKindConfigMap, Name: "nginx-values", Key: "values.yaml"},
+					{Kind: catalogv1alpha1.ValuesReferenceKindSecret, Name: "nginx-values", Key: "values.yaml", Optional: true},
+				}
+				return newTestCatalog(nil, chart)
+			},
+		},
+		{
+			name: "invalid default values references",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.DefaultValuesFrom = []catalogv1alpha1.ValuesReference{
+					{Kind: "Pod", Name: "nginx-values", Key: "values.yaml"},
+					{Kind: catalogv1alpha1.ValuesReferenceKindConfigMap, Name: "Nginx_Values", Key: "values/yaml"},
+					{Kind: catalogv1alpha1.ValuesReferenceKindSecret, Name: "nginx-values", Key: "values.yaml"},
+					{Kind: catalogv1alpha1.ValuesReferenceKindSecret, Name: "nginx-values", Key: "values.yaml", Optional: true},
+				}
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{
+				"spec.helm.charts[0].defaultValuesFrom[0].kind",
+				"spec.helm.charts[0].defaultValuesFrom[1].name",
+				"spec.helm.charts[0].defaultValuesFrom[1].key",
+				"spec.helm.charts[0].defaultValuesFrom[3]",
+			},
+		},
+		{
+			name: "imports",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				catalog := newTestCatalog(nil, newTestChart("nginx", "1.0.0"))
+				catalog.Spec.Imports = []catalogv1alpha1.CatalogImport{{Name: "platform"}, {Name: "security"}}
+				return catalog
+			},
+		},
+		{
+			name: "invalid imports",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				catalog := newTestCatalog(nil, newTestChart("nginx", "1.0.0"))
+				catalog.Spec.Imports = []catalogv1alpha1.CatalogImport{
+					{Name: "my-catalog"},
+					{Name: "Platform"},
+					{Name: "security"},
+					{Name: "security"},
+				}
+				return catalog
+			},
+			expectedErrPaths: []string{
+				"spec.imports[0].name",
+				"spec.imports[1].name",
+				"spec.imports[3].name",
+			},
+		},
+		{
+			name: "vars",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.DefaultValuesBlock = "image:\n  registry: ${catalog.vars.registry}\n"
+				catalog := newTestCatalog(nil, chart)
+				catalog.Spec.Vars = map[string]string{"registry": "registry.example.com", "storage_class": "fast"}
+				return catalog
+			},
+		},
+		{
+			name: "undefined vars produce a warning",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.DefaultValuesBlock = "image:\n  registry: ${catalog.vars.registry}\nliteral: $${catalog.vars.literal}\n"
+				chart.ChartVersions[0].DefaultValuesPatch = "host: ${catalog.vars.domain}\n"
+				return newTestCatalog(nil, chart)
+			},
+			expectedWarnings: 2,
+		},
+		{
+			name: "undefined vars can be defined in ConfigMaps",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.DefaultValuesBlock = "image:\n  registry: ${catalog.vars.registry}\n"
+				catalog := newTestCatalog(nil, chart)
+				catalog.Spec.VarsFrom = []catalogv1alpha1.VarsReference{{Name: "installation"}}
+				return catalog
+			},
+		},
+		{
+			name: "invalid vars",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				catalog := newTestCatalog(nil, newTestChart("nginx", "1.0.0"))
+				catalog.Spec.Vars = map[string]string{"registry.host": "registry.example.com", "1st": "a"}
+				catalog.Spec.VarsFrom = []catalogv1alpha1.VarsReference{
+					{Name: "installation"},
+					{Name: "Installation"},
+					{Name: "installation", Optional: true},
+				}
+				return catalog
+			},
+			expectedErrPaths: []string{
+				"spec.vars[1st]",
+				"spec.vars[registry.host]",
+				"spec.varsFrom[1].name",
+				"spec.varsFrom[2].name",
+			},
+		},
+		{
+			name: "valid image registry rewrite",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.ImageRegistryKeys = []catalogv1alpha1.ImageRegistryKey{
+					{Path: "controller.image.registry", Registry: "registry.k8s.io"},
+					{Path: "global.image.repository", Registry: "quay.io", Repository: "nginx/nginx"},
+				}
+				catalog := newTestCatalog(nil, chart)
+				catalog.Spec.Helm.ImageRegistryRewrite = map[string]string{
+					"registry.k8s.io": "mirror.example.com:5000/k8s",
+					"quay.io":         "mirror.example.com:5000/quay",
+				}
+				return catalog
+			},
+		},
+		{
+			name: "invalid image registry rewrite",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.ImageRegistryKeys = []catalogv1alpha1.ImageRegistryKey{
+					{Path: "controller.image", Registry: "registry.k8s.io"},
+					{Path: "controller.image.registry", Registry: "registry.k8s.io"},
+					{Path: "controller..registry", Registry: "https://registry.k8s.io"},
+					{Path: "controller.image", Registry: "registry.k8s.io", Repository: "/ingress-nginx"},
+				}
+				catalog := newTestCatalog(nil, chart)
+				catalog.Spec.Helm.ImageRegistryRewrite = map[string]string{
+					"https://quay.io": "mirror.example.com",
+					"registry.k8s.io": "mirror.example.com/",
+				}
+				return catalog
+			},
+			expectedErrPaths: []string{
+				"spec.helm.imageRegistryRewrite",
+				"spec.helm.imageRegistryRewrite[registry.k8s.io]",
+				"spec.helm.charts[0].imageRegistryKeys[1].path",
+				"spec.helm.charts[0].imageRegistryKeys[2].path",
+				"spec.helm.charts[0].imageRegistryKeys[2].registry",
+				"spec.helm.charts[0].imageRegistryKeys[3].path",
+				"spec.helm.charts[0].imageRegistryKeys[3].path",
+				"spec.helm.charts[0].imageRegistryKeys[3].repository",
+			},
+		},
+		{
+			name: "full deploy options",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.DefaultDeployOptions = &catalogv1alpha1.DeployOptions{
+					Helm: &catalogv1alpha1.HelmDeployOptions{
+						Wait:      true,
+						Timeout:   &metav1.Duration{Duration: 15 * time.Minute},
+						Atomic:    true,
+						EnableDNS: true,
+					},
+				}
+				return newTestCatalog(nil, chart)
+			},
+		},
+		{
+			name: "timeout and atomic require wait",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.DefaultDeployOptions = &catalogv1alpha1.DeployOptions{
+					Helm: &catalogv1alpha1.HelmDeployOptions{
+						Timeout: &metav1.Duration{Duration: 15 * time.Minute},
+						Atomic:  true,
+					},
+				}
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{
+				"spec.helm.charts[0].defaultDeployOptions.helm.timeout",
+				"spec.helm.charts[0].defaultDeployOptions.helm.atomic",
+			},
+		},
+		{
+			name: "timeout must be positive",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.DefaultDeployOptions = &catalogv1alpha1.DeployOptions{
+					Helm: &catalogv1alpha1.HelmDeployOptions{
+						Wait:    true,
+						Timeout: &metav1.Duration{},
+					},
+				}
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{"spec.helm.charts[0].defaultDeployOptions.helm.timeout"},
+		},
+		{
+			name: "default values match the values schema",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0", "1.1.0")
+				chart.DefaultValuesBlock = "replicaCount: 2\n"
+				chart.ValuesSchema = &catalogv1alpha1.ValuesSchema{
+					Inline: `{"type": "object", "properties": {"replicaCount": {"type": "integer"}}}`,
+				}
+				return newTestCatalog(nil, chart)
+			},
+		},
+		{
+			name: "default values do not match the values schema",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0", "1.1.0")
+				chart.DefaultValuesBlock = "replicaCount: two\n"
+				chart.ValuesSchema = &catalogv1alpha1.ValuesSchema{
+					Inline: `{"type": "object", "properties": {"replicaCount": {"type": "integer"}}}`,
+				}
+				chart.ChartVersions[1].DefaultValuesPatch = "replicaCount: 3\n"
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{"spec.helm.charts[0].defaultValuesBlock"},
+		},
+		{
+			name: "per-version values schema",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0", "1.1.0")
+				chart.DefaultValuesBlock = "replicaCount: 2\n"
+				chart.ChartVersions[1].ValuesSchema = &catalogv1alpha1.ValuesSchema{
+					Inline: "type: object\nproperties:\n  replicaCount:\n    type: string\n",
+				}
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{"spec.helm.charts[0].defaultValuesBlock"},
+		},
+		{
+			name: "invalid values schemas",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0", "1.1.0")
+				chart.ValuesSchema = &catalogv1alpha1.ValuesSchema{
+					Inline:    `{"type": "unknown"}`,
+					FromChart: false,
+				}
+				chart.ChartVersions[0].ValuesSchema = &catalogv1alpha1.ValuesSchema{
+					Inline:    `{"type": "object"}`,
+					FromChart: true,
+				}
+				chart.ChartVersions[1].ValuesSchema = &catalogv1alpha1.ValuesSchema{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{},
+				}
+				return newTestCatalog(nil, chart)
+			},
+			expectedErrPaths: []string{
+				"spec.helm.charts[0].valuesSchema.inline",
+				"spec.helm.charts[0].chartVersions[0].valuesSchema",
+				"spec.helm.charts[0].chartVersions[1].valuesSchema.configMapKeyRef.name",
+				"spec.helm.charts[0].chartVersions[1].valuesSchema.configMapKeyRef.key",
+			},
+		},
+		{
+			name: "values referencing variables are not validated against the values schema",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				chart := newTestChart("nginx", "1.0.0")
+				chart.DefaultValuesBlock = "replicaCount: ${catalog.vars.replicas}\n"
+				chart.ValuesSchema = &catalogv1alpha1.ValuesSchema{
+					Inline: `{"type": "object", "properties": {"replicaCount": {"type": "integer"}}}`,
+				}
+				return newTestCatalog(nil, chart)
+			},
+			expectedWarnings: 2,
+		},
+		{
+			name: "rollback to a revision",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				catalog := newTestCatalog(nil, newTestChart("nginx", "1.0.0"))
+				catalog.Annotations = map[string]string{catalogv1alpha1.AnnotationRollbackTo: "3"}
+				return catalog
+			},
+		},
+		{
+			name: "invalid rollback revision",
+			catalog: func() *catalogv1alpha1.ApplicationCatalog {
+				catalog := newTestCatalog(nil, newTestChart("nginx", "1.0.0"))
+				catalog.Annotations = map[string]string{catalogv1alpha1.AnnotationRollbackTo: "latest"}
+				return catalog
+			},
+			expectedErrPaths: []string{"metadata.annotations[applicationcatalog.k8c.io/rollback-to]"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			errs, warnings := ValidateApplicationCatalog(tc.catalog())
+
+			if len(errs) != len(tc.expectedErrPaths) {
+				t.Fatalf("expected %d errors, got %d: %v", len(tc.expectedErrPaths), len(errs), errs)
+			}
+
+			for i, err := range errs {
+				if err.Field != tc.expectedErrPaths[i] {
+					t.Errorf("expected error for field %q, got %q", tc.expectedErrPaths[i], err.Field)
+				}
+			}
+
+			if len(warnings) != tc.expectedWarnings {
+				t.Errorf("expected %d warnings, got %d: %v", tc.expectedWarnings, len(warnings), warnings)
+			}
+		})
+	}
+}
+
+func TestValidateApplicationCatalogDefaultCharts(t *testing.T) {
+	catalog := &catalogv1alpha1.ApplicationCatalog{
+		ObjectMeta: metav1.ObjectMeta{Name: "defaults"},
+		Spec: catalogv1alpha1.ApplicationCatalogSpec{
+			Helm: &catalogv1alpha1.HelmSpec{IncludeDefaults: true},
+		},
+	}
+	defaulting.DefaultApplicationCatalog(catalog)
+
+	errs, warnings := ValidateApplicationCatalog(catalog)
+	if len(errs) > 0 {
+		t.Errorf("expected default charts to be valid, got: %v", errs)
+	}
+
+	if len(warnings) > 0 {
+		t.Errorf("expected no warnings for default charts, got: %v", warnings)
+	}
+}
+
+func TestValidateInsecureSkipTLSVerifyWarning(t *testing.T) {
+	catalog := newTestCatalog(&catalogv1alpha1.RepositorySettings{BaseURL: "https://harbor.internal", InsecureSkipTLSVerify: true}, newTestChart("nginx", "1.0.0"))
+
+	_, warnings := ValidateApplicationCatalog(catalog)
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "spec.caBundle of the KubermaticConfiguration") {
+		t.Errorf("expected a warning pointing to the CA bundle of KKP, got: %v", warnings)
+	}
+}
+
+func TestValidateAppNames(t *testing.T) {
+	tests := []struct {
+		name             string
+		sanitize         bool
+		charts           []catalogv1alpha1.ChartConfig
+		expectedErrPaths []string
+	}{
+		{
+			name: "valid chart names",
+			charts: []catalogv1alpha1.ChartConfig{
+				{ChartName: "nginx"},
+				{ChartName: "cert-manager"},
+			},
+		},
+		{
+			name: "chart name with slash and no appName",
+			charts: []catalogv1alpha1.ChartConfig{
+				{ChartName: "nvidia/gpu-operator"},
+			},
+			expectedErrPaths: []string{"spec.helm.charts[0].chartName"},
+		},
+		{
+			name: "chart name with slash and valid appName",
+			charts: []catalogv1alpha1.ChartConfig{
+				{
+					ChartName: "nvidia/gpu-operator",
+					Metadata:  &catalogv1alpha1.ChartMetadata{AppName: "nvidia-gpu-operator"},
+				},
+			},
+		},
+		{
+			name: "invalid appName",
+			charts: []catalogv1alpha1.ChartConfig{
+				{ChartName: "nginx"},
+				{
+					ChartName: "redis",
+					Metadata:  &catalogv1alpha1.ChartMetadata{AppName: "My_Redis"},
+				},
+			},
+			expectedErrPaths: []string{"spec.helm.charts[1].metadata.appName"},
+		},
+		{
+			name:     "invalid names are accepted when sanitization is enabled",
+			sanitize: true,
+			charts: []catalogv1alpha1.ChartConfig{
+				{ChartName: "nvidia/gpu-operator"},
+				{
+					ChartName: "redis",
+					Metadata:  &catalogv1alpha1.ChartMetadata{AppName: "My_Redis"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			catalog := newTestCatalog(nil, tc.charts...)
+			catalog.Spec.Helm.SanitizeAppNames = tc.sanitize
+
+			var errs field.ErrorList
+			for i := range catalog.Spec.Helm.Charts {
+				errs = append(errs, validateAppName(catalog, &catalog.Spec.Helm.Charts[i], field.NewPath("spec", "helm", "charts").Index(i))...)
+			}
+
+			if len(errs) != len(tc.expectedErrPaths) {
+				t.Fatalf("expected %d errors, got %d: %v", len(tc.expectedErrPaths), len(errs), errs)
+			}
+
+			for i, err := range errs {
+				if err.Field != tc.expectedErrPaths[i] {
+					t.Errorf("expected error for field %q, got %q", tc.expectedErrPaths[i], err.Field)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	"k8c.io/application-catalog-manager/internal/pkg/chartschema"
+	"k8c.io/application-catalog-manager/internal/pkg/values"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValuesSchemaResolver returns the values schema of a chart version and the default values
+// of the chart, if they are known. Versions without schema are not validated.
+type ValuesSchemaResolver func(version *catalogv1alpha1.ChartVersion) (*chartschema.Schema, []byte)
+
+// ValidateDefaultValuesSchema validates the default values of every version of the chart
+// against the values schema returned by resolve and reports every violation with its JSON
+// path. Variables are rendered with the given vars first, values that still reference
+// variables are not validated, since their type is not known.
+//
+// The chart-level default values are validated once if all versions without override share
+// the same schema, otherwise they are validated for every version and the violations name
+// the version.
+func ValidateDefaultValuesSchema(
+	chart *catalogv1alpha1.ChartConfig,
+	vars map[string]string,
+	fldPath *field.Path,
+	resolve ValuesSchemaResolver,
+) (field.ErrorList, []string) {
+	type target struct {
+		version     *catalogv1alpha1.ChartVersion
+		schema      *chartschema.Schema
+		chartValues []byte
+	}
+
+	var (
+		allErrs  field.ErrorList
+		warnings []string
+
+		inherited []target
+	)
+
+	validate := func(schema *chartschema.Schema, chartValues []byte, block string, path *field.Path, prefix string) {
+		block, unresolved, err := values.Render(block, vars)
+		if err != nil {
+			// Invalid values blocks are reported by validateValuesBlock.
+			return
+		}
+		if len(unresolved) > 0 {
+			warnings = append(warnings, fmt.Sprintf("%s: %snot validated against the values schema, as it references the variables %v", path, prefix, unresolved))
+			return
+		}
+
+		violations, err := schema.Validate(chartValues, block)
+		if err != nil {
+			// Invalid values blocks are reported by validateValuesBlock.
+			return
+		}
+
+		for _, violation := range violations {
+			allErrs = append(allErrs, field.Invalid(path, field.OmitValueType{}, prefix+violation.String()))
+		}
+	}
+
+	for i := range chart.ChartVersions {
+		version := &chart.ChartVersions[i]
+		versionPath := fldPath.Child("chartVersions").Index(i)
+
+		schema, chartValues := resolve(version)
+		if schema == nil {
+			continue
+		}
+
+		switch {
+		case version.DefaultValuesBlock != "":
+			validate(schema, chartValues, version.DefaultValuesBlock, versionPath.Child("defaultValuesBlock"), "")
+
+		case version.DefaultValuesPatch != "":
+			merged, err := values.Merge(chart.DefaultValuesBlock, version.DefaultValuesPatch)
+			if err != nil {
+				continue
+			}
+			validate(schema, chartValues, merged, versionPath.Child("defaultValuesPatch"), "")
+
+		default:
+			inherited = append(inherited, target{version: version, schema: schema, chartValues: chartValues})
+		}
+	}
+
+	shared := true
+	for _, t := range inherited {
+		shared = shared && t.schema == inherited[0].schema
+	}
+
+	for i, t := range inherited {
+		if shared && i > 0 {
+			break
+		}
+
+		prefix := ""
+		if !shared {
+			prefix = fmt.Sprintf("chart version %s: ", t.version.ChartVersion)
+		}
+
+		validate(t.schema, t.chartValues, chart.DefaultValuesBlock, fldPath.Child("defaultValuesBlock"), prefix)
+	}
+
+	return allErrs, warnings
+}
+
+// validateValuesSchemas compiles the inline values schemas of the chart and validates the
+// default values against them. Schemas from ConfigMaps and chart archives are validated by
+// the webhook, which has access to them.
+func validateValuesSchemas(catalog *catalogv1alpha1.ApplicationCatalog, chart *catalogv1alpha1.ChartConfig, fldPath *field.Path) (field.ErrorList, []string) {
+	var allErrs field.ErrorList
+
+	schemas := map[*catalogv1alpha1.ValuesSchema]*chartschema.Schema{}
+	compile := func(source *catalogv1alpha1.ValuesSchema, path *field.Path) {
+		if source == nil {
+			return
+		}
+
+		allErrs = append(allErrs, validateValuesSchemaSource(source, path)...)
+
+		if source.Inline == "" {
+			return
+		}
+
+		schema, err := chartschema.Compile([]byte(source.Inline))
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("inline"), field.OmitValueType{}, err.Error()))
+			return
+		}
+		schemas[source] = schema
+	}
+
+	compile(chart.ValuesSchema, fldPath.Child("valuesSchema"))
+	for i := range chart.ChartVersions {
+		compile(chart.ChartVersions[i].ValuesSchema, fldPath.Child("chartVersions").Index(i).Child("valuesSchema"))
+	}
+
+	errs, warnings := ValidateDefaultValuesSchema(chart, catalog.Spec.Vars, fldPath, func(version *catalogv1alpha1.ChartVersion) (*chartschema.Schema, []byte) {
+		return schemas[chart.ResolveValuesSchema(version)], nil
+	})
+
+	return append(allErrs, errs...), warnings
+}
+
+// validateValuesSchemaSource ensures that exactly one source of the schema is set.
+func validateValuesSchemaSource(source *catalogv1alpha1.ValuesSchema, fldPath *field.Path) field.ErrorList {
+	set := 0
+	for _, isSet := range []bool{source.Inline != "", source.ConfigMapKeyRef != nil, source.FromChart} {
+		if isSet {
+			set++
+		}
+	}
+
+	if set != 1 {
+		return field.ErrorList{field.Invalid(fldPath, field.OmitValueType{}, "exactly one of inline, configMapKeyRef and fromChart must be set")}
+	}
+
+	if ref := source.ConfigMapKeyRef; ref != nil {
+		var allErrs field.ErrorList
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("configMapKeyRef", "name"), ""))
+		}
+		if ref.Key == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("configMapKeyRef", "key"), ""))
+		}
+		return allErrs
+	}
+
+	return nil
+}
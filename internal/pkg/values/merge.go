@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package values implements merging of Helm values blocks. Unlike merging the
+// unmarshalled maps, it works on the YAML node tree, so comments in the values
+// blocks are preserved.
+package values
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// Merge deep-merges the given values blocks in order, later blocks take precedence.
+// Mappings are merged key by key, all other values (including lists) are replaced.
+// Like in Helm, setting a key to null removes it from the result.
+//
+// Comments of the base block are kept, comments of replaced values are taken from
+// the block that provided the value. Empty blocks are skipped. If only one non-empty
+// block is given, it is returned unchanged.
+func Merge(blocks ...string) (string, error) {
+	var (
+		result  *yaml.Node
+		lastRaw string
+		merged  int
+	)
+
+	for i, block := range blocks {
+		if strings.TrimSpace(block) == "" {
+			continue
+		}
+
+		node, err := parse(block)
+		if err != nil {
+			return "", fmt.Errorf("values block %d: %w", i, err)
+		}
+
+		if node == nil {
+			continue
+		}
+
+		merged++
+		lastRaw = block
+
+		if result == nil {
+			result = node
+			continue
+		}
+
+		mergeMappings(result, node)
+	}
+
+	switch merged {
+	case 0:
+		return "", nil
+	case 1:
+		return lastRaw, nil
+	}
+
+	return encode(result)
+}
+
+// parse parses a values block and returns its root mapping node. Returns nil
+// for documents without content (e.g. only comments).
+func parse(block string) (*yaml.Node, error) {
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal([]byte(block), doc); err != nil {
+		return nil, err
+	}
+
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+
+	root := doc.Content[0]
+	if root.Kind == yaml.ScalarNode && root.Tag == "!!null" {
+		return nil, nil
+	}
+
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("must be a YAML object")
+	}
+
+	// Comments attached to the document itself would otherwise get lost.
+	if doc.HeadComment != "" {
+		root.HeadComment = joinComments(doc.HeadComment, root.HeadComment)
+	}
+	if doc.FootComment != "" {
+		root.FootComment = joinComments(root.FootComment, doc.FootComment)
+	}
+
+	return root, nil
+}
+
+// mergeMappings merges the overlay mapping into the base mapping in place.
+func mergeMappings(base, overlay *yaml.Node) {
+	for i := 0; i+1 < len(overlay.Content); i += 2 {
+		key, value := overlay.Content[i], overlay.Content[i+1]
+
+		idx := findKey(base, key.Value)
+		if idx < 0 {
+			if !isNull(value) {
+				base.Content = append(base.Content, key, value)
+			}
+			continue
+		}
+
+		if isNull(value) {
+			base.Content = append(base.Content[:idx], base.Content[idx+2:]...)
+			continue
+		}
+
+		baseKey, baseValue := base.Content[idx], base.Content[idx+1]
+		if key.HeadComment != "" {
+			baseKey.HeadComment = key.HeadComment
+		}
+		if key.LineComment != "" {
+			baseKey.LineComment = key.LineComment
+		}
+
+		if baseValue.Kind == yaml.MappingNode && value.Kind == yaml.MappingNode {
+			mergeMappings(baseValue, value)
+			continue
+		}
+
+		base.Content[idx+1] = value
+	}
+}
+
+func findKey(mapping *yaml.Node, key string) int {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func isNull(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.Tag == "!!null"
+}
+
+func joinComments(comments ...string) string {
+	var nonEmpty []string
+	for _, c := range comments {
+		if c != "" {
+			nonEmpty = append(nonEmpty, c)
+		}
+	}
+
+	return strings.Join(nonEmpty, "\n")
+}
+
+func encode(node *yaml.Node) (string, error) {
+	if len(node.Content) == 0 {
+		return "{}\n", nil
+	}
+
+	var buf bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(node); err != nil {
+		return "", fmt.Errorf("failed to encode values: %w", err)
+	}
+
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("failed to encode values: %w", err)
+	}
+
+	return buf.String(), nil
+}
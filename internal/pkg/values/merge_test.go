@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package values
+
+import (
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name     string
+		blocks   []string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "no blocks",
+			blocks:   nil,
+			expected: "",
+		},
+		{
+			name:     "empty blocks are skipped",
+			blocks:   []string{"", "  \n", "# only a comment\n"},
+			expected: "",
+		},
+		{
+			name:     "single block is returned unchanged",
+			blocks:   []string{"", "# comment\nfoo:   bar\n"},
+			expected: "# comment\nfoo:   bar\n",
+		},
+		{
+			name: "nested maps are merged and comments are preserved",
+			blocks: []string{
+				`# Settings for the server
+server:
+  # number of replicas
+  replicas: 1
+  image:
+    tag: v1 # pinned
+`,
+				`server:
+  image:
+    # use the newer image
+    tag: v2
+  resources: {}
+`,
+			},
+			expected: `# Settings for the server
+server:
+  # number of replicas
+  replicas: 1
+  image:
+    # use the newer image
+    tag: v2
+  resources: {}
+`,
+		},
+		{
+			name: "lists are replaced",
+			blocks: []string{
+				"args:\n  - a\n  - b\n",
+				"args:\n  - c\n",
+			},
+			expected: "args:\n  - c\n",
+		},
+		{
+			name: "null removes keys",
+			blocks: []string{
+				"a: 1\nb:\n  c: 2\n",
+				"b: null\nd: ~\n",
+			},
+			expected: "a: 1\n",
+		},
+		{
+			name: "map replaces scalar",
+			blocks: []string{
+				"service: ClusterIP\n",
+				"service:\n  type: LoadBalancer\n",
+			},
+			expected: "service:\n  type: LoadBalancer\n",
+		},
+		{
+			name: "three blocks are merged in order",
+			blocks: []string{
+				"a: 1\nb: 1\nc: 1\n",
+				"b: 2\nc: 2\n",
+				"c: 3\n",
+			},
+			expected: "a: 1\nb: 2\nc: 3\n",
+		},
+		{
+			name: "everything removed",
+			blocks: []string{
+				"a: 1\n",
+				"a: null\n",
+			},
+			expected: "{}\n",
+		},
+		{
+			name:    "invalid YAML",
+			blocks:  []string{"a: 1\n", "a:\n\tb: 2\n"},
+			wantErr: true,
+		},
+		{
+			name:    "list at root",
+			blocks:  []string{"a: 1\n", "- a\n"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := Merge(tc.blocks...)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error=%v, got %v", tc.wantErr, err)
+			}
+
+			if result != tc.expected {
+				t.Errorf("unexpected result\nexpected:\n%s\ngot:\n%s", tc.expected, result)
+			}
+		})
+	}
+}
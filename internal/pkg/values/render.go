@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package values
+
+import (
+	"regexp"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// variablePattern matches "${catalog.vars.<name>}" references. A leading "$" escapes
+// the reference, "$${catalog.vars.<name>}" is rendered as "${catalog.vars.<name>}".
+var variablePattern = regexp.MustCompile(`\$?\$\{catalog\.vars\.([A-Za-z_][A-Za-z0-9_-]*)\}`)
+
+// variableNamePattern matches valid variable names.
+var variableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// IsValidVariableName returns true if name can be referenced in a values block.
+func IsValidVariableName(name string) bool {
+	return variableNamePattern.MatchString(name)
+}
+
+// Render substitutes the "${catalog.vars.<name>}" references in the values block with the
+// given variables. References are substituted in the scalars of the parsed block, so values
+// containing YAML syntax like ": ", " #" or newlines stay a single scalar. References in
+// comments are not substituted. Plain scalars consisting of a reference are typed after
+// rendering, like they would be if the value was written in the block.
+// References to undefined variables are kept as they are and returned sorted. Blocks without
+// references are returned unchanged.
+func Render(block string, vars map[string]string) (string, []string, error) {
+	if !strings.Contains(block, "${") {
+		return block, nil, nil
+	}
+
+	root, err := parse(block)
+	if err != nil {
+		return "", nil, err
+	}
+	if root == nil {
+		return block, nil, nil
+	}
+
+	unresolved := sets.New[string]()
+	renderNode(root, vars, unresolved)
+
+	rendered, err := encode(root)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if unresolved.Len() == 0 {
+		return rendered, nil, nil
+	}
+
+	return rendered, sets.List(unresolved), nil
+}
+
+// renderNode substitutes the references in all scalars of the node tree in place.
+func renderNode(node *yaml.Node, vars map[string]string, unresolved sets.Set[string]) {
+	if node.Kind == yaml.ScalarNode {
+		rendered := renderScalar(node.Value, vars, unresolved)
+		if rendered == node.Value {
+			return
+		}
+
+		node.Value = rendered
+		// Plain scalars are resolved again, so that e.g. "${catalog.vars.replicas}" becomes
+		// a number. The encoder quotes the value if it is not a valid plain scalar.
+		if node.Style == 0 {
+			node.Tag = ""
+		}
+		return
+	}
+
+	for _, child := range node.Content {
+		renderNode(child, vars, unresolved)
+	}
+}
+
+func renderScalar(value string, vars map[string]string, unresolved sets.Set[string]) string {
+	return variablePattern.ReplaceAllStringFunc(value, func(ref string) string {
+		if strings.HasPrefix(ref, "$$") {
+			return ref[1:]
+		}
+
+		name := variablePattern.FindStringSubmatch(ref)[1]
+		value, ok := vars[name]
+		if !ok {
+			unresolved.Insert(name)
+			return ref
+		}
+
+		return value
+	})
+}
+
+// Variables returns the sorted names of the variables referenced in the values block.
+func Variables(block string) []string {
+	names := sets.New[string]()
+	for _, match := range variablePattern.FindAllStringSubmatch(block, -1) {
+		if !strings.HasPrefix(match[0], "$$") {
+			names.Insert(match[1])
+		}
+	}
+
+	return sets.List(names)
+}
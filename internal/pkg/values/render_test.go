@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package values
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	vars := map[string]string{
+		"registry":     "registry.example.com",
+		"storageClass": "fast",
+		"empty":        "",
+		"replicas":     "3",
+		"mapping":      "key: value",
+		"comment":      "secret # not a comment",
+		"multiline":    "line 1\nline 2",
+		"quote":        `say "hi"`,
+	}
+
+	tests := []struct {
+		name               string
+		block              string
+		expected           string
+		expectedUnresolved []string
+		expectedErr        bool
+	}{
+		{
+			name:     "no references",
+			block:    "# comment\nreplicas: 1\n",
+			expected: "# comment\nreplicas: 1\n",
+		},
+		{
+			name: "references are substituted and comments are kept",
+			block: `# the registry of this installation
+image:
+  repository: ${catalog.vars.registry}/nginx # mirrored
+persistence:
+  storageClass: "${catalog.vars.storageClass}"
+  annotations: "${catalog.vars.empty}"
+`,
+			expected: `# the registry of this installation
+image:
+  repository: registry.example.com/nginx # mirrored
+persistence:
+  storageClass: "fast"
+  annotations: ""
+`,
+		},
+		{
+			name:               "undefined variables are kept and reported",
+			block:              "host: ${catalog.vars.domain}\nother: ${catalog.vars.domain}\nregistry: ${catalog.vars.registry}\nzone: ${catalog.vars.zone}\n",
+			expected:           "host: ${catalog.vars.domain}\nother: ${catalog.vars.domain}\nregistry: registry.example.com\nzone: ${catalog.vars.zone}\n",
+			expectedUnresolved: []string{"domain", "zone"},
+		},
+		{
+			name:     "escaped references are not substituted",
+			block:    "literal: $${catalog.vars.registry}\nundefined: $${catalog.vars.domain}\n",
+			expected: "literal: ${catalog.vars.registry}\nundefined: ${catalog.vars.domain}\n",
+		},
+		{
+			name:     "other references are kept",
+			block:    "env: ${HOME}\nother: ${catalog.other}\n",
+			expected: "env: ${HOME}\nother: ${catalog.other}\n",
+		},
+		{
+			name:     "references in comments are not substituted",
+			block:    "# uses ${catalog.vars.registry}\nregistry: ${catalog.vars.registry}\n",
+			expected: "# uses ${catalog.vars.registry}\nregistry: registry.example.com\n",
+		},
+		{
+			name:     "plain scalars are typed after rendering",
+			block:    "replicas: ${catalog.vars.replicas}\nquoted: \"${catalog.vars.replicas}\"\n",
+			expected: "replicas: 3\nquoted: \"3\"\n",
+		},
+		{
+			name:     "values with a colon stay a scalar",
+			block:    "plain: ${catalog.vars.mapping}\nquoted: \"${catalog.vars.mapping}\"\n",
+			expected: "plain: 'key: value'\nquoted: \"key: value\"\n",
+		},
+		{
+			name:     "values with a hash are not cut off",
+			block:    "password: ${catalog.vars.comment}\n",
+			expected: "password: 'secret # not a comment'\n",
+		},
+		{
+			name:     "values with a newline stay a scalar",
+			block:    "plain: ${catalog.vars.multiline}\nquoted: \"${catalog.vars.multiline}\"\n",
+			expected: "plain: |-\n  line 1\n  line 2\nquoted: \"line 1\\nline 2\"\n",
+		},
+		{
+			name:     "values with quotes are escaped",
+			block:    "greeting: \"${catalog.vars.quote}\"\n",
+			expected: "greeting: \"say \\\"hi\\\"\"\n",
+		},
+		{
+			name:        "invalid blocks are rejected",
+			block:       "a: ${catalog.vars.registry}\n\tb: c\n",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, unresolved, err := Render(tc.block, vars)
+			if tc.expectedErr != (err != nil) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			if result != tc.expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", tc.expected, result)
+			}
+
+			if !reflect.DeepEqual(unresolved, tc.expectedUnresolved) {
+				t.Errorf("expected unresolved variables %v, got %v", tc.expectedUnresolved, unresolved)
+			}
+		})
+	}
+}
+
+func TestVariables(t *testing.T) {
+	block := "a: ${catalog.vars.b}\nb: ${catalog.vars.a}\nc: $${catalog.vars.c}\nd: ${catalog.vars.a}\n"
+
+	if result := Variables(block); !reflect.DeepEqual(result, []string{"a", "b"}) {
+		t.Errorf("expected variables [a b], got %v", result)
+	}
+}
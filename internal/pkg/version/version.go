@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package version provides the version of the application-catalog-manager.
+package version
+
+import (
+	"runtime/debug"
+)
+
+// Version is set at build time with
+// -ldflags "-X k8c.io/application-catalog-manager/internal/pkg/version.Version=<version>".
+var Version string
+
+// Get returns the version the binary was built with. If it was not set at build time, the
+// VCS revision recorded by the Go toolchain is returned, or "unknown" if there is none.
+func Get() string {
+	if Version != "" {
+		return Version
+	}
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, setting := range info.Settings {
+			if setting.Key == "vcs.revision" {
+				return setting.Value
+			}
+		}
+	}
+
+	return "unknown"
+}
@@ -266,6 +266,68 @@ func TestResolveChartCredentials(t *testing.T) {
 	}
 }
 
+func TestResolveChartRepositorySettings(t *testing.T) {
+	t.Parallel()
+
+	global := &RepositorySettings{BaseURL: "oci://global.registry.io/charts", InsecureSkipTLSVerify: true}
+	chartSettings := &RepositorySettings{BaseURL: "oci://chart.registry.io/charts", PlainHTTP: true}
+	versionSettings := &RepositorySettings{BaseURL: "https://version.registry.io/charts"}
+
+	tests := []struct {
+		name     string
+		catalog  *ApplicationCatalog
+		chart    *ChartConfig
+		version  *ChartVersion
+		expected *RepositorySettings
+	}{
+		{
+			name:     "nil when using default URL",
+			catalog:  &ApplicationCatalog{},
+			chart:    newChartConfig("test-chart", nil),
+			version:  newVersion("1.0.0", "v1.0.0", nil),
+			expected: nil,
+		},
+		{
+			name:     "global settings",
+			catalog:  newCatalogWithGlobalSettings(global),
+			chart:    newChartConfig("test-chart", nil),
+			version:  newVersion("1.0.0", "v1.0.0", nil),
+			expected: global,
+		},
+		{
+			name:     "chart settings override global",
+			catalog:  newCatalogWithGlobalSettings(global),
+			chart:    newChartConfig("test-chart", chartSettings),
+			version:  newVersion("1.0.0", "v1.0.0", nil),
+			expected: chartSettings,
+		},
+		{
+			name:     "version settings override all, transport options are not inherited",
+			catalog:  newCatalogWithGlobalSettings(global),
+			chart:    newChartConfig("test-chart", chartSettings),
+			version:  newVersion("1.0.0", "v1.0.0", versionSettings),
+			expected: versionSettings,
+		},
+		{
+			name:     "settings without URL are skipped",
+			catalog:  newCatalogWithGlobalSettings(global),
+			chart:    newChartConfig("test-chart", &RepositorySettings{PlainHTTP: true}),
+			version:  newVersion("1.0.0", "v1.0.0", nil),
+			expected: global,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := tt.catalog.ResolveChartRepositorySettings(tt.chart, tt.version)
+			if got != tt.expected {
+				t.Errorf("ResolveChartRepositorySettings() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
 func TestGetAppName(t *testing.T) {
 	t.Parallel()
 
@@ -412,3 +474,122 @@ func TestGetGlobalRepositorySettings(t *testing.T) {
 		})
 	}
 }
+
+func TestSanitizeAppName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		appName  string
+		expected string
+	}{
+		{
+			name:     "valid name is unchanged",
+			appName:  "cert-manager",
+			expected: "cert-manager",
+		},
+		{
+			name:     "valid subdomain is unchanged",
+			appName:  "my.app",
+			expected: "my.app",
+		},
+		{
+			name:     "slash is replaced",
+			appName:  "nvidia/gpu-operator",
+			expected: "nvidia-gpu-operator",
+		},
+		{
+			name:     "uppercase is lowered and separators are collapsed",
+			appName:  "My__Chart//Name",
+			expected: "my-chart-name",
+		},
+		{
+			name:     "leading and trailing separators are trimmed",
+			appName:  "_chart_",
+			expected: "chart",
+		},
+		{
+			name:     "name without valid characters gets a hash",
+			appName:  "___",
+			expected: "app-bda25155",
+		},
+		{
+			name:     "long name is truncated with hash suffix",
+			appName:  "a-very-long-application-name-that-definitely-exceeds-the-dns-label-limit/x",
+			expected: "a-very-long-application-name-that-definitely-exceeds-t-aa1ff057",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := SanitizeAppName(tt.appName)
+			if got != tt.expected {
+				t.Errorf("SanitizeAppName() = %q, want %q", got, tt.expected)
+			}
+
+			if errs := ValidateAppName(got); len(errs) > 0 {
+				t.Errorf("SanitizeAppName() returned invalid name %q: %v", got, errs)
+			}
+		})
+	}
+}
+
+func TestSanitizeAppNameIsDeterministic(t *testing.T) {
+	t.Parallel()
+
+	a := SanitizeAppName("Some/Really/Long/Chart/Name/That/Does/Not/Fit/Into/A/Single/DNS/Label")
+	b := SanitizeAppName("Some/Really/Long/Chart/Name/That/Does/Not/Fit/Into/A/Single/DNS/Label")
+	if a != b {
+		t.Errorf("SanitizeAppName() is not deterministic: %q != %q", a, b)
+	}
+
+	c := SanitizeAppName("Some/Really/Long/Chart/Name/That/Does/Not/Fit/Into/A/Single/DNS/Label2")
+	if a == c {
+		t.Errorf("SanitizeAppName() returned the same name %q for different inputs", a)
+	}
+}
+
+func TestResolveAppName(t *testing.T) {
+	t.Parallel()
+
+	chart := &ChartConfig{ChartName: "nvidia/gpu-operator"}
+
+	tests := []struct {
+		name     string
+		catalog  *ApplicationCatalog
+		expected string
+	}{
+		{
+			name:     "nil helm spec returns raw name",
+			catalog:  &ApplicationCatalog{},
+			expected: "nvidia/gpu-operator",
+		},
+		{
+			name: "sanitization disabled returns raw name",
+			catalog: &ApplicationCatalog{
+				Spec: ApplicationCatalogSpec{Helm: &HelmSpec{}},
+			},
+			expected: "nvidia/gpu-operator",
+		},
+		{
+			name: "sanitization enabled returns sanitized name",
+			catalog: &ApplicationCatalog{
+				Spec: ApplicationCatalogSpec{Helm: &HelmSpec{SanitizeAppNames: true}},
+			},
+			expected: "nvidia-gpu-operator",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tt.catalog.ResolveAppName(chart)
+			if got != tt.expected {
+				t.Errorf("ResolveAppName() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
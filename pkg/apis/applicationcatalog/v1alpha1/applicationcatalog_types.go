@@ -21,6 +21,8 @@ import (
 )
 
 // HelmSpec defines the Helm-specific configuration for the application catalog.
+//
+// +kubebuilder:validation:XValidation:rule="(has(self.sanitizeAppNames) && self.sanitizeAppNames) == (has(oldSelf.sanitizeAppNames) && oldSelf.sanitizeAppNames)",message="sanitizeAppNames is immutable, changing it would rename all generated ApplicationDefinitions"
 type HelmSpec struct {
 	// RepositorySettings defines the default repository settings for all charts.
 	// Individual charts can override these settings.
@@ -33,6 +35,8 @@ type HelmSpec struct {
 	// Each chart entry in the list will be converted to an ApplicationDefinition.
 	//
 	// +optional
+	// +kubebuilder:validation:MaxItems=128
+	// +kubebuilder:validation:XValidation:rule="self.all(c, self.exists_one(d, d.chartName == c.chartName))",message="chart names must be unique"
 	Charts []ChartConfig `json:"charts"`
 
 	// IncludeDefaults indicates that the webhook should automatically
@@ -42,12 +46,149 @@ type HelmSpec struct {
 	//
 	// +optional
 	IncludeDefaults bool `json:"includeDefaults,omitempty"`
+
+	// SanitizeAppNames makes the controller derive a valid DNS-1123 name for every
+	// generated ApplicationDefinition (e.g. "nvidia/gpu-operator" becomes
+	// "nvidia-gpu-operator") instead of rejecting charts whose appName is invalid.
+	// The original name is recorded in the "applicationcatalog.k8c.io/source-app-name"
+	// annotation of the generated ApplicationDefinition.
+	//
+	// +optional
+	SanitizeAppNames bool `json:"sanitizeAppNames,omitempty"`
+
+	// ImageRegistryRewrite maps the registries the charts pull their images from to
+	// the registries to use instead, e.g. "registry.k8s.io" to "mirror.example.com/k8s".
+	// The rewritten registries are injected into the default values of every chart at
+	// the keys listed in its imageRegistryKeys, taking precedence over the values
+	// configured in the catalog. Charts without such keys are not changed.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxProperties=32
+	ImageRegistryRewrite map[string]string `json:"imageRegistryRewrite,omitempty"`
 }
 
 // ApplicationCatalogSpec defines the desired state of ApplicationCatalog.
 type ApplicationCatalogSpec struct {
 	// Helm contains Helm chart configuration for this catalog.
 	Helm *HelmSpec `json:"helm,omitempty"`
+
+	// Imports lists other ApplicationCatalogs whose charts are included in this catalog.
+	// Charts are merged by chartName: later imports take precedence over earlier ones
+	// and the charts of this catalog take precedence over all imports. Imports are
+	// resolved transitively, import cycles are rejected.
+	// The ApplicationDefinitions of imported charts are managed by this catalog instead
+	// of the imported one.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(i, self.exists_one(j, j.name == i.name))",message="imported catalogs must be unique"
+	Imports []CatalogImport `json:"imports,omitempty"`
+
+	// Vars are the variables that can be referenced as "${catalog.vars.<name>}" in the
+	// default values of the charts. They are rendered when the ApplicationDefinitions are
+	// generated, "$${catalog.vars.<name>}" is rendered as a literal reference. References
+	// are rendered in the YAML values, so a variable stays a single value whatever it contains.
+	// Vars take precedence over the variables loaded from varsFrom.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxProperties=128
+	Vars map[string]string `json:"vars,omitempty"`
+
+	// VarsFrom lists ConfigMaps in the namespace of the controller whose data is loaded
+	// as variables. Later ConfigMaps take precedence over earlier ones.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	VarsFrom []VarsReference `json:"varsFrom,omitempty"`
+
+	// Paused stops the controller from creating, updating and unmanaging the
+	// ApplicationDefinitions of this catalog, and the webhook from merging the default
+	// charts into it, until it is unset again. The ApplicationDefinitions are kept as
+	// they are. Catalogs can also be paused with the "applicationcatalog.k8c.io/paused"
+	// annotation, which is kept when the catalog is updated by an ApplicationCatalogSource.
+	//
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// RevisionHistoryLimit is the number of revisions kept for this catalog. The controller
+	// snapshots the compressed spec of every generation of the catalog into an immutable
+	// ConfigMap in its namespace, and deletes the oldest revisions exceeding the limit.
+	// Generations that do not fit into a ConfigMap are not recorded. The catalog can be
+	// rolled back to a revision with the "applicationcatalog.k8c.io/rollback-to"
+	// annotation. 0 disables the revision history. Defaults to 10.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	// Priority resolves conflicts with other catalogs providing a chart for the same
+	// ApplicationDefinition. A catalog with a higher priority takes over the
+	// ApplicationDefinition from a catalog with a lower priority, which reports the chart
+	// in status.displacedCharts. Catalogs with the same priority cannot provide a chart for
+	// the same ApplicationDefinition. Defaults to 0.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=-1000
+	// +kubebuilder:validation:Maximum=1000
+	Priority int32 `json:"priority,omitempty"`
+}
+
+// VarsReference references a ConfigMap whose data is loaded as variables.
+type VarsReference struct {
+	// Name is the name of the ConfigMap.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Optional makes a missing ConfigMap not an error.
+	//
+	// +optional
+	Optional bool `json:"optional,omitempty"`
+}
+
+// CatalogImport references an ApplicationCatalog whose charts are imported.
+type CatalogImport struct {
+	// Name is the name of the imported ApplicationCatalog.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+}
+
+// DisplacedChart is a chart of the catalog whose ApplicationDefinition is managed by a
+// catalog with a higher priority.
+type DisplacedChart struct {
+	// ChartName is the name of the displaced chart.
+	ChartName string `json:"chartName"`
+
+	// ApplicationDefinition is the name of the ApplicationDefinition of the chart.
+	ApplicationDefinition string `json:"applicationDefinition"`
+
+	// Catalog is the name of the catalog managing the ApplicationDefinition.
+	Catalog string `json:"catalog"`
+}
+
+// SeedSyncStatus is the state of the synchronization of the ApplicationDefinitions of the
+// catalog to a KKP Seed.
+type SeedSyncStatus struct {
+	// Name is the name of the Seed.
+	Name string `json:"name"`
+
+	// Synced is true if all ApplicationDefinitions of the catalog were synchronized to the
+	// Seed in the last attempt.
+	Synced bool `json:"synced"`
+
+	// Message describes why the synchronization failed.
+	//
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is the time the state of the synchronization last changed.
+	//
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
 }
 
 // ApplicationCatalogStatus defines the observed state of ApplicationCatalog.
@@ -56,6 +197,41 @@ type ApplicationCatalogStatus struct {
 	//
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// UnresolvedVariables lists the variables referenced in the default values of the
+	// charts that are not defined. The references are kept as they are in the generated
+	// ApplicationDefinitions.
+	//
+	// +optional
+	UnresolvedVariables []string `json:"unresolvedVariables,omitempty"`
+
+	// DisplacedCharts lists the charts of the catalog whose ApplicationDefinitions are
+	// managed by catalogs with a higher priority.
+	//
+	// +optional
+	DisplacedCharts []DisplacedChart `json:"displacedCharts,omitempty"`
+
+	// Seeds contains the state of the synchronization of the ApplicationDefinitions to the
+	// Seeds of a KKP master cluster. It is only set if the manager runs in master mode.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Seeds []SeedSyncStatus `json:"seeds,omitempty"`
+
+	// VerifiedSigner is the name of the public key that verified the signature of the file
+	// the catalog was applied from by an ApplicationCatalogSource. It is not set for catalogs
+	// read from unsigned files or from sources without signature verification.
+	//
+	// +optional
+	VerifiedSigner string `json:"verifiedSigner,omitempty"`
+
+	// Conditions contains the latest observations of the state of the catalog.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // +genclient
@@ -63,6 +239,8 @@ type ApplicationCatalogStatus struct {
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster,shortName=appcat
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=".status.conditions[?(@.type=='Paused')].status",name="Paused",type="string"
+// +kubebuilder:printcolumn:JSONPath=".spec.priority",name="Priority",type="integer",priority=1
 // +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name="Age",type="date"
 
 // ApplicationCatalog is the Schema for the applicationcatalogs API.
@@ -94,6 +272,26 @@ func (ac *ApplicationCatalog) GetHelmCharts() []ChartConfig {
 	return ac.Spec.Helm.Charts
 }
 
+// IsPaused returns true if the catalog is paused by spec.paused or the pause annotation.
+func (ac *ApplicationCatalog) IsPaused() bool {
+	return ac.Spec.Paused || ac.Annotations[AnnotationPaused] == "true"
+}
+
+// TakesPrecedenceOver returns true if the catalog takes over ApplicationDefinitions from the
+// other catalog, because it has a higher priority.
+func (ac *ApplicationCatalog) TakesPrecedenceOver(other *ApplicationCatalog) bool {
+	return ac.Spec.Priority > other.Spec.Priority
+}
+
+// GetRevisionHistoryLimit returns the number of revisions kept for the catalog.
+func (ac *ApplicationCatalog) GetRevisionHistoryLimit() int {
+	if ac.Spec.RevisionHistoryLimit == nil {
+		return DefaultRevisionHistoryLimit
+	}
+
+	return int(*ac.Spec.RevisionHistoryLimit)
+}
+
 // GetGlobalRepositorySettings returns the global repository settings, or nil if not configured.
 func (ac *ApplicationCatalog) GetGlobalRepositorySettings() *RepositorySettings {
 	if ac.Spec.Helm == nil {
@@ -102,19 +300,42 @@ func (ac *ApplicationCatalog) GetGlobalRepositorySettings() *RepositorySettings
 	return ac.Spec.Helm.RepositorySettings
 }
 
-// ResolveChartURL resolves the repository URL for a specific chart version.
-// It follows the precedence: version-level > chart-level > global > default.
-func (ac *ApplicationCatalog) ResolveChartURL(chart *ChartConfig, version *ChartVersion) string {
+// ResolveAppName returns the name of the ApplicationDefinition generated for the given chart.
+// If spec.helm.sanitizeAppNames is enabled, the name is converted into a valid DNS-1123 name.
+func (ac *ApplicationCatalog) ResolveAppName(chart *ChartConfig) string {
+	appName := chart.GetAppName()
+	if ac.Spec.Helm != nil && ac.Spec.Helm.SanitizeAppNames {
+		return SanitizeAppName(appName)
+	}
+
+	return appName
+}
+
+// ResolveChartRepositorySettings returns the repository settings that apply to a specific
+// chart version, which are the settings of the most specific level that sets a baseURL.
+// It follows the precedence: version-level > chart-level > global.
+// Returns nil if the default repository is used.
+func (ac *ApplicationCatalog) ResolveChartRepositorySettings(chart *ChartConfig, version *ChartVersion) *RepositorySettings {
 	if version.RepositorySettings != nil && version.RepositorySettings.BaseURL != "" {
-		return version.RepositorySettings.BaseURL
+		return version.RepositorySettings
 	}
 
 	if chart.RepositorySettings != nil && chart.RepositorySettings.BaseURL != "" {
-		return chart.RepositorySettings.BaseURL
+		return chart.RepositorySettings
 	}
 
 	if global := ac.GetGlobalRepositorySettings(); global != nil && global.BaseURL != "" {
-		return global.BaseURL
+		return global
+	}
+
+	return nil
+}
+
+// ResolveChartURL resolves the repository URL for a specific chart version.
+// It follows the precedence: version-level > chart-level > global > default.
+func (ac *ApplicationCatalog) ResolveChartURL(chart *ChartConfig, version *ChartVersion) string {
+	if settings := ac.ResolveChartRepositorySettings(chart, version); settings != nil {
+		return settings.BaseURL
 	}
 
 	return DefaultHelmRepository
@@ -123,16 +344,8 @@ func (ac *ApplicationCatalog) ResolveChartURL(chart *ChartConfig, version *Chart
 // ResolveChartCredentials resolves the credentials for a specific chart version.
 // Credentials are only returned if a baseURL is specified at the same level.
 func (ac *ApplicationCatalog) ResolveChartCredentials(chart *ChartConfig, version *ChartVersion) *RepositoryCredentials {
-	if version.RepositorySettings != nil && version.RepositorySettings.BaseURL != "" {
-		return version.RepositorySettings.Credentials
-	}
-
-	if chart.RepositorySettings != nil && chart.RepositorySettings.BaseURL != "" {
-		return chart.RepositorySettings.Credentials
-	}
-
-	if global := ac.GetGlobalRepositorySettings(); global != nil && global.BaseURL != "" {
-		return global.Credentials
+	if settings := ac.ResolveChartRepositorySettings(chart, version); settings != nil {
+		return settings.Credentials
 	}
 
 	return nil
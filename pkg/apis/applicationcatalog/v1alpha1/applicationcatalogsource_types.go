@@ -0,0 +1,279 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApplicationCatalogSourceSpec defines the desired state of ApplicationCatalogSource.
+//
+// +kubebuilder:validation:XValidation:rule="[has(self.http), has(self.git), has(self.oci)].filter(x, x).size() == 1",message="exactly one of http, git or oci must be set"
+type ApplicationCatalogSourceSpec struct {
+	// Interval is the interval at which the source is fetched.
+	//
+	// +optional
+	// +kubebuilder:default="10m"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1m')",message="interval must be at least 1m"
+	Interval metav1.Duration `json:"interval,omitempty"`
+
+	// HTTP fetches the ApplicationCatalogs from a YAML file served over HTTP(S).
+	//
+	// +optional
+	HTTP *HTTPCatalogSource `json:"http,omitempty"`
+
+	// Git fetches the ApplicationCatalogs from a Git repository.
+	//
+	// +optional
+	Git *GitCatalogSource `json:"git,omitempty"`
+
+	// OCI fetches the ApplicationCatalogs from an OCI artifact.
+	//
+	// +optional
+	OCI *OCICatalogSource `json:"oci,omitempty"`
+
+	// Verification configures the verification of the detached signatures of the fetched
+	// files. Content that fails verification is not applied.
+	//
+	// +optional
+	Verification *SignatureVerification `json:"verification,omitempty"`
+}
+
+// SignaturePolicy defines how content without signature is handled.
+//
+// +kubebuilder:validation:Enum=RequireSignature;VerifyIfSigned
+type SignaturePolicy string
+
+const (
+	// SignaturePolicyRequireSignature refuses content without signature.
+	SignaturePolicyRequireSignature SignaturePolicy = "RequireSignature"
+
+	// SignaturePolicyVerifyIfSigned accepts content without signature, but still
+	// refuses content whose signature is invalid.
+	SignaturePolicyVerifyIfSigned SignaturePolicy = "VerifyIfSigned"
+)
+
+// SignatureVerification defines the public keys used to verify detached signatures.
+// Signatures are base64-encoded. They are read from the file with the additional ".sig"
+// extension for HTTP and Git sources, and from the "applicationcatalog.k8c.io/signature"
+// annotation of the layer for OCI sources.
+type SignatureVerification struct {
+	// SecretName is the name of the Secret in the namespace of the manager holding the
+	// PEM-encoded public keys. Every key of the Secret is a public key, ed25519 and ECDSA
+	// (cosign) keys are supported. The name of the key that verified a signature is
+	// reported as signer in the status of the source, and in status.verifiedSigner of the
+	// ApplicationCatalogs read from the signed file.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	SecretName string `json:"secretName"`
+
+	// Policy defines how content without signature is handled.
+	//
+	// +optional
+	// +kubebuilder:default=RequireSignature
+	Policy SignaturePolicy `json:"policy,omitempty"`
+}
+
+// HTTPCatalogSource defines a YAML file served over HTTP(S).
+type HTTPCatalogSource struct {
+	// URL is the URL of the YAML file. It can contain multiple ApplicationCatalogs
+	// separated by "---".
+	//
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:XValidation:rule="self.startsWith('http://') || self.startsWith('https://')",message="url must start with http:// or https://"
+	URL string `json:"url"`
+
+	// InsecureSkipTLSVerify skips TLS certificate verification.
+	//
+	// +optional
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+
+	// Credentials references the secret keys holding the username and password used for
+	// basic authentication. The secrets must exist in the namespace of the manager.
+	//
+	// +optional
+	Credentials *RepositoryCredentials `json:"credentials,omitempty"`
+}
+
+// GitCatalogSource defines a path in a Git repository.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.ref) || [has(self.ref.branch), has(self.ref.tag), has(self.ref.commit)].filter(x, x).size() <= 1",message="only one of branch, tag or commit can be set"
+type GitCatalogSource struct {
+	// URL is the URL of the Git repository.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=2048
+	URL string `json:"url"`
+
+	// Ref is the Git reference to check out. Defaults to the default branch of the repository.
+	//
+	// +optional
+	Ref *GitReference `json:"ref,omitempty"`
+
+	// Path is the path of a YAML file, or of a directory containing YAML files, inside
+	// the repository. Defaults to the root of the repository.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Path string `json:"path,omitempty"`
+
+	// InsecureSkipTLSVerify skips TLS certificate verification.
+	//
+	// +optional
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+
+	// Credentials references the secret keys holding the username and password used for
+	// HTTP basic authentication. The secrets must exist in the namespace of the manager.
+	//
+	// +optional
+	Credentials *RepositoryCredentials `json:"credentials,omitempty"`
+}
+
+// GitReference defines the Git reference to check out.
+type GitReference struct {
+	// Branch is the branch to check out.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=255
+	Branch string `json:"branch,omitempty"`
+
+	// Tag is the tag to check out.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=255
+	Tag string `json:"tag,omitempty"`
+
+	// Commit is the SHA of the commit to check out.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9a-f]{40}$`
+	Commit string `json:"commit,omitempty"`
+}
+
+// OCICatalogSource defines an OCI artifact containing ApplicationCatalogs.
+// Every layer with the media type "application/vnd.k8c.applicationcatalog.layer.v1+yaml"
+// is read as a YAML file.
+//
+// +kubebuilder:validation:XValidation:rule="!(has(self.tag) && has(self.digest))",message="tag and digest are mutually exclusive"
+type OCICatalogSource struct {
+	// URL is the URL of the OCI repository, e.g. "oci://quay.io/example/catalogs".
+	//
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:XValidation:rule="self.startsWith('oci://')",message="url must start with oci://"
+	URL string `json:"url"`
+
+	// Tag is the tag of the artifact. Defaults to "latest".
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=128
+	Tag string `json:"tag,omitempty"`
+
+	// Digest is the digest of the artifact, e.g. "sha256:...".
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^sha256:[0-9a-f]{64}$`
+	Digest string `json:"digest,omitempty"`
+
+	// PlainHTTP uses HTTP instead of HTTPS to connect to the registry.
+	//
+	// +optional
+	PlainHTTP bool `json:"plainHTTP,omitempty"`
+
+	// InsecureSkipTLSVerify skips TLS certificate verification.
+	//
+	// +optional
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+
+	// Credentials references the secret keys holding the registry credentials.
+	// The secrets must exist in the namespace of the manager.
+	//
+	// +optional
+	Credentials *RepositoryCredentials `json:"credentials,omitempty"`
+}
+
+// ApplicationCatalogSourceStatus defines the observed state of ApplicationCatalogSource.
+type ApplicationCatalogSourceStatus struct {
+	// ObservedGeneration is the most recent generation observed by the controller.
+	//
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Revision is the revision of the last applied artifact, e.g. the Git commit,
+	// the OCI manifest digest or the HTTP ETag.
+	//
+	// +optional
+	Revision string `json:"revision,omitempty"`
+
+	// Digest is the SHA-256 digest of the content of the last applied artifact.
+	//
+	// +optional
+	Digest string `json:"digest,omitempty"`
+
+	// LastFetchTime is the time the source was last fetched.
+	//
+	// +optional
+	LastFetchTime *metav1.Time `json:"lastFetchTime,omitempty"`
+
+	// Catalogs are the names of the ApplicationCatalogs managed by this source.
+	//
+	// +optional
+	Catalogs []string `json:"catalogs,omitempty"`
+
+	// VerifiedSigners are the names of the public keys that verified the signatures
+	// of the last applied artifact.
+	//
+	// +optional
+	VerifiedSigners []string `json:"verifiedSigners,omitempty"`
+
+	// Conditions contains the latest observations of the state of the source.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=appcatsrc
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=".status.revision",name="Revision",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.conditions[?(@.type=='Ready')].status",name="Ready",type="string"
+// +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name="Age",type="date"
+
+// ApplicationCatalogSource is the Schema for the applicationcatalogsources API.
+// It periodically fetches ApplicationCatalogs from an HTTP URL, a Git repository or an
+// OCI artifact and creates or updates them in the cluster.
+type ApplicationCatalogSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationCatalogSourceSpec   `json:"spec,omitempty"`
+	Status ApplicationCatalogSourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApplicationCatalogSourceList contains a list of ApplicationCatalogSource.
+type ApplicationCatalogSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ApplicationCatalogSource `json:"items"`
+}
@@ -17,16 +17,35 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // RepositorySettings defines the connection settings for a Helm chart repository.
+// Credentials and transport options are only used together with the baseURL of the
+// same level, they are never inherited from a less specific level.
+//
+// Custom CA bundles are not supported, as KKP's Helm source has no way to reference them.
+// Repositories using a private CA have to be trusted by KKP itself, e.g. through the
+// spec.caBundle of the KubermaticConfiguration.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.credentials) || (has(self.baseURL) && size(self.baseURL) > 0)",message="credentials are only used together with a baseURL at the same level"
+// +kubebuilder:validation:XValidation:rule="!has(self.insecureSkipTLSVerify) || !self.insecureSkipTLSVerify || (has(self.baseURL) && size(self.baseURL) > 0)",message="insecureSkipTLSVerify is only used together with a baseURL at the same level"
+// +kubebuilder:validation:XValidation:rule="!has(self.plainHTTP) || !self.plainHTTP || (has(self.baseURL) && self.baseURL.startsWith('oci://'))",message="plainHTTP is only supported together with an oci:// baseURL at the same level"
 type RepositorySettings struct {
 	// BaseURL is the base URL of the Helm chart repository.
 	// Supports http, https, and oci schemes.
 	// Examples:
 	//   - oci://quay.io/kubermatic-mirror/helm-charts
 	//   - https://charts.example.com
+	//
+	// +kubebuilder:validation:MaxLength=512
+	// +kubebuilder:validation:XValidation:rule="size(self) == 0 || (isURL(self) && url(self).getScheme() in ['http', 'https', 'oci'])",message="baseURL must be a valid URL with one of the schemes: http, https, oci"
 	BaseURL string `json:"baseURL,omitempty"`
 
 	// Credentials contains authentication information for the repository.
@@ -34,6 +53,19 @@ type RepositorySettings struct {
 	//
 	// +optional
 	Credentials *RepositoryCredentials `json:"credentials,omitempty"`
+
+	// InsecureSkipTLSVerify disables certificate validation when connecting to an
+	// https or oci repository. It has no effect for plain http connections. Repositories
+	// using a private CA should be trusted through the CA bundle of KKP instead.
+	//
+	// +optional
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+
+	// PlainHTTP enables unencrypted HTTP connections to an oci repository, which
+	// use HTTPS by default. Only supported for oci:// URLs.
+	//
+	// +optional
+	PlainHTTP bool `json:"plainHTTP,omitempty"`
 }
 
 // RepositoryCredentials defines authentication credentials for a Helm repository.
@@ -56,6 +88,8 @@ type RepositoryCredentials struct {
 }
 
 // ChartMetadata contains display information for an application.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.logo) || !has(self.logoFrom)",message="logo and logoFrom are mutually exclusive"
 type ChartMetadata struct {
 	// AppName is the name used for the ApplicationDefinition metadata.name.
 	// If not specified, chartName is used.
@@ -83,27 +117,60 @@ type ChartMetadata struct {
 	SourceURL string `json:"sourceURL,omitempty"`
 
 	// Logo is a base64-encoded image for the application logo.
+	// Mutually exclusive with logoFrom.
 	//
 	// +optional
 	Logo string `json:"logo,omitempty"`
 
+	// LogoFrom references a logo stored outside of the catalog, which keeps large
+	// images out of the ApplicationCatalog. The logo is inlined into the generated
+	// ApplicationDefinition. Mutually exclusive with logo.
+	//
+	// +optional
+	LogoFrom *LogoSource `json:"logoFrom,omitempty"`
+
 	// LogoFormat specifies the format of the logo image.
+	// If not set, the format is detected from the image.
+	//
 	// +kubebuilder:validation:Enum=svg+xml;png
 	LogoFormat string `json:"logoFormat,omitempty"`
 }
 
+// LogoSource references a logo image. Exactly one of the fields must be set.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.configMapKeyRef) != has(self.bundled)",message="exactly one of configMapKeyRef and bundled must be set"
+type LogoSource struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap in the namespace of the application
+	// catalog manager. The key should be stored in binaryData, SVG images may also be
+	// stored in data.
+	//
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// Bundled is the ID of a logo bundled with the application catalog manager,
+	// which is the name of the default application, e.g. "cert-manager".
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	Bundled string `json:"bundled,omitempty"`
+}
+
 // ChartVersion defines a specific version of a Helm chart.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.defaultValuesBlock) || !has(self.defaultValuesPatch)",message="defaultValuesBlock and defaultValuesPatch are mutually exclusive"
 type ChartVersion struct {
 	// ChartVersion is the semantic version of the Helm chart (e.g., "4.7.1", "v1.16.0").
 	// This corresponds to the chart version in Chart.yaml.
 	//
 	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
 	ChartVersion string `json:"chartVersion"`
 
 	// AppVersion is the version of the application contained in the chart.
 	// This maps to ApplicationDefinition.spec.versions[].version.
 	//
 	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
 	AppVersion string `json:"appVersion"`
 
 	// RepositorySettings allows overriding the repository URL for this specific version.
@@ -111,6 +178,41 @@ type ChartVersion struct {
 	//
 	// +optional
 	RepositorySettings *RepositorySettings `json:"repositorySettings,omitempty"`
+
+	// DefaultValuesBlock replaces the chart-level defaultValuesBlock for this version.
+	// Mutually exclusive with defaultValuesPatch.
+	//
+	// KKP only supports default values for the whole ApplicationDefinition, so the
+	// values of versions with an override are published in the annotation
+	// "default-values.applicationcatalog.k8c.io/<appVersion>" of the ApplicationDefinition.
+	// The resolved values of all versions of a chart may not exceed 224KiB combined.
+	//
+	// +optional
+	DefaultValuesBlock string `json:"defaultValuesBlock,omitempty"`
+
+	// DefaultValuesPatch is deep-merged on top of the chart-level defaultValuesBlock
+	// for this version. Maps are merged, all other values are replaced and keys set
+	// to null are removed. Comments are preserved.
+	// Mutually exclusive with defaultValuesBlock.
+	//
+	// +optional
+	DefaultValuesPatch string `json:"defaultValuesPatch,omitempty"`
+
+	// ValuesSchema replaces the chart-level valuesSchema for this version.
+	//
+	// +optional
+	ValuesSchema *ValuesSchema `json:"valuesSchema,omitempty"`
+}
+
+// HasDefaultValuesOverride returns true if the version overrides the chart-level default values.
+func (v *ChartVersion) HasDefaultValuesOverride() bool {
+	return v.DefaultValuesBlock != "" || v.DefaultValuesPatch != ""
+}
+
+// DefaultValuesAnnotation returns the name of the annotation that holds the default values
+// of this version on the ApplicationDefinition.
+func (v *ChartVersion) DefaultValuesAnnotation() string {
+	return AnnotationPrefixVersionDefaultValues + v.AppVersion
 }
 
 // ChartConfig defines the configuration for a single Helm chart
@@ -120,6 +222,7 @@ type ChartConfig struct {
 	// This is used as the chart name when pulling from the repository.
 	//
 	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
 	ChartName string `json:"chartName"`
 
 	// Metadata contains display information for the application.
@@ -138,6 +241,19 @@ type ChartConfig struct {
 	// +optional
 	DefaultValuesBlock string `json:"defaultValuesBlock,omitempty"`
 
+	// DefaultValuesFrom references ConfigMap or Secret keys holding default Helm values
+	// for this application. The referenced objects must exist in the namespace of the
+	// application catalog manager. The values end up in the ApplicationDefinition, which
+	// is readable by all users, so Secrets are only used if they are labeled with
+	// "applicationcatalog.k8c.io/values-source: true".
+	// The values are deep-merged in the given order, followed by the defaultValuesBlock,
+	// so later entries take precedence. Maps are merged, all other values are replaced
+	// and keys set to null are removed. Comments are preserved.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	DefaultValuesFrom []ValuesReference `json:"defaultValuesFrom,omitempty"`
+
 	// DefaultDeployOptions holds the settings specific to the templating method
 	// used to deploy the application. These are propagated to the generated
 	// ApplicationDefinition.
@@ -145,9 +261,44 @@ type ChartConfig struct {
 	// +optional
 	DefaultDeployOptions *DeployOptions `json:"defaultDeployOptions,omitempty"`
 
+	// DefaultNamespace is the namespace the application is installed into by default.
+	// It is propagated to the generated ApplicationDefinition.
+	//
+	// +optional
+	DefaultNamespace *AppNamespaceSpec `json:"defaultNamespace,omitempty"`
+
+	// ImageRegistryKeys lists the keys of the chart values that hold the registry of
+	// an image. They are used to apply the imageRegistryRewrite of the catalog.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(k, self.exists_one(l, l.path == k.path))",message="image registry key paths must be unique"
+	ImageRegistryKeys []ImageRegistryKey `json:"imageRegistryKeys,omitempty"`
+
+	// ValuesSchema is the JSON schema the default values of every version are validated
+	// against, like Helm validates the values of an installation against the
+	// values.schema.json of the chart. Individual versions can override it.
+	//
+	// +optional
+	ValuesSchema *ValuesSchema `json:"valuesSchema,omitempty"`
+
+	// SharedOwnership allows other catalogs to contribute versions to the ApplicationDefinition
+	// of this chart. It takes effect only if all contributing catalogs set it, and the versions
+	// of the catalogs must not overlap. The catalog which created the ApplicationDefinition
+	// owns it and provides everything but the versions of the other catalogs, like the
+	// metadata and the default values. If it removes the chart, the ownership is handed over
+	// to the next contributing catalog. Versions of removed contributions are kept.
+	//
+	// +optional
+	SharedOwnership bool `json:"sharedOwnership,omitempty"`
+
 	// ChartVersions lists the available versions of this chart.
+	// Both chartVersion and appVersion must be unique within the list.
 	//
 	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:XValidation:rule="self.all(v, self.exists_one(w, w.chartVersion == v.chartVersion))",message="chart versions must be unique"
+	// +kubebuilder:validation:XValidation:rule="self.all(v, self.exists_one(w, w.appVersion == v.appVersion))",message="app versions must be unique"
 	ChartVersions []ChartVersion `json:"chartVersions"`
 }
 
@@ -160,6 +311,193 @@ func (c *ChartConfig) GetAppName() string {
 	return c.ChartName
 }
 
+// ValidateAppName checks whether the given name can be used as ApplicationDefinition name.
+// ApplicationDefinition names must be DNS-1123 subdomains and, since ApplicationInstallations
+// reference them, must not exceed 63 characters.
+// Returns a list of error messages, or nil if the name is valid.
+func ValidateAppName(name string) []string {
+	errs := validation.IsDNS1123Subdomain(name)
+	if len(name) > validation.DNS1123LabelMaxLength {
+		errs = append(errs, validation.MaxLenError(validation.DNS1123LabelMaxLength))
+	}
+
+	return errs
+}
+
+// SanitizeAppName converts the given name into a valid ApplicationDefinition name.
+// The conversion is deterministic: characters outside of [a-z0-9-] are replaced by dashes,
+// and names exceeding 63 characters are truncated and suffixed with a short hash of the
+// original name to keep them unique.
+// Names that are already valid are returned unchanged.
+func SanitizeAppName(name string) string {
+	if len(ValidateAppName(name)) == 0 {
+		return name
+	}
+
+	var sb strings.Builder
+	lastDash := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+			lastDash = false
+			continue
+		}
+
+		if !lastDash {
+			sb.WriteRune('-')
+			lastDash = true
+		}
+	}
+
+	sanitized := strings.Trim(sb.String(), "-")
+
+	sum := sha256.Sum256([]byte(name))
+	hash := hex.EncodeToString(sum[:])[:8]
+
+	if sanitized == "" {
+		return "app-" + hash
+	}
+
+	if len(sanitized) > validation.DNS1123LabelMaxLength {
+		prefix := strings.TrimRight(sanitized[:validation.DNS1123LabelMaxLength-len(hash)-1], "-")
+		return prefix + "-" + hash
+	}
+
+	return sanitized
+}
+
+// ImageRegistryKey describes a key of the chart values that holds the registry of an image.
+type ImageRegistryKey struct {
+	// Path is the dot-separated path of the key in the chart values,
+	// e.g. "controller.image.registry".
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Path string `json:"path"`
+
+	// Registry is the registry the chart uses for this image by default,
+	// e.g. "registry.k8s.io". It is looked up in the imageRegistryRewrite of the catalog.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Registry string `json:"registry"`
+
+	// Repository is set if the key holds the full image repository instead of only
+	// the registry, e.g. "jetstack/cert-manager-controller" for a key defaulting to
+	// "quay.io/jetstack/cert-manager-controller". The rewritten value is then the
+	// rewritten registry followed by the repository.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	Repository string `json:"repository,omitempty"`
+}
+
+// Value returns the value of the key when the registry is rewritten to the given one.
+func (k *ImageRegistryKey) Value(registry string) string {
+	if k.Repository == "" {
+		return registry
+	}
+
+	return strings.TrimSuffix(registry, "/") + "/" + k.Repository
+}
+
+// ValuesSchema defines where the JSON schema of the chart values is taken from.
+// Exactly one of the fields must be set.
+//
+// +kubebuilder:validation:XValidation:rule="[has(self.inline), has(self.configMapKeyRef), has(self.fromChart) && self.fromChart].exists_one(x, x)",message="exactly one of inline, configMapKeyRef and fromChart must be set"
+type ValuesSchema struct {
+	// Inline is the JSON schema, written in JSON or YAML.
+	//
+	// +optional
+	Inline string `json:"inline,omitempty"`
+
+	// ConfigMapKeyRef selects a key of a ConfigMap holding the JSON schema in the
+	// namespace of the application catalog manager.
+	//
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// FromChart reads the values.schema.json from the chart archive of every version.
+	// Versions whose chart has no schema are not validated. Chart archives are fetched in
+	// the background, versions whose archive is not fetched yet are only validated by
+	// later updates of the catalog, a warning is returned until then.
+	//
+	// +optional
+	FromChart bool `json:"fromChart,omitempty"`
+}
+
+// ResolveValuesSchema returns the values schema of the given version of the chart.
+// The version-level schema takes precedence over the chart-level schema.
+func (c *ChartConfig) ResolveValuesSchema(version *ChartVersion) *ValuesSchema {
+	if version != nil && version.ValuesSchema != nil {
+		return version.ValuesSchema
+	}
+
+	return c.ValuesSchema
+}
+
+// ValuesReferenceKind is the kind of object a ValuesReference points to.
+//
+// +kubebuilder:validation:Enum=ConfigMap;Secret
+type ValuesReferenceKind string
+
+const (
+	ValuesReferenceKindConfigMap ValuesReferenceKind = "ConfigMap"
+	ValuesReferenceKindSecret    ValuesReferenceKind = "Secret"
+)
+
+// ValuesReference references a key of a ConfigMap or Secret holding Helm values.
+type ValuesReference struct {
+	// Kind is the kind of the referenced object.
+	Kind ValuesReferenceKind `json:"kind"`
+
+	// Name is the name of the referenced object.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Key is the key in the referenced object that holds the values.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Key string `json:"key"`
+
+	// Optional makes the controller ignore the reference if the object or
+	// the key does not exist. By default, a missing reference is an error.
+	//
+	// +optional
+	Optional bool `json:"optional,omitempty"`
+}
+
+// AppNamespaceSpec describes the namespace an application is installed into.
+type AppNamespaceSpec struct {
+	// Name is the namespace to deploy the application into.
+	// Must be a valid lowercase RFC 1123 label.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Create defines whether the namespace should be created if it does not exist.
+	// Defaults to true.
+	//
+	// +kubebuilder:default=true
+	// +optional
+	Create *bool `json:"create,omitempty"`
+
+	// Labels are added to the namespace.
+	//
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are added to the namespace.
+	//
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
 // DeployOptions holds the settings specific to the templating method
 // used to deploy the application.
 type DeployOptions struct {
@@ -170,6 +508,9 @@ type DeployOptions struct {
 }
 
 // HelmDeployOptions holds deployment settings when the templating method is Helm.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.timeout) || (has(self.wait) && self.wait)",message="timeout requires wait to be enabled"
+// +kubebuilder:validation:XValidation:rule="!has(self.atomic) || !self.atomic || (has(self.wait) && self.wait)",message="atomic requires wait to be enabled"
 type HelmDeployOptions struct {
 	// Wait corresponds to the --wait flag on Helm CLI.
 	// If set, will wait until all Pods, PVCs, Services, and minimum number of Pods
@@ -178,4 +519,26 @@ type HelmDeployOptions struct {
 	//
 	// +optional
 	Wait bool `json:"wait,omitempty"`
+
+	// Timeout corresponds to the --timeout flag on Helm CLI.
+	// It is the time to wait for any individual Kubernetes operation and
+	// requires wait to be enabled.
+	//
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Atomic corresponds to the --atomic flag on Helm CLI.
+	// If set, a failed installation is deleted and a failed upgrade is rolled back.
+	// Requires wait to be enabled.
+	//
+	// +optional
+	Atomic bool `json:"atomic,omitempty"`
+
+	// EnableDNS corresponds to the --enable-dns flag on Helm CLI.
+	// If set, DNS lookups are enabled when rendering templates. Make sure the chart
+	// does not use the getHostByName template function to disclose information
+	// (see CVE-2023-25165).
+	//
+	// +optional
+	EnableDNS bool `json:"enableDNS,omitempty"`
 }
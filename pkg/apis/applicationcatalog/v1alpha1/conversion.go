@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1beta1"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts the ApplicationCatalog to the v1beta1 hub version. The list of included
+// default charts is moved from the annotation to spec.helm.includedDefaults.
+func (ac *ApplicationCatalog) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1beta1.ApplicationCatalog)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", dstRaw)
+	}
+
+	dst.ObjectMeta = *ac.ObjectMeta.DeepCopy()
+	dst.Spec = v1beta1.ApplicationCatalogSpec{}
+	dst.Status = v1beta1.ApplicationCatalogStatus{}
+
+	if err := convertJSON(&ac.Spec, &dst.Spec); err != nil {
+		return fmt.Errorf("failed to convert spec: %w", err)
+	}
+	if err := convertJSON(&ac.Status, &dst.Status); err != nil {
+		return fmt.Errorf("failed to convert status: %w", err)
+	}
+
+	// Without a Helm spec, the annotation has no effect and is kept as it is.
+	if include, ok := dst.Annotations[AnnotationIncludeDefaults]; ok && dst.Spec.Helm != nil {
+		delete(dst.Annotations, AnnotationIncludeDefaults)
+		if len(dst.Annotations) == 0 {
+			dst.Annotations = nil
+		}
+
+		dst.Spec.Helm.IncludedDefaults = ParseIncludedDefaults(include)
+	}
+
+	return nil
+}
+
+// ConvertFrom converts the ApplicationCatalog from the v1beta1 hub version. The list of
+// included default charts is stored in the annotation.
+func (ac *ApplicationCatalog) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1beta1.ApplicationCatalog)
+	if !ok {
+		return fmt.Errorf("unsupported hub type %T", srcRaw)
+	}
+
+	ac.ObjectMeta = *src.ObjectMeta.DeepCopy()
+	ac.Spec = ApplicationCatalogSpec{}
+	ac.Status = ApplicationCatalogStatus{}
+
+	// The spec is converted without the included defaults, which have no field in v1alpha1.
+	spec := src.Spec.DeepCopy()
+	var included []string
+	if spec.Helm != nil {
+		included = spec.Helm.IncludedDefaults
+		spec.Helm.IncludedDefaults = nil
+	}
+
+	if err := convertJSON(spec, &ac.Spec); err != nil {
+		return fmt.Errorf("failed to convert spec: %w", err)
+	}
+	if err := convertJSON(&src.Status, &ac.Status); err != nil {
+		return fmt.Errorf("failed to convert status: %w", err)
+	}
+
+	if spec.Helm != nil {
+		delete(ac.Annotations, AnnotationIncludeDefaults)
+
+		if len(included) > 0 {
+			if ac.Annotations == nil {
+				ac.Annotations = map[string]string{}
+			}
+			ac.Annotations[AnnotationIncludeDefaults] = strings.Join(included, ",")
+		}
+	}
+
+	return nil
+}
+
+// ParseIncludedDefaults returns the chart names of the AnnotationIncludeDefaults annotation,
+// without empty and duplicate names.
+func ParseIncludedDefaults(annotation string) []string {
+	var names []string
+	seen := map[string]bool{}
+
+	for _, name := range strings.Split(annotation, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+
+		seen[name] = true
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// convertJSON converts between the types of different versions which share their JSON
+// representation.
+func convertJSON(src, dst any) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, dst)
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1beta1"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/randfill"
+)
+
+// newConversionFiller returns a filler for ApplicationCatalogs whose values survive the JSON
+// representation of the API.
+func newConversionFiller(seed int64) *randfill.Filler {
+	return randfill.NewWithSeed(seed).NilChance(0.2).NumElements(0, 3).Funcs(
+		// Times are serialized with a precision of seconds.
+		func(t *metav1.Time, c randfill.Continue) {
+			*t = metav1.Unix(c.Int63n(1<<32), 0)
+		},
+		func(d *metav1.Duration, c randfill.Continue) {
+			d.Duration = time.Duration(c.Int63n(int64(24 * time.Hour)))
+		},
+		func(ac *ApplicationCatalog, c randfill.Continue) {
+			c.FillNoCustom(ac)
+			ac.TypeMeta = metav1.TypeMeta{}
+
+			delete(ac.Annotations, AnnotationIncludeDefaults)
+			if c.Bool() {
+				if ac.Annotations == nil {
+					ac.Annotations = map[string]string{}
+				}
+				ac.Annotations[AnnotationIncludeDefaults] = "cert-manager,argo-cd"
+			}
+		},
+		func(ac *v1beta1.ApplicationCatalog, c randfill.Continue) {
+			c.FillNoCustom(ac)
+			ac.TypeMeta = metav1.TypeMeta{}
+			delete(ac.Annotations, AnnotationIncludeDefaults)
+		},
+		func(h *v1beta1.HelmSpec, c randfill.Continue) {
+			c.FillNoCustom(h)
+
+			h.IncludedDefaults = nil
+			for i := range c.Intn(4) {
+				h.IncludedDefaults = append(h.IncludedDefaults, "chart-"+strconv.Itoa(i))
+			}
+		},
+	)
+}
+
+func TestConversionRoundTrip(t *testing.T) {
+	for seed := range int64(1000) {
+		filler := newConversionFiller(seed)
+
+		t.Run("v1alpha1 "+strconv.FormatInt(seed, 10), func(t *testing.T) {
+			original := &ApplicationCatalog{}
+			filler.Fill(original)
+
+			hub := &v1beta1.ApplicationCatalog{}
+			if err := original.DeepCopy().ConvertTo(hub); err != nil {
+				t.Fatalf("failed to convert to v1beta1: %v", err)
+			}
+
+			converted := &ApplicationCatalog{}
+			if err := converted.ConvertFrom(hub); err != nil {
+				t.Fatalf("failed to convert from v1beta1: %v", err)
+			}
+
+			if !equality.Semantic.DeepEqual(original, converted) {
+				t.Errorf("round trip changed the catalog:\nexpected %+v\ngot      %+v", original, converted)
+			}
+		})
+
+		t.Run("v1beta1 "+strconv.FormatInt(seed, 10), func(t *testing.T) {
+			original := &v1beta1.ApplicationCatalog{}
+			filler.Fill(original)
+
+			spoke := &ApplicationCatalog{}
+			if err := spoke.ConvertFrom(original.DeepCopy()); err != nil {
+				t.Fatalf("failed to convert from v1beta1: %v", err)
+			}
+
+			converted := &v1beta1.ApplicationCatalog{}
+			if err := spoke.ConvertTo(converted); err != nil {
+				t.Fatalf("failed to convert to v1beta1: %v", err)
+			}
+
+			if !equality.Semantic.DeepEqual(original, converted) {
+				t.Errorf("round trip changed the catalog:\nexpected %+v\ngot      %+v", original, converted)
+			}
+		})
+	}
+}
+
+func TestConvertIncludedDefaults(t *testing.T) {
+	testCases := []struct {
+		name             string
+		annotation       string
+		expectedIncluded []string
+		expectedBack     string
+	}{
+		{
+			name:             "list",
+			annotation:       "cert-manager,argo-cd",
+			expectedIncluded: []string{"cert-manager", "argo-cd"},
+			expectedBack:     "cert-manager,argo-cd",
+		},
+		{
+			name:             "spaces, empty and duplicate entries",
+			annotation:       " cert-manager, ,argo-cd,cert-manager",
+			expectedIncluded: []string{"cert-manager", "argo-cd"},
+			expectedBack:     "cert-manager,argo-cd",
+		},
+		{
+			name:       "empty",
+			annotation: " , ",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			catalog := &ApplicationCatalog{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "platform",
+					Annotations: map[string]string{AnnotationIncludeDefaults: tc.annotation},
+				},
+				Spec: ApplicationCatalogSpec{Helm: &HelmSpec{IncludeDefaults: true}},
+			}
+
+			hub := &v1beta1.ApplicationCatalog{}
+			if err := catalog.ConvertTo(hub); err != nil {
+				t.Fatalf("failed to convert to v1beta1: %v", err)
+			}
+
+			if !reflect.DeepEqual(hub.Spec.Helm.IncludedDefaults, tc.expectedIncluded) {
+				t.Errorf("expected included defaults %v, got %v", tc.expectedIncluded, hub.Spec.Helm.IncludedDefaults)
+			}
+			if _, ok := hub.Annotations[AnnotationIncludeDefaults]; ok {
+				t.Errorf("expected annotation to be removed, got %v", hub.Annotations)
+			}
+
+			converted := &ApplicationCatalog{}
+			if err := converted.ConvertFrom(hub); err != nil {
+				t.Fatalf("failed to convert from v1beta1: %v", err)
+			}
+
+			if value := converted.Annotations[AnnotationIncludeDefaults]; value != tc.expectedBack {
+				t.Errorf("expected annotation %q, got %q", tc.expectedBack, value)
+			}
+		})
+	}
+}
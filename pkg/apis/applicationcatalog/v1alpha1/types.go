@@ -22,6 +22,12 @@ const (
 
 	// ApplicationCatalogKindName is the kind name of the ApplicationCatalog resource.
 	ApplicationCatalogKindName = "ApplicationCatalog"
+
+	// ApplicationCatalogSourceResourceName is the plural name of the ApplicationCatalogSource resource.
+	ApplicationCatalogSourceResourceName = "applicationcatalogsources"
+
+	// ApplicationCatalogSourceKindName is the kind name of the ApplicationCatalogSource resource.
+	ApplicationCatalogSourceKindName = "ApplicationCatalogSource"
 )
 
 const (
@@ -32,16 +38,138 @@ const (
 	// LabelApplicationCatalogName is applied to ApplicationDefinitions
 	// to indicate which ApplicationCatalog generated them.
 	LabelApplicationCatalogName = "applicationcatalog.k8c.io/catalog-name"
+
+	// LabelApplicationCatalogSourceName is applied to ApplicationCatalogs
+	// to indicate which ApplicationCatalogSource created them.
+	LabelApplicationCatalogSourceName = "applicationcatalog.k8c.io/source-name"
+
+	// LabelApplicationCatalogRevision is applied to the ConfigMaps holding the revisions
+	// of an ApplicationCatalog, together with LabelApplicationCatalogName. Its value is the
+	// generation of the catalog the revision was taken of.
+	LabelApplicationCatalogRevision = "applicationcatalog.k8c.io/revision"
+
+	// LabelValuesSource must be set to "true" on Secrets referenced in defaultValuesFrom.
+	// Their values are copied into ApplicationDefinitions, which can be read by all users
+	// of the cluster, so Secrets have to be published explicitly.
+	LabelValuesSource = "applicationcatalog.k8c.io/values-source"
 )
 
 const (
 	// AnnotationDefaultValuesGeneration tracks the generation of the ApplicationCatalog
 	// when defaultValuesBlock was last synced. Used to preserve user customizations.
 	AnnotationDefaultValuesGeneration = "applicationcatalog.k8c.io/default-values-generation"
+
+	// AnnotationSourceAppName records the original application name on ApplicationDefinitions
+	// whose name was sanitized because spec.helm.sanitizeAppNames is enabled.
+	AnnotationSourceAppName = "applicationcatalog.k8c.io/source-app-name"
+
+	// AnnotationBreakGlass can be set to "true" on a managed ApplicationDefinition to allow
+	// manual edits of catalog-owned fields. While it is set, the controller stops reconciling
+	// the ApplicationDefinition, so the manual changes are kept until it is removed again.
+	AnnotationBreakGlass = "applicationcatalog.k8c.io/break-glass"
+
+	// AnnotationPrefixVersionDefaultValues is the prefix of the annotations that hold the
+	// default values of ApplicationDefinition versions which override the chart-level
+	// default values. The annotation name is the appVersion of the version.
+	AnnotationPrefixVersionDefaultValues = "default-values.applicationcatalog.k8c.io/"
+
+	// AnnotationDefaultValuesHash holds the hash of the defaultValuesBlock the controller
+	// last wrote to an ApplicationDefinition. It is used to tell whether the values were
+	// customized in the cluster, in which case they are not overwritten anymore.
+	AnnotationDefaultValuesHash = "applicationcatalog.k8c.io/default-values-hash"
+
+	// AnnotationVersionOwners is set on ApplicationDefinitions of charts with shared ownership.
+	// It holds a JSON object mapping every version to the name of the ApplicationCatalog
+	// providing it.
+	AnnotationVersionOwners = "applicationcatalog.k8c.io/version-owners"
+
+	// AnnotationCatalogGeneration records the generation of the ApplicationCatalog that
+	// last changed a generated ApplicationDefinition.
+	AnnotationCatalogGeneration = "applicationcatalog.k8c.io/catalog-generation"
+
+	// AnnotationChartSource records where the chart of a generated ApplicationDefinition
+	// comes from, one of the ChartSource values.
+	AnnotationChartSource = "applicationcatalog.k8c.io/chart-source"
+
+	// AnnotationSourceCatalog records the name of the imported ApplicationCatalog the chart
+	// of a generated ApplicationDefinition comes from, if its chart source is "imported".
+	AnnotationSourceCatalog = "applicationcatalog.k8c.io/source-catalog"
+
+	// AnnotationManagerVersion records the version of the application-catalog-manager that
+	// last changed a generated ApplicationDefinition. The default charts are part of the
+	// manager, so it also identifies the release of the default catalog.
+	AnnotationManagerVersion = "applicationcatalog.k8c.io/manager-version"
+
+	// AnnotationContentHash holds the hash of the labels, annotations and spec the controller
+	// last rendered for an ApplicationDefinition, excluding the generation and version
+	// annotations. ApplicationDefinitions whose hash matches are not patched again.
+	AnnotationContentHash = "applicationcatalog.k8c.io/content-hash"
+
+	// AnnotationPaused can be set to "true" on an ApplicationCatalog to pause it, like
+	// spec.paused does.
+	AnnotationPaused = "applicationcatalog.k8c.io/paused"
+
+	// AnnotationRollbackTo can be set on an ApplicationCatalog to the number of one of its
+	// revisions. The controller restores the spec of the catalog from the revision and
+	// removes the annotation afterwards.
+	AnnotationRollbackTo = "applicationcatalog.k8c.io/rollback-to"
+
+	// AnnotationIncludeDefaults restricts the default charts merged into an ApplicationCatalog
+	// with spec.helm.includeDefaults to the comma-separated chart names. It is stored in
+	// spec.helm.includedDefaults of v1beta1.
+	AnnotationIncludeDefaults = "defaultcatalog.k8c.io/include"
+)
+
+// ChartSource describes where the chart of a generated ApplicationDefinition comes from.
+type ChartSource string
+
+const (
+	// ChartSourceDefault is a default chart merged into the catalog because of
+	// spec.helm.includeDefaults, without changes.
+	ChartSourceDefault ChartSource = "default"
+
+	// ChartSourceUser is a chart configured in the catalog itself.
+	ChartSourceUser ChartSource = "user"
+
+	// ChartSourceImported is a chart of an imported catalog.
+	ChartSourceImported ChartSource = "imported"
+)
+
+const (
+	// CatalogConditionPaused indicates whether the reconciliation of an ApplicationCatalog
+	// is paused.
+	CatalogConditionPaused = "Paused"
+
+	// CatalogConditionRolledBack indicates whether the last rollback of an ApplicationCatalog
+	// requested with the rollback annotation succeeded.
+	CatalogConditionRolledBack = "RolledBack"
+
+	// CatalogConditionValuesRendered indicates whether the variables in the default values of
+	// all charts of an ApplicationCatalog could be rendered. The ApplicationDefinitions of
+	// charts whose values could not be rendered keep their previous values.
+	CatalogConditionValuesRendered = "ValuesRendered"
+)
+
+const (
+	// SourceConditionReady indicates whether the last fetched artifact of an
+	// ApplicationCatalogSource was applied successfully.
+	SourceConditionReady = "Ready"
+
+	// CatalogSourceLayerMediaType is the media type of the OCI layers that contain
+	// ApplicationCatalogs.
+	CatalogSourceLayerMediaType = "application/vnd.k8c.applicationcatalog.layer.v1+yaml"
+
+	// AnnotationCatalogSourceSignature holds the base64-encoded detached signature of
+	// an OCI layer containing ApplicationCatalogs.
+	AnnotationCatalogSourceSignature = "applicationcatalog.k8c.io/signature"
 )
 
 const (
 	// DefaultHelmRepository is the default OCI repository for Helm charts
 	// when no repositorySettings.baseURL is specified.
 	DefaultHelmRepository = "oci://quay.io/kubermatic-mirror/helm-charts"
+
+	// DefaultRevisionHistoryLimit is the number of revisions kept per ApplicationCatalog if
+	// spec.revisionHistoryLimit is not set.
+	DefaultRevisionHistoryLimit = 10
 )
@@ -21,17 +21,52 @@ limitations under the License.
 package v1alpha1
 
 import (
-	"k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppNamespaceSpec) DeepCopyInto(out *AppNamespaceSpec) {
+	*out = *in
+	if in.Create != nil {
+		in, out := &in.Create, &out.Create
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppNamespaceSpec.
+func (in *AppNamespaceSpec) DeepCopy() *AppNamespaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppNamespaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationCatalog) DeepCopyInto(out *ApplicationCatalog) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationCatalog.
@@ -84,6 +119,137 @@ func (in *ApplicationCatalogList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationCatalogSource) DeepCopyInto(out *ApplicationCatalogSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationCatalogSource.
+func (in *ApplicationCatalogSource) DeepCopy() *ApplicationCatalogSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationCatalogSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationCatalogSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationCatalogSourceList) DeepCopyInto(out *ApplicationCatalogSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApplicationCatalogSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationCatalogSourceList.
+func (in *ApplicationCatalogSourceList) DeepCopy() *ApplicationCatalogSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationCatalogSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationCatalogSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationCatalogSourceSpec) DeepCopyInto(out *ApplicationCatalogSourceSpec) {
+	*out = *in
+	out.Interval = in.Interval
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPCatalogSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Git != nil {
+		in, out := &in.Git, &out.Git
+		*out = new(GitCatalogSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCICatalogSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(SignatureVerification)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationCatalogSourceSpec.
+func (in *ApplicationCatalogSourceSpec) DeepCopy() *ApplicationCatalogSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationCatalogSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationCatalogSourceStatus) DeepCopyInto(out *ApplicationCatalogSourceStatus) {
+	*out = *in
+	if in.LastFetchTime != nil {
+		in, out := &in.LastFetchTime, &out.LastFetchTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Catalogs != nil {
+		in, out := &in.Catalogs, &out.Catalogs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VerifiedSigners != nil {
+		in, out := &in.VerifiedSigners, &out.VerifiedSigners
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationCatalogSourceStatus.
+func (in *ApplicationCatalogSourceStatus) DeepCopy() *ApplicationCatalogSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationCatalogSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationCatalogSpec) DeepCopyInto(out *ApplicationCatalogSpec) {
 	*out = *in
@@ -92,6 +258,28 @@ func (in *ApplicationCatalogSpec) DeepCopyInto(out *ApplicationCatalogSpec) {
 		*out = new(HelmSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Imports != nil {
+		in, out := &in.Imports, &out.Imports
+		*out = make([]CatalogImport, len(*in))
+		copy(*out, *in)
+	}
+	if in.Vars != nil {
+		in, out := &in.Vars, &out.Vars
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.VarsFrom != nil {
+		in, out := &in.VarsFrom, &out.VarsFrom
+		*out = make([]VarsReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationCatalogSpec.
@@ -107,6 +295,30 @@ func (in *ApplicationCatalogSpec) DeepCopy() *ApplicationCatalogSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationCatalogStatus) DeepCopyInto(out *ApplicationCatalogStatus) {
 	*out = *in
+	if in.UnresolvedVariables != nil {
+		in, out := &in.UnresolvedVariables, &out.UnresolvedVariables
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DisplacedCharts != nil {
+		in, out := &in.DisplacedCharts, &out.DisplacedCharts
+		*out = make([]DisplacedChart, len(*in))
+		copy(*out, *in)
+	}
+	if in.Seeds != nil {
+		in, out := &in.Seeds, &out.Seeds
+		*out = make([]SeedSyncStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationCatalogStatus.
@@ -119,24 +331,59 @@ func (in *ApplicationCatalogStatus) DeepCopy() *ApplicationCatalogStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogImport) DeepCopyInto(out *CatalogImport) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogImport.
+func (in *CatalogImport) DeepCopy() *CatalogImport {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChartConfig) DeepCopyInto(out *ChartConfig) {
 	*out = *in
 	if in.Metadata != nil {
 		in, out := &in.Metadata, &out.Metadata
 		*out = new(ChartMetadata)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.RepositorySettings != nil {
 		in, out := &in.RepositorySettings, &out.RepositorySettings
 		*out = new(RepositorySettings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DefaultValuesFrom != nil {
+		in, out := &in.DefaultValuesFrom, &out.DefaultValuesFrom
+		*out = make([]ValuesReference, len(*in))
+		copy(*out, *in)
+	}
 	if in.DefaultDeployOptions != nil {
 		in, out := &in.DefaultDeployOptions, &out.DefaultDeployOptions
 		*out = new(DeployOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DefaultNamespace != nil {
+		in, out := &in.DefaultNamespace, &out.DefaultNamespace
+		*out = new(AppNamespaceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageRegistryKeys != nil {
+		in, out := &in.ImageRegistryKeys, &out.ImageRegistryKeys
+		*out = make([]ImageRegistryKey, len(*in))
+		copy(*out, *in)
+	}
+	if in.ValuesSchema != nil {
+		in, out := &in.ValuesSchema, &out.ValuesSchema
+		*out = new(ValuesSchema)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ChartVersions != nil {
 		in, out := &in.ChartVersions, &out.ChartVersions
 		*out = make([]ChartVersion, len(*in))
@@ -159,6 +406,11 @@ func (in *ChartConfig) DeepCopy() *ChartConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChartMetadata) DeepCopyInto(out *ChartMetadata) {
 	*out = *in
+	if in.LogoFrom != nil {
+		in, out := &in.LogoFrom, &out.LogoFrom
+		*out = new(LogoSource)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartMetadata.
@@ -179,6 +431,11 @@ func (in *ChartVersion) DeepCopyInto(out *ChartVersion) {
 		*out = new(RepositorySettings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ValuesSchema != nil {
+		in, out := &in.ValuesSchema, &out.ValuesSchema
+		*out = new(ValuesSchema)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartVersion.
@@ -197,7 +454,7 @@ func (in *DeployOptions) DeepCopyInto(out *DeployOptions) {
 	if in.Helm != nil {
 		in, out := &in.Helm, &out.Helm
 		*out = new(HelmDeployOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -211,9 +468,89 @@ func (in *DeployOptions) DeepCopy() *DeployOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DisplacedChart) DeepCopyInto(out *DisplacedChart) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisplacedChart.
+func (in *DisplacedChart) DeepCopy() *DisplacedChart {
+	if in == nil {
+		return nil
+	}
+	out := new(DisplacedChart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitCatalogSource) DeepCopyInto(out *GitCatalogSource) {
+	*out = *in
+	if in.Ref != nil {
+		in, out := &in.Ref, &out.Ref
+		*out = new(GitReference)
+		**out = **in
+	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(RepositoryCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitCatalogSource.
+func (in *GitCatalogSource) DeepCopy() *GitCatalogSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GitCatalogSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitReference) DeepCopyInto(out *GitReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitReference.
+func (in *GitReference) DeepCopy() *GitReference {
+	if in == nil {
+		return nil
+	}
+	out := new(GitReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPCatalogSource) DeepCopyInto(out *HTTPCatalogSource) {
+	*out = *in
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(RepositoryCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPCatalogSource.
+func (in *HTTPCatalogSource) DeepCopy() *HTTPCatalogSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPCatalogSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HelmDeployOptions) DeepCopyInto(out *HelmDeployOptions) {
 	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmDeployOptions.
@@ -241,6 +578,13 @@ func (in *HelmSpec) DeepCopyInto(out *HelmSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ImageRegistryRewrite != nil {
+		in, out := &in.ImageRegistryRewrite, &out.ImageRegistryRewrite
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmSpec.
@@ -253,22 +597,77 @@ func (in *HelmSpec) DeepCopy() *HelmSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageRegistryKey) DeepCopyInto(out *ImageRegistryKey) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageRegistryKey.
+func (in *ImageRegistryKey) DeepCopy() *ImageRegistryKey {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageRegistryKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogoSource) DeepCopyInto(out *LogoSource) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogoSource.
+func (in *LogoSource) DeepCopy() *LogoSource {
+	if in == nil {
+		return nil
+	}
+	out := new(LogoSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCICatalogSource) DeepCopyInto(out *OCICatalogSource) {
+	*out = *in
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(RepositoryCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCICatalogSource.
+func (in *OCICatalogSource) DeepCopy() *OCICatalogSource {
+	if in == nil {
+		return nil
+	}
+	out := new(OCICatalogSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepositoryCredentials) DeepCopyInto(out *RepositoryCredentials) {
 	*out = *in
 	if in.Username != nil {
 		in, out := &in.Username, &out.Username
-		*out = new(v1.SecretKeySelector)
+		*out = new(corev1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Password != nil {
 		in, out := &in.Password, &out.Password
-		*out = new(v1.SecretKeySelector)
+		*out = new(corev1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.RegistryConfigFile != nil {
 		in, out := &in.RegistryConfigFile, &out.RegistryConfigFile
-		*out = new(v1.SecretKeySelector)
+		*out = new(corev1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -302,3 +701,84 @@ func (in *RepositorySettings) DeepCopy() *RepositorySettings {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSyncStatus) DeepCopyInto(out *SeedSyncStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSyncStatus.
+func (in *SeedSyncStatus) DeepCopy() *SeedSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignatureVerification) DeepCopyInto(out *SignatureVerification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignatureVerification.
+func (in *SignatureVerification) DeepCopy() *SignatureVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(SignatureVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValuesReference) DeepCopyInto(out *ValuesReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValuesReference.
+func (in *ValuesReference) DeepCopy() *ValuesReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ValuesReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValuesSchema) DeepCopyInto(out *ValuesSchema) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValuesSchema.
+func (in *ValuesSchema) DeepCopy() *ValuesSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(ValuesSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VarsReference) DeepCopyInto(out *VarsReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VarsReference.
+func (in *VarsReference) DeepCopy() *VarsReference {
+	if in == nil {
+		return nil
+	}
+	out := new(VarsReference)
+	in.DeepCopyInto(out)
+	return out
+}
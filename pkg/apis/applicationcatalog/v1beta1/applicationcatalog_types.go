@@ -0,0 +1,277 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HelmSpec defines the Helm-specific configuration for the application catalog.
+//
+// +kubebuilder:validation:XValidation:rule="(has(self.sanitizeAppNames) && self.sanitizeAppNames) == (has(oldSelf.sanitizeAppNames) && oldSelf.sanitizeAppNames)",message="sanitizeAppNames is immutable, changing it would rename all generated ApplicationDefinitions"
+type HelmSpec struct {
+	// RepositorySettings defines the default repository settings for all charts.
+	// Individual charts can override these settings.
+	// By default, the controller will use the `DefaultHelmRepository`.
+	//
+	// +optional
+	RepositorySettings *RepositorySettings `json:"repositorySettings,omitempty"`
+
+	// Charts is the list of Helm charts to include in this catalog.
+	// Each chart entry in the list will be converted to an ApplicationDefinition.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=128
+	// +kubebuilder:validation:XValidation:rule="self.all(c, self.exists_one(d, d.chartName == c.chartName))",message="chart names must be unique"
+	Charts []ChartConfig `json:"charts,omitempty"`
+
+	// IncludeDefaults indicates that the webhook should automatically
+	// keep this catalog in sync with the default application catalog.
+	// When true, the webhook will merge defaults on every UPDATE operation,
+	// not just when charts is nil.
+	//
+	// +optional
+	IncludeDefaults bool `json:"includeDefaults,omitempty"`
+
+	// IncludedDefaults restricts the default charts merged because of includeDefaults to
+	// the charts with the given names. All default charts are merged if it is empty.
+	// It replaces the "defaultcatalog.k8c.io/include" annotation of v1alpha1.
+	//
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=128
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=253
+	// +kubebuilder:validation:items:Pattern=`^[^,\s]+$`
+	IncludedDefaults []string `json:"includedDefaults,omitempty"`
+
+	// SanitizeAppNames makes the controller derive a valid DNS-1123 name for every
+	// generated ApplicationDefinition (e.g. "nvidia/gpu-operator" becomes
+	// "nvidia-gpu-operator") instead of rejecting charts whose appName is invalid.
+	// The original name is recorded in the "applicationcatalog.k8c.io/source-app-name"
+	// annotation of the generated ApplicationDefinition.
+	//
+	// +optional
+	SanitizeAppNames bool `json:"sanitizeAppNames,omitempty"`
+
+	// ImageRegistryRewrite maps the registries the charts pull their images from to
+	// the registries to use instead, e.g. "registry.k8s.io" to "mirror.example.com/k8s".
+	// The rewritten registries are injected into the default values of every chart at
+	// the keys listed in its imageRegistryKeys, taking precedence over the values
+	// configured in the catalog. Charts without such keys are not changed.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxProperties=32
+	ImageRegistryRewrite map[string]string `json:"imageRegistryRewrite,omitempty"`
+}
+
+// ApplicationCatalogSpec defines the desired state of ApplicationCatalog.
+type ApplicationCatalogSpec struct {
+	// Helm contains Helm chart configuration for this catalog.
+	Helm *HelmSpec `json:"helm,omitempty"`
+
+	// Imports lists other ApplicationCatalogs whose charts are included in this catalog.
+	// Charts are merged by chartName: later imports take precedence over earlier ones
+	// and the charts of this catalog take precedence over all imports. Imports are
+	// resolved transitively, import cycles are rejected.
+	// The ApplicationDefinitions of imported charts are managed by this catalog instead
+	// of the imported one.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(i, self.exists_one(j, j.name == i.name))",message="imported catalogs must be unique"
+	Imports []CatalogImport `json:"imports,omitempty"`
+
+	// Vars are the variables that can be referenced as "${catalog.vars.<name>}" in the
+	// default values of the charts. They are rendered when the ApplicationDefinitions are
+	// generated, "$${catalog.vars.<name>}" is rendered as a literal reference. References
+	// are rendered in the YAML values, so a variable stays a single value whatever it contains.
+	// Vars take precedence over the variables loaded from varsFrom.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxProperties=128
+	Vars map[string]string `json:"vars,omitempty"`
+
+	// VarsFrom lists ConfigMaps in the namespace of the controller whose data is loaded
+	// as variables. Later ConfigMaps take precedence over earlier ones.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	VarsFrom []VarsReference `json:"varsFrom,omitempty"`
+
+	// Paused stops the controller from creating, updating and unmanaging the
+	// ApplicationDefinitions of this catalog, and the webhook from merging the default
+	// charts into it, until it is unset again. The ApplicationDefinitions are kept as
+	// they are. Catalogs can also be paused with the "applicationcatalog.k8c.io/paused"
+	// annotation, which is kept when the catalog is updated by an ApplicationCatalogSource.
+	//
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// RevisionHistoryLimit is the number of revisions kept for this catalog. The controller
+	// snapshots the compressed spec of every generation of the catalog into an immutable
+	// ConfigMap in its namespace, and deletes the oldest revisions exceeding the limit.
+	// Generations that do not fit into a ConfigMap are not recorded. The catalog can be
+	// rolled back to a revision with the "applicationcatalog.k8c.io/rollback-to"
+	// annotation. 0 disables the revision history. Defaults to 10.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	// Priority resolves conflicts with other catalogs providing a chart for the same
+	// ApplicationDefinition. A catalog with a higher priority takes over the
+	// ApplicationDefinition from a catalog with a lower priority, which reports the chart
+	// in status.displacedCharts. Catalogs with the same priority cannot provide a chart for
+	// the same ApplicationDefinition. Defaults to 0.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=-1000
+	// +kubebuilder:validation:Maximum=1000
+	Priority int32 `json:"priority,omitempty"`
+}
+
+// VarsReference references a ConfigMap whose data is loaded as variables.
+type VarsReference struct {
+	// Name is the name of the ConfigMap.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Optional makes a missing ConfigMap not an error.
+	//
+	// +optional
+	Optional bool `json:"optional,omitempty"`
+}
+
+// CatalogImport references an ApplicationCatalog whose charts are imported.
+type CatalogImport struct {
+	// Name is the name of the imported ApplicationCatalog.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+}
+
+// DisplacedChart is a chart of the catalog whose ApplicationDefinition is managed by a
+// catalog with a higher priority.
+type DisplacedChart struct {
+	// ChartName is the name of the displaced chart.
+	ChartName string `json:"chartName"`
+
+	// ApplicationDefinition is the name of the ApplicationDefinition of the chart.
+	ApplicationDefinition string `json:"applicationDefinition"`
+
+	// Catalog is the name of the catalog managing the ApplicationDefinition.
+	Catalog string `json:"catalog"`
+}
+
+// SeedSyncStatus is the state of the synchronization of the ApplicationDefinitions of the
+// catalog to a KKP Seed.
+type SeedSyncStatus struct {
+	// Name is the name of the Seed.
+	Name string `json:"name"`
+
+	// Synced is true if all ApplicationDefinitions of the catalog were synchronized to the
+	// Seed in the last attempt.
+	Synced bool `json:"synced"`
+
+	// Message describes why the synchronization failed.
+	//
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastTransitionTime is the time the state of the synchronization last changed.
+	//
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// ApplicationCatalogStatus defines the observed state of ApplicationCatalog.
+type ApplicationCatalogStatus struct {
+	// ObservedGeneration is the most recent generation observed by the controller.
+	//
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// UnresolvedVariables lists the variables referenced in the default values of the
+	// charts that are not defined. The references are kept as they are in the generated
+	// ApplicationDefinitions.
+	//
+	// +optional
+	UnresolvedVariables []string `json:"unresolvedVariables,omitempty"`
+
+	// DisplacedCharts lists the charts of the catalog whose ApplicationDefinitions are
+	// managed by catalogs with a higher priority.
+	//
+	// +optional
+	DisplacedCharts []DisplacedChart `json:"displacedCharts,omitempty"`
+
+	// Seeds contains the state of the synchronization of the ApplicationDefinitions to the
+	// Seeds of a KKP master cluster. It is only set if the manager runs in master mode.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Seeds []SeedSyncStatus `json:"seeds,omitempty"`
+
+	// VerifiedSigner is the name of the public key that verified the signature of the file
+	// the catalog was applied from by an ApplicationCatalogSource. It is not set for catalogs
+	// read from unsigned files or from sources without signature verification.
+	//
+	// +optional
+	VerifiedSigner string `json:"verifiedSigner,omitempty"`
+
+	// Conditions contains the latest observations of the state of the catalog.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=appcat
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=".status.conditions[?(@.type=='Paused')].status",name="Paused",type="string"
+// +kubebuilder:printcolumn:JSONPath=".spec.priority",name="Priority",type="integer",priority=1
+// +kubebuilder:printcolumn:JSONPath=".metadata.creationTimestamp",name="Age",type="date"
+
+// ApplicationCatalog is the Schema for the applicationcatalogs API.
+// It defines a collection of Helm charts that will be converted to ApplicationDefinitions.
+type ApplicationCatalog struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ApplicationCatalogSpec   `json:"spec,omitempty"`
+	Status ApplicationCatalogStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ApplicationCatalogList contains a list of ApplicationCatalog.
+type ApplicationCatalogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ApplicationCatalog `json:"items"`
+}
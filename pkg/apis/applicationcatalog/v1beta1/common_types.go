@@ -0,0 +1,445 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RepositorySettings defines the connection settings for a Helm chart repository.
+// Credentials and transport options are only used together with the baseURL of the
+// same level, they are never inherited from a less specific level.
+//
+// Custom CA bundles are not supported, as KKP's Helm source has no way to reference them.
+// Repositories using a private CA have to be trusted by KKP itself, e.g. through the
+// spec.caBundle of the KubermaticConfiguration.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.credentials) || (has(self.baseURL) && size(self.baseURL) > 0)",message="credentials are only used together with a baseURL at the same level"
+// +kubebuilder:validation:XValidation:rule="!has(self.insecureSkipTLSVerify) || !self.insecureSkipTLSVerify || (has(self.baseURL) && size(self.baseURL) > 0)",message="insecureSkipTLSVerify is only used together with a baseURL at the same level"
+// +kubebuilder:validation:XValidation:rule="!has(self.plainHTTP) || !self.plainHTTP || (has(self.baseURL) && self.baseURL.startsWith('oci://'))",message="plainHTTP is only supported together with an oci:// baseURL at the same level"
+type RepositorySettings struct {
+	// BaseURL is the base URL of the Helm chart repository.
+	// Supports http, https, and oci schemes.
+	// Examples:
+	//   - oci://quay.io/kubermatic-mirror/helm-charts
+	//   - https://charts.example.com
+	//
+	// +kubebuilder:validation:MaxLength=512
+	// +kubebuilder:validation:XValidation:rule="size(self) == 0 || (isURL(self) && url(self).getScheme() in ['http', 'https', 'oci'])",message="baseURL must be a valid URL with one of the schemes: http, https, oci"
+	BaseURL string `json:"baseURL,omitempty"`
+
+	// Credentials contains authentication information for the repository.
+	// Only used when BaseURL is specified.
+	//
+	// +optional
+	Credentials *RepositoryCredentials `json:"credentials,omitempty"`
+
+	// InsecureSkipTLSVerify disables certificate validation when connecting to an
+	// https or oci repository. It has no effect for plain http connections. Repositories
+	// using a private CA should be trusted through the CA bundle of KKP instead.
+	//
+	// +optional
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+
+	// PlainHTTP enables unencrypted HTTP connections to an oci repository, which
+	// use HTTPS by default. Only supported for oci:// URLs.
+	//
+	// +optional
+	PlainHTTP bool `json:"plainHTTP,omitempty"`
+}
+
+// RepositoryCredentials defines authentication credentials for a Helm repository.
+type RepositoryCredentials struct {
+	// Username is a reference to a secret key containing the username.
+	//
+	// +optional
+	Username *corev1.SecretKeySelector `json:"username,omitempty"`
+
+	// Password is a reference to a secret key containing the password.
+	//
+	// +optional
+	Password *corev1.SecretKeySelector `json:"password,omitempty"`
+
+	// RegistryConfigFile is a reference to a secret key containing
+	// a Docker config.json for OCI registry authentication.
+	//
+	// +optional
+	RegistryConfigFile *corev1.SecretKeySelector `json:"registryConfigFile,omitempty"`
+}
+
+// ChartMetadata contains display information for an application.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.logo) || !has(self.logoFrom)",message="logo and logoFrom are mutually exclusive"
+type ChartMetadata struct {
+	// AppName is the name used for the ApplicationDefinition metadata.name.
+	// If not specified, chartName is used.
+	//
+	// +optional
+	AppName string `json:"appName,omitempty"`
+
+	// DisplayName is a human-readable name for the application.
+	// +kubebuilder:validation:MinLength=1
+	DisplayName string `json:"displayName"`
+
+	// Description provides a brief description of the application.
+	//
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// DocumentationURL is a link to the application's documentation.
+	//
+	// +optional
+	DocumentationURL string `json:"documentationURL,omitempty"`
+
+	// SourceURL is a link to the application's source code repository.
+	//
+	// +optional
+	SourceURL string `json:"sourceURL,omitempty"`
+
+	// Logo is a base64-encoded image for the application logo.
+	// Mutually exclusive with logoFrom.
+	//
+	// +optional
+	Logo string `json:"logo,omitempty"`
+
+	// LogoFrom references a logo stored outside of the catalog, which keeps large
+	// images out of the ApplicationCatalog. The logo is inlined into the generated
+	// ApplicationDefinition. Mutually exclusive with logo.
+	//
+	// +optional
+	LogoFrom *LogoSource `json:"logoFrom,omitempty"`
+
+	// LogoFormat specifies the format of the logo image.
+	// If not set, the format is detected from the image.
+	//
+	// +kubebuilder:validation:Enum=svg+xml;png
+	LogoFormat string `json:"logoFormat,omitempty"`
+}
+
+// LogoSource references a logo image. Exactly one of the fields must be set.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.configMapKeyRef) != has(self.bundled)",message="exactly one of configMapKeyRef and bundled must be set"
+type LogoSource struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap in the namespace of the application
+	// catalog manager. The key should be stored in binaryData, SVG images may also be
+	// stored in data.
+	//
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// Bundled is the ID of a logo bundled with the application catalog manager,
+	// which is the name of the default application, e.g. "cert-manager".
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=63
+	Bundled string `json:"bundled,omitempty"`
+}
+
+// ChartVersion defines a specific version of a Helm chart.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.defaultValuesBlock) || !has(self.defaultValuesPatch)",message="defaultValuesBlock and defaultValuesPatch are mutually exclusive"
+type ChartVersion struct {
+	// ChartVersion is the semantic version of the Helm chart (e.g., "4.7.1", "v1.16.0").
+	// This corresponds to the chart version in Chart.yaml.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	ChartVersion string `json:"chartVersion"`
+
+	// AppVersion is the version of the application contained in the chart.
+	// This maps to ApplicationDefinition.spec.versions[].version.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	AppVersion string `json:"appVersion"`
+
+	// RepositorySettings allows overriding the repository URL for this specific version.
+	// Takes precedence over chart-level and global repository settings.
+	//
+	// +optional
+	RepositorySettings *RepositorySettings `json:"repositorySettings,omitempty"`
+
+	// DefaultValuesBlock replaces the chart-level defaultValuesBlock for this version.
+	// Mutually exclusive with defaultValuesPatch.
+	//
+	// KKP only supports default values for the whole ApplicationDefinition, so the
+	// values of versions with an override are published in the annotation
+	// "default-values.applicationcatalog.k8c.io/<appVersion>" of the ApplicationDefinition.
+	// The resolved values of all versions of a chart may not exceed 224KiB combined.
+	//
+	// +optional
+	DefaultValuesBlock string `json:"defaultValuesBlock,omitempty"`
+
+	// DefaultValuesPatch is deep-merged on top of the chart-level defaultValuesBlock
+	// for this version. Maps are merged, all other values are replaced and keys set
+	// to null are removed. Comments are preserved.
+	// Mutually exclusive with defaultValuesBlock.
+	//
+	// +optional
+	DefaultValuesPatch string `json:"defaultValuesPatch,omitempty"`
+
+	// ValuesSchema replaces the chart-level valuesSchema for this version.
+	//
+	// +optional
+	ValuesSchema *ValuesSchema `json:"valuesSchema,omitempty"`
+}
+
+// ChartConfig defines the configuration for a single Helm chart
+// that will be converted to an ApplicationDefinition.
+type ChartConfig struct {
+	// ChartName is the name of the Helm chart in the repository.
+	// This is used as the chart name when pulling from the repository.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	ChartName string `json:"chartName"`
+
+	// Metadata contains display information for the application.
+	// If metadata.appName is not specified, chartName is used for ApplicationDefinition name.
+	Metadata *ChartMetadata `json:"metadata,omitempty"`
+
+	// RepositorySettings allows overriding the repository URL for this chart.
+	// Takes precedence over global repository settings.
+	//
+	// +optional
+	RepositorySettings *RepositorySettings `json:"repositorySettings,omitempty"`
+
+	// DefaultValuesBlock contains the default Helm values for this application.
+	// This is a YAML string that preserves comments.
+	//
+	// +optional
+	DefaultValuesBlock string `json:"defaultValuesBlock,omitempty"`
+
+	// DefaultValuesFrom references ConfigMap or Secret keys holding default Helm values
+	// for this application. The referenced objects must exist in the namespace of the
+	// application catalog manager. The values end up in the ApplicationDefinition, which
+	// is readable by all users, so Secrets are only used if they are labeled with
+	// "applicationcatalog.k8c.io/values-source: true".
+	// The values are deep-merged in the given order, followed by the defaultValuesBlock,
+	// so later entries take precedence. Maps are merged, all other values are replaced
+	// and keys set to null are removed. Comments are preserved.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	DefaultValuesFrom []ValuesReference `json:"defaultValuesFrom,omitempty"`
+
+	// DefaultDeployOptions holds the settings specific to the templating method
+	// used to deploy the application. These are propagated to the generated
+	// ApplicationDefinition.
+	//
+	// +optional
+	DefaultDeployOptions *DeployOptions `json:"defaultDeployOptions,omitempty"`
+
+	// DefaultNamespace is the namespace the application is installed into by default.
+	// It is propagated to the generated ApplicationDefinition.
+	//
+	// +optional
+	DefaultNamespace *AppNamespaceSpec `json:"defaultNamespace,omitempty"`
+
+	// ImageRegistryKeys lists the keys of the chart values that hold the registry of
+	// an image. They are used to apply the imageRegistryRewrite of the catalog.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:rule="self.all(k, self.exists_one(l, l.path == k.path))",message="image registry key paths must be unique"
+	ImageRegistryKeys []ImageRegistryKey `json:"imageRegistryKeys,omitempty"`
+
+	// ValuesSchema is the JSON schema the default values of every version are validated
+	// against, like Helm validates the values of an installation against the
+	// values.schema.json of the chart. Individual versions can override it.
+	//
+	// +optional
+	ValuesSchema *ValuesSchema `json:"valuesSchema,omitempty"`
+
+	// SharedOwnership allows other catalogs to contribute versions to the ApplicationDefinition
+	// of this chart. It takes effect only if all contributing catalogs set it, and the versions
+	// of the catalogs must not overlap. The catalog which created the ApplicationDefinition
+	// owns it and provides everything but the versions of the other catalogs, like the
+	// metadata and the default values. If it removes the chart, the ownership is handed over
+	// to the next contributing catalog. Versions of removed contributions are kept.
+	//
+	// +optional
+	SharedOwnership bool `json:"sharedOwnership,omitempty"`
+
+	// ChartVersions lists the available versions of this chart.
+	// Both chartVersion and appVersion must be unique within the list.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:XValidation:rule="self.all(v, self.exists_one(w, w.chartVersion == v.chartVersion))",message="chart versions must be unique"
+	// +kubebuilder:validation:XValidation:rule="self.all(v, self.exists_one(w, w.appVersion == v.appVersion))",message="app versions must be unique"
+	ChartVersions []ChartVersion `json:"chartVersions"`
+}
+
+// ImageRegistryKey describes a key of the chart values that holds the registry of an image.
+type ImageRegistryKey struct {
+	// Path is the dot-separated path of the key in the chart values,
+	// e.g. "controller.image.registry".
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Path string `json:"path"`
+
+	// Registry is the registry the chart uses for this image by default,
+	// e.g. "registry.k8s.io". It is looked up in the imageRegistryRewrite of the catalog.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Registry string `json:"registry"`
+
+	// Repository is set if the key holds the full image repository instead of only
+	// the registry, e.g. "jetstack/cert-manager-controller" for a key defaulting to
+	// "quay.io/jetstack/cert-manager-controller". The rewritten value is then the
+	// rewritten registry followed by the repository.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	Repository string `json:"repository,omitempty"`
+}
+
+// ValuesSchema defines where the JSON schema of the chart values is taken from.
+// Exactly one of the fields must be set.
+//
+// +kubebuilder:validation:XValidation:rule="[has(self.inline), has(self.configMapKeyRef), has(self.fromChart) && self.fromChart].exists_one(x, x)",message="exactly one of inline, configMapKeyRef and fromChart must be set"
+type ValuesSchema struct {
+	// Inline is the JSON schema, written in JSON or YAML.
+	//
+	// +optional
+	Inline string `json:"inline,omitempty"`
+
+	// ConfigMapKeyRef selects a key of a ConfigMap holding the JSON schema in the
+	// namespace of the application catalog manager.
+	//
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// FromChart reads the values.schema.json from the chart archive of every version.
+	// Versions whose chart has no schema are not validated. Chart archives are fetched in
+	// the background, versions whose archive is not fetched yet are only validated by
+	// later updates of the catalog, a warning is returned until then.
+	//
+	// +optional
+	FromChart bool `json:"fromChart,omitempty"`
+}
+
+// ValuesReferenceKind is the kind of object a ValuesReference points to.
+//
+// +kubebuilder:validation:Enum=ConfigMap;Secret
+type ValuesReferenceKind string
+
+const (
+	ValuesReferenceKindConfigMap ValuesReferenceKind = "ConfigMap"
+	ValuesReferenceKindSecret    ValuesReferenceKind = "Secret"
+)
+
+// ValuesReference references a key of a ConfigMap or Secret holding Helm values.
+type ValuesReference struct {
+	// Kind is the kind of the referenced object.
+	Kind ValuesReferenceKind `json:"kind"`
+
+	// Name is the name of the referenced object.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Key is the key in the referenced object that holds the values.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Key string `json:"key"`
+
+	// Optional makes the controller ignore the reference if the object or
+	// the key does not exist. By default, a missing reference is an error.
+	//
+	// +optional
+	Optional bool `json:"optional,omitempty"`
+}
+
+// AppNamespaceSpec describes the namespace an application is installed into.
+type AppNamespaceSpec struct {
+	// Name is the namespace to deploy the application into.
+	// Must be a valid lowercase RFC 1123 label.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	// Create defines whether the namespace should be created if it does not exist.
+	// Defaults to true.
+	//
+	// +kubebuilder:default=true
+	// +optional
+	Create *bool `json:"create,omitempty"`
+
+	// Labels are added to the namespace.
+	//
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are added to the namespace.
+	//
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// DeployOptions holds the settings specific to the templating method
+// used to deploy the application.
+type DeployOptions struct {
+	// Helm holds deployment settings when the templating method is Helm.
+	//
+	// +optional
+	Helm *HelmDeployOptions `json:"helm,omitempty"`
+}
+
+// HelmDeployOptions holds deployment settings when the templating method is Helm.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.timeout) || (has(self.wait) && self.wait)",message="timeout requires wait to be enabled"
+// +kubebuilder:validation:XValidation:rule="!has(self.atomic) || !self.atomic || (has(self.wait) && self.wait)",message="atomic requires wait to be enabled"
+type HelmDeployOptions struct {
+	// Wait corresponds to the --wait flag on Helm CLI.
+	// If set, will wait until all Pods, PVCs, Services, and minimum number of Pods
+	// of a Deployment, StatefulSet, or ReplicaSet are in a ready state before
+	// marking the release as successful.
+	//
+	// +optional
+	Wait bool `json:"wait,omitempty"`
+
+	// Timeout corresponds to the --timeout flag on Helm CLI.
+	// It is the time to wait for any individual Kubernetes operation and
+	// requires wait to be enabled.
+	//
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Atomic corresponds to the --atomic flag on Helm CLI.
+	// If set, a failed installation is deleted and a failed upgrade is rolled back.
+	// Requires wait to be enabled.
+	//
+	// +optional
+	Atomic bool `json:"atomic,omitempty"`
+
+	// EnableDNS corresponds to the --enable-dns flag on Helm CLI.
+	// If set, DNS lookups are enabled when rendering templates. Make sure the chart
+	// does not use the getHostByName template function to disclose information
+	// (see CVE-2023-25165).
+	//
+	// +optional
+	EnableDNS bool `json:"enableDNS,omitempty"`
+}
@@ -0,0 +1,563 @@
+//go:build !ignore_autogenerated
+
+/*
+Copyright  The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppNamespaceSpec) DeepCopyInto(out *AppNamespaceSpec) {
+	*out = *in
+	if in.Create != nil {
+		in, out := &in.Create, &out.Create
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppNamespaceSpec.
+func (in *AppNamespaceSpec) DeepCopy() *AppNamespaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AppNamespaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationCatalog) DeepCopyInto(out *ApplicationCatalog) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationCatalog.
+func (in *ApplicationCatalog) DeepCopy() *ApplicationCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationCatalog) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationCatalogList) DeepCopyInto(out *ApplicationCatalogList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ApplicationCatalog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationCatalogList.
+func (in *ApplicationCatalogList) DeepCopy() *ApplicationCatalogList {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationCatalogList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ApplicationCatalogList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationCatalogSpec) DeepCopyInto(out *ApplicationCatalogSpec) {
+	*out = *in
+	if in.Helm != nil {
+		in, out := &in.Helm, &out.Helm
+		*out = new(HelmSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Imports != nil {
+		in, out := &in.Imports, &out.Imports
+		*out = make([]CatalogImport, len(*in))
+		copy(*out, *in)
+	}
+	if in.Vars != nil {
+		in, out := &in.Vars, &out.Vars
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.VarsFrom != nil {
+		in, out := &in.VarsFrom, &out.VarsFrom
+		*out = make([]VarsReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationCatalogSpec.
+func (in *ApplicationCatalogSpec) DeepCopy() *ApplicationCatalogSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationCatalogSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationCatalogStatus) DeepCopyInto(out *ApplicationCatalogStatus) {
+	*out = *in
+	if in.UnresolvedVariables != nil {
+		in, out := &in.UnresolvedVariables, &out.UnresolvedVariables
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DisplacedCharts != nil {
+		in, out := &in.DisplacedCharts, &out.DisplacedCharts
+		*out = make([]DisplacedChart, len(*in))
+		copy(*out, *in)
+	}
+	if in.Seeds != nil {
+		in, out := &in.Seeds, &out.Seeds
+		*out = make([]SeedSyncStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationCatalogStatus.
+func (in *ApplicationCatalogStatus) DeepCopy() *ApplicationCatalogStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationCatalogStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogImport) DeepCopyInto(out *CatalogImport) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogImport.
+func (in *CatalogImport) DeepCopy() *CatalogImport {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChartConfig) DeepCopyInto(out *ChartConfig) {
+	*out = *in
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(ChartMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepositorySettings != nil {
+		in, out := &in.RepositorySettings, &out.RepositorySettings
+		*out = new(RepositorySettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultValuesFrom != nil {
+		in, out := &in.DefaultValuesFrom, &out.DefaultValuesFrom
+		*out = make([]ValuesReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.DefaultDeployOptions != nil {
+		in, out := &in.DefaultDeployOptions, &out.DefaultDeployOptions
+		*out = new(DeployOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultNamespace != nil {
+		in, out := &in.DefaultNamespace, &out.DefaultNamespace
+		*out = new(AppNamespaceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageRegistryKeys != nil {
+		in, out := &in.ImageRegistryKeys, &out.ImageRegistryKeys
+		*out = make([]ImageRegistryKey, len(*in))
+		copy(*out, *in)
+	}
+	if in.ValuesSchema != nil {
+		in, out := &in.ValuesSchema, &out.ValuesSchema
+		*out = new(ValuesSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChartVersions != nil {
+		in, out := &in.ChartVersions, &out.ChartVersions
+		*out = make([]ChartVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartConfig.
+func (in *ChartConfig) DeepCopy() *ChartConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ChartConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChartMetadata) DeepCopyInto(out *ChartMetadata) {
+	*out = *in
+	if in.LogoFrom != nil {
+		in, out := &in.LogoFrom, &out.LogoFrom
+		*out = new(LogoSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartMetadata.
+func (in *ChartMetadata) DeepCopy() *ChartMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(ChartMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChartVersion) DeepCopyInto(out *ChartVersion) {
+	*out = *in
+	if in.RepositorySettings != nil {
+		in, out := &in.RepositorySettings, &out.RepositorySettings
+		*out = new(RepositorySettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ValuesSchema != nil {
+		in, out := &in.ValuesSchema, &out.ValuesSchema
+		*out = new(ValuesSchema)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartVersion.
+func (in *ChartVersion) DeepCopy() *ChartVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(ChartVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployOptions) DeepCopyInto(out *DeployOptions) {
+	*out = *in
+	if in.Helm != nil {
+		in, out := &in.Helm, &out.Helm
+		*out = new(HelmDeployOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployOptions.
+func (in *DeployOptions) DeepCopy() *DeployOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DisplacedChart) DeepCopyInto(out *DisplacedChart) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DisplacedChart.
+func (in *DisplacedChart) DeepCopy() *DisplacedChart {
+	if in == nil {
+		return nil
+	}
+	out := new(DisplacedChart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmDeployOptions) DeepCopyInto(out *HelmDeployOptions) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmDeployOptions.
+func (in *HelmDeployOptions) DeepCopy() *HelmDeployOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmDeployOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmSpec) DeepCopyInto(out *HelmSpec) {
+	*out = *in
+	if in.RepositorySettings != nil {
+		in, out := &in.RepositorySettings, &out.RepositorySettings
+		*out = new(RepositorySettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Charts != nil {
+		in, out := &in.Charts, &out.Charts
+		*out = make([]ChartConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IncludedDefaults != nil {
+		in, out := &in.IncludedDefaults, &out.IncludedDefaults
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageRegistryRewrite != nil {
+		in, out := &in.ImageRegistryRewrite, &out.ImageRegistryRewrite
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmSpec.
+func (in *HelmSpec) DeepCopy() *HelmSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageRegistryKey) DeepCopyInto(out *ImageRegistryKey) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageRegistryKey.
+func (in *ImageRegistryKey) DeepCopy() *ImageRegistryKey {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageRegistryKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogoSource) DeepCopyInto(out *LogoSource) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogoSource.
+func (in *LogoSource) DeepCopy() *LogoSource {
+	if in == nil {
+		return nil
+	}
+	out := new(LogoSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryCredentials) DeepCopyInto(out *RepositoryCredentials) {
+	*out = *in
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RegistryConfigFile != nil {
+		in, out := &in.RegistryConfigFile, &out.RegistryConfigFile
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryCredentials.
+func (in *RepositoryCredentials) DeepCopy() *RepositoryCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositorySettings) DeepCopyInto(out *RepositorySettings) {
+	*out = *in
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(RepositoryCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositorySettings.
+func (in *RepositorySettings) DeepCopy() *RepositorySettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositorySettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSyncStatus) DeepCopyInto(out *SeedSyncStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSyncStatus.
+func (in *SeedSyncStatus) DeepCopy() *SeedSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValuesReference) DeepCopyInto(out *ValuesReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValuesReference.
+func (in *ValuesReference) DeepCopy() *ValuesReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ValuesReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValuesSchema) DeepCopyInto(out *ValuesSchema) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(corev1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValuesSchema.
+func (in *ValuesSchema) DeepCopy() *ValuesSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(ValuesSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VarsReference) DeepCopyInto(out *VarsReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VarsReference.
+func (in *VarsReference) DeepCopy() *VarsReference {
+	if in == nil {
+		return nil
+	}
+	out := new(VarsReference)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -33,9 +33,10 @@ import (
 //
 // The returned charts are sorted by name and include all available
 // versions for each chart. The chart definitions include:
-// - Chart name and metadata (displayName, description, documentation URLs, logo)
+// - Chart name and metadata (displayName, description, documentation URLs, bundled logo ID)
 // - Available chart versions with AppVersion mapping
 // - Default Helm values for each chart
+// - Image registry keys used to rewrite the image registries for mirrors
 // - Repository settings (if overridden from default)
 //
 // This function does not require any cluster access or Kubernetes
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ApplicationCatalogSourceSpecApplyConfiguration represents a declarative configuration of the ApplicationCatalogSourceSpec type for use
+// with apply.
+type ApplicationCatalogSourceSpecApplyConfiguration struct {
+	Interval     *v1.Duration                             `json:"interval,omitempty"`
+	HTTP         *HTTPCatalogSourceApplyConfiguration     `json:"http,omitempty"`
+	Git          *GitCatalogSourceApplyConfiguration      `json:"git,omitempty"`
+	OCI          *OCICatalogSourceApplyConfiguration      `json:"oci,omitempty"`
+	Verification *SignatureVerificationApplyConfiguration `json:"verification,omitempty"`
+}
+
+// ApplicationCatalogSourceSpecApplyConfiguration constructs a declarative configuration of the ApplicationCatalogSourceSpec type for use with
+// apply.
+func ApplicationCatalogSourceSpec() *ApplicationCatalogSourceSpecApplyConfiguration {
+	return &ApplicationCatalogSourceSpecApplyConfiguration{}
+}
+
+// WithInterval sets the Interval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Interval field is set to the value of the last call.
+func (b *ApplicationCatalogSourceSpecApplyConfiguration) WithInterval(value v1.Duration) *ApplicationCatalogSourceSpecApplyConfiguration {
+	b.Interval = &value
+	return b
+}
+
+// WithHTTP sets the HTTP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the HTTP field is set to the value of the last call.
+func (b *ApplicationCatalogSourceSpecApplyConfiguration) WithHTTP(value *HTTPCatalogSourceApplyConfiguration) *ApplicationCatalogSourceSpecApplyConfiguration {
+	b.HTTP = value
+	return b
+}
+
+// WithGit sets the Git field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Git field is set to the value of the last call.
+func (b *ApplicationCatalogSourceSpecApplyConfiguration) WithGit(value *GitCatalogSourceApplyConfiguration) *ApplicationCatalogSourceSpecApplyConfiguration {
+	b.Git = value
+	return b
+}
+
+// WithOCI sets the OCI field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OCI field is set to the value of the last call.
+func (b *ApplicationCatalogSourceSpecApplyConfiguration) WithOCI(value *OCICatalogSourceApplyConfiguration) *ApplicationCatalogSourceSpecApplyConfiguration {
+	b.OCI = value
+	return b
+}
+
+// WithVerification sets the Verification field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Verification field is set to the value of the last call.
+func (b *ApplicationCatalogSourceSpecApplyConfiguration) WithVerification(value *SignatureVerificationApplyConfiguration) *ApplicationCatalogSourceSpecApplyConfiguration {
+	b.Verification = value
+	return b
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// ApplicationCatalogSourceStatusApplyConfiguration represents a declarative configuration of the ApplicationCatalogSourceStatus type for use
+// with apply.
+type ApplicationCatalogSourceStatusApplyConfiguration struct {
+	ObservedGeneration *int64                               `json:"observedGeneration,omitempty"`
+	Revision           *string                              `json:"revision,omitempty"`
+	Digest             *string                              `json:"digest,omitempty"`
+	LastFetchTime      *v1.Time                             `json:"lastFetchTime,omitempty"`
+	Catalogs           []string                             `json:"catalogs,omitempty"`
+	VerifiedSigners    []string                             `json:"verifiedSigners,omitempty"`
+	Conditions         []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// ApplicationCatalogSourceStatusApplyConfiguration constructs a declarative configuration of the ApplicationCatalogSourceStatus type for use with
+// apply.
+func ApplicationCatalogSourceStatus() *ApplicationCatalogSourceStatusApplyConfiguration {
+	return &ApplicationCatalogSourceStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *ApplicationCatalogSourceStatusApplyConfiguration) WithObservedGeneration(value int64) *ApplicationCatalogSourceStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithRevision sets the Revision field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Revision field is set to the value of the last call.
+func (b *ApplicationCatalogSourceStatusApplyConfiguration) WithRevision(value string) *ApplicationCatalogSourceStatusApplyConfiguration {
+	b.Revision = &value
+	return b
+}
+
+// WithDigest sets the Digest field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Digest field is set to the value of the last call.
+func (b *ApplicationCatalogSourceStatusApplyConfiguration) WithDigest(value string) *ApplicationCatalogSourceStatusApplyConfiguration {
+	b.Digest = &value
+	return b
+}
+
+// WithLastFetchTime sets the LastFetchTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastFetchTime field is set to the value of the last call.
+func (b *ApplicationCatalogSourceStatusApplyConfiguration) WithLastFetchTime(value v1.Time) *ApplicationCatalogSourceStatusApplyConfiguration {
+	b.LastFetchTime = &value
+	return b
+}
+
+// WithCatalogs adds the given value to the Catalogs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Catalogs field.
+func (b *ApplicationCatalogSourceStatusApplyConfiguration) WithCatalogs(values ...string) *ApplicationCatalogSourceStatusApplyConfiguration {
+	for i := range values {
+		b.Catalogs = append(b.Catalogs, values[i])
+	}
+	return b
+}
+
+// WithVerifiedSigners adds the given value to the VerifiedSigners field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VerifiedSigners field.
+func (b *ApplicationCatalogSourceStatusApplyConfiguration) WithVerifiedSigners(values ...string) *ApplicationCatalogSourceStatusApplyConfiguration {
+	for i := range values {
+		b.VerifiedSigners = append(b.VerifiedSigners, values[i])
+	}
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *ApplicationCatalogSourceStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *ApplicationCatalogSourceStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ApplicationCatalogSpecApplyConfiguration represents a declarative configuration of the ApplicationCatalogSpec type for use
+// with apply.
+type ApplicationCatalogSpecApplyConfiguration struct {
+	Helm                 *HelmSpecApplyConfiguration       `json:"helm,omitempty"`
+	Imports              []CatalogImportApplyConfiguration `json:"imports,omitempty"`
+	Vars                 map[string]string                 `json:"vars,omitempty"`
+	VarsFrom             []VarsReferenceApplyConfiguration `json:"varsFrom,omitempty"`
+	Paused               *bool                             `json:"paused,omitempty"`
+	RevisionHistoryLimit *int32                            `json:"revisionHistoryLimit,omitempty"`
+	Priority             *int32                            `json:"priority,omitempty"`
+}
+
+// ApplicationCatalogSpecApplyConfiguration constructs a declarative configuration of the ApplicationCatalogSpec type for use with
+// apply.
+func ApplicationCatalogSpec() *ApplicationCatalogSpecApplyConfiguration {
+	return &ApplicationCatalogSpecApplyConfiguration{}
+}
+
+// WithHelm sets the Helm field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Helm field is set to the value of the last call.
+func (b *ApplicationCatalogSpecApplyConfiguration) WithHelm(value *HelmSpecApplyConfiguration) *ApplicationCatalogSpecApplyConfiguration {
+	b.Helm = value
+	return b
+}
+
+// WithImports adds the given value to the Imports field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Imports field.
+func (b *ApplicationCatalogSpecApplyConfiguration) WithImports(values ...*CatalogImportApplyConfiguration) *ApplicationCatalogSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithImports")
+		}
+		b.Imports = append(b.Imports, *values[i])
+	}
+	return b
+}
+
+// WithVars puts the entries into the Vars field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Vars field,
+// overwriting an existing map entries in Vars field with the same key.
+func (b *ApplicationCatalogSpecApplyConfiguration) WithVars(entries map[string]string) *ApplicationCatalogSpecApplyConfiguration {
+	if b.Vars == nil && len(entries) > 0 {
+		b.Vars = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.Vars[k] = v
+	}
+	return b
+}
+
+// WithVarsFrom adds the given value to the VarsFrom field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VarsFrom field.
+func (b *ApplicationCatalogSpecApplyConfiguration) WithVarsFrom(values ...*VarsReferenceApplyConfiguration) *ApplicationCatalogSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVarsFrom")
+		}
+		b.VarsFrom = append(b.VarsFrom, *values[i])
+	}
+	return b
+}
+
+// WithPaused sets the Paused field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Paused field is set to the value of the last call.
+func (b *ApplicationCatalogSpecApplyConfiguration) WithPaused(value bool) *ApplicationCatalogSpecApplyConfiguration {
+	b.Paused = &value
+	return b
+}
+
+// WithRevisionHistoryLimit sets the RevisionHistoryLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RevisionHistoryLimit field is set to the value of the last call.
+func (b *ApplicationCatalogSpecApplyConfiguration) WithRevisionHistoryLimit(value int32) *ApplicationCatalogSpecApplyConfiguration {
+	b.RevisionHistoryLimit = &value
+	return b
+}
+
+// WithPriority sets the Priority field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Priority field is set to the value of the last call.
+func (b *ApplicationCatalogSpecApplyConfiguration) WithPriority(value int32) *ApplicationCatalogSpecApplyConfiguration {
+	b.Priority = &value
+	return b
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ChartMetadataApplyConfiguration represents a declarative configuration of the ChartMetadata type for use
+// with apply.
+type ChartMetadataApplyConfiguration struct {
+	AppName          *string                       `json:"appName,omitempty"`
+	DisplayName      *string                       `json:"displayName,omitempty"`
+	Description      *string                       `json:"description,omitempty"`
+	DocumentationURL *string                       `json:"documentationURL,omitempty"`
+	SourceURL        *string                       `json:"sourceURL,omitempty"`
+	Logo             *string                       `json:"logo,omitempty"`
+	LogoFrom         *LogoSourceApplyConfiguration `json:"logoFrom,omitempty"`
+	LogoFormat       *string                       `json:"logoFormat,omitempty"`
+}
+
+// ChartMetadataApplyConfiguration constructs a declarative configuration of the ChartMetadata type for use with
+// apply.
+func ChartMetadata() *ChartMetadataApplyConfiguration {
+	return &ChartMetadataApplyConfiguration{}
+}
+
+// WithAppName sets the AppName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AppName field is set to the value of the last call.
+func (b *ChartMetadataApplyConfiguration) WithAppName(value string) *ChartMetadataApplyConfiguration {
+	b.AppName = &value
+	return b
+}
+
+// WithDisplayName sets the DisplayName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DisplayName field is set to the value of the last call.
+func (b *ChartMetadataApplyConfiguration) WithDisplayName(value string) *ChartMetadataApplyConfiguration {
+	b.DisplayName = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *ChartMetadataApplyConfiguration) WithDescription(value string) *ChartMetadataApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithDocumentationURL sets the DocumentationURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DocumentationURL field is set to the value of the last call.
+func (b *ChartMetadataApplyConfiguration) WithDocumentationURL(value string) *ChartMetadataApplyConfiguration {
+	b.DocumentationURL = &value
+	return b
+}
+
+// WithSourceURL sets the SourceURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SourceURL field is set to the value of the last call.
+func (b *ChartMetadataApplyConfiguration) WithSourceURL(value string) *ChartMetadataApplyConfiguration {
+	b.SourceURL = &value
+	return b
+}
+
+// WithLogo sets the Logo field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Logo field is set to the value of the last call.
+func (b *ChartMetadataApplyConfiguration) WithLogo(value string) *ChartMetadataApplyConfiguration {
+	b.Logo = &value
+	return b
+}
+
+// WithLogoFrom sets the LogoFrom field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogoFrom field is set to the value of the last call.
+func (b *ChartMetadataApplyConfiguration) WithLogoFrom(value *LogoSourceApplyConfiguration) *ChartMetadataApplyConfiguration {
+	b.LogoFrom = value
+	return b
+}
+
+// WithLogoFormat sets the LogoFormat field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogoFormat field is set to the value of the last call.
+func (b *ChartMetadataApplyConfiguration) WithLogoFormat(value string) *ChartMetadataApplyConfiguration {
+	b.LogoFormat = &value
+	return b
+}
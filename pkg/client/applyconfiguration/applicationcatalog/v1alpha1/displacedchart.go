@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// DisplacedChartApplyConfiguration represents a declarative configuration of the DisplacedChart type for use
+// with apply.
+type DisplacedChartApplyConfiguration struct {
+	ChartName             *string `json:"chartName,omitempty"`
+	ApplicationDefinition *string `json:"applicationDefinition,omitempty"`
+	Catalog               *string `json:"catalog,omitempty"`
+}
+
+// DisplacedChartApplyConfiguration constructs a declarative configuration of the DisplacedChart type for use with
+// apply.
+func DisplacedChart() *DisplacedChartApplyConfiguration {
+	return &DisplacedChartApplyConfiguration{}
+}
+
+// WithChartName sets the ChartName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ChartName field is set to the value of the last call.
+func (b *DisplacedChartApplyConfiguration) WithChartName(value string) *DisplacedChartApplyConfiguration {
+	b.ChartName = &value
+	return b
+}
+
+// WithApplicationDefinition sets the ApplicationDefinition field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ApplicationDefinition field is set to the value of the last call.
+func (b *DisplacedChartApplyConfiguration) WithApplicationDefinition(value string) *DisplacedChartApplyConfiguration {
+	b.ApplicationDefinition = &value
+	return b
+}
+
+// WithCatalog sets the Catalog field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Catalog field is set to the value of the last call.
+func (b *DisplacedChartApplyConfiguration) WithCatalog(value string) *DisplacedChartApplyConfiguration {
+	b.Catalog = &value
+	return b
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// GitCatalogSourceApplyConfiguration represents a declarative configuration of the GitCatalogSource type for use
+// with apply.
+type GitCatalogSourceApplyConfiguration struct {
+	URL                   *string                                  `json:"url,omitempty"`
+	Ref                   *GitReferenceApplyConfiguration          `json:"ref,omitempty"`
+	Path                  *string                                  `json:"path,omitempty"`
+	InsecureSkipTLSVerify *bool                                    `json:"insecureSkipTLSVerify,omitempty"`
+	Credentials           *RepositoryCredentialsApplyConfiguration `json:"credentials,omitempty"`
+}
+
+// GitCatalogSourceApplyConfiguration constructs a declarative configuration of the GitCatalogSource type for use with
+// apply.
+func GitCatalogSource() *GitCatalogSourceApplyConfiguration {
+	return &GitCatalogSourceApplyConfiguration{}
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *GitCatalogSourceApplyConfiguration) WithURL(value string) *GitCatalogSourceApplyConfiguration {
+	b.URL = &value
+	return b
+}
+
+// WithRef sets the Ref field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Ref field is set to the value of the last call.
+func (b *GitCatalogSourceApplyConfiguration) WithRef(value *GitReferenceApplyConfiguration) *GitCatalogSourceApplyConfiguration {
+	b.Ref = value
+	return b
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *GitCatalogSourceApplyConfiguration) WithPath(value string) *GitCatalogSourceApplyConfiguration {
+	b.Path = &value
+	return b
+}
+
+// WithInsecureSkipTLSVerify sets the InsecureSkipTLSVerify field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InsecureSkipTLSVerify field is set to the value of the last call.
+func (b *GitCatalogSourceApplyConfiguration) WithInsecureSkipTLSVerify(value bool) *GitCatalogSourceApplyConfiguration {
+	b.InsecureSkipTLSVerify = &value
+	return b
+}
+
+// WithCredentials sets the Credentials field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Credentials field is set to the value of the last call.
+func (b *GitCatalogSourceApplyConfiguration) WithCredentials(value *RepositoryCredentialsApplyConfiguration) *GitCatalogSourceApplyConfiguration {
+	b.Credentials = value
+	return b
+}
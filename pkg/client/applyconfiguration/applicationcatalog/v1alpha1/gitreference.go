@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// GitReferenceApplyConfiguration represents a declarative configuration of the GitReference type for use
+// with apply.
+type GitReferenceApplyConfiguration struct {
+	Branch *string `json:"branch,omitempty"`
+	Tag    *string `json:"tag,omitempty"`
+	Commit *string `json:"commit,omitempty"`
+}
+
+// GitReferenceApplyConfiguration constructs a declarative configuration of the GitReference type for use with
+// apply.
+func GitReference() *GitReferenceApplyConfiguration {
+	return &GitReferenceApplyConfiguration{}
+}
+
+// WithBranch sets the Branch field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Branch field is set to the value of the last call.
+func (b *GitReferenceApplyConfiguration) WithBranch(value string) *GitReferenceApplyConfiguration {
+	b.Branch = &value
+	return b
+}
+
+// WithTag sets the Tag field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tag field is set to the value of the last call.
+func (b *GitReferenceApplyConfiguration) WithTag(value string) *GitReferenceApplyConfiguration {
+	b.Tag = &value
+	return b
+}
+
+// WithCommit sets the Commit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Commit field is set to the value of the last call.
+func (b *GitReferenceApplyConfiguration) WithCommit(value string) *GitReferenceApplyConfiguration {
+	b.Commit = &value
+	return b
+}
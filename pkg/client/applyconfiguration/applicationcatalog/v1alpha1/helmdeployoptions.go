@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HelmDeployOptionsApplyConfiguration represents a declarative configuration of the HelmDeployOptions type for use
+// with apply.
+type HelmDeployOptionsApplyConfiguration struct {
+	Wait      *bool        `json:"wait,omitempty"`
+	Timeout   *v1.Duration `json:"timeout,omitempty"`
+	Atomic    *bool        `json:"atomic,omitempty"`
+	EnableDNS *bool        `json:"enableDNS,omitempty"`
+}
+
+// HelmDeployOptionsApplyConfiguration constructs a declarative configuration of the HelmDeployOptions type for use with
+// apply.
+func HelmDeployOptions() *HelmDeployOptionsApplyConfiguration {
+	return &HelmDeployOptionsApplyConfiguration{}
+}
+
+// WithWait sets the Wait field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Wait field is set to the value of the last call.
+func (b *HelmDeployOptionsApplyConfiguration) WithWait(value bool) *HelmDeployOptionsApplyConfiguration {
+	b.Wait = &value
+	return b
+}
+
+// WithTimeout sets the Timeout field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Timeout field is set to the value of the last call.
+func (b *HelmDeployOptionsApplyConfiguration) WithTimeout(value v1.Duration) *HelmDeployOptionsApplyConfiguration {
+	b.Timeout = &value
+	return b
+}
+
+// WithAtomic sets the Atomic field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Atomic field is set to the value of the last call.
+func (b *HelmDeployOptionsApplyConfiguration) WithAtomic(value bool) *HelmDeployOptionsApplyConfiguration {
+	b.Atomic = &value
+	return b
+}
+
+// WithEnableDNS sets the EnableDNS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EnableDNS field is set to the value of the last call.
+func (b *HelmDeployOptionsApplyConfiguration) WithEnableDNS(value bool) *HelmDeployOptionsApplyConfiguration {
+	b.EnableDNS = &value
+	return b
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// HTTPCatalogSourceApplyConfiguration represents a declarative configuration of the HTTPCatalogSource type for use
+// with apply.
+type HTTPCatalogSourceApplyConfiguration struct {
+	URL                   *string                                  `json:"url,omitempty"`
+	InsecureSkipTLSVerify *bool                                    `json:"insecureSkipTLSVerify,omitempty"`
+	Credentials           *RepositoryCredentialsApplyConfiguration `json:"credentials,omitempty"`
+}
+
+// HTTPCatalogSourceApplyConfiguration constructs a declarative configuration of the HTTPCatalogSource type for use with
+// apply.
+func HTTPCatalogSource() *HTTPCatalogSourceApplyConfiguration {
+	return &HTTPCatalogSourceApplyConfiguration{}
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *HTTPCatalogSourceApplyConfiguration) WithURL(value string) *HTTPCatalogSourceApplyConfiguration {
+	b.URL = &value
+	return b
+}
+
+// WithInsecureSkipTLSVerify sets the InsecureSkipTLSVerify field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InsecureSkipTLSVerify field is set to the value of the last call.
+func (b *HTTPCatalogSourceApplyConfiguration) WithInsecureSkipTLSVerify(value bool) *HTTPCatalogSourceApplyConfiguration {
+	b.InsecureSkipTLSVerify = &value
+	return b
+}
+
+// WithCredentials sets the Credentials field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Credentials field is set to the value of the last call.
+func (b *HTTPCatalogSourceApplyConfiguration) WithCredentials(value *RepositoryCredentialsApplyConfiguration) *HTTPCatalogSourceApplyConfiguration {
+	b.Credentials = value
+	return b
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// OCICatalogSourceApplyConfiguration represents a declarative configuration of the OCICatalogSource type for use
+// with apply.
+type OCICatalogSourceApplyConfiguration struct {
+	URL                   *string                                  `json:"url,omitempty"`
+	Tag                   *string                                  `json:"tag,omitempty"`
+	Digest                *string                                  `json:"digest,omitempty"`
+	PlainHTTP             *bool                                    `json:"plainHTTP,omitempty"`
+	InsecureSkipTLSVerify *bool                                    `json:"insecureSkipTLSVerify,omitempty"`
+	Credentials           *RepositoryCredentialsApplyConfiguration `json:"credentials,omitempty"`
+}
+
+// OCICatalogSourceApplyConfiguration constructs a declarative configuration of the OCICatalogSource type for use with
+// apply.
+func OCICatalogSource() *OCICatalogSourceApplyConfiguration {
+	return &OCICatalogSourceApplyConfiguration{}
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *OCICatalogSourceApplyConfiguration) WithURL(value string) *OCICatalogSourceApplyConfiguration {
+	b.URL = &value
+	return b
+}
+
+// WithTag sets the Tag field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tag field is set to the value of the last call.
+func (b *OCICatalogSourceApplyConfiguration) WithTag(value string) *OCICatalogSourceApplyConfiguration {
+	b.Tag = &value
+	return b
+}
+
+// WithDigest sets the Digest field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Digest field is set to the value of the last call.
+func (b *OCICatalogSourceApplyConfiguration) WithDigest(value string) *OCICatalogSourceApplyConfiguration {
+	b.Digest = &value
+	return b
+}
+
+// WithPlainHTTP sets the PlainHTTP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PlainHTTP field is set to the value of the last call.
+func (b *OCICatalogSourceApplyConfiguration) WithPlainHTTP(value bool) *OCICatalogSourceApplyConfiguration {
+	b.PlainHTTP = &value
+	return b
+}
+
+// WithInsecureSkipTLSVerify sets the InsecureSkipTLSVerify field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InsecureSkipTLSVerify field is set to the value of the last call.
+func (b *OCICatalogSourceApplyConfiguration) WithInsecureSkipTLSVerify(value bool) *OCICatalogSourceApplyConfiguration {
+	b.InsecureSkipTLSVerify = &value
+	return b
+}
+
+// WithCredentials sets the Credentials field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Credentials field is set to the value of the last call.
+func (b *OCICatalogSourceApplyConfiguration) WithCredentials(value *RepositoryCredentialsApplyConfiguration) *OCICatalogSourceApplyConfiguration {
+	b.Credentials = value
+	return b
+}
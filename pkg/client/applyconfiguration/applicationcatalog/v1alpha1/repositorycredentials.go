@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// RepositoryCredentialsApplyConfiguration represents a declarative configuration of the RepositoryCredentials type for use
+// with apply.
+type RepositoryCredentialsApplyConfiguration struct {
+	Username           *v1.SecretKeySelector `json:"username,omitempty"`
+	Password           *v1.SecretKeySelector `json:"password,omitempty"`
+	RegistryConfigFile *v1.SecretKeySelector `json:"registryConfigFile,omitempty"`
+}
+
+// RepositoryCredentialsApplyConfiguration constructs a declarative configuration of the RepositoryCredentials type for use with
+// apply.
+func RepositoryCredentials() *RepositoryCredentialsApplyConfiguration {
+	return &RepositoryCredentialsApplyConfiguration{}
+}
+
+// WithUsername sets the Username field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Username field is set to the value of the last call.
+func (b *RepositoryCredentialsApplyConfiguration) WithUsername(value v1.SecretKeySelector) *RepositoryCredentialsApplyConfiguration {
+	b.Username = &value
+	return b
+}
+
+// WithPassword sets the Password field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Password field is set to the value of the last call.
+func (b *RepositoryCredentialsApplyConfiguration) WithPassword(value v1.SecretKeySelector) *RepositoryCredentialsApplyConfiguration {
+	b.Password = &value
+	return b
+}
+
+// WithRegistryConfigFile sets the RegistryConfigFile field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RegistryConfigFile field is set to the value of the last call.
+func (b *RepositoryCredentialsApplyConfiguration) WithRegistryConfigFile(value v1.SecretKeySelector) *RepositoryCredentialsApplyConfiguration {
+	b.RegistryConfigFile = &value
+	return b
+}
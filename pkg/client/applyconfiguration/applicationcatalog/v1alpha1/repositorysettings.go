@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// RepositorySettingsApplyConfiguration represents a declarative configuration of the RepositorySettings type for use
+// with apply.
+type RepositorySettingsApplyConfiguration struct {
+	BaseURL               *string                                  `json:"baseURL,omitempty"`
+	Credentials           *RepositoryCredentialsApplyConfiguration `json:"credentials,omitempty"`
+	InsecureSkipTLSVerify *bool                                    `json:"insecureSkipTLSVerify,omitempty"`
+	PlainHTTP             *bool                                    `json:"plainHTTP,omitempty"`
+}
+
+// RepositorySettingsApplyConfiguration constructs a declarative configuration of the RepositorySettings type for use with
+// apply.
+func RepositorySettings() *RepositorySettingsApplyConfiguration {
+	return &RepositorySettingsApplyConfiguration{}
+}
+
+// WithBaseURL sets the BaseURL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BaseURL field is set to the value of the last call.
+func (b *RepositorySettingsApplyConfiguration) WithBaseURL(value string) *RepositorySettingsApplyConfiguration {
+	b.BaseURL = &value
+	return b
+}
+
+// WithCredentials sets the Credentials field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Credentials field is set to the value of the last call.
+func (b *RepositorySettingsApplyConfiguration) WithCredentials(value *RepositoryCredentialsApplyConfiguration) *RepositorySettingsApplyConfiguration {
+	b.Credentials = value
+	return b
+}
+
+// WithInsecureSkipTLSVerify sets the InsecureSkipTLSVerify field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InsecureSkipTLSVerify field is set to the value of the last call.
+func (b *RepositorySettingsApplyConfiguration) WithInsecureSkipTLSVerify(value bool) *RepositorySettingsApplyConfiguration {
+	b.InsecureSkipTLSVerify = &value
+	return b
+}
+
+// WithPlainHTTP sets the PlainHTTP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PlainHTTP field is set to the value of the last call.
+func (b *RepositorySettingsApplyConfiguration) WithPlainHTTP(value bool) *RepositorySettingsApplyConfiguration {
+	b.PlainHTTP = &value
+	return b
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SeedSyncStatusApplyConfiguration represents a declarative configuration of the SeedSyncStatus type for use
+// with apply.
+type SeedSyncStatusApplyConfiguration struct {
+	Name               *string  `json:"name,omitempty"`
+	Synced             *bool    `json:"synced,omitempty"`
+	Message            *string  `json:"message,omitempty"`
+	LastTransitionTime *v1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// SeedSyncStatusApplyConfiguration constructs a declarative configuration of the SeedSyncStatus type for use with
+// apply.
+func SeedSyncStatus() *SeedSyncStatusApplyConfiguration {
+	return &SeedSyncStatusApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *SeedSyncStatusApplyConfiguration) WithName(value string) *SeedSyncStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithSynced sets the Synced field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Synced field is set to the value of the last call.
+func (b *SeedSyncStatusApplyConfiguration) WithSynced(value bool) *SeedSyncStatusApplyConfiguration {
+	b.Synced = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *SeedSyncStatusApplyConfiguration) WithMessage(value string) *SeedSyncStatusApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithLastTransitionTime sets the LastTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastTransitionTime field is set to the value of the last call.
+func (b *SeedSyncStatusApplyConfiguration) WithLastTransitionTime(value v1.Time) *SeedSyncStatusApplyConfiguration {
+	b.LastTransitionTime = &value
+	return b
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	applicationcatalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+)
+
+// SignatureVerificationApplyConfiguration represents a declarative configuration of the SignatureVerification type for use
+// with apply.
+type SignatureVerificationApplyConfiguration struct {
+	SecretName *string                                     `json:"secretName,omitempty"`
+	Policy     *applicationcatalogv1alpha1.SignaturePolicy `json:"policy,omitempty"`
+}
+
+// SignatureVerificationApplyConfiguration constructs a declarative configuration of the SignatureVerification type for use with
+// apply.
+func SignatureVerification() *SignatureVerificationApplyConfiguration {
+	return &SignatureVerificationApplyConfiguration{}
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *SignatureVerificationApplyConfiguration) WithSecretName(value string) *SignatureVerificationApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
+
+// WithPolicy sets the Policy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Policy field is set to the value of the last call.
+func (b *SignatureVerificationApplyConfiguration) WithPolicy(value applicationcatalogv1alpha1.SignaturePolicy) *SignatureVerificationApplyConfiguration {
+	b.Policy = &value
+	return b
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// ValuesSchemaApplyConfiguration represents a declarative configuration of the ValuesSchema type for use
+// with apply.
+type ValuesSchemaApplyConfiguration struct {
+	Inline          *string                  `json:"inline,omitempty"`
+	ConfigMapKeyRef *v1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+	FromChart       *bool                    `json:"fromChart,omitempty"`
+}
+
+// ValuesSchemaApplyConfiguration constructs a declarative configuration of the ValuesSchema type for use with
+// apply.
+func ValuesSchema() *ValuesSchemaApplyConfiguration {
+	return &ValuesSchemaApplyConfiguration{}
+}
+
+// WithInline sets the Inline field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Inline field is set to the value of the last call.
+func (b *ValuesSchemaApplyConfiguration) WithInline(value string) *ValuesSchemaApplyConfiguration {
+	b.Inline = &value
+	return b
+}
+
+// WithConfigMapKeyRef sets the ConfigMapKeyRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMapKeyRef field is set to the value of the last call.
+func (b *ValuesSchemaApplyConfiguration) WithConfigMapKeyRef(value v1.ConfigMapKeySelector) *ValuesSchemaApplyConfiguration {
+	b.ConfigMapKeyRef = &value
+	return b
+}
+
+// WithFromChart sets the FromChart field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FromChart field is set to the value of the last call.
+func (b *ValuesSchemaApplyConfiguration) WithFromChart(value bool) *ValuesSchemaApplyConfiguration {
+	b.FromChart = &value
+	return b
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// VarsReferenceApplyConfiguration represents a declarative configuration of the VarsReference type for use
+// with apply.
+type VarsReferenceApplyConfiguration struct {
+	Name     *string `json:"name,omitempty"`
+	Optional *bool   `json:"optional,omitempty"`
+}
+
+// VarsReferenceApplyConfiguration constructs a declarative configuration of the VarsReference type for use with
+// apply.
+func VarsReference() *VarsReferenceApplyConfiguration {
+	return &VarsReferenceApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *VarsReferenceApplyConfiguration) WithName(value string) *VarsReferenceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithOptional sets the Optional field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Optional field is set to the value of the last call.
+func (b *VarsReferenceApplyConfiguration) WithOptional(value bool) *VarsReferenceApplyConfiguration {
+	b.Optional = &value
+	return b
+}
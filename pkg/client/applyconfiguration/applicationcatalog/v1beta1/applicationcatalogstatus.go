@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// ApplicationCatalogStatusApplyConfiguration represents a declarative configuration of the ApplicationCatalogStatus type for use
+// with apply.
+type ApplicationCatalogStatusApplyConfiguration struct {
+	ObservedGeneration  *int64                             `json:"observedGeneration,omitempty"`
+	UnresolvedVariables []string                           `json:"unresolvedVariables,omitempty"`
+	DisplacedCharts     []DisplacedChartApplyConfiguration `json:"displacedCharts,omitempty"`
+	Seeds               []SeedSyncStatusApplyConfiguration `json:"seeds,omitempty"`
+	VerifiedSigner      *string                            `json:"verifiedSigner,omitempty"`
+	Conditions          []v1.ConditionApplyConfiguration   `json:"conditions,omitempty"`
+}
+
+// ApplicationCatalogStatusApplyConfiguration constructs a declarative configuration of the ApplicationCatalogStatus type for use with
+// apply.
+func ApplicationCatalogStatus() *ApplicationCatalogStatusApplyConfiguration {
+	return &ApplicationCatalogStatusApplyConfiguration{}
+}
+
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *ApplicationCatalogStatusApplyConfiguration) WithObservedGeneration(value int64) *ApplicationCatalogStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
+// WithUnresolvedVariables adds the given value to the UnresolvedVariables field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the UnresolvedVariables field.
+func (b *ApplicationCatalogStatusApplyConfiguration) WithUnresolvedVariables(values ...string) *ApplicationCatalogStatusApplyConfiguration {
+	for i := range values {
+		b.UnresolvedVariables = append(b.UnresolvedVariables, values[i])
+	}
+	return b
+}
+
+// WithDisplacedCharts adds the given value to the DisplacedCharts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DisplacedCharts field.
+func (b *ApplicationCatalogStatusApplyConfiguration) WithDisplacedCharts(values ...*DisplacedChartApplyConfiguration) *ApplicationCatalogStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDisplacedCharts")
+		}
+		b.DisplacedCharts = append(b.DisplacedCharts, *values[i])
+	}
+	return b
+}
+
+// WithSeeds adds the given value to the Seeds field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Seeds field.
+func (b *ApplicationCatalogStatusApplyConfiguration) WithSeeds(values ...*SeedSyncStatusApplyConfiguration) *ApplicationCatalogStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSeeds")
+		}
+		b.Seeds = append(b.Seeds, *values[i])
+	}
+	return b
+}
+
+// WithVerifiedSigner sets the VerifiedSigner field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VerifiedSigner field is set to the value of the last call.
+func (b *ApplicationCatalogStatusApplyConfiguration) WithVerifiedSigner(value string) *ApplicationCatalogStatusApplyConfiguration {
+	b.VerifiedSigner = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *ApplicationCatalogStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *ApplicationCatalogStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// ChartConfigApplyConfiguration represents a declarative configuration of the ChartConfig type for use
+// with apply.
+type ChartConfigApplyConfiguration struct {
+	ChartName            *string                               `json:"chartName,omitempty"`
+	Metadata             *ChartMetadataApplyConfiguration      `json:"metadata,omitempty"`
+	RepositorySettings   *RepositorySettingsApplyConfiguration `json:"repositorySettings,omitempty"`
+	DefaultValuesBlock   *string                               `json:"defaultValuesBlock,omitempty"`
+	DefaultValuesFrom    []ValuesReferenceApplyConfiguration   `json:"defaultValuesFrom,omitempty"`
+	DefaultDeployOptions *DeployOptionsApplyConfiguration      `json:"defaultDeployOptions,omitempty"`
+	DefaultNamespace     *AppNamespaceSpecApplyConfiguration   `json:"defaultNamespace,omitempty"`
+	ImageRegistryKeys    []ImageRegistryKeyApplyConfiguration  `json:"imageRegistryKeys,omitempty"`
+	ValuesSchema         *ValuesSchemaApplyConfiguration       `json:"valuesSchema,omitempty"`
+	SharedOwnership      *bool                                 `json:"sharedOwnership,omitempty"`
+	ChartVersions        []ChartVersionApplyConfiguration      `json:"chartVersions,omitempty"`
+}
+
+// ChartConfigApplyConfiguration constructs a declarative configuration of the ChartConfig type for use with
+// apply.
+func ChartConfig() *ChartConfigApplyConfiguration {
+	return &ChartConfigApplyConfiguration{}
+}
+
+// WithChartName sets the ChartName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ChartName field is set to the value of the last call.
+func (b *ChartConfigApplyConfiguration) WithChartName(value string) *ChartConfigApplyConfiguration {
+	b.ChartName = &value
+	return b
+}
+
+// WithMetadata sets the Metadata field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Metadata field is set to the value of the last call.
+func (b *ChartConfigApplyConfiguration) WithMetadata(value *ChartMetadataApplyConfiguration) *ChartConfigApplyConfiguration {
+	b.Metadata = value
+	return b
+}
+
+// WithRepositorySettings sets the RepositorySettings field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RepositorySettings field is set to the value of the last call.
+func (b *ChartConfigApplyConfiguration) WithRepositorySettings(value *RepositorySettingsApplyConfiguration) *ChartConfigApplyConfiguration {
+	b.RepositorySettings = value
+	return b
+}
+
+// WithDefaultValuesBlock sets the DefaultValuesBlock field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultValuesBlock field is set to the value of the last call.
+func (b *ChartConfigApplyConfiguration) WithDefaultValuesBlock(value string) *ChartConfigApplyConfiguration {
+	b.DefaultValuesBlock = &value
+	return b
+}
+
+// WithDefaultValuesFrom adds the given value to the DefaultValuesFrom field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DefaultValuesFrom field.
+func (b *ChartConfigApplyConfiguration) WithDefaultValuesFrom(values ...*ValuesReferenceApplyConfiguration) *ChartConfigApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithDefaultValuesFrom")
+		}
+		b.DefaultValuesFrom = append(b.DefaultValuesFrom, *values[i])
+	}
+	return b
+}
+
+// WithDefaultDeployOptions sets the DefaultDeployOptions field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultDeployOptions field is set to the value of the last call.
+func (b *ChartConfigApplyConfiguration) WithDefaultDeployOptions(value *DeployOptionsApplyConfiguration) *ChartConfigApplyConfiguration {
+	b.DefaultDeployOptions = value
+	return b
+}
+
+// WithDefaultNamespace sets the DefaultNamespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultNamespace field is set to the value of the last call.
+func (b *ChartConfigApplyConfiguration) WithDefaultNamespace(value *AppNamespaceSpecApplyConfiguration) *ChartConfigApplyConfiguration {
+	b.DefaultNamespace = value
+	return b
+}
+
+// WithImageRegistryKeys adds the given value to the ImageRegistryKeys field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ImageRegistryKeys field.
+func (b *ChartConfigApplyConfiguration) WithImageRegistryKeys(values ...*ImageRegistryKeyApplyConfiguration) *ChartConfigApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithImageRegistryKeys")
+		}
+		b.ImageRegistryKeys = append(b.ImageRegistryKeys, *values[i])
+	}
+	return b
+}
+
+// WithValuesSchema sets the ValuesSchema field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ValuesSchema field is set to the value of the last call.
+func (b *ChartConfigApplyConfiguration) WithValuesSchema(value *ValuesSchemaApplyConfiguration) *ChartConfigApplyConfiguration {
+	b.ValuesSchema = value
+	return b
+}
+
+// WithSharedOwnership sets the SharedOwnership field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SharedOwnership field is set to the value of the last call.
+func (b *ChartConfigApplyConfiguration) WithSharedOwnership(value bool) *ChartConfigApplyConfiguration {
+	b.SharedOwnership = &value
+	return b
+}
+
+// WithChartVersions adds the given value to the ChartVersions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ChartVersions field.
+func (b *ChartConfigApplyConfiguration) WithChartVersions(values ...*ChartVersionApplyConfiguration) *ChartConfigApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithChartVersions")
+		}
+		b.ChartVersions = append(b.ChartVersions, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// ChartVersionApplyConfiguration represents a declarative configuration of the ChartVersion type for use
+// with apply.
+type ChartVersionApplyConfiguration struct {
+	ChartVersion       *string                               `json:"chartVersion,omitempty"`
+	AppVersion         *string                               `json:"appVersion,omitempty"`
+	RepositorySettings *RepositorySettingsApplyConfiguration `json:"repositorySettings,omitempty"`
+	DefaultValuesBlock *string                               `json:"defaultValuesBlock,omitempty"`
+	DefaultValuesPatch *string                               `json:"defaultValuesPatch,omitempty"`
+	ValuesSchema       *ValuesSchemaApplyConfiguration       `json:"valuesSchema,omitempty"`
+}
+
+// ChartVersionApplyConfiguration constructs a declarative configuration of the ChartVersion type for use with
+// apply.
+func ChartVersion() *ChartVersionApplyConfiguration {
+	return &ChartVersionApplyConfiguration{}
+}
+
+// WithChartVersion sets the ChartVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ChartVersion field is set to the value of the last call.
+func (b *ChartVersionApplyConfiguration) WithChartVersion(value string) *ChartVersionApplyConfiguration {
+	b.ChartVersion = &value
+	return b
+}
+
+// WithAppVersion sets the AppVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AppVersion field is set to the value of the last call.
+func (b *ChartVersionApplyConfiguration) WithAppVersion(value string) *ChartVersionApplyConfiguration {
+	b.AppVersion = &value
+	return b
+}
+
+// WithRepositorySettings sets the RepositorySettings field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RepositorySettings field is set to the value of the last call.
+func (b *ChartVersionApplyConfiguration) WithRepositorySettings(value *RepositorySettingsApplyConfiguration) *ChartVersionApplyConfiguration {
+	b.RepositorySettings = value
+	return b
+}
+
+// WithDefaultValuesBlock sets the DefaultValuesBlock field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultValuesBlock field is set to the value of the last call.
+func (b *ChartVersionApplyConfiguration) WithDefaultValuesBlock(value string) *ChartVersionApplyConfiguration {
+	b.DefaultValuesBlock = &value
+	return b
+}
+
+// WithDefaultValuesPatch sets the DefaultValuesPatch field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultValuesPatch field is set to the value of the last call.
+func (b *ChartVersionApplyConfiguration) WithDefaultValuesPatch(value string) *ChartVersionApplyConfiguration {
+	b.DefaultValuesPatch = &value
+	return b
+}
+
+// WithValuesSchema sets the ValuesSchema field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ValuesSchema field is set to the value of the last call.
+func (b *ChartVersionApplyConfiguration) WithValuesSchema(value *ValuesSchemaApplyConfiguration) *ChartVersionApplyConfiguration {
+	b.ValuesSchema = value
+	return b
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// HelmSpecApplyConfiguration represents a declarative configuration of the HelmSpec type for use
+// with apply.
+type HelmSpecApplyConfiguration struct {
+	RepositorySettings   *RepositorySettingsApplyConfiguration `json:"repositorySettings,omitempty"`
+	Charts               []ChartConfigApplyConfiguration       `json:"charts,omitempty"`
+	IncludeDefaults      *bool                                 `json:"includeDefaults,omitempty"`
+	IncludedDefaults     []string                              `json:"includedDefaults,omitempty"`
+	SanitizeAppNames     *bool                                 `json:"sanitizeAppNames,omitempty"`
+	ImageRegistryRewrite map[string]string                     `json:"imageRegistryRewrite,omitempty"`
+}
+
+// HelmSpecApplyConfiguration constructs a declarative configuration of the HelmSpec type for use with
+// apply.
+func HelmSpec() *HelmSpecApplyConfiguration {
+	return &HelmSpecApplyConfiguration{}
+}
+
+// WithRepositorySettings sets the RepositorySettings field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RepositorySettings field is set to the value of the last call.
+func (b *HelmSpecApplyConfiguration) WithRepositorySettings(value *RepositorySettingsApplyConfiguration) *HelmSpecApplyConfiguration {
+	b.RepositorySettings = value
+	return b
+}
+
+// WithCharts adds the given value to the Charts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Charts field.
+func (b *HelmSpecApplyConfiguration) WithCharts(values ...*ChartConfigApplyConfiguration) *HelmSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithCharts")
+		}
+		b.Charts = append(b.Charts, *values[i])
+	}
+	return b
+}
+
+// WithIncludeDefaults sets the IncludeDefaults field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IncludeDefaults field is set to the value of the last call.
+func (b *HelmSpecApplyConfiguration) WithIncludeDefaults(value bool) *HelmSpecApplyConfiguration {
+	b.IncludeDefaults = &value
+	return b
+}
+
+// WithIncludedDefaults adds the given value to the IncludedDefaults field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the IncludedDefaults field.
+func (b *HelmSpecApplyConfiguration) WithIncludedDefaults(values ...string) *HelmSpecApplyConfiguration {
+	for i := range values {
+		b.IncludedDefaults = append(b.IncludedDefaults, values[i])
+	}
+	return b
+}
+
+// WithSanitizeAppNames sets the SanitizeAppNames field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SanitizeAppNames field is set to the value of the last call.
+func (b *HelmSpecApplyConfiguration) WithSanitizeAppNames(value bool) *HelmSpecApplyConfiguration {
+	b.SanitizeAppNames = &value
+	return b
+}
+
+// WithImageRegistryRewrite puts the entries into the ImageRegistryRewrite field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the ImageRegistryRewrite field,
+// overwriting an existing map entries in ImageRegistryRewrite field with the same key.
+func (b *HelmSpecApplyConfiguration) WithImageRegistryRewrite(entries map[string]string) *HelmSpecApplyConfiguration {
+	if b.ImageRegistryRewrite == nil && len(entries) > 0 {
+		b.ImageRegistryRewrite = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ImageRegistryRewrite[k] = v
+	}
+	return b
+}
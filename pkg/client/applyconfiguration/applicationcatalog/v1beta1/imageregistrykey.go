@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+// ImageRegistryKeyApplyConfiguration represents a declarative configuration of the ImageRegistryKey type for use
+// with apply.
+type ImageRegistryKeyApplyConfiguration struct {
+	Path       *string `json:"path,omitempty"`
+	Registry   *string `json:"registry,omitempty"`
+	Repository *string `json:"repository,omitempty"`
+}
+
+// ImageRegistryKeyApplyConfiguration constructs a declarative configuration of the ImageRegistryKey type for use with
+// apply.
+func ImageRegistryKey() *ImageRegistryKeyApplyConfiguration {
+	return &ImageRegistryKeyApplyConfiguration{}
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *ImageRegistryKeyApplyConfiguration) WithPath(value string) *ImageRegistryKeyApplyConfiguration {
+	b.Path = &value
+	return b
+}
+
+// WithRegistry sets the Registry field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Registry field is set to the value of the last call.
+func (b *ImageRegistryKeyApplyConfiguration) WithRegistry(value string) *ImageRegistryKeyApplyConfiguration {
+	b.Registry = &value
+	return b
+}
+
+// WithRepository sets the Repository field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Repository field is set to the value of the last call.
+func (b *ImageRegistryKeyApplyConfiguration) WithRepository(value string) *ImageRegistryKeyApplyConfiguration {
+	b.Repository = &value
+	return b
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// LogoSourceApplyConfiguration represents a declarative configuration of the LogoSource type for use
+// with apply.
+type LogoSourceApplyConfiguration struct {
+	ConfigMapKeyRef *v1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+	Bundled         *string                  `json:"bundled,omitempty"`
+}
+
+// LogoSourceApplyConfiguration constructs a declarative configuration of the LogoSource type for use with
+// apply.
+func LogoSource() *LogoSourceApplyConfiguration {
+	return &LogoSourceApplyConfiguration{}
+}
+
+// WithConfigMapKeyRef sets the ConfigMapKeyRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigMapKeyRef field is set to the value of the last call.
+func (b *LogoSourceApplyConfiguration) WithConfigMapKeyRef(value v1.ConfigMapKeySelector) *LogoSourceApplyConfiguration {
+	b.ConfigMapKeyRef = &value
+	return b
+}
+
+// WithBundled sets the Bundled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Bundled field is set to the value of the last call.
+func (b *LogoSourceApplyConfiguration) WithBundled(value string) *LogoSourceApplyConfiguration {
+	b.Bundled = &value
+	return b
+}
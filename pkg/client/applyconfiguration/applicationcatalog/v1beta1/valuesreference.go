@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	applicationcatalogv1beta1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1beta1"
+)
+
+// ValuesReferenceApplyConfiguration represents a declarative configuration of the ValuesReference type for use
+// with apply.
+type ValuesReferenceApplyConfiguration struct {
+	Kind     *applicationcatalogv1beta1.ValuesReferenceKind `json:"kind,omitempty"`
+	Name     *string                                        `json:"name,omitempty"`
+	Key      *string                                        `json:"key,omitempty"`
+	Optional *bool                                          `json:"optional,omitempty"`
+}
+
+// ValuesReferenceApplyConfiguration constructs a declarative configuration of the ValuesReference type for use with
+// apply.
+func ValuesReference() *ValuesReferenceApplyConfiguration {
+	return &ValuesReferenceApplyConfiguration{}
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *ValuesReferenceApplyConfiguration) WithKind(value applicationcatalogv1beta1.ValuesReferenceKind) *ValuesReferenceApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ValuesReferenceApplyConfiguration) WithName(value string) *ValuesReferenceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithKey sets the Key field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Key field is set to the value of the last call.
+func (b *ValuesReferenceApplyConfiguration) WithKey(value string) *ValuesReferenceApplyConfiguration {
+	b.Key = &value
+	return b
+}
+
+// WithOptional sets the Optional field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Optional field is set to the value of the last call.
+func (b *ValuesReferenceApplyConfiguration) WithOptional(value bool) *ValuesReferenceApplyConfiguration {
+	b.Optional = &value
+	return b
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package applyconfiguration
+
+import (
+	v1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	v1beta1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1beta1"
+	applicationcatalogv1alpha1 "k8c.io/application-catalog-manager/pkg/client/applyconfiguration/applicationcatalog/v1alpha1"
+	applicationcatalogv1beta1 "k8c.io/application-catalog-manager/pkg/client/applyconfiguration/applicationcatalog/v1beta1"
+	internal "k8c.io/application-catalog-manager/pkg/client/applyconfiguration/internal"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	managedfields "k8s.io/apimachinery/pkg/util/managedfields"
+)
+
+// ForKind returns an apply configuration type for the given GroupVersionKind, or nil if no
+// apply configuration type exists for the given GroupVersionKind.
+func ForKind(kind schema.GroupVersionKind) interface{} {
+	switch kind {
+	// Group=applicationcatalog.k8c.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("ApplicationCatalog"):
+		return &applicationcatalogv1alpha1.ApplicationCatalogApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ApplicationCatalogSource"):
+		return &applicationcatalogv1alpha1.ApplicationCatalogSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ApplicationCatalogSourceSpec"):
+		return &applicationcatalogv1alpha1.ApplicationCatalogSourceSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ApplicationCatalogSourceStatus"):
+		return &applicationcatalogv1alpha1.ApplicationCatalogSourceStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ApplicationCatalogSpec"):
+		return &applicationcatalogv1alpha1.ApplicationCatalogSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ApplicationCatalogStatus"):
+		return &applicationcatalogv1alpha1.ApplicationCatalogStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AppNamespaceSpec"):
+		return &applicationcatalogv1alpha1.AppNamespaceSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("CatalogImport"):
+		return &applicationcatalogv1alpha1.CatalogImportApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ChartConfig"):
+		return &applicationcatalogv1alpha1.ChartConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ChartMetadata"):
+		return &applicationcatalogv1alpha1.ChartMetadataApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ChartVersion"):
+		return &applicationcatalogv1alpha1.ChartVersionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DeployOptions"):
+		return &applicationcatalogv1alpha1.DeployOptionsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("DisplacedChart"):
+		return &applicationcatalogv1alpha1.DisplacedChartApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("GitCatalogSource"):
+		return &applicationcatalogv1alpha1.GitCatalogSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("GitReference"):
+		return &applicationcatalogv1alpha1.GitReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HelmDeployOptions"):
+		return &applicationcatalogv1alpha1.HelmDeployOptionsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HelmSpec"):
+		return &applicationcatalogv1alpha1.HelmSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HTTPCatalogSource"):
+		return &applicationcatalogv1alpha1.HTTPCatalogSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ImageRegistryKey"):
+		return &applicationcatalogv1alpha1.ImageRegistryKeyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LogoSource"):
+		return &applicationcatalogv1alpha1.LogoSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OCICatalogSource"):
+		return &applicationcatalogv1alpha1.OCICatalogSourceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RepositoryCredentials"):
+		return &applicationcatalogv1alpha1.RepositoryCredentialsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("RepositorySettings"):
+		return &applicationcatalogv1alpha1.RepositorySettingsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SeedSyncStatus"):
+		return &applicationcatalogv1alpha1.SeedSyncStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SignatureVerification"):
+		return &applicationcatalogv1alpha1.SignatureVerificationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ValuesReference"):
+		return &applicationcatalogv1alpha1.ValuesReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ValuesSchema"):
+		return &applicationcatalogv1alpha1.ValuesSchemaApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VarsReference"):
+		return &applicationcatalogv1alpha1.VarsReferenceApplyConfiguration{}
+
+		// Group=applicationcatalog.k8c.io, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithKind("ApplicationCatalog"):
+		return &applicationcatalogv1beta1.ApplicationCatalogApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ApplicationCatalogSpec"):
+		return &applicationcatalogv1beta1.ApplicationCatalogSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ApplicationCatalogStatus"):
+		return &applicationcatalogv1beta1.ApplicationCatalogStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("AppNamespaceSpec"):
+		return &applicationcatalogv1beta1.AppNamespaceSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("CatalogImport"):
+		return &applicationcatalogv1beta1.CatalogImportApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ChartConfig"):
+		return &applicationcatalogv1beta1.ChartConfigApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ChartMetadata"):
+		return &applicationcatalogv1beta1.ChartMetadataApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ChartVersion"):
+		return &applicationcatalogv1beta1.ChartVersionApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DeployOptions"):
+		return &applicationcatalogv1beta1.DeployOptionsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("DisplacedChart"):
+		return &applicationcatalogv1beta1.DisplacedChartApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HelmDeployOptions"):
+		return &applicationcatalogv1beta1.HelmDeployOptionsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("HelmSpec"):
+		return &applicationcatalogv1beta1.HelmSpecApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ImageRegistryKey"):
+		return &applicationcatalogv1beta1.ImageRegistryKeyApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("LogoSource"):
+		return &applicationcatalogv1beta1.LogoSourceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RepositoryCredentials"):
+		return &applicationcatalogv1beta1.RepositoryCredentialsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("RepositorySettings"):
+		return &applicationcatalogv1beta1.RepositorySettingsApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("SeedSyncStatus"):
+		return &applicationcatalogv1beta1.SeedSyncStatusApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ValuesReference"):
+		return &applicationcatalogv1beta1.ValuesReferenceApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("ValuesSchema"):
+		return &applicationcatalogv1beta1.ValuesSchemaApplyConfiguration{}
+	case v1beta1.SchemeGroupVersion.WithKind("VarsReference"):
+		return &applicationcatalogv1beta1.VarsReferenceApplyConfiguration{}
+
+	}
+	return nil
+}
+
+func NewTypeConverter(scheme *runtime.Scheme) managedfields.TypeConverter {
+	return managedfields.NewSchemeTypeConverter(scheme, internal.Parser())
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	applicationcatalogv1alpha1 "k8c.io/application-catalog-manager/pkg/client/applyconfiguration/applicationcatalog/v1alpha1"
+	typedapplicationcatalogv1alpha1 "k8c.io/application-catalog-manager/pkg/client/clientset/versioned/typed/applicationcatalog/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeApplicationCatalogSources implements ApplicationCatalogSourceInterface
+type fakeApplicationCatalogSources struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.ApplicationCatalogSource, *v1alpha1.ApplicationCatalogSourceList, *applicationcatalogv1alpha1.ApplicationCatalogSourceApplyConfiguration]
+	Fake *FakeApplicationcatalogV1alpha1
+}
+
+func newFakeApplicationCatalogSources(fake *FakeApplicationcatalogV1alpha1) typedapplicationcatalogv1alpha1.ApplicationCatalogSourceInterface {
+	return &fakeApplicationCatalogSources{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.ApplicationCatalogSource, *v1alpha1.ApplicationCatalogSourceList, *applicationcatalogv1alpha1.ApplicationCatalogSourceApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha1.SchemeGroupVersion.WithResource("applicationcatalogsources"),
+			v1alpha1.SchemeGroupVersion.WithKind("ApplicationCatalogSource"),
+			func() *v1alpha1.ApplicationCatalogSource { return &v1alpha1.ApplicationCatalogSource{} },
+			func() *v1alpha1.ApplicationCatalogSourceList { return &v1alpha1.ApplicationCatalogSourceList{} },
+			func(dst, src *v1alpha1.ApplicationCatalogSourceList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.ApplicationCatalogSourceList) []*v1alpha1.ApplicationCatalogSource {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.ApplicationCatalogSourceList, items []*v1alpha1.ApplicationCatalogSource) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
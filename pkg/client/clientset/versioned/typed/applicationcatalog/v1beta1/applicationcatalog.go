@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	context "context"
+
+	applicationcatalogv1beta1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1beta1"
+	applyconfigurationapplicationcatalogv1beta1 "k8c.io/application-catalog-manager/pkg/client/applyconfiguration/applicationcatalog/v1beta1"
+	scheme "k8c.io/application-catalog-manager/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// ApplicationCatalogsGetter has a method to return a ApplicationCatalogInterface.
+// A group's client should implement this interface.
+type ApplicationCatalogsGetter interface {
+	ApplicationCatalogs() ApplicationCatalogInterface
+}
+
+// ApplicationCatalogInterface has methods to work with ApplicationCatalog resources.
+type ApplicationCatalogInterface interface {
+	Create(ctx context.Context, applicationCatalog *applicationcatalogv1beta1.ApplicationCatalog, opts v1.CreateOptions) (*applicationcatalogv1beta1.ApplicationCatalog, error)
+	Update(ctx context.Context, applicationCatalog *applicationcatalogv1beta1.ApplicationCatalog, opts v1.UpdateOptions) (*applicationcatalogv1beta1.ApplicationCatalog, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, applicationCatalog *applicationcatalogv1beta1.ApplicationCatalog, opts v1.UpdateOptions) (*applicationcatalogv1beta1.ApplicationCatalog, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*applicationcatalogv1beta1.ApplicationCatalog, error)
+	List(ctx context.Context, opts v1.ListOptions) (*applicationcatalogv1beta1.ApplicationCatalogList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *applicationcatalogv1beta1.ApplicationCatalog, err error)
+	Apply(ctx context.Context, applicationCatalog *applyconfigurationapplicationcatalogv1beta1.ApplicationCatalogApplyConfiguration, opts v1.ApplyOptions) (result *applicationcatalogv1beta1.ApplicationCatalog, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, applicationCatalog *applyconfigurationapplicationcatalogv1beta1.ApplicationCatalogApplyConfiguration, opts v1.ApplyOptions) (result *applicationcatalogv1beta1.ApplicationCatalog, err error)
+	ApplicationCatalogExpansion
+}
+
+// applicationCatalogs implements ApplicationCatalogInterface
+type applicationCatalogs struct {
+	*gentype.ClientWithListAndApply[*applicationcatalogv1beta1.ApplicationCatalog, *applicationcatalogv1beta1.ApplicationCatalogList, *applyconfigurationapplicationcatalogv1beta1.ApplicationCatalogApplyConfiguration]
+}
+
+// newApplicationCatalogs returns a ApplicationCatalogs
+func newApplicationCatalogs(c *ApplicationcatalogV1beta1Client) *applicationCatalogs {
+	return &applicationCatalogs{
+		gentype.NewClientWithListAndApply[*applicationcatalogv1beta1.ApplicationCatalog, *applicationcatalogv1beta1.ApplicationCatalogList, *applyconfigurationapplicationcatalogv1beta1.ApplicationCatalogApplyConfiguration](
+			"applicationcatalogs",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *applicationcatalogv1beta1.ApplicationCatalog {
+				return &applicationcatalogv1beta1.ApplicationCatalog{}
+			},
+			func() *applicationcatalogv1beta1.ApplicationCatalogList {
+				return &applicationcatalogv1beta1.ApplicationCatalogList{}
+			},
+		),
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1beta1"
+	applicationcatalogv1beta1 "k8c.io/application-catalog-manager/pkg/client/applyconfiguration/applicationcatalog/v1beta1"
+	typedapplicationcatalogv1beta1 "k8c.io/application-catalog-manager/pkg/client/clientset/versioned/typed/applicationcatalog/v1beta1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeApplicationCatalogs implements ApplicationCatalogInterface
+type fakeApplicationCatalogs struct {
+	*gentype.FakeClientWithListAndApply[*v1beta1.ApplicationCatalog, *v1beta1.ApplicationCatalogList, *applicationcatalogv1beta1.ApplicationCatalogApplyConfiguration]
+	Fake *FakeApplicationcatalogV1beta1
+}
+
+func newFakeApplicationCatalogs(fake *FakeApplicationcatalogV1beta1) typedapplicationcatalogv1beta1.ApplicationCatalogInterface {
+	return &fakeApplicationCatalogs{
+		gentype.NewFakeClientWithListAndApply[*v1beta1.ApplicationCatalog, *v1beta1.ApplicationCatalogList, *applicationcatalogv1beta1.ApplicationCatalogApplyConfiguration](
+			fake.Fake,
+			"",
+			v1beta1.SchemeGroupVersion.WithResource("applicationcatalogs"),
+			v1beta1.SchemeGroupVersion.WithKind("ApplicationCatalog"),
+			func() *v1beta1.ApplicationCatalog { return &v1beta1.ApplicationCatalog{} },
+			func() *v1beta1.ApplicationCatalogList { return &v1beta1.ApplicationCatalogList{} },
+			func(dst, src *v1beta1.ApplicationCatalogList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta1.ApplicationCatalogList) []*v1beta1.ApplicationCatalog {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta1.ApplicationCatalogList, items []*v1beta1.ApplicationCatalog) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+	time "time"
+
+	apisapplicationcatalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	versioned "k8c.io/application-catalog-manager/pkg/client/clientset/versioned"
+	internalinterfaces "k8c.io/application-catalog-manager/pkg/client/informers/externalversions/internalinterfaces"
+	applicationcatalogv1alpha1 "k8c.io/application-catalog-manager/pkg/client/listers/applicationcatalog/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ApplicationCatalogSourceInformer provides access to a shared informer and lister for
+// ApplicationCatalogSources.
+type ApplicationCatalogSourceInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() applicationcatalogv1alpha1.ApplicationCatalogSourceLister
+}
+
+type applicationCatalogSourceInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewApplicationCatalogSourceInformer constructs a new informer for ApplicationCatalogSource type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewApplicationCatalogSourceInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredApplicationCatalogSourceInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredApplicationCatalogSourceInformer constructs a new informer for ApplicationCatalogSource type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredApplicationCatalogSourceInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ApplicationcatalogV1alpha1().ApplicationCatalogSources().List(context.Background(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ApplicationcatalogV1alpha1().ApplicationCatalogSources().Watch(context.Background(), options)
+			},
+			ListWithContextFunc: func(ctx context.Context, options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ApplicationcatalogV1alpha1().ApplicationCatalogSources().List(ctx, options)
+			},
+			WatchFuncWithContext: func(ctx context.Context, options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ApplicationcatalogV1alpha1().ApplicationCatalogSources().Watch(ctx, options)
+			},
+		},
+		&apisapplicationcatalogv1alpha1.ApplicationCatalogSource{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *applicationCatalogSourceInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredApplicationCatalogSourceInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *applicationCatalogSourceInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&apisapplicationcatalogv1alpha1.ApplicationCatalogSource{}, f.defaultInformer)
+}
+
+func (f *applicationCatalogSourceInformer) Lister() applicationcatalogv1alpha1.ApplicationCatalogSourceLister {
+	return applicationcatalogv1alpha1.NewApplicationCatalogSourceLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	applicationcatalogv1beta1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1beta1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ApplicationCatalogLister helps list ApplicationCatalogs.
+// All objects returned here must be treated as read-only.
+type ApplicationCatalogLister interface {
+	// List lists all ApplicationCatalogs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*applicationcatalogv1beta1.ApplicationCatalog, err error)
+	// Get retrieves the ApplicationCatalog from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*applicationcatalogv1beta1.ApplicationCatalog, error)
+	ApplicationCatalogListerExpansion
+}
+
+// applicationCatalogLister implements the ApplicationCatalogLister interface.
+type applicationCatalogLister struct {
+	listers.ResourceIndexer[*applicationcatalogv1beta1.ApplicationCatalog]
+}
+
+// NewApplicationCatalogLister returns a new ApplicationCatalogLister.
+func NewApplicationCatalogLister(indexer cache.Indexer) ApplicationCatalogLister {
+	return &applicationCatalogLister{listers.New[*applicationcatalogv1beta1.ApplicationCatalog](indexer, applicationcatalogv1beta1.Resource("applicationcatalog"))}
+}
@@ -0,0 +1,317 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envtest_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newCatalog(name string, helm *catalogv1alpha1.HelmSpec) *catalogv1alpha1.ApplicationCatalog {
+	return &catalogv1alpha1.ApplicationCatalog{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       catalogv1alpha1.ApplicationCatalogSpec{Helm: helm},
+	}
+}
+
+func newChart(name string, versions ...string) catalogv1alpha1.ChartConfig {
+	chart := catalogv1alpha1.ChartConfig{ChartName: name}
+	for _, v := range versions {
+		chart.ChartVersions = append(chart.ChartVersions, catalogv1alpha1.ChartVersion{ChartVersion: v, AppVersion: "v" + v})
+	}
+
+	return chart
+}
+
+func newCredentials() *catalogv1alpha1.RepositoryCredentials {
+	return &catalogv1alpha1.RepositoryCredentials{
+		Password: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "creds"},
+			Key:                  "password",
+		},
+	}
+}
+
+func TestApplicationCatalogCELValidation(t *testing.T) {
+	requireEnvtest(t)
+
+	tests := []struct {
+		name        string
+		helm        func() *catalogv1alpha1.HelmSpec
+		expectedErr string
+	}{
+		{
+			name: "valid catalog is accepted",
+			helm: func() *catalogv1alpha1.HelmSpec {
+				chart := newChart("nginx", "1.0.0", "1.1.0")
+				chart.RepositorySettings = &catalogv1alpha1.RepositorySettings{
+					BaseURL:     "oci://registry.example.com/charts",
+					Credentials: newCredentials(),
+				}
+				return &catalogv1alpha1.HelmSpec{
+					RepositorySettings: &catalogv1alpha1.RepositorySettings{BaseURL: "https://charts.example.com"},
+					Charts:             []catalogv1alpha1.ChartConfig{chart, newChart("redis", "2.0.0")},
+				}
+			},
+		},
+		{
+			name: "duplicate chart names are rejected",
+			helm: func() *catalogv1alpha1.HelmSpec {
+				return &catalogv1alpha1.HelmSpec{
+					Charts: []catalogv1alpha1.ChartConfig{newChart("nginx", "1.0.0"), newChart("nginx", "2.0.0")},
+				}
+			},
+			expectedErr: "chart names must be unique",
+		},
+		{
+			name: "duplicate chart versions are rejected",
+			helm: func() *catalogv1alpha1.HelmSpec {
+				chart := newChart("nginx", "1.0.0", "1.0.0")
+				chart.ChartVersions[1].AppVersion = "v1.0.1"
+				return &catalogv1alpha1.HelmSpec{Charts: []catalogv1alpha1.ChartConfig{chart}}
+			},
+			expectedErr: "chart versions must be unique",
+		},
+		{
+			name: "duplicate app versions are rejected",
+			helm: func() *catalogv1alpha1.HelmSpec {
+				chart := newChart("nginx", "1.0.0", "1.0.1")
+				chart.ChartVersions[1].AppVersion = chart.ChartVersions[0].AppVersion
+				return &catalogv1alpha1.HelmSpec{Charts: []catalogv1alpha1.ChartConfig{chart}}
+			},
+			expectedErr: "app versions must be unique",
+		},
+		{
+			name: "global credentials without baseURL are rejected",
+			helm: func() *catalogv1alpha1.HelmSpec {
+				return &catalogv1alpha1.HelmSpec{
+					RepositorySettings: &catalogv1alpha1.RepositorySettings{Credentials: newCredentials()},
+				}
+			},
+			expectedErr: "credentials are only used together with a baseURL",
+		},
+		{
+			name: "version credentials without baseURL are rejected",
+			helm: func() *catalogv1alpha1.HelmSpec {
+				chart := newChart("nginx", "1.0.0")
+				chart.ChartVersions[0].RepositorySettings = &catalogv1alpha1.RepositorySettings{Credentials: newCredentials()}
+				return &catalogv1alpha1.HelmSpec{Charts: []catalogv1alpha1.ChartConfig{chart}}
+			},
+			expectedErr: "credentials are only used together with a baseURL",
+		},
+		{
+			name: "unsupported scheme is rejected",
+			helm: func() *catalogv1alpha1.HelmSpec {
+				chart := newChart("nginx", "1.0.0")
+				chart.RepositorySettings = &catalogv1alpha1.RepositorySettings{BaseURL: "ftp://charts.example.com"}
+				return &catalogv1alpha1.HelmSpec{Charts: []catalogv1alpha1.ChartConfig{chart}}
+			},
+			expectedErr: "baseURL must be a valid URL",
+		},
+		{
+			name: "invalid URL is rejected",
+			helm: func() *catalogv1alpha1.HelmSpec {
+				return &catalogv1alpha1.HelmSpec{
+					RepositorySettings: &catalogv1alpha1.RepositorySettings{BaseURL: "charts.example.com"},
+				}
+			},
+			expectedErr: "baseURL must be a valid URL",
+		},
+		{
+			name: "plainHTTP without oci URL is rejected",
+			helm: func() *catalogv1alpha1.HelmSpec {
+				return &catalogv1alpha1.HelmSpec{
+					RepositorySettings: &catalogv1alpha1.RepositorySettings{BaseURL: "https://charts.example.com", PlainHTTP: true},
+				}
+			},
+			expectedErr: "plainHTTP is only supported together with an oci:// baseURL",
+		},
+		{
+			name: "insecureSkipTLSVerify without baseURL is rejected",
+			helm: func() *catalogv1alpha1.HelmSpec {
+				return &catalogv1alpha1.HelmSpec{
+					RepositorySettings: &catalogv1alpha1.RepositorySettings{InsecureSkipTLSVerify: true},
+				}
+			},
+			expectedErr: "insecureSkipTLSVerify is only used together with a baseURL",
+		},
+		{
+			name: "timeout without wait is rejected",
+			helm: func() *catalogv1alpha1.HelmSpec {
+				chart := newChart("nginx", "1.0.0")
+				chart.DefaultDeployOptions = &catalogv1alpha1.DeployOptions{
+					Helm: &catalogv1alpha1.HelmDeployOptions{Timeout: &metav1.Duration{Duration: 10 * time.Minute}},
+				}
+				return &catalogv1alpha1.HelmSpec{Charts: []catalogv1alpha1.ChartConfig{chart}}
+			},
+			expectedErr: "timeout requires wait to be enabled",
+		},
+		{
+			name: "atomic without wait is rejected",
+			helm: func() *catalogv1alpha1.HelmSpec {
+				chart := newChart("nginx", "1.0.0")
+				chart.DefaultDeployOptions = &catalogv1alpha1.DeployOptions{
+					Helm: &catalogv1alpha1.HelmDeployOptions{Atomic: true},
+				}
+				return &catalogv1alpha1.HelmSpec{Charts: []catalogv1alpha1.ChartConfig{chart}}
+			},
+			expectedErr: "atomic requires wait to be enabled",
+		},
+		{
+			name: "version defaultValuesBlock and defaultValuesPatch together are rejected",
+			helm: func() *catalogv1alpha1.HelmSpec {
+				chart := newChart("nginx", "1.0.0")
+				chart.ChartVersions[0].DefaultValuesBlock = "replicas: 1\n"
+				chart.ChartVersions[0].DefaultValuesPatch = "replicas: 2\n"
+				return &catalogv1alpha1.HelmSpec{Charts: []catalogv1alpha1.ChartConfig{chart}}
+			},
+			expectedErr: "defaultValuesBlock and defaultValuesPatch are mutually exclusive",
+		},
+		{
+			name: "unsupported defaultValuesFrom kind is rejected",
+			helm: func() *catalogv1alpha1.HelmSpec {
+				chart := newChart("nginx", "1.0.0")
+				chart.DefaultValuesFrom = []catalogv1alpha1.ValuesReference{{Kind: "Pod", Name: "nginx", Key: "values.yaml"}}
+				return &catalogv1alpha1.HelmSpec{Charts: []catalogv1alpha1.ChartConfig{chart}}
+			},
+			expectedErr: "supported values: \"ConfigMap\", \"Secret\"",
+		},
+		{
+			name: "logo and logoFrom together are rejected",
+			helm: func() *catalogv1alpha1.HelmSpec {
+				chart := newChart("nginx", "1.0.0")
+				chart.Metadata = &catalogv1alpha1.ChartMetadata{
+					Logo:     "iVBORw0KGgoAAAAAAAAAAA==",
+					LogoFrom: &catalogv1alpha1.LogoSource{Bundled: "nginx"},
+				}
+				return &catalogv1alpha1.HelmSpec{Charts: []catalogv1alpha1.ChartConfig{chart}}
+			},
+			expectedErr: "logo and logoFrom are mutually exclusive",
+		},
+		{
+			name: "empty logoFrom is rejected",
+			helm: func() *catalogv1alpha1.HelmSpec {
+				chart := newChart("nginx", "1.0.0")
+				chart.Metadata = &catalogv1alpha1.ChartMetadata{LogoFrom: &catalogv1alpha1.LogoSource{}}
+				return &catalogv1alpha1.HelmSpec{Charts: []catalogv1alpha1.ChartConfig{chart}}
+			},
+			expectedErr: "exactly one of configMapKeyRef and bundled must be set",
+		},
+		{
+			name: "duplicate image registry key paths are rejected",
+			helm: func() *catalogv1alpha1.HelmSpec {
+				chart := newChart("nginx", "1.0.0")
+				chart.ImageRegistryKeys = []catalogv1alpha1.ImageRegistryKey{
+					{Path: "image.registry", Registry: "docker.io"},
+					{Path: "image.registry", Registry: "quay.io"},
+				}
+				return &catalogv1alpha1.HelmSpec{Charts: []catalogv1alpha1.ChartConfig{chart}}
+			},
+			expectedErr: "image registry key paths must be unique",
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			catalog := newCatalog(fmt.Sprintf("cel-validation-%d", i), tc.helm())
+
+			err := testClient.Create(ctx, catalog)
+			if err == nil {
+				t.Cleanup(func() {
+					_ = testClient.Delete(ctx, catalog)
+				})
+			}
+
+			if tc.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("expected catalog to be accepted, got: %v", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q, got nil", tc.expectedErr)
+			}
+
+			if !strings.Contains(err.Error(), tc.expectedErr) {
+				t.Errorf("expected error containing %q, got: %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestApplicationCatalogSanitizeAppNamesIsImmutable(t *testing.T) {
+	requireEnvtest(t)
+
+	ctx := context.Background()
+
+	catalog := newCatalog("cel-immutable-sanitize", &catalogv1alpha1.HelmSpec{
+		SanitizeAppNames: true,
+		Charts:           []catalogv1alpha1.ChartConfig{newChart("nvidia/gpu-operator", "1.0.0")},
+	})
+	if err := testClient.Create(ctx, catalog); err != nil {
+		t.Fatalf("failed to create catalog: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = testClient.Delete(ctx, catalog)
+	})
+
+	catalog.Spec.Helm.Charts = append(catalog.Spec.Helm.Charts, newChart("redis", "1.0.0"))
+	if err := testClient.Update(ctx, catalog); err != nil {
+		t.Fatalf("expected unrelated update to be accepted, got: %v", err)
+	}
+
+	catalog.Spec.Helm.SanitizeAppNames = false
+	err := testClient.Update(ctx, catalog)
+	if err == nil {
+		t.Fatal("expected disabling sanitizeAppNames to be rejected")
+	}
+
+	if !strings.Contains(err.Error(), "sanitizeAppNames is immutable") {
+		t.Errorf("expected immutability error, got: %v", err)
+	}
+}
+
+func TestApplicationCatalogImportsMustBeUnique(t *testing.T) {
+	requireEnvtest(t)
+
+	ctx := context.Background()
+
+	catalog := newCatalog("cel-unique-imports", nil)
+	catalog.Spec.Imports = []catalogv1alpha1.CatalogImport{{Name: "platform"}, {Name: "platform"}}
+
+	err := testClient.Create(ctx, catalog)
+	if err == nil {
+		t.Cleanup(func() {
+			_ = testClient.Delete(ctx, catalog)
+		})
+		t.Fatal("expected catalog with duplicate imports to be rejected")
+	}
+
+	if !strings.Contains(err.Error(), "imported catalogs must be unique") {
+		t.Errorf("expected error about duplicate imports, got: %v", err)
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envtest_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestApplicationCatalogSourceCELValidation(t *testing.T) {
+	requireEnvtest(t)
+
+	tests := []struct {
+		name        string
+		spec        catalogv1alpha1.ApplicationCatalogSourceSpec
+		expectedErr string
+	}{
+		{
+			name: "http source is accepted",
+			spec: catalogv1alpha1.ApplicationCatalogSourceSpec{
+				HTTP: &catalogv1alpha1.HTTPCatalogSource{URL: "https://catalogs.example.com/catalog.yaml", Credentials: newCredentials()},
+			},
+		},
+		{
+			name: "git source is accepted",
+			spec: catalogv1alpha1.ApplicationCatalogSourceSpec{
+				Interval: metav1.Duration{Duration: time.Minute},
+				Git: &catalogv1alpha1.GitCatalogSource{
+					URL:  "https://github.com/example/catalogs.git",
+					Ref:  &catalogv1alpha1.GitReference{Tag: "v1.0.0"},
+					Path: "catalogs",
+				},
+			},
+		},
+		{
+			name: "oci source is accepted",
+			spec: catalogv1alpha1.ApplicationCatalogSourceSpec{
+				OCI: &catalogv1alpha1.OCICatalogSource{URL: "oci://quay.io/example/catalogs", Tag: "v1"},
+			},
+		},
+		{
+			name: "verification is accepted",
+			spec: catalogv1alpha1.ApplicationCatalogSourceSpec{
+				HTTP:         &catalogv1alpha1.HTTPCatalogSource{URL: "https://catalogs.example.com/catalog.yaml"},
+				Verification: &catalogv1alpha1.SignatureVerification{SecretName: "catalog-keys", Policy: catalogv1alpha1.SignaturePolicyVerifyIfSigned},
+			},
+		},
+		{
+			name: "unknown signature policy is rejected",
+			spec: catalogv1alpha1.ApplicationCatalogSourceSpec{
+				HTTP:         &catalogv1alpha1.HTTPCatalogSource{URL: "https://catalogs.example.com/catalog.yaml"},
+				Verification: &catalogv1alpha1.SignatureVerification{SecretName: "catalog-keys", Policy: "Never"},
+			},
+			expectedErr: "Unsupported value",
+		},
+		{
+			name: "interval below one minute is rejected",
+			spec: catalogv1alpha1.ApplicationCatalogSourceSpec{
+				Interval: metav1.Duration{Duration: 10 * time.Second},
+				HTTP:     &catalogv1alpha1.HTTPCatalogSource{URL: "https://catalogs.example.com/catalog.yaml"},
+			},
+			expectedErr: "interval must be at least 1m",
+		},
+		{
+			name:        "no source is rejected",
+			spec:        catalogv1alpha1.ApplicationCatalogSourceSpec{},
+			expectedErr: "exactly one of http, git or oci must be set",
+		},
+		{
+			name: "multiple sources are rejected",
+			spec: catalogv1alpha1.ApplicationCatalogSourceSpec{
+				HTTP: &catalogv1alpha1.HTTPCatalogSource{URL: "https://catalogs.example.com/catalog.yaml"},
+				OCI:  &catalogv1alpha1.OCICatalogSource{URL: "oci://quay.io/example/catalogs"},
+			},
+			expectedErr: "exactly one of http, git or oci must be set",
+		},
+		{
+			name: "http url without scheme is rejected",
+			spec: catalogv1alpha1.ApplicationCatalogSourceSpec{
+				HTTP: &catalogv1alpha1.HTTPCatalogSource{URL: "catalogs.example.com/catalog.yaml"},
+			},
+			expectedErr: "url must start with http:// or https://",
+		},
+		{
+			name: "multiple git references are rejected",
+			spec: catalogv1alpha1.ApplicationCatalogSourceSpec{
+				Git: &catalogv1alpha1.GitCatalogSource{
+					URL: "https://github.com/example/catalogs.git",
+					Ref: &catalogv1alpha1.GitReference{Branch: "main", Tag: "v1.0.0"},
+				},
+			},
+			expectedErr: "only one of branch, tag or commit can be set",
+		},
+		{
+			name: "oci tag and digest are rejected",
+			spec: catalogv1alpha1.ApplicationCatalogSourceSpec{
+				OCI: &catalogv1alpha1.OCICatalogSource{
+					URL:    "oci://quay.io/example/catalogs",
+					Tag:    "v1",
+					Digest: "sha256:" + strings.Repeat("0", 64),
+				},
+			},
+			expectedErr: "tag and digest are mutually exclusive",
+		},
+		{
+			name: "oci url without scheme is rejected",
+			spec: catalogv1alpha1.ApplicationCatalogSourceSpec{
+				OCI: &catalogv1alpha1.OCICatalogSource{URL: "quay.io/example/catalogs"},
+			},
+			expectedErr: "url must start with oci://",
+		},
+	}
+
+	for i, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			src := &catalogv1alpha1.ApplicationCatalogSource{
+				ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("cel-source-validation-%d", i)},
+				Spec:       tc.spec,
+			}
+
+			err := testClient.Create(ctx, src)
+			if err == nil {
+				t.Cleanup(func() {
+					_ = testClient.Delete(ctx, src)
+				})
+			}
+
+			if tc.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("expected source to be accepted, got: %v", err)
+				}
+				if src.Spec.Interval.Duration == 0 {
+					t.Error("expected interval to be defaulted")
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error containing %q, got nil", tc.expectedErr)
+			}
+
+			if !strings.Contains(err.Error(), tc.expectedErr) {
+				t.Errorf("expected error containing %q, got: %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envtest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	catalogv1beta1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1beta1"
+	catalogv1beta1apply "k8c.io/application-catalog-manager/pkg/client/applyconfiguration/applicationcatalog/v1beta1"
+	"k8c.io/application-catalog-manager/pkg/client/clientset/versioned"
+	"k8c.io/application-catalog-manager/pkg/client/informers/externalversions"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+func TestClientset(t *testing.T) {
+	requireEnvtest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	clientset, err := versioned.NewForConfig(testConfig)
+	if err != nil {
+		t.Fatalf("failed to create clientset: %v", err)
+	}
+
+	catalogs := clientset.ApplicationcatalogV1beta1().ApplicationCatalogs()
+
+	applied, err := catalogs.Apply(ctx,
+		catalogv1beta1apply.ApplicationCatalog("clientset").
+			WithSpec(catalogv1beta1apply.ApplicationCatalogSpec().
+				WithHelm(catalogv1beta1apply.HelmSpec().
+					WithIncludeDefaults(true).
+					WithIncludedDefaults("cert-manager"))),
+		metav1.ApplyOptions{FieldManager: "envtest"},
+	)
+	if err != nil {
+		t.Fatalf("failed to apply catalog: %v", err)
+	}
+	t.Cleanup(func() { _ = catalogs.Delete(context.Background(), applied.Name, metav1.DeleteOptions{}) })
+
+	factory := externalversions.NewSharedInformerFactory(clientset, 0)
+	lister := factory.Applicationcatalog().V1beta1().ApplicationCatalogs().Lister()
+
+	factory.Start(ctx.Done())
+	factory.WaitForCacheSync(ctx.Done())
+
+	var cached *catalogv1beta1.ApplicationCatalog
+	err = wait.PollUntilContextTimeout(ctx, 100*time.Millisecond, 10*time.Second, true, func(ctx context.Context) (bool, error) {
+		cached, err = lister.Get("clientset")
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return err == nil, err
+	})
+	if err != nil {
+		t.Fatalf("catalog did not appear in the lister: %v", err)
+	}
+
+	if included := cached.Spec.Helm.IncludedDefaults; len(included) != 1 || included[0] != "cert-manager" {
+		t.Errorf("expected included defaults [cert-manager], got %v", included)
+	}
+}
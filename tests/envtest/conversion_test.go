@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envtest_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	catalogv1beta1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1beta1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestApplicationCatalogConversion(t *testing.T) {
+	requireEnvtest(t)
+
+	ctx := context.Background()
+
+	// A catalog created as v1alpha1 is served as v1beta1 with the included defaults in the spec.
+	alpha := newCatalog("conversion-alpha", &catalogv1alpha1.HelmSpec{
+		IncludeDefaults: true,
+		Charts:          []catalogv1alpha1.ChartConfig{newChart("nginx", "1.0.0")},
+	})
+	alpha.Annotations = map[string]string{catalogv1alpha1.AnnotationIncludeDefaults: "cert-manager, argo-cd"}
+	if err := testClient.Create(ctx, alpha); err != nil {
+		t.Fatalf("failed to create v1alpha1 catalog: %v", err)
+	}
+	t.Cleanup(func() { _ = testClient.Delete(ctx, alpha) })
+
+	beta := &catalogv1beta1.ApplicationCatalog{}
+	if err := testClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(alpha), beta); err != nil {
+		t.Fatalf("failed to get catalog as v1beta1: %v", err)
+	}
+
+	if _, ok := beta.Annotations[catalogv1alpha1.AnnotationIncludeDefaults]; ok {
+		t.Errorf("expected the include annotation to be converted, got annotations %v", beta.Annotations)
+	}
+	if expected := []string{"cert-manager", "argo-cd"}; !reflect.DeepEqual(beta.Spec.Helm.IncludedDefaults, expected) {
+		t.Errorf("expected included defaults %v, got %v", expected, beta.Spec.Helm.IncludedDefaults)
+	}
+	if len(beta.Spec.Helm.Charts) != 1 || beta.Spec.Helm.Charts[0].ChartName != "nginx" {
+		t.Errorf("expected the charts to be converted, got %v", beta.Spec.Helm.Charts)
+	}
+
+	// A catalog created as v1beta1 is served as v1alpha1 with the include annotation.
+	created := &catalogv1beta1.ApplicationCatalog{
+		ObjectMeta: metav1.ObjectMeta{Name: "conversion-beta"},
+		Spec: catalogv1beta1.ApplicationCatalogSpec{
+			Helm: &catalogv1beta1.HelmSpec{IncludeDefaults: true, IncludedDefaults: []string{"cert-manager"}},
+		},
+	}
+	if err := testClient.Create(ctx, created); err != nil {
+		t.Fatalf("failed to create v1beta1 catalog: %v", err)
+	}
+	t.Cleanup(func() { _ = testClient.Delete(ctx, created) })
+
+	converted := &catalogv1alpha1.ApplicationCatalog{}
+	if err := testClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(created), converted); err != nil {
+		t.Fatalf("failed to get catalog as v1alpha1: %v", err)
+	}
+
+	if include := converted.Annotations[catalogv1alpha1.AnnotationIncludeDefaults]; include != "cert-manager" {
+		t.Errorf("expected include annotation %q, got %q", "cert-manager", include)
+	}
+	if !converted.Spec.Helm.IncludeDefaults {
+		t.Error("expected includeDefaults to be converted")
+	}
+
+	// Entries of the included defaults cannot hold multiple names.
+	invalid := created.DeepCopy()
+	invalid.ObjectMeta = metav1.ObjectMeta{Name: "conversion-invalid"}
+	invalid.Spec.Helm.IncludedDefaults = []string{"cert-manager,argo-cd"}
+	if err := testClient.Create(ctx, invalid); err == nil {
+		_ = testClient.Delete(ctx, invalid)
+		t.Error("expected a comma in the included defaults to be rejected")
+	}
+}
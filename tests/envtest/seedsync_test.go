@@ -0,0 +1,242 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envtest_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"k8c.io/application-catalog-manager/internal/controllers/seedsync"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/config"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+)
+
+const seedNamespace = "kubermatic"
+
+func newSeedSyncScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{
+		catalogv1alpha1.AddToScheme,
+		appskubermaticv1.AddToScheme,
+		kubermaticv1.AddToScheme,
+		corev1.AddToScheme,
+	} {
+		if err := add(scheme); err != nil {
+			t.Fatalf("failed to build scheme: %v", err)
+		}
+	}
+
+	return scheme
+}
+
+// startSeed starts another kube-apiserver acting as the cluster of a Seed, and registers the
+// Seed together with its kubeconfig Secret on the master. It returns a client for the Seed.
+func startSeed(t *testing.T, ctx context.Context, master ctrlruntimeclient.Client, scheme *runtime.Scheme, name string) ctrlruntimeclient.Client {
+	t.Helper()
+
+	env := &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("testdata", "crd")},
+		ErrorIfCRDPathMissing: true,
+	}
+
+	cfg, err := env.Start()
+	if err != nil {
+		t.Fatalf("failed to start Seed %q: %v", name, err)
+	}
+	t.Cleanup(func() {
+		if err := env.Stop(); err != nil {
+			t.Errorf("failed to stop Seed %q: %v", name, err)
+		}
+	})
+
+	user, err := env.AddUser(envtest.User{Name: "seedsync", Groups: []string{"system:masters"}}, cfg)
+	if err != nil {
+		t.Fatalf("failed to add user to Seed %q: %v", name, err)
+	}
+
+	kubeconfig, err := user.KubeConfig()
+	if err != nil {
+		t.Fatalf("failed to build kubeconfig of Seed %q: %v", name, err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "kubeconfig-" + name, Namespace: seedNamespace},
+		Data:       map[string][]byte{"kubeconfig": kubeconfig},
+	}
+	if err := master.Create(ctx, secret); err != nil {
+		t.Fatalf("failed to create kubeconfig Secret: %v", err)
+	}
+
+	seed := &kubermaticv1.Seed{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: seedNamespace},
+		Spec: kubermaticv1.SeedSpec{
+			Kubeconfig: corev1.ObjectReference{Name: secret.Name},
+		},
+	}
+	if err := master.Create(ctx, seed); err != nil {
+		t.Fatalf("failed to create Seed: %v", err)
+	}
+
+	seedClient, err := ctrlruntimeclient.New(cfg, ctrlruntimeclient.Options{Scheme: scheme})
+	if err != nil {
+		t.Fatalf("failed to create client for Seed %q: %v", name, err)
+	}
+
+	return seedClient
+}
+
+func TestSeedSync(t *testing.T) {
+	requireEnvtest(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme := newSeedSyncScheme(t)
+
+	master, err := ctrlruntimeclient.New(testConfig, ctrlruntimeclient.Options{Scheme: scheme})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	if err := master.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: seedNamespace}}); err != nil {
+		t.Fatalf("failed to create namespace: %v", err)
+	}
+
+	seeds := map[string]ctrlruntimeclient.Client{
+		"europe": startSeed(t, ctx, master, scheme, "europe"),
+		"asia":   startSeed(t, ctx, master, scheme, "asia"),
+	}
+
+	// No synchronizer is running, so the ApplicationDefinition generated for the catalog is
+	// created directly.
+	catalog := newCatalog("seedsync", &catalogv1alpha1.HelmSpec{Charts: []catalogv1alpha1.ChartConfig{newChart("nginx", "1.0.0")}})
+	if err := master.Create(ctx, catalog); err != nil {
+		t.Fatalf("failed to create catalog: %v", err)
+	}
+
+	appDef := &appskubermaticv1.ApplicationDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "seedsync-nginx",
+			Labels: map[string]string{
+				catalogv1alpha1.LabelManagedByApplicationCatalog: "true",
+				catalogv1alpha1.LabelApplicationCatalogName:      catalog.Name,
+			},
+		},
+		Spec: appskubermaticv1.ApplicationDefinitionSpec{
+			Description: "nginx",
+			Method:      appskubermaticv1.HelmTemplateMethod,
+			Versions: []appskubermaticv1.ApplicationVersion{{
+				Version: "v1.0.0",
+				Template: appskubermaticv1.ApplicationTemplate{
+					Source: appskubermaticv1.ApplicationSource{
+						Helm: &appskubermaticv1.HelmSource{URL: "https://charts.example.com", ChartName: "nginx", ChartVersion: "1.0.0"},
+					},
+				},
+			}},
+		},
+	}
+	if err := master.Create(ctx, appDef); err != nil {
+		t.Fatalf("failed to create ApplicationDefinition: %v", err)
+	}
+
+	mgr, err := manager.New(testConfig, manager.Options{
+		Scheme:     scheme,
+		Metrics:    metricsserver.Options{BindAddress: "0"},
+		Controller: config.Controller{SkipNameValidation: ptr.To(true)},
+	})
+	if err != nil {
+		t.Fatalf("failed to create manager: %v", err)
+	}
+
+	if err := seedsync.Add(mgr, &seedsync.ControllerConfig{Log: zap.NewNop().Sugar(), Namespace: seedNamespace}); err != nil {
+		t.Fatalf("failed to add controller: %v", err)
+	}
+
+	go func() {
+		if err := mgr.Start(ctx); err != nil {
+			t.Errorf("failed to start manager: %v", err)
+		}
+	}()
+
+	eventually := func(description string, condition func() bool) {
+		t.Helper()
+
+		err := wait.PollUntilContextTimeout(ctx, 250*time.Millisecond, 30*time.Second, true, func(context.Context) (bool, error) {
+			return condition(), nil
+		})
+		if err != nil {
+			t.Fatalf("timed out waiting until %s", description)
+		}
+	}
+
+	for name, seedClient := range seeds {
+		eventually("the ApplicationDefinition is synced to Seed "+name, func() bool {
+			synced := &appskubermaticv1.ApplicationDefinition{}
+			if err := seedClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(appDef), synced); err != nil {
+				return false
+			}
+			return synced.Labels[catalogv1alpha1.LabelApplicationCatalogName] == catalog.Name && synced.Spec.Description == "nginx"
+		})
+	}
+
+	eventually("the catalog status reports both Seeds as synced", func() bool {
+		current := &catalogv1alpha1.ApplicationCatalog{}
+		if err := master.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(catalog), current); err != nil {
+			return false
+		}
+		return len(current.Status.Seeds) == 2 && current.Status.Seeds[0].Synced && current.Status.Seeds[1].Synced
+	})
+
+	// Unmanaging the ApplicationDefinition on the master unmanages it on the Seeds.
+	if err := master.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(appDef), appDef); err != nil {
+		t.Fatalf("failed to get ApplicationDefinition: %v", err)
+	}
+	appDef.Labels = nil
+	appDef.Spec.Description = "unmanaged nginx"
+	if err := master.Update(ctx, appDef); err != nil {
+		t.Fatalf("failed to update ApplicationDefinition: %v", err)
+	}
+
+	for name, seedClient := range seeds {
+		eventually("the ApplicationDefinition is unmanaged on Seed "+name, func() bool {
+			synced := &appskubermaticv1.ApplicationDefinition{}
+			if err := seedClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(appDef), synced); err != nil {
+				return false
+			}
+			_, managed := synced.Labels[catalogv1alpha1.LabelApplicationCatalogName]
+			return !managed && synced.Spec.Description == "unmanaged nginx"
+		})
+	}
+}
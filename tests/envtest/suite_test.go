@@ -0,0 +1,138 @@
+/*
+Copyright 2026 The Application Catalog Manager contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envtest_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"k8c.io/application-catalog-manager/internal/controllers/crdconversion"
+	catalogv1alpha1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1alpha1"
+	catalogv1beta1 "k8c.io/application-catalog-manager/pkg/apis/applicationcatalog/v1beta1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+)
+
+// testClient talks to a kube-apiserver started by envtest with the CRDs from
+// deploy/crd installed. Only the conversion webhook is running, so these tests
+// only cover what the API server enforces on its own (schema and CEL rules),
+// unless a test starts a controller itself. testClient knows all versions of
+// the API.
+var testClient ctrlruntimeclient.Client
+
+// testConfig is the configuration of the kube-apiserver testClient talks to.
+var testConfig *rest.Config
+
+func TestMain(m *testing.M) {
+	// Without the envtest binaries, the tests are run without a kube-apiserver, so that
+	// every test reports itself as skipped.
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		os.Exit(m.Run())
+	}
+
+	os.Exit(run(m))
+}
+
+// requireEnvtest skips the test if no kube-apiserver was started.
+func requireEnvtest(t *testing.T) {
+	t.Helper()
+
+	if testClient == nil {
+		t.Skip("KUBEBUILDER_ASSETS is not set, skipping envtest-based test (use 'make test-envtest')")
+	}
+}
+
+func run(m *testing.M) int {
+	scheme := runtime.NewScheme()
+	if err := catalogv1alpha1.AddToScheme(scheme); err != nil {
+		fmt.Printf("failed to add catalogv1alpha1 to scheme: %v\n", err)
+		return 1
+	}
+	if err := catalogv1beta1.AddToScheme(scheme); err != nil {
+		fmt.Printf("failed to add catalogv1beta1 to scheme: %v\n", err)
+		return 1
+	}
+
+	// envtest configures the conversion of the CRDs of the convertible types in the scheme
+	// to use the webhook server started below.
+	env := &envtest.Environment{
+		Scheme:                scheme,
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "deploy", "crd"), filepath.Join("testdata", "crd")},
+		ErrorIfCRDPathMissing: true,
+	}
+
+	var err error
+	testConfig, err = env.Start()
+	if err != nil {
+		fmt.Printf("failed to start test environment: %v\n", err)
+		return 1
+	}
+
+	defer func() {
+		if err := env.Stop(); err != nil {
+			fmt.Printf("failed to stop test environment: %v\n", err)
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if err := startConversionWebhook(ctx, env, scheme); err != nil {
+		fmt.Printf("failed to start conversion webhook: %v\n", err)
+		return 1
+	}
+
+	testClient, err = ctrlruntimeclient.New(testConfig, ctrlruntimeclient.Options{Scheme: scheme})
+	if err != nil {
+		fmt.Printf("failed to create client: %v\n", err)
+		return 1
+	}
+
+	return m.Run()
+}
+
+// startConversionWebhook serves the conversion webhook like cmd/webhook does, and waits
+// until it accepts connections.
+func startConversionWebhook(ctx context.Context, env *envtest.Environment, scheme *runtime.Scheme) error {
+	options := env.WebhookInstallOptions
+	server := webhook.NewServer(webhook.Options{
+		Host:    options.LocalServingHost,
+		Port:    options.LocalServingPort,
+		CertDir: options.LocalServingCertDir,
+	})
+	server.Register(crdconversion.WebhookPath, conversion.NewWebhookHandler(scheme))
+
+	go func() {
+		if err := server.Start(ctx); err != nil {
+			fmt.Printf("webhook server failed: %v\n", err)
+		}
+	}()
+
+	return wait.PollUntilContextTimeout(ctx, 100*time.Millisecond, 10*time.Second, true, func(context.Context) (bool, error) {
+		return server.StartedChecker()(nil) == nil, nil
+	})
+}